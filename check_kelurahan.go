@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/rifqidaiva/stunting-web/internal/wilayah"
+)
+
+// runCheckKelurahan runs the check-kelurahan command, which lists the
+// active keluarga whose id_kelurahan does not match the kelurahan their
+// koordinat lies in. With -fix the located kelurahan is stored; keluarga
+// outside every kelurahan or with koordinat out of range are only
+// reported, their koordinat has to be corrected by hand.
+func runCheckKelurahan(args []string) error {
+	flags := flag.NewFlagSet("check-kelurahan", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	fix := flags.Bool("fix", false, "store the kelurahan located from the koordinat")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	_, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	mismatches, err := wilayah.FindMismatches(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	fixed, outside := 0, 0
+	for _, m := range mismatches {
+		stored := "no kelurahan"
+		if m.IdKelurahan != "" {
+			stored = fmt.Sprintf("kelurahan %s (%s)", m.IdKelurahan, m.Kelurahan)
+		}
+		if m.Invalid {
+			outside++
+			fmt.Printf("keluarga %s: %s, koordinat %v is not a [longitude, latitude] pair\n", m.IdKeluarga, stored, m.Koordinat)
+			continue
+		}
+		if m.Outside {
+			outside++
+			fmt.Printf("keluarga %s: %s, koordinat outside every kelurahan\n", m.IdKeluarga, stored)
+			continue
+		}
+		fmt.Printf("keluarga %s: %s, koordinat in kelurahan %s (%s, kecamatan %s)\n",
+			m.IdKeluarga, stored, m.Located.Id, m.Located.Kelurahan, m.Located.Kecamatan)
+
+		if *fix {
+			if err := wilayah.Fix(ctx, db, m); err != nil {
+				return err
+			}
+			fixed++
+		}
+	}
+
+	fmt.Printf("%d mismatches, %d outside every kelurahan, %d fixed\n", len(mismatches), outside, fixed)
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/legacy"
+)
+
+// runConvertLegacy runs the convert-legacy command, which copies the rows of
+// a database with the legacy stuntingdb schema into the configured database
+// and prints the reconciliation report. Running it again only retries the
+// failed rows.
+func runConvertLegacy(args []string) error {
+	flags := flag.NewFlagSet("convert-legacy", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	sourceDSN := flags.String("source", os.Getenv("STUNTING_LEGACY_DSN"), "DSN of the legacy database, e.g. user:pass@tcp(localhost:3306)/stuntingdb")
+	petugas := flags.String("petugas", "", "comma separated emails of legacy users that become petugas kesehatan")
+	idSkpd := flags.String("skpd", "", "skpd id for the converted petugas kesehatan")
+	reportPath := flags.String("report", "", "write the reconciliation report as CSV to this file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *sourceDSN == "" {
+		return errors.New("convert-legacy: -source or STUNTING_LEGACY_DSN is required")
+	}
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	keys, err := fieldcrypt.NewKeyring(cfg.Encryption)
+	if err != nil {
+		return err
+	}
+
+	source, err := sql.Open("mysql", *sourceDSN)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	opts := legacy.Options{IdSkpd: *idSkpd, Keys: keys}
+	for _, email := range strings.Split(*petugas, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			opts.PetugasEmails = append(opts.PetugasEmails, email)
+		}
+	}
+
+	report, runErr := legacy.NewConverter(source, db, opts).Run(context.Background())
+	if report != nil {
+		if err := report.WriteText(os.Stdout); err != nil {
+			return err
+		}
+		if *reportPath != "" {
+			file, err := os.Create(*reportPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			if err := report.WriteCSV(file); err != nil {
+				return err
+			}
+		}
+	}
+	return runErr
+}
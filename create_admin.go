@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/api/auth"
+)
+
+// runCreateAdmin runs the create-admin command, which creates an admin
+// account without going through the HTTP API, e.g. the first admin of a
+// new installation.
+func runCreateAdmin(args []string) error {
+	flags := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	email := flags.String("email", "", "email of the new admin")
+	password := flags.String("password", "", "password of the new admin, read from standard input when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *email == "" {
+		return errors.New("create-admin: -email is required")
+	}
+
+	// Reading the password from stdin keeps it out of the shell history
+	if *password == "" {
+		fmt.Fprint(os.Stderr, "Password: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("create-admin: read password: %w", err)
+		}
+		*password = strings.TrimRight(line, "\r\n")
+	}
+
+	_, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	adminID, err := auth.CreateAdmin(db, *email, *password)
+	if err != nil {
+		return fmt.Errorf("create-admin: %w", err)
+	}
+	fmt.Printf("admin %s created with id %d\n", *email, adminID)
+	return nil
+}
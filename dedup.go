@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/rifqidaiva/stunting-web/internal/dedup"
+)
+
+// runDedup runs the dedup command, which compares the active keluarga and
+// balita and queues the likely duplicates for review by an admin in
+// /api/admin/duplikat/get. It is meant to run periodically, e.g. nightly
+// from cron; pairs already merged or rejected are not queued again.
+func runDedup(args []string) error {
+	flags := flag.NewFlagSet("dedup", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	_, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	results, err := dedup.Scan(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		fmt.Printf("%s: %d records, %d pairs compared, %d candidates\n",
+			result.Entity, result.Records, result.Compared, result.Candidates)
+	}
+	return nil
+}
@@ -23,14 +23,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/admin/balita/delete": {
-            "delete": {
+        "/api/admin/access-log/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete balita data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+                "description": "Get reads of keluarga, balita and laporan masyarakat data, newest first (Admin only)\n\nEvery request to the keluarga, balita, laporan masyarakat and balita points endpoints\nis recorded with the viewer, the stated purpose (X-Access-Purpose header or tujuan\nquery parameter) and the ids of the returned records.\n\nWith entitas and id_entitas the log answers who accessed one record. Reads of a\nkeluarga include reads of its balita and their laporan masyarakat, reads of a balita\ninclude reads of its laporan masyarakat.\n\nReads in which an admin asked for the unmasked NIK, nomor KK and phone numbers\n(lengkap=true) are marked privileged, use privileged=true to list only those.",
                 "consumes": [
                     "application/json"
                 ],
@@ -40,21 +40,42 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete balita data (soft delete)",
+                "summary": "Get access log",
                 "parameters": [
                     {
-                        "description": "Balita ID to delete",
-                        "name": "balita",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.deleteBalitaRequest"
-                        }
+                        "type": "string",
+                        "description": "Entity (keluarga, balita, laporan_masyarakat)",
+                        "name": "entitas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record ID, used together with entitas",
+                        "name": "id_entitas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pengguna ID of the viewer",
+                        "name": "id_pengguna",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of days to look back (default 90)",
+                        "name": "hari",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only list reads of unmasked data",
+                        "name": "privileged",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita deleted successfully",
+                        "description": "Access log retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -64,7 +85,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteBalitaResponse"
+                                            "$ref": "#/definitions/admin.getAccessLogResponse"
                                         }
                                     }
                                 }
@@ -125,24 +146,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Balita not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -164,14 +167,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/balita/get": {
+        "/api/admin/audit-log/get": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get balita data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all balita with total count\n- With id parameter: Returns specific balita data\n\nBalita data includes: nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, umur, keluarga info, location info",
+                "description": "Get audit log entries, newest first (Admin only)\n\nEvery insert, update, delete and restore is recorded with the actor, timestamp,\nrequest id and the changed columns with their old and new values.\n\nFilters can be combined:\n- entitas: table name, e.g. balita, keluarga, riwayat_pemeriksaan\n- id_entitas: id of one record, used together with entitas\n- id_pengguna: pengguna who made the changes\n- id_request: every change of one request (X-Request-Id response header)",
                 "consumes": [
                     "application/json"
                 ],
@@ -181,18 +184,54 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get balita data",
+                "summary": "Get audit log",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Balita ID",
-                        "name": "id",
+                        "description": "Entity (table name)",
+                        "name": "entitas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Record ID",
+                        "name": "id_entitas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pengguna ID of the actor",
+                        "name": "id_pengguna",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "id_request",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action (create, update, delete, restore, revoke, merge)",
+                        "name": "aksi",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries to skip",
+                        "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita data retrieved successfully",
+                        "description": "Audit log retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -202,7 +241,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllBalitaResponse"
+                                            "$ref": "#/definitions/admin.getAuditLogResponse"
                                         }
                                     }
                                 }
@@ -263,24 +302,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Balita not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -302,14 +323,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/balita/insert": {
-            "post": {
+        "/api/admin/balita/delete": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new balita data (Admin only)\n\nInserts balita record with data including:\n- id_keluarga, nama, tanggal_lahir, jenis_kelamin\n- berat_lahir (in grams), tinggi_lahir (in cm)\n- Validates keluarga existence and balita age criteria (under 5 years)",
+                "description": "Soft delete balita data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion\n\nWith cascade, the active laporan, intervensi and riwayat pemeriksaan of the balita\nare soft deleted together with the balita instead of blocking the deletion.",
                 "consumes": [
                     "application/json"
                 ],
@@ -319,21 +340,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new balita",
+                "summary": "Delete balita data (soft delete)",
                 "parameters": [
                     {
-                        "description": "Balita data",
+                        "description": "Balita ID to delete",
                         "name": "balita",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.insertBalitaRequest"
+                            "$ref": "#/definitions/admin.deleteBalitaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita inserted successfully",
+                        "description": "Balita deleted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -343,7 +364,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertBalitaResponse"
+                                            "$ref": "#/definitions/admin.deleteBalitaResponse"
                                         }
                                     }
                                 }
@@ -404,6 +425,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Balita not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -425,14 +464,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/balita/restore": {
-            "post": {
+        "/api/admin/balita/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted balita data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Get balita data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all balita with total count\n- With id parameter: Returns specific balita data\n\nBalita data includes: nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, umur, keluarga info, location info\n\nnomor_kk is masked unless lengkap=true, which is logged as a privileged read.",
                 "consumes": [
                     "application/json"
                 ],
@@ -442,21 +481,24 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Restore deleted balita data",
+                "summary": "Get balita data",
                 "parameters": [
                     {
-                        "description": "Balita ID to restore",
-                        "name": "balita",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.deleteBalitaRequest"
-                        }
+                        "type": "string",
+                        "description": "Balita ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked nomor KK",
+                        "name": "lengkap",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita restored successfully",
+                        "description": "Balita data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -466,7 +508,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteBalitaResponse"
+                                            "$ref": "#/definitions/admin.getAllBalitaResponse"
                                         }
                                     }
                                 }
@@ -566,14 +608,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/balita/update": {
-            "put": {
+        "/api/admin/balita/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing balita data (Admin only)\n\nUpdates balita record with new data including:\n- id_keluarga, nama, tanggal_lahir, jenis_kelamin\n- berat_lahir (in grams), tinggi_lahir (in cm)\n- Validates keluarga existence, balita age criteria, and prevents duplicates",
+                "description": "Insert new balita data (Admin only)\n\nInserts balita record with data including:\n- id_keluarga, nama, tanggal_lahir, jenis_kelamin\n- berat_lahir (in grams), tinggi_lahir (in cm)\n- Validates keluarga existence and balita age criteria (under 5 years)",
                 "consumes": [
                     "application/json"
                 ],
@@ -583,21 +625,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Update balita data",
+                "summary": "Insert new balita",
                 "parameters": [
                     {
-                        "description": "Updated balita data",
+                        "description": "Balita data",
                         "name": "balita",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updateBalitaRequest"
+                            "$ref": "#/definitions/admin.insertBalitaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita updated successfully",
+                        "description": "Balita inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -607,7 +649,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.updateBalitaResponse"
+                                            "$ref": "#/definitions/admin.insertBalitaResponse"
                                         }
                                     }
                                 }
@@ -668,24 +710,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Balita not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -707,14 +731,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/geojson-balita-points": {
-            "get": {
+        "/api/admin/balita/restore": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get balita locations as GeoJSON points with status laporan (Admin only)",
+                "description": "Restore soft deleted balita data by clearing deleted_date and deleted_id (Admin only)\n\nWith cascade, the laporan, intervensi and riwayat pemeriksaan that were deleted\ntogether with the balita (same deleted_id and deleted_date) are restored as well.",
                 "consumes": [
                     "application/json"
                 ],
@@ -724,30 +748,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get balita points GeoJSON",
+                "summary": "Restore deleted balita data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filter by status laporan",
-                        "name": "status_laporan",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by kecamatan",
-                        "name": "id_kecamatan",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by kelurahan",
-                        "name": "id_kelurahan",
-                        "in": "query"
+                        "description": "Balita ID to restore",
+                        "name": "balita",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteBalitaRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita points GeoJSON retrieved successfully",
+                        "description": "Balita restored successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -757,7 +772,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
+                                            "$ref": "#/definitions/admin.deleteBalitaResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -800,6 +833,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Balita not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -821,14 +872,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/geojson-kecamatan": {
-            "get": {
-                "security": [
+        "/api/admin/balita/update": {
+            "put": {
+                "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get kecamatan boundary areas as GeoJSON MultiPolygon (Admin only)",
+                "description": "Update existing balita data (Admin only)\n\nUpdates balita record with new data including:\n- id_keluarga, nama, tanggal_lahir, jenis_kelamin\n- berat_lahir (in grams), tinggi_lahir (in cm)\n- Validates keluarga existence, balita age criteria, and prevents duplicates",
                 "consumes": [
                     "application/json"
                 ],
@@ -838,36 +889,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get kecamatan area GeoJSON",
+                "summary": "Update balita data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Specific Kecamatan ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Updated balita data",
+                        "name": "balita",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.updateBalitaRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Kecamatan GeoJSON retrieved successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Balita updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -877,15 +913,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/admin.updateBalitaResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "allOf": [
                                 {
@@ -902,8 +938,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -919,63 +955,9 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/api/admin/geojson-kelurahan": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get kelurahan boundary areas as GeoJSON MultiPolygon (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "admin"
-                ],
-                "summary": "Get kelurahan area GeoJSON",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Specific Kelurahan ID",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by Kecamatan ID",
-                        "name": "id_kecamatan",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Kelurahan GeoJSON retrieved successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -992,8 +974,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Balita not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -1031,14 +1013,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi-petugas/assign": {
-            "post": {
+        "/api/admin/duplikat/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Assign petugas kesehatan to specific intervensi (Admin only)\n\nCreates assignment between petugas and intervensi:\n- Validates both intervensi and petugas existence\n- Prevents duplicate assignments\n- Ensures petugas is active and not soft deleted\n- Ensures intervensi is active and not soft deleted",
+                "description": "Get the keluarga and balita that were likely recorded twice, highest score first (Admin only)\n\nCandidates are found by the dedup command, which scores pairs of active records\nfrom 0 to 1 on nomor KK, NIK, normalized names, birth date and the distance between\nthe koordinat of the keluarga. alasan lists the features that matched.\n\nMerge a candidate with /api/admin/duplikat/merge or reject it with\n/api/admin/duplikat/reject. Rejected pairs are not proposed again.\nnomor_kk, nik_ayah and nik_ibu are masked unless lengkap=true.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1048,21 +1030,30 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Assign petugas to intervensi",
+                "summary": "Get candidate duplicates",
                 "parameters": [
                     {
-                        "description": "Assignment data",
-                        "name": "assignment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.assignIntervensiPetugasRequest"
-                        }
+                        "type": "string",
+                        "description": "Entity (keluarga, balita)",
+                        "name": "entitas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review status (menunggu, digabung, ditolak), default menunggu",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked NIK and nomor KK, logged as privileged access",
+                        "name": "lengkap",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Petugas assigned successfully",
+                        "description": "Candidate duplicates retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1072,7 +1063,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.assignIntervensiPetugasResponse"
+                                            "$ref": "#/definitions/admin.getDuplikatResponse"
                                         }
                                     }
                                 }
@@ -1154,14 +1145,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi-petugas/get": {
-            "get": {
+        "/api/admin/duplikat/merge": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get intervensi petugas assignments based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all assignments with total count\n- With id parameter: Returns specific assignment data\n- With id_intervensi parameter: Returns all petugas assigned to specific intervensi\n- With id_petugas_kesehatan parameter: Returns all intervensi assigned to specific petugas\n\nAssignment data includes: intervensi info, petugas info, SKPD info",
+                "description": "Merge the duplikat record of a candidate into the surviving record (Admin only)\n\nBy default the id_utama of the candidate survives, pass id_utama to keep the other\nrecord instead. In one transaction:\n- keluarga: every balita of the duplikat is moved to the surviving keluarga\n- balita: every laporan masyarakat, riwayat pemeriksaan and intervensi of the duplikat\nis moved to the surviving balita\n- the duplikat is soft deleted and recorded in the audit log with action merge\n- other pending candidates of the duplikat are removed\n\nEvery moved row is recorded in the audit log. Balita of merged keluarga that are\nthemselves duplicates are found by the next run of the dedup command.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1171,30 +1162,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get intervensi petugas assignments",
+                "summary": "Merge a candidate duplicate",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Assignment ID",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Intervensi ID",
-                        "name": "id_intervensi",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Petugas Kesehatan ID",
-                        "name": "id_petugas_kesehatan",
-                        "in": "query"
+                        "description": "Candidate to merge",
+                        "name": "duplikat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.mergeDuplikatRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Assignment data retrieved successfully",
+                        "description": "Candidate merged successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1204,7 +1186,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllIntervensiPetugasResponse"
+                                            "$ref": "#/definitions/admin.mergeDuplikatResponse"
                                         }
                                     }
                                 }
@@ -1266,7 +1248,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Assignment not found",
+                        "description": "Candidate or record not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -1304,14 +1286,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi-petugas/remove": {
-            "delete": {
+        "/api/admin/duplikat/reject": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Remove petugas kesehatan assignment from intervensi (Admin only)\n\nRemoves assignment between petugas and intervensi:\n- Validates assignment existence\n- Provides detailed information about removed assignment",
+                "description": "Mark a candidate as not a duplicate (Admin only)\n\nBoth records stay unchanged and the pair is not proposed again by the dedup command.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1321,21 +1303,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Remove petugas assignment from intervensi",
+                "summary": "Reject a candidate duplicate",
                 "parameters": [
                     {
-                        "description": "Assignment ID to remove",
-                        "name": "assignment",
+                        "description": "Candidate to reject",
+                        "name": "duplikat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.removeIntervensiPetugasRequest"
+                            "$ref": "#/definitions/admin.rejectDuplikatRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Assignment removed successfully",
+                        "description": "Candidate rejected successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1345,7 +1327,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.removeIntervensiPetugasResponse"
+                                            "$ref": "#/definitions/admin.rejectDuplikatResponse"
                                         }
                                     }
                                 }
@@ -1407,7 +1389,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Assignment not found",
+                        "description": "Candidate not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -1445,14 +1427,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi/delete": {
-            "delete": {
+        "/api/admin/geojson-balita-points": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete intervensi data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+                "description": "Get balita locations as GeoJSON points with status laporan (Admin only)\n\nnomor_kk is masked unless lengkap=true, which is logged as a privileged read.\n\nbbox limits the balita to the visible part of the map. Below zoom 16 the balita are\ngrouped into clusters of about 60 pixels instead: cluster features (type cluster)\nwith jumlah, the number of balita per status_gizi and per status_laporan, the color\nof the most severe status gizi and the expansion_zoom at which the cluster splits.\nClusters carry no names and are not recorded in the access log. Without zoom the\nbalita are clustered as at zoom 0, individual points need a zoom of 16 or more.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1462,21 +1444,48 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete intervensi data (soft delete)",
+                "summary": "Get balita points GeoJSON",
                 "parameters": [
                     {
-                        "description": "Intervensi ID to delete",
-                        "name": "intervensi",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.deleteIntervensiRequest"
-                        }
+                        "type": "string",
+                        "description": "Filter by status laporan",
+                        "name": "status_laporan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by kecamatan",
+                        "name": "id_kecamatan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by kelurahan",
+                        "name": "id_kelurahan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Visible area as minLon,minLat,maxLon,maxLat",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Zoom level of the map (0-20), clusters below 16 and when missing",
+                        "name": "zoom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked nomor KK",
+                        "name": "lengkap",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Intervensi deleted successfully",
+                        "description": "Balita points GeoJSON retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1486,7 +1495,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteIntervensiResponse"
+                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
                                         }
                                     }
                                 }
@@ -1547,24 +1556,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Intervensi not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1586,14 +1577,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi/get": {
+        "/api/admin/geojson-kecamatan": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get intervensi data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all intervensi with total count\n- With id parameter: Returns specific intervensi data\n\nIntervensi data includes: jenis, tanggal, deskripsi, hasil, petugas count, riwayat count, creation/update info",
+                "description": "Get kecamatan boundary areas as GeoJSON MultiPolygon (Admin only)\n\nWith statistik=true each feature also carries the stunting statistics of the\nkecamatan: jumlah_balita (under five at the end of the period), jumlah_diperiksa,\njumlah_stunting and jumlah_gizi_buruk by the last pemeriksaan in the period,\nprevalensi (percentage of the examined balita with stunting or gizi buruk, null\nwithout pemeriksaan), jumlah_laporan_terbuka (laporan not yet in a final status),\nklasifikasi with its color (sangat rendah \u003c 2.5, rendah \u003c 10, sedang \u003c 20,\ntinggi \u003c 30, sangat tinggi, or tidak ada data) and the periode_dari and periode_sampai.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1603,18 +1594,36 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get intervensi data",
+                "summary": "Get kecamatan area GeoJSON",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Intervensi ID",
+                        "description": "Specific Kecamatan ID",
                         "name": "id",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add the stunting statistics to the properties",
+                        "name": "statistik",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (YYYY-MM or YYYY-MM-DD), default 12 months before sampai",
+                        "name": "dari",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (YYYY-MM or YYYY-MM-DD), default today",
+                        "name": "sampai",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Intervensi data retrieved successfully",
+                        "description": "Kecamatan GeoJSON retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1624,7 +1633,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllIntervensiResponse"
+                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
                                         }
                                     }
                                 }
@@ -1685,9 +1694,117 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Intervensi not found",
-                        "schema": {
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/geojson-kelurahan": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get kelurahan boundary areas as GeoJSON MultiPolygon (Admin only)\n\nWith statistik=true each feature also carries the stunting statistics of the\nkelurahan: jumlah_balita (under five at the end of the period), jumlah_diperiksa,\njumlah_stunting and jumlah_gizi_buruk by the last pemeriksaan in the period,\nprevalensi (percentage of the examined balita with stunting or gizi buruk, null\nwithout pemeriksaan), jumlah_laporan_terbuka (laporan not yet in a final status),\nklasifikasi with its color (sangat rendah \u003c 2.5, rendah \u003c 10, sedang \u003c 20,\ntinggi \u003c 30, sangat tinggi, or tidak ada data) and the periode_dari and periode_sampai.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get kelurahan area GeoJSON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Specific Kelurahan ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by Kecamatan ID",
+                        "name": "id_kecamatan",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Kelurahan GeoJSON retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/object.GeoJSONFeatureCollection"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
                             "allOf": [
                                 {
                                     "$ref": "#/definitions/object.Response"
@@ -1724,14 +1841,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi/insert": {
+        "/api/admin/intervensi-petugas/assign": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new intervensi data (Admin only)\n\nCreates a new intervensi record with:\n- id_balita: ID of the balita being intervened\n- jenis: type of intervention (gizi, kesehatan, sosial)\n- tanggal: intervention date (YYYY-MM-DD format)\n- deskripsi: detailed description of the intervention\n- hasil: results or outcomes of the intervention\n- Validates intervention type and date constraints",
+                "description": "Assign petugas kesehatan to specific intervensi (Admin only)\n\nCreates assignment between petugas and intervensi:\n- Validates both intervensi and petugas existence\n- Prevents duplicate assignments\n- Ensures petugas is active and not soft deleted\n- Ensures intervensi is active and not soft deleted\n- Rejects completed or cancelled intervensi",
                 "consumes": [
                     "application/json"
                 ],
@@ -1741,21 +1858,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new intervensi",
+                "summary": "Assign petugas to intervensi",
                 "parameters": [
                     {
-                        "description": "Intervensi data",
-                        "name": "intervensi",
+                        "description": "Assignment data",
+                        "name": "assignment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.insertIntervensiRequest"
+                            "$ref": "#/definitions/admin.assignIntervensiPetugasRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Intervensi inserted successfully",
+                        "description": "Petugas assigned successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1765,7 +1882,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertIntervensiResponse"
+                                            "$ref": "#/definitions/admin.assignIntervensiPetugasResponse"
                                         }
                                     }
                                 }
@@ -1847,14 +1964,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi/restore": {
-            "post": {
+        "/api/admin/intervensi-petugas/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted intervensi data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Get intervensi petugas assignments based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all assignments with total count\n- With id parameter: Returns specific assignment data\n- With id_intervensi parameter: Returns all petugas assigned to specific intervensi\n- With id_petugas_kesehatan parameter: Returns all intervensi assigned to specific petugas\n\nAssignment data includes: intervensi info, petugas info, SKPD info",
                 "consumes": [
                     "application/json"
                 ],
@@ -1864,21 +1981,30 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Restore deleted intervensi data",
+                "summary": "Get intervensi petugas assignments",
                 "parameters": [
                     {
-                        "description": "Intervensi ID to restore",
-                        "name": "intervensi",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.deleteIntervensiRequest"
-                        }
+                        "type": "string",
+                        "description": "Assignment ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Intervensi ID",
+                        "name": "id_intervensi",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Petugas Kesehatan ID",
+                        "name": "id_petugas_kesehatan",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Intervensi restored successfully",
+                        "description": "Assignment data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1888,7 +2014,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteIntervensiResponse"
+                                            "$ref": "#/definitions/admin.getAllIntervensiPetugasResponse"
                                         }
                                     }
                                 }
@@ -1950,7 +2076,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Intervensi not found",
+                        "description": "Assignment not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -1988,14 +2114,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/intervensi/update": {
-            "put": {
+        "/api/admin/intervensi-petugas/remove": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing intervensi data (Admin only)\n\nUpdates intervensi record including:\n- id_balita: ID of the balita being intervened\n- jenis: type of intervention (gizi, kesehatan, sosial)\n- tanggal: intervention date (YYYY-MM-DD format)\n- deskripsi: detailed description of the intervention\n- hasil: results or outcomes of the intervention\n- Validates intervention type and date constraints\n- Checks for related records before allowing changes",
+                "description": "Remove petugas kesehatan assignment from intervensi (Admin only)\n\nRemoves assignment between petugas and intervensi:\n- Validates assignment existence\n- Provides detailed information about removed assignment",
                 "consumes": [
                     "application/json"
                 ],
@@ -2005,21 +2131,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Update intervensi data",
+                "summary": "Remove petugas assignment from intervensi",
                 "parameters": [
                     {
-                        "description": "Updated intervensi data",
-                        "name": "intervensi",
+                        "description": "Assignment ID to remove",
+                        "name": "assignment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updateIntervensiRequest"
+                            "$ref": "#/definitions/admin.removeIntervensiPetugasRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Intervensi updated successfully",
+                        "description": "Assignment removed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2029,7 +2155,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.updateIntervensiResponse"
+                                            "$ref": "#/definitions/admin.removeIntervensiPetugasResponse"
                                         }
                                     }
                                 }
@@ -2091,7 +2217,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Intervensi not found",
+                        "description": "Assignment not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2129,14 +2255,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/keluarga/delete": {
+        "/api/admin/intervensi/delete": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete keluarga data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future",
+                "description": "Soft delete intervensi data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
                 "consumes": [
                     "application/json"
                 ],
@@ -2146,21 +2272,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete keluarga data (soft delete)",
+                "summary": "Delete intervensi data (soft delete)",
                 "parameters": [
                     {
-                        "description": "Keluarga ID to delete",
-                        "name": "keluarga",
+                        "description": "Intervensi ID to delete",
+                        "name": "intervensi",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteKeluargaRequest"
+                            "$ref": "#/definitions/admin.deleteIntervensiRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga deleted successfully",
+                        "description": "Intervensi deleted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2170,7 +2296,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteKeluargaResponse"
+                                            "$ref": "#/definitions/admin.deleteIntervensiResponse"
                                         }
                                     }
                                 }
@@ -2232,7 +2358,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Keluarga not found",
+                        "description": "Intervensi not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2270,14 +2396,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/keluarga/get": {
+        "/api/admin/intervensi/get": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get keluarga data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all keluarga with total count\n- With id parameter: Returns specific keluarga data\n\nKeluarga data includes: nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu, alamat, rt, rw, kelurahan, kecamatan, koordinat",
+                "description": "Get intervensi data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all intervensi with total count\n- With id parameter: Returns specific intervensi data\n\nIntervensi data includes: jenis, tanggal, deskripsi, hasil, status, petugas count, riwayat count, creation/update info",
                 "consumes": [
                     "application/json"
                 ],
@@ -2287,18 +2413,24 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get keluarga data",
+                "summary": "Get intervensi data",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Keluarga ID",
+                        "description": "Intervensi ID",
                         "name": "id",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by intervensi status (planned, in_progress, completed, cancelled)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga data retrieved successfully",
+                        "description": "Intervensi data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2308,7 +2440,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllKeluargaResponse"
+                                            "$ref": "#/definitions/admin.getAllIntervensiResponse"
                                         }
                                     }
                                 }
@@ -2370,7 +2502,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Keluarga not found",
+                        "description": "Intervensi not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2408,14 +2540,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/keluarga/insert": {
+        "/api/admin/intervensi/insert": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new keluarga data (Admin only)",
+                "description": "Insert new intervensi data (Admin only)\n\nCreates a new intervensi record with:\n- id_balita: ID of the balita being intervened\n- jenis: type of intervention (gizi, kesehatan, sosial)\n- tanggal: intervention date (YYYY-MM-DD format)\n- deskripsi: detailed description of the intervention\n- hasil: optional results or outcomes of the intervention\n- Validates intervention type and date constraints\n\nNew intervensi always starts with status planned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2425,21 +2557,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new keluarga",
+                "summary": "Insert new intervensi",
                 "parameters": [
                     {
-                        "description": "Keluarga data",
-                        "name": "keluarga",
+                        "description": "Intervensi data",
+                        "name": "intervensi",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.insertKeluargaRequest"
+                            "$ref": "#/definitions/admin.insertIntervensiRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga inserted successfully",
+                        "description": "Intervensi inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2449,7 +2581,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertKeluargaResponse"
+                                            "$ref": "#/definitions/admin.insertIntervensiResponse"
                                         }
                                     }
                                 }
@@ -2531,14 +2663,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/keluarga/restore": {
+        "/api/admin/intervensi/restore": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted keluarga data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Restore soft deleted intervensi data by clearing deleted_date and deleted_id (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2548,21 +2680,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Restore deleted keluarga data",
+                "summary": "Restore deleted intervensi data",
                 "parameters": [
                     {
-                        "description": "Keluarga ID to restore",
-                        "name": "keluarga",
+                        "description": "Intervensi ID to restore",
+                        "name": "intervensi",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteKeluargaRequest"
+                            "$ref": "#/definitions/admin.deleteIntervensiRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga restored successfully",
+                        "description": "Intervensi restored successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2572,7 +2704,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteKeluargaResponse"
+                                            "$ref": "#/definitions/admin.deleteIntervensiResponse"
                                         }
                                     }
                                 }
@@ -2634,7 +2766,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Keluarga not found",
+                        "description": "Intervensi not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2672,14 +2804,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/keluarga/update": {
+        "/api/admin/intervensi/status": {
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing keluarga data (Admin only)\n\nUpdates keluarga record with new data including:\n- nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu\n- alamat, rt, rw, id_kelurahan, koordinat\n- Validates uniqueness of nomor_kk and NIK (excluding current record)",
+                "description": "Move an intervensi to the next status of its lifecycle (Admin only)\n\nAllowed transitions:\n- planned → in_progress, cancelled\n- in_progress → completed, cancelled\n\nCompleted and cancelled are final. The user and time of every transition are recorded.\nCompleting an intervensi requires a hasil, either already stored or sent in the request.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2689,21 +2821,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Update keluarga data",
+                "summary": "Update intervensi status",
                 "parameters": [
                     {
-                        "description": "Updated keluarga data",
-                        "name": "keluarga",
+                        "description": "Intervensi status",
+                        "name": "intervensi",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updateKeluargaRequest"
+                            "$ref": "#/definitions/admin.updateIntervensiStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga updated successfully",
+                        "description": "Intervensi status updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2713,7 +2845,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.updateKeluargaResponse"
+                                            "$ref": "#/definitions/admin.updateIntervensiStatusResponse"
                                         }
                                     }
                                 }
@@ -2721,7 +2853,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid request or transition",
                         "schema": {
                             "allOf": [
                                 {
@@ -2775,7 +2907,25 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Keluarga not found",
+                        "description": "Intervensi not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Intervensi status changed concurrently",
                         "schema": {
                             "allOf": [
                                 {
@@ -2813,14 +2963,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/laporan-masyarakat/delete": {
-            "delete": {
+        "/api/admin/intervensi/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete laporan masyarakat data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+                "description": "Update existing intervensi data (Admin only)\n\nUpdates intervensi record including:\n- id_balita: ID of the balita being intervened\n- jenis: type of intervention (gizi, kesehatan, sosial)\n- tanggal: intervention date (YYYY-MM-DD format)\n- deskripsi: detailed description of the intervention\n- hasil: optional results or outcomes of the intervention\n- Validates intervention type and date constraints\n- Checks for related records before allowing changes\n\nStatus is changed through /api/admin/intervensi/status.\nCompleted or cancelled intervensi cannot be updated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2830,21 +2980,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete laporan masyarakat data (soft delete)",
+                "summary": "Update intervensi data",
                 "parameters": [
                     {
-                        "description": "Laporan Masyarakat ID to delete",
-                        "name": "laporan",
+                        "description": "Updated intervensi data",
+                        "name": "intervensi",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatRequest"
+                            "$ref": "#/definitions/admin.updateIntervensiRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan masyarakat deleted successfully",
+                        "description": "Intervensi updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2854,7 +3004,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.updateIntervensiResponse"
                                         }
                                     }
                                 }
@@ -2916,7 +3066,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Laporan masyarakat not found",
+                        "description": "Intervensi not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -2954,14 +3104,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/laporan-masyarakat/get": {
-            "get": {
+        "/api/admin/keluarga/delete": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get laporan masyarakat data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all laporan masyarakat with total count\n- With id parameter: Returns specific laporan masyarakat data\n\nLaporan masyarakat data includes: pelapor info, balita info, keluarga info, status laporan, contact details",
+                "description": "Soft delete keluarga data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n\nWithout cascade, keluarga with active balita or laporan cannot be deleted.\nWith cascade, the active balita of the keluarga and their laporan, intervensi and\nriwayat pemeriksaan are soft deleted together with the keluarga.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2971,18 +3121,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get laporan masyarakat data",
+                "summary": "Delete keluarga data (soft delete)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Laporan Masyarakat ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Keluarga ID to delete",
+                        "name": "keluarga",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteKeluargaRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan masyarakat data retrieved successfully",
+                        "description": "Keluarga deleted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -2992,7 +3145,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllLaporanMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.deleteKeluargaResponse"
                                         }
                                     }
                                 }
@@ -3054,7 +3207,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Laporan masyarakat not found",
+                        "description": "Keluarga not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -3092,14 +3245,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/laporan-masyarakat/insert": {
-            "post": {
+        "/api/admin/keluarga/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new laporan masyarakat data (Admin only)\n\nInserts laporan masyarakat record with data including:\n- id_masyarakat (optional, null if admin report), id_balita, id_status_laporan\n- tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita\n- Validates balita existence, status laporan, and masyarakat (if provided)",
+                "description": "Get keluarga data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all keluarga with total count\n- With id parameter: Returns specific keluarga data\n\nKeluarga data includes: nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu, alamat, rt, rw, kelurahan, kecamatan, koordinat\n\nnomor_kk, nik_ayah and nik_ibu are masked unless lengkap=true, which is logged as a privileged read.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3109,21 +3262,24 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new laporan masyarakat",
+                "summary": "Get keluarga data",
                 "parameters": [
                     {
-                        "description": "Laporan masyarakat data",
-                        "name": "laporan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.insertLaporanMasyarakatRequest"
-                        }
+                        "type": "string",
+                        "description": "Keluarga ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked NIK and nomor KK",
+                        "name": "lengkap",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan masyarakat inserted successfully",
+                        "description": "Keluarga data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3133,7 +3289,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertLaporanMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.getAllKeluargaResponse"
                                         }
                                     }
                                 }
@@ -3194,6 +3350,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Keluarga not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3215,14 +3389,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/laporan-masyarakat/restore": {
+        "/api/admin/keluarga/insert": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted laporan masyarakat data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Insert new keluarga data (Admin only)\n\nNomor KK and NIK are checked for a valid region code and serial, the nomor KK must\nstart with the code of the kecamatan and NIK ibu must encode a female birth date\n(day plus 40). Errors of a single field return it in data (object.FieldError).\n\nid_kelurahan may be left empty, it is then resolved from the koordinat. A chosen\nid_kelurahan must contain the koordinat, otherwise the located kelurahan is returned\nas error on id_kelurahan. Koordinat outside every kelurahan are rejected.\nKoordinat is [longitude, latitude], pairs out of range are rejected on koordinat.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3232,21 +3406,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Restore deleted laporan masyarakat data",
+                "summary": "Insert new keluarga",
                 "parameters": [
                     {
-                        "description": "Laporan Masyarakat ID to restore",
-                        "name": "laporan",
+                        "description": "Keluarga data",
+                        "name": "keluarga",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatRequest"
+                            "$ref": "#/definitions/admin.insertKeluargaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan masyarakat restored successfully",
+                        "description": "Keluarga inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3256,7 +3430,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.insertKeluargaResponse"
                                         }
                                     }
                                 }
@@ -3274,7 +3448,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/object.FieldError"
                                         }
                                     }
                                 }
@@ -3317,24 +3491,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Laporan masyarakat not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3356,14 +3512,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/laporan-masyarakat/update": {
-            "put": {
+        "/api/admin/keluarga/restore": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing laporan masyarakat data (Admin only)\n\nUpdates laporan masyarakat record with new data including:\n- id_masyarakat (optional, null if admin report), id_balita, id_status_laporan\n- tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita\n- Validates balita existence, status laporan, masyarakat (if provided), and prevents duplicates",
+                "description": "Restore soft deleted keluarga data by clearing deleted_date and deleted_id (Admin only)\n\nWith cascade, the balita, laporan, intervensi and riwayat pemeriksaan that were deleted\ntogether with the keluarga (same deleted_id and deleted_date) are restored as well.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3373,21 +3529,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Update laporan masyarakat data",
+                "summary": "Restore deleted keluarga data",
                 "parameters": [
                     {
-                        "description": "Updated laporan masyarakat data",
-                        "name": "laporan",
+                        "description": "Keluarga ID to restore",
+                        "name": "keluarga",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updateLaporanMasyarakatRequest"
+                            "$ref": "#/definitions/admin.deleteKeluargaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan masyarakat updated successfully",
+                        "description": "Keluarga restored successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3397,7 +3553,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.updateLaporanMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.deleteKeluargaResponse"
                                         }
                                     }
                                 }
@@ -3459,7 +3615,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Laporan masyarakat not found",
+                        "description": "Keluarga not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -3497,14 +3653,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/master-kecamatan": {
-            "get": {
+        "/api/admin/keluarga/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all kecamatan for dropdown/reference (Admin only)",
+                "description": "Update existing keluarga data (Admin only)\n\nUpdates keluarga record with new data including:\n- nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu\n- alamat, rt, rw, id_kelurahan, koordinat\n- Validates uniqueness of nomor_kk and NIK (excluding current record)\n\nNomor KK and NIK are checked for a valid region code and serial, the nomor KK must\nstart with the code of the kecamatan and NIK ibu must encode a female birth date\n(day plus 40). Errors of a single field return it in data (object.FieldError).\n\nid_kelurahan may be left empty, it is then resolved from the koordinat. A chosen\nid_kelurahan must contain the koordinat, otherwise the located kelurahan is returned\nas error on id_kelurahan. Koordinat outside every kelurahan are rejected.\nKoordinat is [longitude, latitude], pairs out of range are rejected on koordinat.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3514,10 +3670,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get kecamatan master data",
+                "summary": "Update keluarga data",
+                "parameters": [
+                    {
+                        "description": "Updated keluarga data",
+                        "name": "keluarga",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.updateKeluargaRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Kecamatan data retrieved successfully",
+                        "description": "Keluarga updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3527,7 +3694,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllKecamatanResponse"
+                                            "$ref": "#/definitions/admin.updateKeluargaResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/object.FieldError"
                                         }
                                     }
                                 }
@@ -3570,6 +3755,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Keluarga not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3591,14 +3794,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/master-kelurahan": {
-            "get": {
+        "/api/admin/laporan-masyarakat/advance": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get kelurahan data with optional kecamatan filter (Admin only)",
+                "description": "Move a laporan masyarakat to another status of the workflow (Admin only)\n\nAllowed transitions are defined in the status_laporan_transisi table:\n- Belum diproses → Diproses dan data tidak sesuai, Diproses dan data sesuai\n- Diproses dan data tidak sesuai → Diproses dan data sesuai\n- Diproses dan data sesuai → Belum ditindaklanjuti\n- Belum ditindaklanjuti → Sudah ditindaklanjuti\n- Sudah ditindaklanjuti → Sudah perbaikan gizi\n\nEvery change is written to laporan_status_history together with the mandatory catatan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3608,18 +3811,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get kelurahan master data",
+                "summary": "Advance laporan masyarakat status",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filter by Kecamatan ID",
-                        "name": "id_kecamatan",
-                        "in": "query"
+                        "description": "Status tujuan dan catatan",
+                        "name": "laporan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.advanceLaporanMasyarakatRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Kelurahan data retrieved successfully",
+                        "description": "Laporan masyarakat status updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3629,7 +3835,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllKelurahanResponse"
+                                            "$ref": "#/definitions/admin.advanceLaporanMasyarakatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or transition",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -3672,8 +3896,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Laporan masyarakat not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -3689,18 +3913,36 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/api/admin/master-masyarakat": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/laporan-masyarakat/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
                     }
                 ],
-                "description": "Get all masyarakat for dropdown/reference (Admin only)",
+                "description": "Soft delete laporan masyarakat data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
                 "consumes": [
                     "application/json"
                 ],
@@ -3710,10 +3952,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get masyarakat master data",
+                "summary": "Delete laporan masyarakat data (soft delete)",
+                "parameters": [
+                    {
+                        "description": "Laporan Masyarakat ID to delete",
+                        "name": "laporan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Masyarakat data retrieved successfully",
+                        "description": "Laporan masyarakat deleted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3723,7 +3976,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllMasyarakatResponse"
+                                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -3766,6 +4037,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Laporan masyarakat not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3787,14 +4076,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/master-skpd": {
+        "/api/admin/laporan-masyarakat/get": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get SKPD data with optional jenis filter (Admin only)",
+                "description": "Get laporan masyarakat data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all laporan masyarakat with total count\n- With id parameter: Returns specific laporan masyarakat data, including the\nstatus history (riwayat_status) and the statuses it may advance to (next_status)\n\nLaporan masyarakat data includes: pelapor info, balita info, keluarga info, status laporan, contact details\n\nnomor_kk and the phone numbers are masked unless lengkap=true, which is logged as a privileged read.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3804,18 +4093,24 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get SKPD master data",
+                "summary": "Get laporan masyarakat data",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by SKPD jenis (puskesmas, kelurahan, skpd)",
-                        "name": "jenis",
+                        "description": "Laporan Masyarakat ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked nomor KK and phone numbers",
+                        "name": "lengkap",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "SKPD data retrieved successfully",
+                        "description": "Laporan masyarakat data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3825,7 +4120,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllSkpdMasterResponse"
+                                            "$ref": "#/definitions/admin.getAllLaporanMasyarakatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -3868,6 +4181,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Laporan masyarakat not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3889,14 +4220,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/master-status-laporan": {
-            "get": {
+        "/api/admin/laporan-masyarakat/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all status laporan for dropdown/reference (Admin only)",
+                "description": "Insert new laporan masyarakat data (Admin only)\n\nInserts laporan masyarakat record with data including:\n- id_masyarakat (optional, null if admin report), id_balita, id_status_laporan\n- tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita\n- Validates balita existence, status laporan, and masyarakat (if provided)\n\nid_status_laporan must be an initial status of the workflow (Belum diproses).\nThe initial status is recorded in laporan_status_history.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3906,10 +4237,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get status laporan master data",
+                "summary": "Insert new laporan masyarakat",
+                "parameters": [
+                    {
+                        "description": "Laporan masyarakat data",
+                        "name": "laporan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.insertLaporanMasyarakatRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Status laporan data retrieved successfully",
+                        "description": "Laporan masyarakat inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -3919,7 +4261,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllStatusLaporanResponse"
+                                            "$ref": "#/definitions/admin.insertLaporanMasyarakatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -3983,14 +4343,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/petugas-kesehatan/delete": {
-            "delete": {
+        "/api/admin/laporan-masyarakat/restore": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete petugas kesehatan data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+                "description": "Restore soft deleted laporan masyarakat data by clearing deleted_date and deleted_id (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4000,21 +4360,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete petugas kesehatan data (soft delete)",
+                "summary": "Restore deleted laporan masyarakat data",
                 "parameters": [
                     {
-                        "description": "Petugas Kesehatan ID to delete",
-                        "name": "petugas",
+                        "description": "Laporan Masyarakat ID to restore",
+                        "name": "laporan",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deletePetugasKesehatanRequest"
+                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Petugas kesehatan deleted successfully",
+                        "description": "Laporan masyarakat restored successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4024,7 +4384,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deletePetugasKesehatanResponse"
+                                            "$ref": "#/definitions/admin.deleteLaporanMasyarakatResponse"
                                         }
                                     }
                                 }
@@ -4086,7 +4446,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Petugas kesehatan not found",
+                        "description": "Laporan masyarakat not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -4124,14 +4484,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/petugas-kesehatan/get": {
-            "get": {
+        "/api/admin/laporan-masyarakat/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get petugas kesehatan data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all petugas kesehatan with total count\n- With id parameter: Returns specific petugas kesehatan data\n\nPetugas kesehatan data includes: nama, email, SKPD info, intervensi count, creation/update dates",
+                "description": "Update existing laporan masyarakat data (Admin only)\n\nUpdates laporan masyarakat record with new data including:\n- id_masyarakat (optional, null if admin report), id_balita\n- tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita\n- Validates balita existence, masyarakat (if provided), and prevents duplicates\n\nStatus laporan cannot be changed here, use /api/admin/laporan-masyarakat/advance.\nid_status_laporan is optional and must match the current status when sent.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4141,18 +4501,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get petugas kesehatan data",
+                "summary": "Update laporan masyarakat data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Petugas Kesehatan ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Updated laporan masyarakat data",
+                        "name": "laporan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.updateLaporanMasyarakatRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Petugas kesehatan data retrieved successfully",
+                        "description": "Laporan masyarakat updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4162,7 +4525,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllPetugasKesehatanResponse"
+                                            "$ref": "#/definitions/admin.updateLaporanMasyarakatResponse"
                                         }
                                     }
                                 }
@@ -4224,7 +4587,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Petugas kesehatan not found",
+                        "description": "Laporan masyarakat not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -4262,14 +4625,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/petugas-kesehatan/insert": {
-            "post": {
+        "/api/admin/master-kecamatan": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new petugas kesehatan data (Admin only)\n\nCreates a complete petugas kesehatan record including:\n- Creates pengguna account with email and hashed password\n- Creates petugas_kesehatan record linked to SKPD\n- Sets role as \"petugas kesehatan\" in pengguna table\n- Validates email uniqueness and SKPD existence",
+                "description": "Get all kecamatan for dropdown/reference (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4279,21 +4642,10 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new petugas kesehatan",
-                "parameters": [
-                    {
-                        "description": "Petugas kesehatan data",
-                        "name": "petugas",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.insertPetugasKesehatanRequest"
-                        }
-                    }
-                ],
+                "summary": "Get kecamatan master data",
                 "responses": {
                     "200": {
-                        "description": "Petugas kesehatan inserted successfully",
+                        "description": "Kecamatan data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4303,15 +4655,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertPetugasKesehatanResponse"
+                                            "$ref": "#/definitions/admin.getAllKecamatanResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -4328,8 +4680,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -4346,8 +4698,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "allOf": [
                                 {
@@ -4363,9 +4715,1793 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                    }
+                }
+            }
+        },
+        "/api/admin/master-kelurahan": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get kelurahan data with optional kecamatan filter (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get kelurahan master data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by Kecamatan ID",
+                        "name": "id_kecamatan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Add the stunting statistics to the properties",
+                        "name": "statistik",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (YYYY-MM or YYYY-MM-DD), default 12 months before sampai",
+                        "name": "dari",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (YYYY-MM or YYYY-MM-DD), default today",
+                        "name": "sampai",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Kelurahan data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllKelurahanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/master-masyarakat": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all masyarakat for dropdown/reference (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get masyarakat master data",
+                "responses": {
+                    "200": {
+                        "description": "Masyarakat data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllMasyarakatResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/master-skpd": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get SKPD data with optional jenis filter (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get SKPD master data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by SKPD jenis (puskesmas, kelurahan, skpd)",
+                        "name": "jenis",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SKPD data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllSkpdMasterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/master-status-laporan": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all status laporan for dropdown/reference (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get status laporan master data",
+                "responses": {
+                    "200": {
+                        "description": "Status laporan data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllStatusLaporanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/pengguna/revoke-sessions": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revoke every active session of a pengguna (Admin only). The access tokens and\nrefresh tokens of those sessions stop working immediately, so the pengguna has\nto log in again. Use it for leaked credentials or staff leaving the service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Revoke all sessions of a pengguna",
+                "parameters": [
+                    {
+                        "description": "Pengguna ID",
+                        "name": "pengguna",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.revokeSessionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sessions revoked successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.revokeSessionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Pengguna not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/petugas-kesehatan/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Soft delete petugas kesehatan data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Delete petugas kesehatan data (soft delete)",
+                "parameters": [
+                    {
+                        "description": "Petugas Kesehatan ID to delete",
+                        "name": "petugas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deletePetugasKesehatanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Petugas kesehatan deleted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.deletePetugasKesehatanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Petugas kesehatan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/petugas-kesehatan/get": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get petugas kesehatan data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all petugas kesehatan with total count\n- With id parameter: Returns specific petugas kesehatan data\n\nPetugas kesehatan data includes: nama, email, SKPD info, intervensi count, creation/update dates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get petugas kesehatan data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Petugas Kesehatan ID",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Petugas kesehatan data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllPetugasKesehatanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Petugas kesehatan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/petugas-kesehatan/insert": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Insert new petugas kesehatan data (Admin only)\n\nCreates a complete petugas kesehatan record including:\n- Creates pengguna account with email and hashed password\n- Creates petugas_kesehatan record linked to SKPD\n- Sets role as \"petugas kesehatan\" in pengguna table\n- Validates email uniqueness and SKPD existence",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Insert new petugas kesehatan",
+                "parameters": [
+                    {
+                        "description": "Petugas kesehatan data",
+                        "name": "petugas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.insertPetugasKesehatanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Petugas kesehatan inserted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.insertPetugasKesehatanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/petugas-kesehatan/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Restore soft deleted petugas kesehatan data by clearing deleted_date and deleted_id (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Restore deleted petugas kesehatan data",
+                "parameters": [
+                    {
+                        "description": "Petugas Kesehatan ID to restore",
+                        "name": "petugas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deletePetugasKesehatanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Petugas kesehatan restored successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.deletePetugasKesehatanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Petugas kesehatan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/petugas-kesehatan/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update existing petugas kesehatan data (Admin only)\n\nUpdates petugas kesehatan record including:\n- Updates pengguna account (email and password)\n- Updates petugas_kesehatan record (nama and SKPD)\n- Validates email uniqueness (excluding current record)\n- Validates SKPD existence and name uniqueness within SKPD\n- Checks for related intervensi before allowing SKPD change\n- Password is required and will be updated if different from current",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Update petugas kesehatan data",
+                "parameters": [
+                    {
+                        "description": "Updated petugas kesehatan data",
+                        "name": "petugas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.updatePetugasKesehatanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Petugas kesehatan updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.updatePetugasKesehatanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Petugas kesehatan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/riwayat-pemeriksaan/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Soft delete riwayat pemeriksaan data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Provides detailed information about the deleted medical record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Delete riwayat pemeriksaan data (soft delete)",
+                "parameters": [
+                    {
+                        "description": "Riwayat Pemeriksaan ID to delete",
+                        "name": "riwayat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Riwayat pemeriksaan deleted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Riwayat pemeriksaan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/riwayat-pemeriksaan/get": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get riwayat pemeriksaan data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without any parameter: Returns all riwayat pemeriksaan with total count\n- With id parameter: Returns specific riwayat pemeriksaan data\n- With id_balita parameter: Returns all riwayat pemeriksaan for specific balita\n- With id_laporan_masyarakat parameter: Returns all riwayat pemeriksaan for specific laporan\n- With id_intervensi parameter: Returns all riwayat pemeriksaan for specific intervensi\n\nRiwayat pemeriksaan data includes: balita info, intervensi info, laporan info, examination details, location info\n\nnomor_kk is masked unless lengkap=true, which is logged as a privileged read of the balita.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get riwayat pemeriksaan data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Riwayat Pemeriksaan ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Balita ID",
+                        "name": "id_balita",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Laporan Masyarakat ID",
+                        "name": "id_laporan_masyarakat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Intervensi ID",
+                        "name": "id_intervensi",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked nomor KK",
+                        "name": "lengkap",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Riwayat pemeriksaan data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.getAllRiwayatPemeriksaanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Riwayat pemeriksaan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/riwayat-pemeriksaan/insert": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Insert new riwayat pemeriksaan data (Admin only)\n\nCreates a new riwayat pemeriksaan record with:\n- id_balita: balita being examined\n- id_intervensi: related intervention program\n- id_laporan_masyarakat: related masyarakat report\n- tanggal: examination date (YYYY-MM-DD format)\n- berat_badan: weight in kg (decimal)\n- tinggi_badan: height in cm (decimal)\n- status_gizi: optional nutritional status (normal, stunting, gizi buruk)\n- keterangan: examination notes and recommendations\n\nStatus gizi and z-scores (TB/U, BB/U, BB/TB) are computed from the WHO 2006\nChild Growth Standards. A submitted status gizi that contradicts the computed\none is rejected. Status gizi is only required for balita outside the WHO range.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Insert new riwayat pemeriksaan",
+                "parameters": [
+                    {
+                        "description": "Riwayat pemeriksaan data",
+                        "name": "riwayat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/object.RiwayatPemeriksaanInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Riwayat pemeriksaan inserted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.insertRiwayatPemeriksaanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/riwayat-pemeriksaan/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Restore soft deleted riwayat pemeriksaan data by clearing deleted_date and deleted_id (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Restore deleted riwayat pemeriksaan data",
+                "parameters": [
+                    {
+                        "description": "Riwayat Pemeriksaan ID to restore",
+                        "name": "riwayat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Riwayat pemeriksaan restored successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Riwayat pemeriksaan not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "allOf": [
                                 {
@@ -4385,14 +6521,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/petugas-kesehatan/restore": {
-            "post": {
+        "/api/admin/riwayat-pemeriksaan/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted petugas kesehatan data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Update existing riwayat pemeriksaan data (Admin only)\n\nUpdates riwayat pemeriksaan record with new data including:\n- id_balita: balita being examined\n- id_intervensi: related intervention program\n- id_laporan_masyarakat: related masyarakat report\n- tanggal: examination date (YYYY-MM-DD format)\n- berat_badan: weight in kg (decimal)\n- tinggi_badan: height in cm (decimal)\n- status_gizi: optional nutritional status (normal, stunting, gizi buruk)\n- keterangan: examination notes and recommendations\n- Validates existence of balita and intervensi, prevents duplicates\n- Recomputes status gizi and z-scores from the WHO 2006 Child Growth Standards\nand rejects a submitted status gizi that contradicts the computed one",
                 "consumes": [
                     "application/json"
                 ],
@@ -4402,21 +6538,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Restore deleted petugas kesehatan data",
+                "summary": "Update riwayat pemeriksaan data",
                 "parameters": [
                     {
-                        "description": "Petugas Kesehatan ID to restore",
-                        "name": "petugas",
+                        "description": "Updated riwayat pemeriksaan data",
+                        "name": "riwayat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deletePetugasKesehatanRequest"
+                            "$ref": "#/definitions/admin.updateRiwayatPemeriksaanRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Petugas kesehatan restored successfully",
+                        "description": "Riwayat pemeriksaan updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4426,7 +6562,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deletePetugasKesehatanResponse"
+                                            "$ref": "#/definitions/admin.updateRiwayatPemeriksaanResponse"
                                         }
                                     }
                                 }
@@ -4488,7 +6624,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Petugas kesehatan not found",
+                        "description": "Riwayat pemeriksaan not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -4526,14 +6662,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/petugas-kesehatan/update": {
-            "put": {
+        "/api/admin/skpd/delete": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing petugas kesehatan data (Admin only)\n\nUpdates petugas kesehatan record including:\n- Updates pengguna account (email and password)\n- Updates petugas_kesehatan record (nama and SKPD)\n- Validates email uniqueness (excluding current record)\n- Validates SKPD existence and name uniqueness within SKPD\n- Checks for related intervensi before allowing SKPD change\n- Password is required and will be updated if different from current",
+                "description": "Soft delete SKPD data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
                 "consumes": [
                     "application/json"
                 ],
@@ -4543,21 +6679,159 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Update petugas kesehatan data",
+                "summary": "Delete SKPD data (soft delete)",
                 "parameters": [
                     {
-                        "description": "Updated petugas kesehatan data",
-                        "name": "petugas",
+                        "description": "SKPD ID to delete",
+                        "name": "skpd",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updatePetugasKesehatanRequest"
+                            "$ref": "#/definitions/admin.deleteSkpdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SKPD deleted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/admin.deleteSkpdResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "SKPD not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
+                }
+            }
+        },
+        "/api/admin/skpd/get": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get SKPD data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all SKPD with total count\n- With id parameter: Returns specific SKPD data\n\nSKPD data includes: skpd name, jenis (type), petugas count, creation/update dates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get SKPD data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SKPD ID",
+                        "name": "id",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Petugas kesehatan updated successfully",
+                        "description": "SKPD data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4567,7 +6841,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.updatePetugasKesehatanResponse"
+                                            "$ref": "#/definitions/admin.getAllSkpdResponse"
                                         }
                                     }
                                 }
@@ -4629,7 +6903,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Petugas kesehatan not found",
+                        "description": "SKPD not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -4667,14 +6941,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/riwayat-pemeriksaan/delete": {
-            "delete": {
+        "/api/admin/skpd/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Soft delete riwayat pemeriksaan data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Provides detailed information about the deleted medical record",
+                "description": "Insert new SKPD data (Admin only)\n\nInserts SKPD record with data including:\n- skpd (nama SKPD), jenis (puskesmas/kelurahan/skpd)\n- Validates uniqueness of SKPD name within the same jenis\n- Supports different types of SKPD organizations",
                 "consumes": [
                     "application/json"
                 ],
@@ -4684,21 +6958,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Delete riwayat pemeriksaan data (soft delete)",
+                "summary": "Insert new SKPD",
                 "parameters": [
                     {
-                        "description": "Riwayat Pemeriksaan ID to delete",
-                        "name": "riwayat",
+                        "description": "SKPD data",
+                        "name": "skpd",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanRequest"
+                            "$ref": "#/definitions/admin.insertSkpdRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Riwayat pemeriksaan deleted successfully",
+                        "description": "SKPD inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4708,7 +6982,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanResponse"
+                                            "$ref": "#/definitions/admin.insertSkpdResponse"
                                         }
                                     }
                                 }
@@ -4769,24 +7043,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Riwayat pemeriksaan not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -4808,14 +7064,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/riwayat-pemeriksaan/get": {
-            "get": {
+        "/api/admin/skpd/restore": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get riwayat pemeriksaan data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without any parameter: Returns all riwayat pemeriksaan with total count\n- With id parameter: Returns specific riwayat pemeriksaan data\n- With id_balita parameter: Returns all riwayat pemeriksaan for specific balita\n- With id_laporan_masyarakat parameter: Returns all riwayat pemeriksaan for specific laporan\n- With id_intervensi parameter: Returns all riwayat pemeriksaan for specific intervensi\n\nRiwayat pemeriksaan data includes: balita info, intervensi info, laporan info, examination details, location info",
+                "description": "Restore soft deleted SKPD data by clearing deleted_date and deleted_id (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4825,36 +7081,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get riwayat pemeriksaan data",
+                "summary": "Restore deleted SKPD data",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Riwayat Pemeriksaan ID",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Balita ID",
-                        "name": "id_balita",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Laporan Masyarakat ID",
-                        "name": "id_laporan_masyarakat",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Intervensi ID",
-                        "name": "id_intervensi",
-                        "in": "query"
+                        "description": "SKPD ID to restore",
+                        "name": "skpd",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.deleteSkpdRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Riwayat pemeriksaan data retrieved successfully",
+                        "description": "SKPD restored successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -4864,7 +7105,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllRiwayatPemeriksaanResponse"
+                                            "$ref": "#/definitions/admin.deleteSkpdResponse"
                                         }
                                     }
                                 }
@@ -4926,7 +7167,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Riwayat pemeriksaan not found",
+                        "description": "SKPD not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -4964,14 +7205,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/riwayat-pemeriksaan/insert": {
-            "post": {
+        "/api/admin/skpd/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new riwayat pemeriksaan data (Admin only)\n\nCreates a new riwayat pemeriksaan record with:\n- id_balita: balita being examined\n- id_intervensi: related intervention program\n- id_laporan_masyarakat: related masyarakat report\n- tanggal: examination date (YYYY-MM-DD format)\n- berat_badan: weight in kg (decimal)\n- tinggi_badan: height in cm (decimal)\n- status_gizi: nutritional status (normal, stunting, gizi buruk)\n- keterangan: examination notes and recommendations",
+                "description": "Update existing SKPD data (Admin only)\n\nUpdates SKPD record with new data including:\n- skpd (nama SKPD), jenis (puskesmas/kelurahan/skpd)\n- Validates uniqueness of SKPD name within the same jenis (excluding current record)\n- Checks for related petugas kesehatan before allowing jenis change",
                 "consumes": [
                     "application/json"
                 ],
@@ -4981,21 +7222,21 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Insert new riwayat pemeriksaan",
+                "summary": "Update SKPD data",
                 "parameters": [
                     {
-                        "description": "Riwayat pemeriksaan data",
-                        "name": "riwayat",
+                        "description": "Updated SKPD data",
+                        "name": "skpd",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.insertRiwayatPemeriksaanRequest"
+                            "$ref": "#/definitions/admin.updateSkpdRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Riwayat pemeriksaan inserted successfully",
+                        "description": "SKPD updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5005,7 +7246,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertRiwayatPemeriksaanResponse"
+                                            "$ref": "#/definitions/admin.updateSkpdResponse"
                                         }
                                     }
                                 }
@@ -5066,6 +7307,24 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "404": {
+                        "description": "SKPD not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -5087,14 +7346,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/riwayat-pemeriksaan/restore": {
+        "/api/auth/login": {
             "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Restore soft deleted riwayat pemeriksaan data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Login with email and password. Returns a short-lived access token and a\nrefresh token that can be exchanged for new tokens at /api/auth/refresh.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5102,23 +7356,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "auth"
                 ],
-                "summary": "Restore deleted riwayat pemeriksaan data",
+                "summary": "User login",
                 "parameters": [
                     {
-                        "description": "Riwayat Pemeriksaan ID to restore",
-                        "name": "riwayat",
+                        "description": "Login request",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanRequest"
+                            "$ref": "#/definitions/auth.loginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Riwayat pemeriksaan restored successfully",
+                        "description": "Login successful",
                         "schema": {
                             "allOf": [
                                 {
@@ -5128,7 +7382,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteRiwayatPemeriksaanResponse"
+                                            "$ref": "#/definitions/auth.loginResponse"
                                         }
                                     }
                                 }
@@ -5171,8 +7425,45 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revoke the session of the access token. The access token and the refresh\ntoken of the session stop working immediately.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User logout",
+                "responses": {
+                    "200": {
+                        "description": "Logout successful",
                         "schema": {
                             "allOf": [
                                 {
@@ -5189,8 +7480,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Riwayat pemeriksaan not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -5228,14 +7519,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/riwayat-pemeriksaan/update": {
-            "put": {
+        "/api/auth/profile": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing riwayat pemeriksaan data (Admin only)\n\nUpdates riwayat pemeriksaan record with new data including:\n- id_balita: balita being examined\n- id_intervensi: related intervention program\n- id_laporan_masyarakat: related masyarakat report\n- tanggal: examination date (YYYY-MM-DD format)\n- berat_badan: weight in kg (decimal)\n- tinggi_badan: height in cm (decimal)\n- status_gizi: nutritional status (normal, stunting, gizi buruk)\n- keterangan: examination notes and recommendations\n- Validates existence of balita and intervensi, prevents duplicates",
+                "description": "Get user profile data based on JWT token and role\n\nResponse data varies by role:\n- masyarakat: {id, nama, alamat}\n- petugas kesehatan: {id, id_skpd, nama, created_date}\n- admin: {nama: \"Administrator\"}",
                 "consumes": [
                     "application/json"
                 ],
@@ -5243,59 +7534,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
-                ],
-                "summary": "Update riwayat pemeriksaan data",
-                "parameters": [
-                    {
-                        "description": "Updated riwayat pemeriksaan data",
-                        "name": "riwayat",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.updateRiwayatPemeriksaanRequest"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "Riwayat pemeriksaan updated successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/admin.updateRiwayatPemeriksaanResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "User profile retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5305,15 +7549,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/auth.userProfileResponse"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "data": {
+                                                            "type": "object"
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -5330,8 +7586,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Riwayat pemeriksaan not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -5369,14 +7625,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/skpd/delete": {
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Soft delete SKPD data by setting deleted_date and deleted_id (Admin only)\n\nPerforms soft delete operation:\n- Sets deleted_date to current timestamp\n- Sets deleted_id to current user ID\n- Data remains in database but is excluded from queries\n- Can be restored if needed in the future\n- Checks for related records before deletion",
+        "/api/auth/refresh": {
+            "post": {
+                "description": "Exchange a refresh token for a new access token and a new refresh token.\nEvery refresh token can be used once. Presenting a refresh token that was\nalready exchanged revokes the whole session, since it indicates the token leaked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5384,23 +7635,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "auth"
                 ],
-                "summary": "Delete SKPD data (soft delete)",
+                "summary": "Refresh tokens",
                 "parameters": [
                     {
-                        "description": "SKPD ID to delete",
-                        "name": "skpd",
+                        "description": "Refresh request",
+                        "name": "refresh",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteSkpdRequest"
+                            "$ref": "#/definitions/auth.refreshRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "SKPD deleted successfully",
+                        "description": "Token refreshed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5410,7 +7661,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteSkpdResponse"
+                                            "$ref": "#/definitions/auth.loginResponse"
                                         }
                                     }
                                 }
@@ -5436,7 +7687,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid, expired or revoked refresh token",
                         "schema": {
                             "allOf": [
                                 {
@@ -5453,8 +7704,54 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/register": {
+            "post": {
+                "description": "Register a new user with email, nama, password, and alamat",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User registration",
+                "parameters": [
+                    {
+                        "description": "Register request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.registerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User registered successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5471,8 +7768,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "SKPD not found",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "allOf": [
                                 {
@@ -5510,14 +7807,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/skpd/get": {
-            "get": {
+        "/api/auth/register_admin": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get SKPD data based on query parameter (Admin only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all SKPD with total count\n- With id parameter: Returns specific SKPD data\n\nSKPD data includes: skpd name, jenis (type), petugas count, creation/update dates",
+                "description": "Register a new admin account. The caller must either be logged in as admin,\nor send the configured bootstrap token in the X-Bootstrap-Token header.\nThe bootstrap token is only accepted while no admin account exists yet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5525,20 +7822,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "auth"
                 ],
-                "summary": "Get SKPD data",
+                "summary": "Admin registration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SKPD ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Bootstrap token for creating the first admin",
+                        "name": "X-Bootstrap-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Register admin request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.registerAdminRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "SKPD data retrieved successfully",
+                        "description": "Admin registered successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5548,7 +7854,11 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.getAllSkpdResponse"
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "integer",
+                                                "format": "int64"
+                                            }
                                         }
                                     }
                                 }
@@ -5592,25 +7902,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "SKPD not found",
+                        "description": "Forbidden - Admin role or valid bootstrap token required",
                         "schema": {
                             "allOf": [
                                 {
@@ -5648,14 +7940,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/skpd/insert": {
-            "post": {
+        "/api/community/balita/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new SKPD data (Admin only)\n\nInserts SKPD record with data including:\n- skpd (nama SKPD), jenis (puskesmas/kelurahan/skpd)\n- Validates uniqueness of SKPD name within the same jenis\n- Supports different types of SKPD organizations",
+                "description": "Get balita data for community/masyarakat users (own data only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all balita from user's keluarga\n- With id parameter: Returns specific balita data (if owned by user)\n\nData includes balita information, laporan status, medical history summary,\nand action permissions (edit/report capabilities).\nUsers can only access balita from keluarga they have created themselves.\nnomor_kk is masked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5663,23 +7955,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "community"
                 ],
-                "summary": "Insert new SKPD",
+                "summary": "Get balita data (Community)",
                 "parameters": [
                     {
-                        "description": "SKPD data",
-                        "name": "skpd",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/admin.insertSkpdRequest"
-                        }
+                        "type": "string",
+                        "description": "Balita ID",
+                        "name": "id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "SKPD inserted successfully",
+                        "description": "Balita data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5689,7 +7978,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.insertSkpdResponse"
+                                            "$ref": "#/definitions/community.getAllBalitaResponse"
                                         }
                                     }
                                 }
@@ -5733,7 +8022,25 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Forbidden - Masyarakat role required",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Balita not found or not owned by user",
                         "schema": {
                             "allOf": [
                                 {
@@ -5771,14 +8078,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/skpd/restore": {
+        "/api/community/balita/insert": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Restore soft deleted SKPD data by clearing deleted_date and deleted_id (Admin only)",
+                "description": "Insert new balita data for community/masyarakat users\n\nThis endpoint allows masyarakat users to register balita data\nfor families they have created. The balita will be linked to the\nspecified keluarga and can later be reported for stunting assessment.\n\nValidation includes:\n- Keluarga ownership verification (user can only add balita to their own keluarga)\n- Age criteria check (must be under 5 years old for balita classification)\n- Birth data validation (weight, height within reasonable ranges)\n- Duplicate prevention (same name and birth date in same keluarga)\n- Format validation for all fields",
                 "consumes": [
                     "application/json"
                 ],
@@ -5786,23 +8093,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "community"
                 ],
-                "summary": "Restore deleted SKPD data",
+                "summary": "Insert new balita (Community)",
                 "parameters": [
                     {
-                        "description": "SKPD ID to restore",
-                        "name": "skpd",
+                        "description": "Balita data",
+                        "name": "balita",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.deleteSkpdRequest"
+                            "$ref": "#/definitions/community.insertBalitaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "SKPD restored successfully",
+                        "description": "Balita inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5812,7 +8119,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/admin.deleteSkpdResponse"
+                                            "$ref": "#/definitions/community.insertBalitaResponse"
                                         }
                                     }
                                 }
@@ -5856,25 +8163,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "SKPD not found",
+                        "description": "Forbidden - Masyarakat role required or not owner of keluarga",
                         "schema": {
                             "allOf": [
                                 {
@@ -5912,14 +8201,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/skpd/update": {
+        "/api/community/balita/update": {
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing SKPD data (Admin only)\n\nUpdates SKPD record with new data including:\n- skpd (nama SKPD), jenis (puskesmas/kelurahan/skpd)\n- Validates uniqueness of SKPD name within the same jenis (excluding current record)\n- Checks for related petugas kesehatan before allowing jenis change",
+                "description": "Update existing balita data for community/masyarakat users\n\nThis endpoint allows masyarakat users to update balita data\nthat they have previously created. Users can only update balita\nfrom keluarga they own and only if there are no active reports.\n\nValidation includes:\n- Balita ownership verification (through keluarga ownership)\n- Age criteria check (must be under 5 years old for balita classification)\n- Birth data validation (weight, height within reasonable ranges)\n- Duplicate prevention (same name and birth date in same keluarga)\n- Business rule checks (no active reports constraint)\n- Format validation for all fields",
                 "consumes": [
                     "application/json"
                 ],
@@ -5927,59 +8216,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "admin"
+                    "community"
                 ],
-                "summary": "Update SKPD data",
+                "summary": "Update balita data (Community)",
                 "parameters": [
                     {
-                        "description": "Updated SKPD data",
-                        "name": "skpd",
+                        "description": "Updated balita data",
+                        "name": "balita",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/admin.updateSkpdRequest"
+                            "$ref": "#/definitions/community.updateBalitaRequest"
                         }
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "SKPD updated successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/admin.updateSkpdResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                "responses": {
+                    "200": {
+                        "description": "Balita updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -5989,15 +8242,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/community.updateBalitaResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "allOf": [
                                 {
@@ -6014,8 +8267,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "SKPD not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -6032,8 +8285,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - Masyarakat role required or not owner",
                         "schema": {
                             "allOf": [
                                 {
@@ -6049,55 +8302,9 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/api/auth/login": {
-            "post": {
-                "description": "Login with email and password",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "User login",
-                "parameters": [
-                    {
-                        "description": "Login request",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.loginRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Login successful",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/auth.loginResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "404": {
+                        "description": "Balita not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -6114,8 +8321,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "409": {
+                        "description": "Conflict - Cannot update due to active reports",
                         "schema": {
                             "allOf": [
                                 {
@@ -6153,14 +8360,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/profile": {
+        "/api/community/keluarga/get": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get user profile data based on JWT token and role\n\nResponse data varies by role:\n- masyarakat: {id, nama, alamat}\n- petugas kesehatan: {id, id_skpd, nama, created_date}\n- admin: {nama: \"Administrator\"}",
+                "description": "Get keluarga data for community/masyarakat users (own data only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all keluarga created by the user\n- With id parameter: Returns specific keluarga data (if owned by user)\n\nData includes family information, balita count, laporan status, and edit permissions.\nnomor_kk, nik_ayah and nik_ibu are masked.\nUsers can only access keluarga data they have created themselves.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6168,12 +8375,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "community"
+                ],
+                "summary": "Get keluarga data (Community)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keluarga ID",
+                        "name": "id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "User profile retrieved successfully",
+                        "description": "Keluarga data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6183,27 +8398,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/auth.userProfileResponse"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "data": {
-                                                            "type": "object"
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/community.getAllKeluargaResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "allOf": [
                                 {
@@ -6220,8 +8423,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -6238,54 +8441,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/api/auth/register": {
-            "post": {
-                "description": "Register a new user with email, nama, password, and alamat",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "User registration",
-                "parameters": [
-                    {
-                        "description": "Register request",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.registerRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "User registered successfully",
+                    "403": {
+                        "description": "Forbidden - Masyarakat role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -6302,8 +8459,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "404": {
+                        "description": "Keluarga not found or not owned by user",
                         "schema": {
                             "allOf": [
                                 {
@@ -6341,14 +8498,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/balita/get": {
-            "get": {
+        "/api/community/keluarga/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get balita data for community/masyarakat users (own data only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all balita from user's keluarga\n- With id parameter: Returns specific balita data (if owned by user)\n\nData includes balita information, laporan status, medical history summary,\nand action permissions (edit/report capabilities).\nUsers can only access balita from keluarga they have created themselves.",
+                "description": "Insert new keluarga data for community/masyarakat users\n\nThis endpoint allows masyarakat users to register family data\nwhen reporting balita. The data will be linked to the reporting user.\n\nValidation includes:\n- Nomor KK and NIK uniqueness check\n- Format validation for all fields\n- Kelurahan existence validation\n- Coordinate bounds validation\n\nNomor KK and NIK are checked for a valid region code and serial, the nomor KK must\nstart with the code of the kecamatan and NIK ibu must encode a female birth date\n(day plus 40). Errors of a single field return it in data (object.FieldError).\n\nid_kelurahan may be left empty, it is then resolved from the koordinat. A chosen\nid_kelurahan must contain the koordinat, otherwise the located kelurahan is returned\nas error on id_kelurahan. Koordinat outside every kelurahan are rejected.\nKoordinat is [longitude, latitude], pairs out of range are rejected on koordinat.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6358,18 +8515,21 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Get balita data (Community)",
+                "summary": "Insert new keluarga (Community)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Balita ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Keluarga data",
+                        "name": "keluarga",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/community.insertKeluargaRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita data retrieved successfully",
+                        "description": "Keluarga inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6379,7 +8539,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.getAllBalitaResponse"
+                                            "$ref": "#/definitions/community.insertKeluargaResponse"
                                         }
                                     }
                                 }
@@ -6397,7 +8557,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/object.FieldError"
                                         }
                                     }
                                 }
@@ -6440,24 +8600,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Balita not found or not owned by user",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -6479,14 +8621,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/balita/insert": {
-            "post": {
+        "/api/community/keluarga/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new balita data for community/masyarakat users\n\nThis endpoint allows masyarakat users to register balita data\nfor families they have created. The balita will be linked to the\nspecified keluarga and can later be reported for stunting assessment.\n\nValidation includes:\n- Keluarga ownership verification (user can only add balita to their own keluarga)\n- Age criteria check (must be under 5 years old for balita classification)\n- Birth data validation (weight, height within reasonable ranges)\n- Duplicate prevention (same name and birth date in same keluarga)\n- Format validation for all fields",
+                "description": "Update existing keluarga data for community/masyarakat users\n\nThis endpoint allows masyarakat users to update family data\nthat they have previously created. Users can only update their own data.\n\nValidation includes:\n- Ownership verification (user can only update their own data)\n- Nomor KK and NIK uniqueness check (excluding current record)\n- Format validation for all fields\n- Kelurahan existence validation\n- Coordinate bounds validation\n- Business rule checks (no active reports constraint)\n\nNomor KK and NIK are checked for a valid region code and serial, the nomor KK must\nstart with the code of the kecamatan and NIK ibu must encode a female birth date\n(day plus 40). Errors of a single field return it in data (object.FieldError).\n\nid_kelurahan may be left empty, it is then resolved from the koordinat. A chosen\nid_kelurahan must contain the koordinat, otherwise the located kelurahan is returned\nas error on id_kelurahan. Koordinat outside every kelurahan are rejected.\nKoordinat is [longitude, latitude], pairs out of range are rejected on koordinat.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6496,21 +8638,21 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Insert new balita (Community)",
+                "summary": "Update keluarga data (Community)",
                 "parameters": [
                     {
-                        "description": "Balita data",
-                        "name": "balita",
+                        "description": "Keluarga data to update",
+                        "name": "keluarga",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/community.insertBalitaRequest"
+                            "$ref": "#/definitions/community.updateKeluargaRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita inserted successfully",
+                        "description": "Keluarga updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6520,7 +8662,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.insertBalitaResponse"
+                                            "$ref": "#/definitions/community.updateKeluargaResponse"
                                         }
                                     }
                                 }
@@ -6538,7 +8680,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/object.FieldError"
                                         }
                                     }
                                 }
@@ -6564,7 +8706,43 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required or not owner of keluarga",
+                        "description": "Forbidden - Masyarakat role required or not owner",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Keluarga not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Cannot update due to active reports",
                         "schema": {
                             "allOf": [
                                 {
@@ -6602,14 +8780,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/balita/update": {
-            "put": {
+        "/api/community/laporan/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing balita data for community/masyarakat users\n\nThis endpoint allows masyarakat users to update balita data\nthat they have previously created. Users can only update balita\nfrom keluarga they own and only if there are no active reports.\n\nValidation includes:\n- Balita ownership verification (through keluarga ownership)\n- Age criteria check (must be under 5 years old for balita classification)\n- Birth data validation (weight, height within reasonable ranges)\n- Duplicate prevention (same name and birth date in same keluarga)\n- Business rule checks (no active reports constraint)\n- Format validation for all fields",
+                "description": "Get laporan data for community/masyarakat users (own reports only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all laporan created by the user\n- With id parameter: Returns specific laporan data (if owned by user),\nincluding the status history (riwayat_status) so the reporter can follow the case\n\nData includes laporan information, balita details, keluarga info, status tracking,\nrelated medical records count, and action permissions.\nUsers can only access laporan they have created themselves.\nnomor_kk and the phone numbers are masked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6619,21 +8797,18 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Update balita data (Community)",
+                "summary": "Get laporan data (Community)",
                 "parameters": [
                     {
-                        "description": "Updated balita data",
-                        "name": "balita",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/community.updateBalitaRequest"
-                        }
+                        "type": "string",
+                        "description": "Laporan ID",
+                        "name": "id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balita updated successfully",
+                        "description": "Laporan data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6643,7 +8818,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.updateBalitaResponse"
+                                            "$ref": "#/definitions/community.getAllLaporanResponse"
                                         }
                                     }
                                 }
@@ -6687,7 +8862,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required or not owner",
+                        "description": "Forbidden - Masyarakat role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -6705,25 +8880,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Balita not found",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict - Cannot update due to active reports",
+                        "description": "Laporan not found or not owned by user",
                         "schema": {
                             "allOf": [
                                 {
@@ -6761,14 +8918,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/keluarga/get": {
-            "get": {
+        "/api/community/laporan/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get keluarga data for community/masyarakat users (own data only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all keluarga created by the user\n- With id parameter: Returns specific keluarga data (if owned by user)\n\nData includes family information, balita count, laporan status, and edit permissions.\nUsers can only access keluarga data they have created themselves.",
+                "description": "Insert new laporan for community/masyarakat users\n\nThis endpoint allows masyarakat users to report balita for stunting assessment.\nThe laporan will be automatically set with \"Belum diproses\" status and linked\nto the reporting masyarakat user.\n\nValidation includes:\n- Balita ownership verification (user can only report balita from their own keluarga)\n- Duplicate prevention (same balita and date)\n- Business rule checks (no pending reports for same balita)\n- Date validation (not future, not older than 1 year)\n- Contact information validation",
                 "consumes": [
                     "application/json"
                 ],
@@ -6778,18 +8935,21 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Get keluarga data (Community)",
+                "summary": "Insert new laporan (Community)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Keluarga ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Laporan data",
+                        "name": "laporan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/community.insertLaporanRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga data retrieved successfully",
+                        "description": "Laporan inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6799,7 +8959,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.getAllKeluargaResponse"
+                                            "$ref": "#/definitions/community.insertLaporanResponse"
                                         }
                                     }
                                 }
@@ -6843,7 +9003,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required",
+                        "description": "Forbidden - Masyarakat role required or not owner of balita",
                         "schema": {
                             "allOf": [
                                 {
@@ -6860,8 +9020,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Keluarga not found or not owned by user",
+                    "409": {
+                        "description": "Conflict - Pending report exists for this balita",
                         "schema": {
                             "allOf": [
                                 {
@@ -6899,14 +9059,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/keluarga/insert": {
-            "post": {
+        "/api/community/master-kecamatan": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new keluarga data for community/masyarakat users\n\nThis endpoint allows masyarakat users to register family data\nwhen reporting balita. The data will be linked to the reporting user.\n\nValidation includes:\n- Nomor KK and NIK uniqueness check\n- Format validation for all fields\n- Kelurahan existence validation\n- Coordinate bounds validation",
+                "description": "Get all kecamatan for dropdown/reference (Masyarakat only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -6916,39 +9076,10 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Insert new keluarga (Community)",
-                "parameters": [
-                    {
-                        "description": "Keluarga data",
-                        "name": "keluarga",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/community.insertKeluargaRequest"
-                        }
-                    }
-                ],
+                "summary": "Get kecamatan master data (Community)",
                 "responses": {
                     "200": {
-                        "description": "Keluarga inserted successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/community.insertKeluargaResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "Kecamatan data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -6958,7 +9089,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/community.getAllKecamatanResponse"
                                         }
                                     }
                                 }
@@ -7022,14 +9153,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/keluarga/update": {
-            "put": {
+        "/api/community/master-kelurahan": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update existing keluarga data for community/masyarakat users\n\nThis endpoint allows masyarakat users to update family data\nthat they have previously created. Users can only update their own data.\n\nValidation includes:\n- Ownership verification (user can only update their own data)\n- Nomor KK and NIK uniqueness check (excluding current record)\n- Format validation for all fields\n- Kelurahan existence validation\n- Coordinate bounds validation\n- Business rule checks (no active reports constraint)",
+                "description": "Get kelurahan data with optional kecamatan filter (Masyarakat only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -7039,21 +9170,18 @@ const docTemplate = `{
                 "tags": [
                     "community"
                 ],
-                "summary": "Update keluarga data (Community)",
+                "summary": "Get kelurahan master data (Community)",
                 "parameters": [
                     {
-                        "description": "Keluarga data to update",
-                        "name": "keluarga",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/community.updateKeluargaRequest"
-                        }
+                        "type": "string",
+                        "description": "Filter by Kecamatan ID",
+                        "name": "id_kecamatan",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Keluarga updated successfully",
+                        "description": "Kelurahan data retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -7063,15 +9191,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.updateKeluargaResponse"
+                                            "$ref": "#/definitions/community.getAllKelurahanResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -7088,8 +9216,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden - Masyarakat role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -7106,8 +9234,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - Masyarakat role required or not owner",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "allOf": [
                                 {
@@ -7123,9 +9251,49 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            }
+        },
+        "/api/community/master-status-laporan": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all status laporan for reference/display (Masyarakat only)\nThis is primarily for display purposes to show status meanings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "community"
+                ],
+                "summary": "Get status laporan master data (Community)",
+                "responses": {
+                    "200": {
+                        "description": "Status laporan data retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/community.getAllStatusLaporanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "404": {
-                        "description": "Keluarga not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -7142,8 +9310,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "409": {
-                        "description": "Conflict - Cannot update due to active reports",
+                    "403": {
+                        "description": "Forbidden - Masyarakat role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -7181,14 +9349,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/laporan/get": {
-            "get": {
+        "/api/health-worker/assignment/complete": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get laporan data for community/masyarakat users (own reports only)\n\nResponse data varies by parameter:\n- Without id parameter: Returns all laporan created by the user\n- With id parameter: Returns specific laporan data (if owned by user)\n\nData includes laporan information, balita details, keluarga info, status tracking,\nrelated medical records count, and action permissions.\nUsers can only access laporan they have created themselves.",
+                "description": "Mark an intervention assigned to the authenticated health worker as completed\n\n- id_intervensi: assigned intervention\n- hasil: final result of the intervention (5-500 characters)\n\nOnly interventions in progress and assigned to the health worker can be completed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7196,20 +9364,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "community"
+                    "health-worker"
                 ],
-                "summary": "Get laporan data (Community)",
+                "summary": "Complete intervention (Health Worker)",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Laporan ID",
-                        "name": "id",
-                        "in": "query"
+                        "description": "Final intervention result",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/healthworker.completeAssignmentRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan data retrieved successfully",
+                        "description": "Intervention completed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -7219,7 +9390,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.getAllLaporanResponse"
+                                            "$ref": "#/definitions/healthworker.completeAssignmentResponse"
                                         }
                                     }
                                 }
@@ -7263,7 +9434,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required",
+                        "description": "Forbidden - Health worker role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -7281,7 +9452,25 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Laporan not found or not owned by user",
+                        "description": "Intervention not found or not assigned to user",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "Intervention status changed concurrently",
                         "schema": {
                             "allOf": [
                                 {
@@ -7319,14 +9508,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/laporan/insert": {
-            "post": {
+        "/api/health-worker/assignment/get": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Insert new laporan for community/masyarakat users\n\nThis endpoint allows masyarakat users to report balita for stunting assessment.\nThe laporan will be automatically set with \"Belum diproses\" status and linked\nto the reporting masyarakat user.\n\nValidation includes:\n- Balita ownership verification (user can only report balita from their own keluarga)\n- Duplicate prevention (same balita and date)\n- Business rule checks (no pending reports for same balita)\n- Date validation (not future, not older than 1 year)\n- Contact information validation",
+                "description": "Get all interventions assigned to the authenticated health worker\n\nResponse data varies by parameter:\n- Without id parameter: Returns all assigned interventions\n- With id parameter: Returns specific intervention details (if assigned to user)\n\nData includes intervention information, balita details, family info, medical history,\nrelated reports, and action permissions based on intervention status.\nHealth workers can only access interventions assigned to them.\nnomor_kk is masked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7334,23 +9523,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "community"
+                    "health-worker"
                 ],
-                "summary": "Insert new laporan (Community)",
+                "summary": "Get assigned interventions (Health Worker)",
                 "parameters": [
                     {
-                        "description": "Laporan data",
-                        "name": "laporan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/community.insertLaporanRequest"
-                        }
+                        "type": "string",
+                        "description": "Intervention ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by intervention status (planned, in_progress, completed, cancelled)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Laporan inserted successfully",
+                        "description": "Assigned interventions retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -7360,7 +9552,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.insertLaporanResponse"
+                                            "$ref": "#/definitions/healthworker.getAllAssignedIntervensiResponse"
                                         }
                                     }
                                 }
@@ -7404,7 +9596,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required or not owner of balita",
+                        "description": "Forbidden - Health worker role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -7421,8 +9613,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "409": {
-                        "description": "Conflict - Pending report exists for this balita",
+                    "404": {
+                        "description": "Intervention not found or not assigned to user",
                         "schema": {
                             "allOf": [
                                 {
@@ -7460,14 +9652,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/master-kecamatan": {
-            "get": {
+        "/api/health-worker/assignment/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all kecamatan for dropdown/reference (Masyarakat only)",
+                "description": "Update the hasil (progress notes) of an intervention assigned to the authenticated health worker\n\n- id_intervensi: assigned intervention\n- hasil: progress notes (5-500 characters)\n\nReporting progress on a planned intervention moves it to in_progress.\nOnly interventions assigned to the health worker can be updated.\nCompleted or cancelled interventions cannot be updated anymore.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7475,12 +9667,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "community"
+                    "health-worker"
+                ],
+                "summary": "Update intervention progress (Health Worker)",
+                "parameters": [
+                    {
+                        "description": "Intervention progress",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/healthworker.updateAssignmentRequest"
+                        }
+                    }
                 ],
-                "summary": "Get kecamatan master data (Community)",
                 "responses": {
                     "200": {
-                        "description": "Kecamatan data retrieved successfully",
+                        "description": "Intervention progress updated successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -7490,15 +9693,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.getAllKecamatanResponse"
+                                            "$ref": "#/definitions/healthworker.updateAssignmentResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request",
                         "schema": {
                             "allOf": [
                                 {
@@ -7515,8 +9718,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - Masyarakat role required",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "allOf": [
                                 {
@@ -7533,8 +9736,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - Health worker role required",
                         "schema": {
                             "allOf": [
                                 {
@@ -7550,57 +9753,9 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/api/community/master-kelurahan": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get kelurahan data with optional kecamatan filter (Masyarakat only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "community"
-                ],
-                "summary": "Get kelurahan master data (Community)",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by Kecamatan ID",
-                        "name": "id_kecamatan",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Kelurahan data retrieved successfully",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/community.getAllKelurahanResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Intervention not found or not assigned to user",
                         "schema": {
                             "allOf": [
                                 {
@@ -7617,8 +9772,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - Masyarakat role required",
+                    "409": {
+                        "description": "Intervention status changed concurrently",
                         "schema": {
                             "allOf": [
                                 {
@@ -7656,14 +9811,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/community/master-status-laporan": {
-            "get": {
+        "/api/health-worker/riwayat-pemeriksaan/insert": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all status laporan for reference/display (Masyarakat only)\nThis is primarily for display purposes to show status meanings",
+                "description": "Insert new riwayat pemeriksaan data for a balita on an intervention\nassigned to the authenticated health worker\n\nCreates a new riwayat pemeriksaan record with:\n- id_balita: balita being examined\n- id_intervensi: related intervention program\n- id_laporan_masyarakat: related masyarakat report\n- tanggal: examination date (YYYY-MM-DD format)\n- berat_badan: weight in kg (decimal)\n- tinggi_badan: height in cm (decimal)\n- status_gizi: optional nutritional status (normal, stunting, gizi buruk)\n- keterangan: examination notes and recommendations\n\nStatus gizi and z-scores (TB/U, BB/U, BB/TB) are computed from the WHO 2006\nChild Growth Standards. A submitted status gizi that contradicts the computed\none is rejected. Status gizi is only required for balita outside the WHO range.\n\nThe balita must be the subject of the intervention and the intervention\nstatus must be in_progress or completed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7671,12 +9826,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "community"
+                    "health-worker"
+                ],
+                "summary": "Insert riwayat pemeriksaan (Health Worker)",
+                "parameters": [
+                    {
+                        "description": "Riwayat pemeriksaan data",
+                        "name": "riwayat",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/object.RiwayatPemeriksaanInput"
+                        }
+                    }
                 ],
-                "summary": "Get status laporan master data (Community)",
                 "responses": {
                     "200": {
-                        "description": "Status laporan data retrieved successfully",
+                        "description": "Riwayat pemeriksaan inserted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -7686,7 +9852,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/community.getAllStatusLaporanResponse"
+                                            "$ref": "#/definitions/healthworker.insertRiwayatPemeriksaanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
                                         }
                                     }
                                 }
@@ -7712,7 +9896,25 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Masyarakat role required",
+                        "description": "Forbidden - Health worker role required",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/object.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Intervention not found or not assigned to user",
                         "schema": {
                             "allOf": [
                                 {
@@ -7750,55 +9952,81 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/health-worker/assignment/get": {
+        "/api/tiles/{layer}/{z}/{x}/{y}.mvt": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all interventions assigned to the authenticated health worker\n\nResponse data varies by parameter:\n- Without id parameter: Returns all assigned interventions\n- With id parameter: Returns specific intervention details (if assigned to user)\n\nData includes intervention information, balita details, family info, medical history,\nrelated reports, and action permissions based on intervention status.\nHealth workers can only access interventions assigned to them.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get a tile of the kecamatan, kelurahan or balita layer as a Mapbox Vector Tile (Admin and health worker)\n\nTiles use the XYZ scheme of Leaflet and OpenStreetMap, e.g. with Leaflet.VectorGrid.\nThe layer in the tile has the name of the requested layer. Boundaries are clipped\nto the tile and simplified to half a pixel at the zoom level. Below zoom 16 the\nbalita layer holds clusters as returned by /api/admin/geojson-balita-points with\na zoom; status_gizi and status_laporan are JSON encoded. From zoom 16 it holds the\nindividual balita for admins, recorded in the access log, with nomor_kk masked\nunless lengkap=true. Health workers get clusters at every zoom.\n\nTiles are cached on the server, boundaries for an hour and balita for a minute.",
                 "produces": [
-                    "application/json"
+                    "application/vnd.mapbox-vector-tile"
                 ],
                 "tags": [
+                    "admin",
                     "health-worker"
                 ],
-                "summary": "Get assigned interventions (Health Worker)",
+                "summary": "Get map layer vector tile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Intervention ID",
-                        "name": "id",
+                        "description": "Layer (kecamatan, kelurahan, balita)",
+                        "name": "layer",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Zoom level (0-20)",
+                        "name": "z",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tile column",
+                        "name": "x",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tile row",
+                        "name": "y",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter the balita by status laporan",
+                        "name": "status_laporan",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by intervention status (pending, in_progress, completed)",
-                        "name": "status",
+                        "description": "Filter the balita by kecamatan",
+                        "name": "id_kecamatan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter the balita by kelurahan",
+                        "name": "id_kelurahan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the unmasked nomor KK",
+                        "name": "lengkap",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Assigned interventions retrieved successfully",
+                        "description": "Vector tile, empty when the tile has no features",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/object.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/healthworker.getAllAssignedIntervensiResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -7838,7 +10066,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Health worker role required",
+                        "description": "Forbidden",
                         "schema": {
                             "allOf": [
                                 {
@@ -7856,7 +10084,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Intervention not found or not assigned to user",
+                        "description": "Layer or tile not found",
                         "schema": {
                             "allOf": [
                                 {
@@ -7896,6 +10124,82 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "admin.accessLogResponse": {
+            "type": "object",
+            "properties": {
+                "created_date": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "entitas": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_pengguna": {
+                    "type": "string"
+                },
+                "id_request": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "jumlah": {
+                    "description": "number of records returned by the request",
+                    "type": "integer"
+                },
+                "privileged": {
+                    "description": "the identifiers were returned unmasked",
+                    "type": "boolean"
+                },
+                "tujuan": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.advanceLaporanMasyarakatRequest": {
+            "type": "object",
+            "properties": {
+                "catatan": {
+                    "description": "wajib, alasan perubahan status",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_status_laporan": {
+                    "description": "status tujuan",
+                    "type": "string"
+                }
+            }
+        },
+        "admin.advanceLaporanMasyarakatResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "id_status_laporan_asal": {
+                    "type": "string"
+                },
+                "id_status_laporan_tujuan": {
+                    "type": "string"
+                },
+                "status_laporan_asal": {
+                    "type": "string"
+                },
+                "status_laporan_tujuan": {
+                    "type": "string"
+                }
+            }
+        },
         "admin.assignIntervensiPetugasRequest": {
             "type": "object",
             "properties": {
@@ -7918,6 +10222,47 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.auditLogResponse": {
+            "type": "object",
+            "properties": {
+                "aksi": {
+                    "type": "string"
+                },
+                "created_date": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "entitas": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_entitas": {
+                    "type": "string"
+                },
+                "id_pengguna": {
+                    "type": "string"
+                },
+                "id_request": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "keterangan": {
+                    "type": "string"
+                },
+                "perubahan": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/audit.Change"
+                    }
+                }
+            }
+        },
         "admin.balitaResponse": {
             "type": "object",
             "properties": {
@@ -7969,9 +10314,30 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.cascadeResult": {
+            "type": "object",
+            "properties": {
+                "balita": {
+                    "type": "integer"
+                },
+                "intervensi": {
+                    "type": "integer"
+                },
+                "laporan_masyarakat": {
+                    "type": "integer"
+                },
+                "riwayat_pemeriksaan": {
+                    "type": "integer"
+                }
+            }
+        },
         "admin.deleteBalitaRequest": {
             "type": "object",
             "properties": {
+                "cascade": {
+                    "description": "also delete/restore laporan, intervensi and riwayat",
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 }
@@ -7980,6 +10346,9 @@ const docTemplate = `{
         "admin.deleteBalitaResponse": {
             "type": "object",
             "properties": {
+                "cascade": {
+                    "$ref": "#/definitions/admin.cascadeResult"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -8010,6 +10379,10 @@ const docTemplate = `{
         "admin.deleteKeluargaRequest": {
             "type": "object",
             "properties": {
+                "cascade": {
+                    "description": "also delete/restore balita, laporan, intervensi and riwayat",
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 }
@@ -8018,6 +10391,9 @@ const docTemplate = `{
         "admin.deleteKeluargaResponse": {
             "type": "object",
             "properties": {
+                "cascade": {
+                    "$ref": "#/definitions/admin.cascadeResult"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -8102,6 +10478,124 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.duplikatRecordResponse": {
+            "type": "object",
+            "properties": {
+                "alamat": {
+                    "type": "string"
+                },
+                "created_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_keluarga": {
+                    "type": "string"
+                },
+                "jenis_kelamin": {
+                    "type": "string"
+                },
+                "kecamatan": {
+                    "type": "string"
+                },
+                "kelurahan": {
+                    "type": "string"
+                },
+                "koordinat": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "nama": {
+                    "type": "string"
+                },
+                "nama_ayah": {
+                    "type": "string"
+                },
+                "nama_ibu": {
+                    "type": "string"
+                },
+                "nik_ayah": {
+                    "type": "string"
+                },
+                "nik_ibu": {
+                    "type": "string"
+                },
+                "nomor_kk": {
+                    "type": "string"
+                },
+                "tanggal_lahir": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.duplikatResponse": {
+            "type": "object",
+            "properties": {
+                "alasan": {
+                    "description": "matching features, e.g. nomor_kk, nama_ibu",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_date": {
+                    "type": "string"
+                },
+                "duplikat": {
+                    "$ref": "#/definitions/admin.duplikatRecordResponse"
+                },
+                "entitas": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_pengguna_review": {
+                    "type": "string"
+                },
+                "skor": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tanggal_review": {
+                    "type": "string"
+                },
+                "updated_date": {
+                    "type": "string"
+                },
+                "utama": {
+                    "description": "The records are missing once deleted, e.g. the duplikat of a merged pair",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/admin.duplikatRecordResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "admin.getAccessLogResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.accessLogResponse"
+                    }
+                },
+                "sejak": {
+                    "description": "start of the period",
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "admin.getAllBalitaResponse": {
             "type": "object",
             "properties": {
@@ -8284,6 +10778,40 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.getAuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.auditLogResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "admin.getDuplikatResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.duplikatResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "admin.insertBalitaRequest": {
             "type": "object",
             "properties": {
@@ -8347,6 +10875,9 @@ const docTemplate = `{
             "properties": {
                 "id": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
@@ -8394,6 +10925,10 @@ const docTemplate = `{
             "properties": {
                 "id": {
                     "type": "string"
+                },
+                "id_kelurahan": {
+                    "description": "chosen or resolved from the koordinat",
+                    "type": "string"
                 }
             }
         },
@@ -8458,44 +10993,16 @@ const docTemplate = `{
                 }
             }
         },
-        "admin.insertRiwayatPemeriksaanRequest": {
+        "admin.insertRiwayatPemeriksaanResponse": {
             "type": "object",
             "properties": {
-                "berat_badan": {
-                    "description": "in kg (decimal)",
-                    "type": "string"
-                },
-                "id_balita": {
-                    "type": "string"
-                },
-                "id_intervensi": {
-                    "type": "string"
-                },
-                "id_laporan_masyarakat": {
-                    "description": "\u003c- Field baru wajib",
-                    "type": "string"
+                "assessment": {
+                    "$ref": "#/definitions/growth.Result"
                 },
-                "keterangan": {
+                "id": {
                     "type": "string"
                 },
                 "status_gizi": {
-                    "description": "\"normal\", \"stunting\", \"gizi buruk\"",
-                    "type": "string"
-                },
-                "tanggal": {
-                    "description": "Format: YYYY-MM-DD",
-                    "type": "string"
-                },
-                "tinggi_badan": {
-                    "description": "in cm (decimal)",
-                    "type": "string"
-                }
-            }
-        },
-        "admin.insertRiwayatPemeriksaanResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
                     "type": "string"
                 }
             }
@@ -8558,6 +11065,12 @@ const docTemplate = `{
         "admin.intervensiResponse": {
             "type": "object",
             "properties": {
+                "cancelled_date": {
+                    "type": "string"
+                },
+                "completed_date": {
+                    "type": "string"
+                },
                 "created_by": {
                     "description": "nama admin yang membuat",
                     "type": "string"
@@ -8593,6 +11106,13 @@ const docTemplate = `{
                     "description": "jumlah riwayat pemeriksaan terkait",
                     "type": "integer"
                 },
+                "started_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "planned, in_progress, completed, cancelled",
+                    "type": "string"
+                },
                 "tanggal": {
                     "type": "string"
                 },
@@ -8735,6 +11255,13 @@ const docTemplate = `{
                 "nama_pelapor": {
                     "type": "string"
                 },
+                "next_status": {
+                    "description": "id status yang dapat dituju",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "nomor_hp_keluarga_balita": {
                     "type": "string"
                 },
@@ -8744,30 +11271,77 @@ const docTemplate = `{
                 "nomor_kk": {
                     "type": "string"
                 },
+                "riwayat_status": {
+                    "description": "Workflow status laporan (hanya untuk detail laporan)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/object.LaporanStatusHistory"
+                    }
+                },
                 "status_laporan": {
                     "type": "string"
                 },
                 "tanggal_laporan": {
                     "type": "string"
                 },
-                "updated_date": {
+                "updated_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.masyarakatResponse": {
+            "type": "object",
+            "properties": {
+                "alamat": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nama": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.mergeDuplikatRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "id_utama": {
+                    "description": "surviving record, defaults to the id_utama of the candidate",
                     "type": "string"
                 }
             }
         },
-        "admin.masyarakatResponse": {
+        "admin.mergeDuplikatResponse": {
             "type": "object",
             "properties": {
-                "alamat": {
-                    "type": "string"
+                "dipindahkan": {
+                    "description": "rows moved to the surviving record",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/admin.cascadeResult"
+                        }
+                    ]
                 },
-                "email": {
+                "entitas": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "nama": {
+                "id_duplikat": {
+                    "type": "string"
+                },
+                "id_utama": {
+                    "type": "string"
+                },
+                "message": {
                     "type": "string"
                 }
             }
@@ -8808,6 +11382,25 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.rejectDuplikatRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.rejectDuplikatResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
         "admin.removeIntervensiPetugasRequest": {
             "type": "object",
             "properties": {
@@ -8828,6 +11421,26 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.revokeSessionsRequest": {
+            "type": "object",
+            "properties": {
+                "id_pengguna": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.revokeSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "id_pengguna": {
+                    "type": "string"
+                },
+                "revoked": {
+                    "description": "number of sessions revoked",
+                    "type": "integer"
+                }
+            }
+        },
         "admin.riwayatPemeriksaanResponse": {
             "type": "object",
             "properties": {
@@ -8912,6 +11525,15 @@ const docTemplate = `{
                 },
                 "updated_date": {
                     "type": "string"
+                },
+                "zscore_bb_tb": {
+                    "type": "number"
+                },
+                "zscore_bb_u": {
+                    "type": "number"
+                },
+                "zscore_tb_u": {
+                    "type": "number"
                 }
             }
         },
@@ -9042,6 +11664,36 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.updateIntervensiStatusRequest": {
+            "type": "object",
+            "properties": {
+                "hasil": {
+                    "description": "optional, required when completing without hasil",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "\"in_progress\", \"completed\", \"cancelled\"",
+                    "type": "string"
+                }
+            }
+        },
+        "admin.updateIntervensiStatusResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "previous_status": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "admin.updateKeluargaRequest": {
             "type": "object",
             "properties": {
@@ -9090,6 +11742,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "id_kelurahan": {
+                    "description": "chosen or resolved from the koordinat",
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 }
@@ -9112,6 +11768,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "id_status_laporan": {
+                    "description": "optional, status diubah melalui endpoint advance",
                     "type": "string"
                 },
                 "nomor_hp_keluarga_balita": {
@@ -9193,7 +11850,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "status_gizi": {
-                    "description": "\"normal\", \"stunting\", \"gizi buruk\"",
+                    "description": "optional, computed from WHO growth standards",
                     "type": "string"
                 },
                 "tanggal": {
@@ -9209,11 +11866,17 @@ const docTemplate = `{
         "admin.updateRiwayatPemeriksaanResponse": {
             "type": "object",
             "properties": {
+                "assessment": {
+                    "$ref": "#/definitions/growth.Result"
+                },
                 "id": {
                     "type": "string"
                 },
                 "message": {
                     "type": "string"
+                },
+                "status_gizi": {
+                    "type": "string"
                 }
             }
         },
@@ -9243,6 +11906,13 @@ const docTemplate = `{
                 }
             }
         },
+        "audit.Change": {
+            "type": "object",
+            "properties": {
+                "new": {},
+                "old": {}
+            }
+        },
         "auth.loginRequest": {
             "type": "object",
             "properties": {
@@ -9257,11 +11927,37 @@ const docTemplate = `{
         "auth.loginResponse": {
             "type": "object",
             "properties": {
+                "expires_in": {
+                    "description": "seconds until token expires",
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
                 "token": {
                     "type": "string"
                 }
             }
         },
+        "auth.refreshRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.registerAdminRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
         "auth.registerRequest": {
             "type": "object",
             "properties": {
@@ -9527,6 +12223,10 @@ const docTemplate = `{
             "properties": {
                 "id": {
                     "type": "string"
+                },
+                "id_kelurahan": {
+                    "description": "chosen or resolved from the koordinat",
+                    "type": "string"
                 }
             }
         },
@@ -9708,6 +12408,13 @@ const docTemplate = `{
                 "riwayat_pemeriksaan": {
                     "type": "integer"
                 },
+                "riwayat_status": {
+                    "description": "Riwayat perubahan status (hanya untuk detail laporan)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/object.LaporanStatusHistory"
+                    }
+                },
                 "status_keterangan": {
                     "type": "string"
                 },
@@ -9825,11 +12532,44 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "id_kelurahan": {
+                    "description": "chosen or resolved from the koordinat",
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 }
             }
         },
+        "growth.Result": {
+            "type": "object",
+            "properties": {
+                "kategori_bb_tb": {
+                    "type": "string"
+                },
+                "kategori_bb_u": {
+                    "type": "string"
+                },
+                "kategori_tb_u": {
+                    "type": "string"
+                },
+                "status_gizi": {
+                    "type": "string"
+                },
+                "umur_bulan": {
+                    "type": "number"
+                },
+                "zscore_bb_tb": {
+                    "type": "number"
+                },
+                "zscore_bb_u": {
+                    "type": "number"
+                },
+                "zscore_tb_u": {
+                    "type": "number"
+                }
+            }
+        },
         "healthworker.assignedIntervensiResponse": {
             "type": "object",
             "properties": {
@@ -9922,6 +12662,32 @@ const docTemplate = `{
                 }
             }
         },
+        "healthworker.completeAssignmentRequest": {
+            "type": "object",
+            "properties": {
+                "hasil": {
+                    "description": "final result of the intervention",
+                    "type": "string"
+                },
+                "id_intervensi": {
+                    "type": "string"
+                }
+            }
+        },
+        "healthworker.completeAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "hasil": {
+                    "type": "string"
+                },
+                "id_intervensi": {
+                    "type": "string"
+                },
+                "status_intervensi": {
+                    "type": "string"
+                }
+            }
+        },
         "healthworker.getAllAssignedIntervensiResponse": {
             "type": "object",
             "properties": {
@@ -9936,6 +12702,53 @@ const docTemplate = `{
                 }
             }
         },
+        "healthworker.insertRiwayatPemeriksaanResponse": {
+            "type": "object",
+            "properties": {
+                "assessment": {
+                    "$ref": "#/definitions/growth.Result"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status_gizi": {
+                    "type": "string"
+                }
+            }
+        },
+        "healthworker.updateAssignmentRequest": {
+            "type": "object",
+            "properties": {
+                "hasil": {
+                    "type": "string"
+                },
+                "id_intervensi": {
+                    "type": "string"
+                }
+            }
+        },
+        "healthworker.updateAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "id_intervensi": {
+                    "type": "string"
+                },
+                "status_intervensi": {
+                    "type": "string"
+                }
+            }
+        },
+        "object.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
         "object.GeoJSONFeature": {
             "type": "object",
             "properties": {
@@ -9969,11 +12782,47 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "coordinates": {},
+                "geometries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/object.GeoJSONGeometry"
+                    }
+                },
                 "type": {
                     "type": "string"
                 }
             }
         },
+        "object.LaporanStatusHistory": {
+            "type": "object",
+            "properties": {
+                "catatan": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "email pengguna yang mengubah status",
+                    "type": "string"
+                },
+                "created_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "id_status_laporan_asal": {
+                    "type": "string"
+                },
+                "id_status_laporan_tujuan": {
+                    "type": "string"
+                },
+                "status_laporan_asal": {
+                    "type": "string"
+                },
+                "status_laporan_tujuan": {
+                    "type": "string"
+                }
+            }
+        },
         "object.Response": {
             "type": "object",
             "properties": {
@@ -9985,6 +12834,39 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "object.RiwayatPemeriksaanInput": {
+            "type": "object",
+            "properties": {
+                "berat_badan": {
+                    "description": "in kg (decimal)",
+                    "type": "string"
+                },
+                "id_balita": {
+                    "type": "string"
+                },
+                "id_intervensi": {
+                    "type": "string"
+                },
+                "id_laporan_masyarakat": {
+                    "type": "string"
+                },
+                "keterangan": {
+                    "type": "string"
+                },
+                "status_gizi": {
+                    "description": "optional, computed from WHO growth standards",
+                    "type": "string"
+                },
+                "tanggal": {
+                    "description": "Format: YYYY-MM-DD",
+                    "type": "string"
+                },
+                "tinggi_badan": {
+                    "description": "in cm (decimal)",
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/api/admin"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+// runImport runs the import command, which bulk inserts keluarga or balita
+// from a CSV file. Nothing is imported when any row is rejected.
+func runImport(args []string) error {
+	tables := make([]string, 0, len(admin.ImportTables))
+	for table := range admin.ImportTables {
+		tables = append(tables, table)
+	}
+	slices.Sort(tables)
+
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	table := flags.String("table", "", "table to import into: "+strings.Join(tables, ", "))
+	file := flags.String("file", "", "CSV file with a header line naming the columns")
+	createdBy := flags.String("created-by", "", "email of the admin recorded as creator of the rows")
+	dryRun := flags.Bool("dry-run", false, "check the rows without importing them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *table == "" || *file == "" {
+		return errors.New("import: -table and -file are required")
+	}
+	if _, ok := admin.ImportTables[*table]; !ok {
+		return fmt.Errorf("import: unknown table %q, use %s", *table, strings.Join(tables, ", "))
+	}
+
+	input, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var createdId string
+	if *createdBy != "" {
+		err := db.QueryRow("SELECT id FROM pengguna WHERE email = ? AND role = 'admin'", *createdBy).Scan(&createdId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("import: no admin with email %s", *createdBy)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	keys, err := fieldcrypt.NewKeyring(cfg.Encryption)
+	if err != nil {
+		return err
+	}
+
+	service := admin.NewService(cfg, db, store.NewMySQL(db, keys))
+	report, err := service.Import(*table, input, createdId, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, rowErr := range report.Errors {
+		fmt.Printf("line %d: %s\n", rowErr.Line, rowErr.Message)
+	}
+	switch {
+	case len(report.Errors) > 0:
+		return fmt.Errorf("import: %d of %d rows rejected, nothing was imported", len(report.Errors), report.Rows)
+	case *dryRun:
+		fmt.Printf("%d %s rows are valid, nothing was imported (dry run)\n", report.Rows, *table)
+	default:
+		fmt.Printf("imported %d %s rows\n", report.Imported, *table)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package admin
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// Period limits of AccessLogGet, in days.
+const (
+	accessLogDefaultDays = 90
+	accessLogMaxDays     = 3650
+)
+
+// accessLogMaxRows is the maximum number of accesses returned by AccessLogGet.
+const accessLogMaxRows = 1000
+
+type accessLogResponse struct {
+	Id          string `json:"id"`
+	IdPengguna  string `json:"id_pengguna,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Endpoint    string `json:"endpoint"`
+	Tujuan      string `json:"tujuan"`
+	Entitas     string `json:"entitas"`
+	Jumlah      int    `json:"jumlah"`     // number of records returned by the request
+	Privileged  bool   `json:"privileged"` // the identifiers were returned unmasked
+	IdRequest   string `json:"id_request,omitempty"`
+	IPAddress   string `json:"ip_address"`
+	CreatedDate string `json:"created_date"`
+}
+
+type getAccessLogResponse struct {
+	Data  []accessLogResponse `json:"data"`
+	Total int                 `json:"total"`
+	Sejak string              `json:"sejak"` // start of the period
+}
+
+// accessLogFilter holds the query parameters of AccessLogGet.
+type accessLogFilter struct {
+	Entitas    string
+	IdEntitas  string
+	IdPengguna string
+	Privileged bool
+	Sejak      string
+}
+
+// # AccessLogGet handles querying the access log
+//
+// @Summary Get access log
+// @Description Get reads of keluarga, balita and laporan masyarakat data, newest first (Admin only)
+// @Description
+// @Description Every request to the keluarga, balita, laporan masyarakat and balita points endpoints
+// @Description is recorded with the viewer, the stated purpose (X-Access-Purpose header or tujuan
+// @Description query parameter) and the ids of the returned records.
+// @Description
+// @Description With entitas and id_entitas the log answers who accessed one record. Reads of a
+// @Description keluarga include reads of its balita and their laporan masyarakat, reads of a balita
+// @Description include reads of its laporan masyarakat.
+// @Description
+// @Description Reads in which an admin asked for the unmasked NIK, nomor KK and phone numbers
+// @Description (lengkap=true) are marked privileged, use privileged=true to list only those.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param entitas query string false "Entity (keluarga, balita, laporan_masyarakat)"
+// @Param id_entitas query string false "Record ID, used together with entitas"
+// @Param id_pengguna query string false "Pengguna ID of the viewer"
+// @Param hari query int false "Number of days to look back (default 90)"
+// @Param privileged query bool false "Only list reads of unmasked data"
+// @Success 200 {object} object.Response{data=getAccessLogResponse} "Access log retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/access-log/get [get]
+func (s *Service) AccessLogGet(w http.ResponseWriter, r *http.Request) {
+	// Connect to database
+	db := s.db
+
+	// Parse query parameters
+	query := r.URL.Query()
+	filter := accessLogFilter{
+		Entitas:    query.Get("entitas"),
+		IdEntitas:  query.Get("id_entitas"),
+		IdPengguna: query.Get("id_pengguna"),
+	}
+
+	if filter.IdEntitas != "" && filter.Entitas == "" {
+		response := object.NewResponse(http.StatusBadRequest, "entitas is required when filtering by id_entitas", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	switch filter.Entitas {
+	case "", "keluarga", "balita", "laporan_masyarakat":
+	default:
+		response := object.NewResponse(http.StatusBadRequest, "entitas must be keluarga, balita or laporan_masyarakat", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if privilegedParam := query.Get("privileged"); privilegedParam != "" {
+		privileged, err := strconv.ParseBool(privilegedParam)
+		if err != nil {
+			response := object.NewResponse(http.StatusBadRequest, "privileged must be true or false", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		filter.Privileged = privileged
+	}
+
+	days := accessLogDefaultDays
+	if daysParam := query.Get("hari"); daysParam != "" {
+		var err error
+		days, err = strconv.Atoi(daysParam)
+		if err != nil || days < 1 || days > accessLogMaxDays {
+			response := object.NewResponse(http.StatusBadRequest, "hari must be between 1 and 3650", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+	filter.Sejak = time.Now().AddDate(0, 0, -days).Format("2006-01-02 15:04:05")
+
+	entries, err := getAccessLog(db, filter)
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to get access log", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Access log retrieved successfully", getAccessLogResponse{
+		Data:  entries,
+		Total: len(entries),
+		Sejak: filter.Sejak,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to get the condition matching the access log entries that
+// returned the record of entity with the given id, including the records
+// belonging to it
+func accessLogRecordCondition(entity, id string) (string, []any) {
+	var parts []string
+	var args []any
+
+	parts = append(parts, "(al.entitas = ? AND alr.id_entitas = ?)")
+	args = append(args, entity, id)
+
+	switch entity {
+	case "keluarga":
+		parts = append(parts,
+			"(al.entitas = 'balita' AND alr.id_entitas IN (SELECT id FROM balita WHERE id_keluarga = ?))",
+			"(al.entitas = 'laporan_masyarakat' AND alr.id_entitas IN (SELECT lm.id FROM laporan_masyarakat lm JOIN balita b ON lm.id_balita = b.id WHERE b.id_keluarga = ?))",
+		)
+		args = append(args, id, id)
+	case "balita":
+		parts = append(parts,
+			"(al.entitas = 'laporan_masyarakat' AND alr.id_entitas IN (SELECT id FROM laporan_masyarakat WHERE id_balita = ?))",
+		)
+		args = append(args, id)
+	}
+
+	return "EXISTS (SELECT 1 FROM access_log_record alr WHERE alr.id_access_log = al.id AND (" +
+		strings.Join(parts, " OR ") + "))", args
+}
+
+// Helper function to get the access log entries matching filter
+func getAccessLog(db *sql.DB, filter accessLogFilter) ([]accessLogResponse, error) {
+	conditions := []string{"al.created_date >= ?"}
+	args := []any{filter.Sejak}
+	if filter.IdEntitas != "" {
+		condition, conditionArgs := accessLogRecordCondition(filter.Entitas, filter.IdEntitas)
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	} else if filter.Entitas != "" {
+		conditions = append(conditions, "al.entitas = ?")
+		args = append(args, filter.Entitas)
+	}
+	if filter.IdPengguna != "" {
+		conditions = append(conditions, "al.id_pengguna = ?")
+		args = append(args, filter.IdPengguna)
+	}
+	if filter.Privileged {
+		conditions = append(conditions, "al.privileged = 1")
+	}
+
+	query := `
+        SELECT
+            al.id, al.id_pengguna, p.email, al.endpoint, al.tujuan, al.entitas,
+            al.jumlah, al.privileged, al.id_request, al.ip_address, al.created_date
+        FROM access_log al
+        LEFT JOIN pengguna p ON al.id_pengguna = p.id
+        WHERE ` + strings.Join(conditions, " AND ") + `
+        ORDER BY al.id DESC
+        LIMIT ?
+    `
+
+	rows, err := db.Query(query, append(args, accessLogMaxRows)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []accessLogResponse{}
+	for rows.Next() {
+		var entry accessLogResponse
+		var idPengguna, email, idRequest sql.NullString
+
+		err := rows.Scan(
+			&entry.Id,
+			&idPengguna,
+			&email,
+			&entry.Endpoint,
+			&entry.Tujuan,
+			&entry.Entitas,
+			&entry.Jumlah,
+			&entry.Privileged,
+			&idRequest,
+			&entry.IPAddress,
+			&entry.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.IdPengguna = idPengguna.String
+		entry.Email = email.String
+		entry.IdRequest = idRequest.String
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
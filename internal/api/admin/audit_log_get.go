@@ -0,0 +1,241 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// Page size limits of AuditLogGet.
+const (
+	auditLogDefaultLimit = 100
+	auditLogMaxLimit     = 500
+)
+
+type auditLogResponse struct {
+	Id          string                  `json:"id"`
+	IdPengguna  string                  `json:"id_pengguna,omitempty"`
+	Email       string                  `json:"email,omitempty"`
+	Aksi        string                  `json:"aksi"`
+	Entitas     string                  `json:"entitas"`
+	IdEntitas   string                  `json:"id_entitas"`
+	IdRequest   string                  `json:"id_request,omitempty"`
+	Keterangan  string                  `json:"keterangan,omitempty"`
+	Perubahan   map[string]audit.Change `json:"perubahan,omitempty"`
+	IPAddress   string                  `json:"ip_address"`
+	CreatedDate string                  `json:"created_date"`
+}
+
+type getAuditLogResponse struct {
+	Data   []auditLogResponse `json:"data"`
+	Total  int                `json:"total"`
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+}
+
+// auditLogFilter holds the query parameters of AuditLogGet.
+type auditLogFilter struct {
+	Entitas    string
+	IdEntitas  string
+	IdPengguna string
+	IdRequest  string
+	Aksi       string
+	Limit      int
+	Offset     int
+}
+
+// # AuditLogGet handles querying the audit log
+//
+// @Summary Get audit log
+// @Description Get audit log entries, newest first (Admin only)
+// @Description
+// @Description Every insert, update, delete and restore is recorded with the actor, timestamp,
+// @Description request id and the changed columns with their old and new values.
+// @Description
+// @Description Filters can be combined:
+// @Description - entitas: table name, e.g. balita, keluarga, riwayat_pemeriksaan
+// @Description - id_entitas: id of one record, used together with entitas
+// @Description - id_pengguna: pengguna who made the changes
+// @Description - id_request: every change of one request (X-Request-Id response header)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param entitas query string false "Entity (table name)"
+// @Param id_entitas query string false "Record ID"
+// @Param id_pengguna query string false "Pengguna ID of the actor"
+// @Param id_request query string false "Request ID"
+// @Param aksi query string false "Action (create, update, delete, restore, revoke, merge)"
+// @Param limit query int false "Maximum number of entries (default 100, max 500)"
+// @Param offset query int false "Number of entries to skip"
+// @Success 200 {object} object.Response{data=getAuditLogResponse} "Audit log retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/audit-log/get [get]
+func (s *Service) AuditLogGet(w http.ResponseWriter, r *http.Request) {
+	// Connect to database
+	db := s.db
+
+	// Parse query parameters
+	query := r.URL.Query()
+	filter := auditLogFilter{
+		Entitas:    query.Get("entitas"),
+		IdEntitas:  query.Get("id_entitas"),
+		IdPengguna: query.Get("id_pengguna"),
+		IdRequest:  query.Get("id_request"),
+		Aksi:       query.Get("aksi"),
+		Limit:      auditLogDefaultLimit,
+	}
+
+	if filter.IdEntitas != "" && filter.Entitas == "" {
+		response := object.NewResponse(http.StatusBadRequest, "entitas is required when filtering by id_entitas", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 || limit > auditLogMaxLimit {
+			response := object.NewResponse(http.StatusBadRequest, "limit must be between 1 and 500", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		filter.Limit = limit
+	}
+
+	if offsetParam := query.Get("offset"); offsetParam != "" {
+		offset, err := strconv.Atoi(offsetParam)
+		if err != nil || offset < 0 {
+			response := object.NewResponse(http.StatusBadRequest, "offset must be a non-negative number", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		filter.Offset = offset
+	}
+
+	entries, total, err := getAuditLog(db, filter)
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to get audit log", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Audit log retrieved successfully", getAuditLogResponse{
+		Data:   entries,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to get the audit log entries matching filter and the
+// number of matching entries
+func getAuditLog(db *sql.DB, filter auditLogFilter) ([]auditLogResponse, int, error) {
+	var conditions []string
+	var args []any
+	if filter.Entitas != "" {
+		conditions = append(conditions, "al.entitas = ?")
+		args = append(args, filter.Entitas)
+	}
+	if filter.IdEntitas != "" {
+		conditions = append(conditions, "al.id_entitas = ?")
+		args = append(args, filter.IdEntitas)
+	}
+	if filter.IdPengguna != "" {
+		conditions = append(conditions, "al.id_pengguna = ?")
+		args = append(args, filter.IdPengguna)
+	}
+	if filter.IdRequest != "" {
+		conditions = append(conditions, "al.id_request = ?")
+		args = append(args, filter.IdRequest)
+	}
+	if filter.Aksi != "" {
+		conditions = append(conditions, "al.aksi = ?")
+		args = append(args, filter.Aksi)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	err := db.QueryRow("SELECT COUNT(*) FROM audit_log al "+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+        SELECT
+            al.id, al.id_pengguna, p.email, al.aksi, al.entitas, al.id_entitas,
+            al.id_request, al.keterangan, al.perubahan, al.ip_address, al.created_date
+        FROM audit_log al
+        LEFT JOIN pengguna p ON al.id_pengguna = p.id
+        ` + where + `
+        ORDER BY al.id DESC
+        LIMIT ? OFFSET ?
+    `
+
+	rows, err := db.Query(query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := []auditLogResponse{}
+	for rows.Next() {
+		var entry auditLogResponse
+		var idPengguna, email, idRequest, perubahan sql.NullString
+
+		err := rows.Scan(
+			&entry.Id,
+			&idPengguna,
+			&email,
+			&entry.Aksi,
+			&entry.Entitas,
+			&entry.IdEntitas,
+			&idRequest,
+			&entry.Keterangan,
+			&perubahan,
+			&entry.IPAddress,
+			&entry.CreatedDate,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		entry.IdPengguna = idPengguna.String
+		entry.Email = email.String
+		entry.IdRequest = idRequest.String
+		if perubahan.Valid {
+			if err := json.Unmarshal([]byte(perubahan.String), &entry.Perubahan); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
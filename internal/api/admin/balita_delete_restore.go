@@ -7,11 +7,14 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
 type deleteBalitaRequest struct {
-	Id string `json:"id"`
+	Id      string `json:"id"`
+	Cascade bool   `json:"cascade"` // also delete/restore laporan, intervensi and riwayat
 }
 
 func (r *deleteBalitaRequest) validate() error {
@@ -22,8 +25,9 @@ func (r *deleteBalitaRequest) validate() error {
 }
 
 type deleteBalitaResponse struct {
-	Id      string `json:"id"`
-	Message string `json:"message"`
+	Id      string         `json:"id"`
+	Message string         `json:"message"`
+	Cascade *cascadeResult `json:"cascade,omitempty"`
 }
 
 // # BalitaDelete handles soft deleting balita data
@@ -37,6 +41,9 @@ type deleteBalitaResponse struct {
 // @Description - Data remains in database but is excluded from queries
 // @Description - Can be restored if needed in the future
 // @Description - Checks for related records before deletion
+// @Description
+// @Description With cascade, the active laporan, intervensi and riwayat pemeriksaan of the balita
+// @Description are soft deleted together with the balita instead of blocking the deletion.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -49,47 +56,12 @@ type deleteBalitaResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Balita not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/balita/delete [delete]
-func BalitaDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) BalitaDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteBalitaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -109,134 +81,121 @@ func BalitaDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if balita exists and not already soft deleted
-	var exists int
-	var deletedDate sql.NullString
-	checkQuery := "SELECT COUNT(*), deleted_date FROM balita WHERE id = ? GROUP BY deleted_date"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var cascade *cascadeResult
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists and not already soft deleted
+		var exists int
+		var deletedDate sql.NullString
+		checkQuery := "SELECT COUNT(*), deleted_date FROM balita WHERE id = ? GROUP BY deleted_date FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Balita not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita already deleted")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "Balita already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if balita has related laporan masyarakat records (prevent deletion if has reports, unless cascading)
+		var laporanCount int
+		checkLaporanQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id_balita = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related laporan")
 		}
-		return
-	}
 
-	// Check if balita has related laporan masyarakat records (prevent deletion if has reports)
-	var laporanCount int
-	checkLaporanQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id_balita = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if laporanCount > 0 && !req.Cascade {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete balita. There are %d active laporan masyarakat records related to this balita", laporanCount))
 		}
-		return
-	}
 
-	if laporanCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete balita. There are %d active laporan masyarakat records related to this balita", laporanCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if balita has related riwayat pemeriksaan records (prevent deletion if has medical history, unless cascading)
+		var riwayatCount int
+		checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Check if balita has related riwayat pemeriksaan records (prevent deletion if has medical history)
-	var riwayatCount int
-	checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if riwayatCount > 0 && !req.Cascade {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete balita. There are %d active riwayat pemeriksaan records related to this balita", riwayatCount))
 		}
-		return
-	}
 
-	if riwayatCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete balita. There are %d active riwayat pemeriksaan records related to this balita", riwayatCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Soft delete the related data with the same timestamp, so it can be restored together
+		if req.Cascade {
+			cascade = &cascadeResult{}
+			err = cascadeDelete(tx, r, cascade, currentTime, "id = ?", req.Id)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete related data")
+			}
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "balita", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read balita for audit log")
+		}
 
-	// Perform soft delete
-	deleteQuery := `UPDATE balita SET 
+		// Perform soft delete
+		deleteQuery := `UPDATE balita SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(deleteQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deleteQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete balita")
+		}
+
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found or already deleted")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "balita", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found or already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+	message := "Data balita berhasil dihapus"
+	if cascade != nil {
+		message = "Data balita beserta data terkait berhasil dihapus"
 	}
 
 	response := object.NewResponse(http.StatusOK, "Balita deleted successfully", deleteBalitaResponse{
 		Id:      req.Id,
-		Message: "Data balita berhasil dihapus",
+		Message: message,
+		Cascade: cascade,
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -247,6 +206,9 @@ func BalitaDelete(w http.ResponseWriter, r *http.Request) {
 //
 // @Summary Restore deleted balita data
 // @Description Restore soft deleted balita data by clearing deleted_date and deleted_id (Admin only)
+// @Description
+// @Description With cascade, the laporan, intervensi and riwayat pemeriksaan that were deleted
+// @Description together with the balita (same deleted_id and deleted_date) are restored as well.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -259,47 +221,12 @@ func BalitaDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Balita not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/balita/restore [post]
-func BalitaRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) BalitaRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteBalitaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -319,108 +246,107 @@ func BalitaRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var cascade *cascadeResult
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists and is soft deleted
+		var deletedId sql.NullString
+		var deletedDate string
+		checkQuery := "SELECT deleted_id, deleted_date FROM balita WHERE id = ? AND deleted_date IS NOT NULL FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&deletedId, &deletedDate)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Balita not found or not deleted")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
-	defer db.Close()
 
-	// Check if balita exists and is soft deleted
-	var exists int
-	checkQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NOT NULL"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related keluarga still exists and is not soft deleted
+		var keluargaExists int
+		var balitaIdKeluarga string
+		getKeluargaQuery := "SELECT id_keluarga FROM balita WHERE id = ?"
+		err = tx.QueryRow(getKeluargaQuery, req.Id).Scan(&balitaIdKeluarga)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get balita keluarga")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL LOCK IN SHARE MODE"
+		err = tx.QueryRow(checkKeluargaQuery, balitaIdKeluarga).Scan(&keluargaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related keluarga")
 		}
-		return
-	}
 
-	// Check if related keluarga still exists and is not soft deleted
-	var keluargaExists int
-	var balitaIdKeluarga string
-	getKeluargaQuery := "SELECT id_keluarga FROM balita WHERE id = ?"
-	err = db.QueryRow(getKeluargaQuery, req.Id).Scan(&balitaIdKeluarga)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get balita keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if keluargaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore balita. Related keluarga does not exist or is deleted")
 		}
-		return
-	}
 
-	checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkKeluargaQuery, balitaIdKeluarga).Scan(&keluargaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	if keluargaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore balita. Related keluarga does not exist or is deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Restore the related data that was deleted together with the balita
+		if req.Cascade {
+			cascade = &cascadeResult{}
+			err = cascadeRestore(tx, r, cascade, currentTime, deletedId, deletedDate, "id = ?", req.Id)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore related data")
+			}
 		}
-		return
-	}
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "balita", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read balita for audit log")
+		}
 
-	// Restore balita (clear soft delete fields)
-	restoreQuery := `UPDATE balita SET 
+		// Restore balita (clear soft delete fields)
+		restoreQuery := `UPDATE balita SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore balita")
+		}
+
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found or not deleted")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "balita", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+	message := "Data balita berhasil dipulihkan"
+	if cascade != nil {
+		message = "Data balita beserta data terkait berhasil dipulihkan"
 	}
 
 	response := object.NewResponse(http.StatusOK, "Balita restored successfully", deleteBalitaResponse{
 		Id:      req.Id,
-		Message: "Data balita berhasil dipulihkan",
+		Message: message,
+		Cascade: cascade,
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1,18 +1,20 @@
 package admin
 
 import (
-	"database/sql"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type balitaResponse struct {
 	Id           string `json:"id"`
 	IdKeluarga   string `json:"id_keluarga"`
-	NomorKk      string `json:"nomor_kk"`
+	NomorKk      string `json:"nomor_kk" pii:"nomor_kk"`
 	NamaAyah     string `json:"nama_ayah"`
 	NamaIbu      string `json:"nama_ibu"`
 	Nama         string `json:"nama"`
@@ -46,11 +48,14 @@ type getBalitaByIdResponse struct {
 // @Description - With id parameter: Returns specific balita data
 // @Description
 // @Description Balita data includes: nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, umur, keluarga info, location info
+// @Description
+// @Description nomor_kk is masked unless lengkap=true, which is logged as a privileged read.
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Balita ID"
+// @Param lengkap query bool false "Return the unmasked nomor KK"
 // @Success 200 {object} object.Response{data=getAllBalitaResponse} "Balita data retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -58,62 +63,24 @@ type getBalitaByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Balita not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/balita/get [get]
-func BalitaGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
+func (s *Service) BalitaGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
-	defer db.Close()
 
 	// Check if ID parameter is provided
 	idParam := r.URL.Query().Get("id")
 	if idParam != "" {
 		// Get specific balita by ID
-		balita, err := getBalitaById(db, idParam)
+		balita, err := getBalitaById(s.store.Balita, idParam)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -127,6 +94,19 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		access := audit.NewAccess(r, "balita", []string{balita.Id})
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&balita)
 		response := object.NewResponse(http.StatusOK, "Balita retrieved successfully", getBalitaByIdResponse{
 			Data: balita,
 		})
@@ -135,7 +115,7 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all balita
-		balitaList, total, err := getAllBalita(db)
+		balitaList, total, err := getAllBalita(s.store.Balita)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get balita list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -144,6 +124,23 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		ids := make([]string, len(balitaList))
+		for i, balita := range balitaList {
+			ids[i] = balita.Id
+		}
+		access := audit.NewAccess(r, "balita", ids)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&balitaList)
 		response := object.NewResponse(http.StatusOK, "All balita retrieved successfully", getAllBalitaResponse{
 			Data:  balitaList,
 			Total: total,
@@ -155,128 +152,49 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get balita by ID
-func getBalitaById(db *sql.DB, id string) (balitaResponse, error) {
-	var balita balitaResponse
-	var updatedDate sql.NullString
-
-	query := `
-        SELECT 
-            b.id, b.id_keluarga, b.nama, b.tanggal_lahir, b.jenis_kelamin,
-            b.berat_lahir, b.tinggi_lahir, b.created_date, b.updated_date,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan
-        FROM balita b
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE b.id = ? AND b.deleted_date IS NULL
-    `
-
-	err := db.QueryRow(query, id).Scan(
-		&balita.Id,
-		&balita.IdKeluarga,
-		&balita.Nama,
-		&balita.TanggalLahir,
-		&balita.JenisKelamin,
-		&balita.BeratLahir,
-		&balita.TinggiLahir,
-		&balita.CreatedDate,
-		&updatedDate,
-		&balita.NomorKk,
-		&balita.NamaAyah,
-		&balita.NamaIbu,
-		&balita.Kelurahan,
-		&balita.Kecamatan,
-	)
-
+func getBalitaById(repo store.BalitaRepository, id string) (balitaResponse, error) {
+	row, err := repo.Get(id, store.BalitaFilter{})
 	if err != nil {
-		return balita, err
-	}
-
-	// Calculate age in months
-	balita.Umur = calculateAgeInMonths(balita.TanggalLahir)
-
-	// Handle nullable updated_date
-	if updatedDate.Valid {
-		balita.UpdatedDate = updatedDate.String
+		return balitaResponse{}, err
 	}
 
-	return balita, nil
+	return newBalitaResponse(row), nil
 }
 
 // Helper function to get all balita
-func getAllBalita(db *sql.DB) ([]balitaResponse, int, error) {
-	var balitaList []balitaResponse
-
-	query := `
-        SELECT 
-            b.id, b.id_keluarga, b.nama, b.tanggal_lahir, b.jenis_kelamin,
-            b.berat_lahir, b.tinggi_lahir, b.created_date, b.updated_date,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan
-        FROM balita b
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE b.deleted_date IS NULL
-        ORDER BY b.created_date DESC
-    `
-
-	rows, err := db.Query(query)
+func getAllBalita(repo store.BalitaRepository) ([]balitaResponse, int, error) {
+	rows, err := repo.List(store.BalitaFilter{})
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var balita balitaResponse
-		var updatedDate sql.NullString
-
-		err := rows.Scan(
-			&balita.Id,
-			&balita.IdKeluarga,
-			&balita.Nama,
-			&balita.TanggalLahir,
-			&balita.JenisKelamin,
-			&balita.BeratLahir,
-			&balita.TinggiLahir,
-			&balita.CreatedDate,
-			&updatedDate,
-			&balita.NomorKk,
-			&balita.NamaAyah,
-			&balita.NamaIbu,
-			&balita.Kelurahan,
-			&balita.Kecamatan,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Calculate age in months
-		balita.Umur = calculateAgeInMonths(balita.TanggalLahir)
 
-		// Handle nullable updated_date
-		if updatedDate.Valid {
-			balita.UpdatedDate = updatedDate.String
-		}
-
-		balitaList = append(balitaList, balita)
+	balitaList := []balitaResponse{}
+	for _, row := range rows {
+		balitaList = append(balitaList, newBalitaResponse(row))
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
+	return balitaList, len(balitaList), nil
+}
 
-	// Get total count
-	var total int
-	countQuery := "SELECT COUNT(*) FROM balita WHERE deleted_date IS NULL"
-	err = db.QueryRow(countQuery).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// Helper function to build the response of a balita row
+func newBalitaResponse(row store.Balita) balitaResponse {
+	return balitaResponse{
+		Id:           row.Id,
+		IdKeluarga:   row.IdKeluarga,
+		NomorKk:      row.NomorKk,
+		NamaAyah:     row.NamaAyah,
+		NamaIbu:      row.NamaIbu,
+		Nama:         row.Nama,
+		TanggalLahir: row.TanggalLahir,
+		JenisKelamin: row.JenisKelamin,
+		BeratLahir:   row.BeratLahir,
+		TinggiLahir:  row.TinggiLahir,
+		Umur:         calculateAgeInMonths(row.TanggalLahir),
+		Kelurahan:    row.Kelurahan,
+		Kecamatan:    row.Kecamatan,
+		CreatedDate:  row.CreatedDate,
+		UpdatedDate:  row.UpdatedDate,
 	}
-
-	return balitaList, total, nil
 }
 
 // Helper function to calculate age in months
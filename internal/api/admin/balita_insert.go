@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -121,47 +124,12 @@ type insertBalitaResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/balita/insert [post]
-func BalitaInsert(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodPost {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    userId, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is admin
-    if role != "admin" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+func (s *Service) BalitaInsert(w http.ResponseWriter, r *http.Request) {
+    principal := middleware.GetPrincipal(r)
 
     // Parse request body
     var req insertBalitaRequest
-    err = json.NewDecoder(r.Body).Decode(&req)
+    err := json.NewDecoder(r.Body).Decode(&req)
     if err != nil {
         response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
         if err := response.WriteJson(w); err != nil {
@@ -181,85 +149,71 @@ func BalitaInsert(w http.ResponseWriter, r *http.Request) {
     }
 
     // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-    defer db.Close()
-
-    // Check if keluarga exists and not soft deleted
-    var keluargaExists int
-    checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check keluarga existence", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+    db := s.db
+
+    var insertedId int64
+    err = object.RunInTx(db, func(tx *sql.Tx) error {
+        // Check if keluarga exists and not soft deleted
+        var keluargaExists int
+        checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
+        err = tx.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check keluarga existence")
         }
-        return
-    }
-    if keluargaExists == 0 {
-        response := object.NewResponse(http.StatusBadRequest, "Keluarga not found", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if keluargaExists == 0 {
+            return object.NewHTTPError(http.StatusBadRequest, "Keluarga not found")
         }
-        return
-    }
 
-    // Check for duplicate balita (same name, birth date, and keluarga)
-    var duplicateExists int
-    checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
+        // Check for duplicate balita (same name, birth date, and keluarga)
+        var duplicateExists int
+        checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
         WHERE id_keluarga = ? AND nama = ? AND tanggal_lahir = ? AND deleted_date IS NULL`
-    err = db.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir).Scan(&duplicateExists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate balita", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        err = tx.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir).Scan(&duplicateExists)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate balita")
         }
-        return
-    }
-    if duplicateExists > 0 {
-        response := object.NewResponse(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if duplicateExists > 0 {
+            return object.NewHTTPError(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga")
         }
-        return
-    }
 
-    // Current timestamp
-    currentTime := time.Now().Format("2006-01-02 15:04:05")
+        // Current timestamp
+        currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-    // Insert balita
-    insertQuery := `INSERT INTO balita 
+        // Insert balita
+        insertQuery := `INSERT INTO balita 
         (id_keluarga, nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, created_id, created_date) 
         VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
-    result, err := db.Exec(insertQuery,
-        req.IdKeluarga,
-        req.Nama,
-        req.TanggalLahir,
-        req.JenisKelamin,
-        req.BeratLahir,
-        req.TinggiLahir,
-        userId,
-        currentTime,
-    )
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to insert balita", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        result, err := tx.Exec(insertQuery,
+            req.IdKeluarga,
+            req.Nama,
+            req.TanggalLahir,
+            req.JenisKelamin,
+            req.BeratLahir,
+            req.TinggiLahir,
+            principal.UserId,
+            currentTime,
+        )
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert balita")
+        }
+
+        // Get the inserted ID
+        insertedId, err = result.LastInsertId()
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
         }
-        return
-    }
 
-    // Get the inserted ID
-    insertedId, err := result.LastInsertId()
+        // Record the change in the audit log
+        err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "balita", strconv.FormatInt(insertedId, 10)), nil)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+        }
+
+        return nil
+    })
     if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
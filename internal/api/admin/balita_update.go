@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -129,47 +132,12 @@ type updateBalitaResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Balita not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/balita/update [put]
-func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodPut {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    userId, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is admin
-    if role != "admin" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+func (s *Service) BalitaUpdate(w http.ResponseWriter, r *http.Request) {
+    principal := middleware.GetPrincipal(r)
 
     // Parse request body
     var req updateBalitaRequest
-    err = json.NewDecoder(r.Body).Decode(&req)
+    err := json.NewDecoder(r.Body).Decode(&req)
     if err != nil {
         response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
         if err := response.WriteJson(w); err != nil {
@@ -189,150 +157,122 @@ func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
     }
 
     // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+    db := s.db
+
+    var message string
+    err = object.RunInTx(db, func(tx *sql.Tx) error {
+        // Check if balita exists and not soft deleted
+        var exists int
+        checkExistQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+        err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
         }
-        return
-    }
-    defer db.Close()
-
-    // Check if balita exists and not soft deleted
-    var exists int
-    checkExistQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if exists == 0 {
+            return object.NewHTTPError(http.StatusNotFound, "Balita not found")
         }
-        return
-    }
-    if exists == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
 
-    // Check if keluarga exists and not soft deleted
-    var keluargaExists int
-    checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check keluarga existence", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if keluarga exists and not soft deleted
+        var keluargaExists int
+        checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
+        err = tx.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check keluarga existence")
         }
-        return
-    }
-    if keluargaExists == 0 {
-        response := object.NewResponse(http.StatusBadRequest, "Keluarga not found", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if keluargaExists == 0 {
+            return object.NewHTTPError(http.StatusBadRequest, "Keluarga not found")
         }
-        return
-    }
 
-    // Check for duplicate balita (same name, birth date, and keluarga, excluding current record)
-    var duplicateExists int
-    checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
+        // Check for duplicate balita (same name, birth date, and keluarga, excluding current record)
+        var duplicateExists int
+        checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
         WHERE id_keluarga = ? AND nama = ? AND tanggal_lahir = ? AND id != ? AND deleted_date IS NULL`
-    err = db.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir, req.Id).Scan(&duplicateExists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate balita", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        err = tx.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir, req.Id).Scan(&duplicateExists)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate balita")
         }
-        return
-    }
-    if duplicateExists > 0 {
-        response := object.NewResponse(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if duplicateExists > 0 {
+            return object.NewHTTPError(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga")
         }
-        return
-    }
 
-    // Check if balita has related laporan masyarakat records (warn user)
-    var laporanCount int
-    checkLaporanQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id_balita = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check related laporan", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if balita has related laporan masyarakat records (warn user)
+        var laporanCount int
+        checkLaporanQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id_balita = ? AND deleted_date IS NULL"
+        err = tx.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related laporan")
         }
-        return
-    }
 
-    // Check if balita has related riwayat pemeriksaan records (warn user)
-    var riwayatCount int
-    checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if balita has related riwayat pemeriksaan records (warn user)
+        var riwayatCount int
+        checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
+        err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
         }
-        return
-    }
 
-    // Current timestamp
-    currentTime := time.Now().Format("2006-01-02 15:04:05")
+        // Current timestamp
+        currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-    // Update balita
-    updateQuery := `UPDATE balita SET 
+        // Keep the current row for the audit log
+        before, err := audit.Snapshot(tx, "balita", req.Id)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to read balita for audit log")
+        }
+
+        // Update balita
+        updateQuery := `UPDATE balita SET 
         id_keluarga = ?, nama = ?, tanggal_lahir = ?, jenis_kelamin = ?,
         berat_lahir = ?, tinggi_lahir = ?, updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-    result, err := db.Exec(updateQuery,
-        req.IdKeluarga,
-        req.Nama,
-        req.TanggalLahir,
-        req.JenisKelamin,
-        req.BeratLahir,
-        req.TinggiLahir,
-        userId,
-        currentTime,
-        req.Id,
-    )
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to update balita", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        result, err := tx.Exec(updateQuery,
+            req.IdKeluarga,
+            req.Nama,
+            req.TanggalLahir,
+            req.JenisKelamin,
+            req.BeratLahir,
+            req.TinggiLahir,
+            principal.UserId,
+            currentTime,
+            req.Id,
+        )
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to update balita")
         }
-        return
-    }
 
-    // Check if any rows were affected
-    rowsAffected, err := result.RowsAffected()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if any rows were affected
+        rowsAffected, err := result.RowsAffected()
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
+        }
+
+        if rowsAffected == 0 {
+            return object.NewHTTPError(http.StatusNotFound, "Balita not found, already deleted or no changes made")
         }
-        return
-    }
 
-    if rowsAffected == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Balita not found, already deleted or no changes made", nil)
+        // Prepare response message with warnings if applicable
+        message = "Data balita berhasil diperbarui"
+        if laporanCount > 0 || riwayatCount > 0 {
+            message += fmt.Sprintf(" (Note: This balita has %d related laporan and %d related riwayat pemeriksaan)", laporanCount, riwayatCount)
+        }
+
+        // Record the change in the audit log
+        err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "balita", req.Id), before)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+        }
+
+        return nil
+    })
+    if err != nil {
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
         return
     }
 
-    // Prepare response message with warnings if applicable
-    message := "Data balita berhasil diperbarui"
-    if laporanCount > 0 || riwayatCount > 0 {
-        message += fmt.Sprintf(" (Note: This balita has %d related laporan and %d related riwayat pemeriksaan)", laporanCount, riwayatCount)
-    }
-
     response := object.NewResponse(http.StatusOK, "Balita updated successfully", updateBalitaResponse{
         Id:      req.Id,
         Message: message,
@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+)
+
+// MARK: Cascade
+
+// cascadeTables lists the tables holding rows that belong to a balita, in
+// the order they are soft deleted.
+var cascadeTables = []string{"riwayat_pemeriksaan", "intervensi", "laporan_masyarakat"}
+
+// SET clauses of a cascading soft delete and restore.
+const (
+	cascadeDeleteSet  = "deleted_id = ?, deleted_date = ?"
+	cascadeRestoreSet = "deleted_id = NULL, deleted_date = NULL, updated_id = ?, updated_date = ?"
+)
+
+// cascadeResult counts the dependent rows changed by a cascading delete or
+// restore.
+type cascadeResult struct {
+	Balita             int64 `json:"balita"`
+	LaporanMasyarakat  int64 `json:"laporan_masyarakat"`
+	Intervensi         int64 `json:"intervensi"`
+	RiwayatPemeriksaan int64 `json:"riwayat_pemeriksaan"`
+}
+
+// Helper function to add the rows changed in a table to the result
+func (c *cascadeResult) add(table string, n int64) {
+	switch table {
+	case "balita":
+		c.Balita += n
+	case "laporan_masyarakat":
+		c.LaporanMasyarakat += n
+	case "intervensi":
+		c.Intervensi += n
+	case "riwayat_pemeriksaan":
+		c.RiwayatPemeriksaan += n
+	}
+}
+
+// Helper function to soft delete the active laporan, intervensi and riwayat
+// pemeriksaan of the balita matching balitaCondition, using the same
+// deleted_id and deleted_date as their parent
+func cascadeDelete(tx *sql.Tx, r *http.Request, result *cascadeResult, currentTime, balitaCondition string, args ...any) error {
+	userId := middleware.GetPrincipal(r).UserId
+	for _, table := range cascadeTables {
+		where := fmt.Sprintf("deleted_date IS NULL AND id_balita IN (SELECT id FROM balita WHERE %s)", balitaCondition)
+		n, err := cascadeUpdate(tx, r, audit.ActionDelete, table, cascadeDeleteSet, []any{userId, currentTime}, where, args...)
+		if err != nil {
+			return err
+		}
+		result.add(table, n)
+	}
+	return nil
+}
+
+// Helper function to restore the laporan, intervensi and riwayat pemeriksaan
+// of the balita matching balitaCondition that were deleted together with
+// their parent, i.e. carry the same deleted_id and deleted_date
+func cascadeRestore(tx *sql.Tx, r *http.Request, result *cascadeResult, currentTime string, deletedId sql.NullString, deletedDate, balitaCondition string, args ...any) error {
+	userId := middleware.GetPrincipal(r).UserId
+	for _, table := range cascadeTables {
+		where := fmt.Sprintf("deleted_date = ? AND deleted_id <=> ? AND id_balita IN (SELECT id FROM balita WHERE %s)", balitaCondition)
+		whereArgs := append([]any{deletedDate, deletedId}, args...)
+		n, err := cascadeUpdate(tx, r, audit.ActionRestore, table, cascadeRestoreSet, []any{userId, currentTime}, where, whereArgs...)
+		if err != nil {
+			return err
+		}
+		result.add(table, n)
+	}
+	return nil
+}
+
+// Helper function to apply set to every row of table matching where and
+// record each changed row in the audit log
+func cascadeUpdate(tx *sql.Tx, r *http.Request, action, table, set string, setArgs []any, where string, whereArgs ...any) (int64, error) {
+	rows, err := tx.Query(fmt.Sprintf("SELECT id FROM %s WHERE %s FOR UPDATE", table, where), whereArgs...)
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	updateQuery := fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, set)
+	for _, id := range ids {
+		before, err := audit.Snapshot(tx, table, id)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = tx.Exec(updateQuery, append(append([]any{}, setArgs...), id)...)
+		if err != nil {
+			return 0, err
+		}
+
+		err = audit.RecordChange(tx, audit.NewEntry(r, action, table, id), before)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(ids)), nil
+}
@@ -0,0 +1,301 @@
+package admin
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/dedup"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+// duplikatMaxRows is the maximum number of candidates returned by DuplikatGet.
+const duplikatMaxRows = 500
+
+// duplikatRecordResponse is a keluarga or balita of a candidate pair. The
+// keluarga fields of a balita are those of its keluarga.
+type duplikatRecordResponse struct {
+	Id           string      `json:"id"`
+	IdKeluarga   string      `json:"id_keluarga,omitempty"`
+	Nama         string      `json:"nama,omitempty"`
+	TanggalLahir string      `json:"tanggal_lahir,omitempty"`
+	JenisKelamin string      `json:"jenis_kelamin,omitempty"`
+	NomorKk      string      `json:"nomor_kk" pii:"nomor_kk"`
+	NamaAyah     string      `json:"nama_ayah"`
+	NamaIbu      string      `json:"nama_ibu"`
+	NikAyah      string      `json:"nik_ayah,omitempty" pii:"nik"`
+	NikIbu       string      `json:"nik_ibu,omitempty" pii:"nik"`
+	Alamat       string      `json:"alamat,omitempty"`
+	Koordinat    *[2]float64 `json:"koordinat,omitempty"`
+	Kelurahan    string      `json:"kelurahan"`
+	Kecamatan    string      `json:"kecamatan"`
+	CreatedDate  string      `json:"created_date"`
+}
+
+type duplikatResponse struct {
+	Id               string   `json:"id"`
+	Entitas          string   `json:"entitas"`
+	Skor             float64  `json:"skor"`
+	Alasan           []string `json:"alasan"` // matching features, e.g. nomor_kk, nama_ibu
+	Status           string   `json:"status"`
+	IdPenggunaReview string   `json:"id_pengguna_review,omitempty"`
+	TanggalReview    string   `json:"tanggal_review,omitempty"`
+	CreatedDate      string   `json:"created_date"`
+	UpdatedDate      string   `json:"updated_date"`
+
+	// The records are missing once deleted, e.g. the duplikat of a merged pair
+	Utama    *duplikatRecordResponse `json:"utama,omitempty"`
+	Duplikat *duplikatRecordResponse `json:"duplikat,omitempty"`
+}
+
+type getDuplikatResponse struct {
+	Data  []duplikatResponse `json:"data"`
+	Total int                `json:"total"`
+}
+
+// # DuplikatGet handles listing the candidate duplicates
+//
+// @Summary Get candidate duplicates
+// @Description Get the keluarga and balita that were likely recorded twice, highest score first (Admin only)
+// @Description
+// @Description Candidates are found by the dedup command, which scores pairs of active records
+// @Description from 0 to 1 on nomor KK, NIK, normalized names, birth date and the distance between
+// @Description the koordinat of the keluarga. alasan lists the features that matched.
+// @Description
+// @Description Merge a candidate with /api/admin/duplikat/merge or reject it with
+// @Description /api/admin/duplikat/reject. Rejected pairs are not proposed again.
+// @Description nomor_kk, nik_ayah and nik_ibu are masked unless lengkap=true.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param entitas query string false "Entity (keluarga, balita)"
+// @Param status query string false "Review status (menunggu, digabung, ditolak), default menunggu"
+// @Param lengkap query bool false "Return the unmasked NIK and nomor KK, logged as privileged access"
+// @Success 200 {object} object.Response{data=getDuplikatResponse} "Candidate duplicates retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/duplikat/get [get]
+func (s *Service) DuplikatGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	entitas := query.Get("entitas")
+	switch entitas {
+	case "", dedup.EntityKeluarga, dedup.EntityBalita:
+	default:
+		response := object.NewResponse(http.StatusBadRequest, "entitas must be keluarga or balita", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	status := query.Get("status")
+	switch status {
+	case "":
+		status = dedup.StatusMenunggu
+	case dedup.StatusMenunggu, dedup.StatusDigabung, dedup.StatusDitolak:
+	default:
+		response := object.NewResponse(http.StatusBadRequest, "status must be menunggu, digabung or ditolak", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	candidates, err := getDuplikat(db, s.store, entitas, status)
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to get candidate duplicates", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Record the read in the access log, one entry per entity
+	ids := map[string][]string{}
+	for _, candidate := range candidates {
+		for _, record := range []*duplikatRecordResponse{candidate.Utama, candidate.Duplikat} {
+			if record != nil {
+				ids[candidate.Entitas] = append(ids[candidate.Entitas], record.Id)
+			}
+		}
+	}
+	for _, entity := range []string{dedup.EntityKeluarga, dedup.EntityBalita} {
+		if len(ids[entity]) == 0 {
+			continue
+		}
+		access := audit.NewAccess(r, entity, ids[entity])
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
+	masker.Apply(&candidates)
+	response := object.NewResponse(http.StatusOK, "Candidate duplicates retrieved successfully", getDuplikatResponse{
+		Data:  candidates,
+		Total: len(candidates),
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to get the candidates with the given status, with their
+// records
+func getDuplikat(db *sql.DB, st *store.Store, entitas, status string) ([]duplikatResponse, error) {
+	conditions := []string{"status = ?"}
+	args := []any{status}
+	if entitas != "" {
+		conditions = append(conditions, "entitas = ?")
+		args = append(args, entitas)
+	}
+
+	query := `
+        SELECT id, entitas, id_utama, id_duplikat, skor, alasan, status,
+            id_pengguna_review, tanggal_review, created_date, updated_date
+        FROM duplikat_kandidat
+        WHERE ` + strings.Join(conditions, " AND ") + `
+        ORDER BY skor DESC, id
+        LIMIT ?
+    `
+
+	rows, err := db.Query(query, append(args, duplikatMaxRows)...)
+	if err != nil {
+		return nil, err
+	}
+
+	type pair struct{ utama, duplikat string }
+	var pairs []pair
+	candidates := []duplikatResponse{}
+	for rows.Next() {
+		var candidate duplikatResponse
+		var p pair
+		var alasan string
+		var idPenggunaReview, tanggalReview sql.NullString
+
+		err := rows.Scan(
+			&candidate.Id,
+			&candidate.Entitas,
+			&p.utama,
+			&p.duplikat,
+			&candidate.Skor,
+			&alasan,
+			&candidate.Status,
+			&idPenggunaReview,
+			&tanggalReview,
+			&candidate.CreatedDate,
+			&candidate.UpdatedDate,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		candidate.Alasan = []string{}
+		if alasan != "" {
+			candidate.Alasan = strings.Split(alasan, ",")
+		}
+		candidate.IdPenggunaReview = idPenggunaReview.String
+		candidate.TanggalReview = tanggalReview.String
+
+		candidates = append(candidates, candidate)
+		pairs = append(pairs, p)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Load the records once the rows are closed, the store uses the same pool
+	for i := range candidates {
+		candidates[i].Utama, err = getDuplikatRecord(st, candidates[i].Entitas, pairs[i].utama)
+		if err != nil {
+			return nil, err
+		}
+		candidates[i].Duplikat, err = getDuplikatRecord(st, candidates[i].Entitas, pairs[i].duplikat)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return candidates, nil
+}
+
+// Helper function to get an active keluarga or balita of a candidate, nil
+// when it was deleted
+func getDuplikatRecord(st *store.Store, entitas, id string) (*duplikatRecordResponse, error) {
+	switch entitas {
+	case dedup.EntityKeluarga:
+		row, err := st.Keluarga.Get(id, store.KeluargaFilter{})
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		record := &duplikatRecordResponse{
+			Id:          row.Id,
+			NomorKk:     row.NomorKk,
+			NamaAyah:    row.NamaAyah,
+			NamaIbu:     row.NamaIbu,
+			NikAyah:     row.NikAyah,
+			NikIbu:      row.NikIbu,
+			Alamat:      row.Alamat,
+			Kelurahan:   row.Kelurahan,
+			Kecamatan:   row.Kecamatan,
+			CreatedDate: row.CreatedDate,
+		}
+		if row.Koordinat != [2]float64{0, 0} {
+			record.Koordinat = &row.Koordinat
+		}
+		return record, nil
+	default:
+		row, err := st.Balita.Get(id, store.BalitaFilter{})
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &duplikatRecordResponse{
+			Id:           row.Id,
+			IdKeluarga:   row.IdKeluarga,
+			Nama:         row.Nama,
+			TanggalLahir: row.TanggalLahir,
+			JenisKelamin: row.JenisKelamin,
+			NomorKk:      row.NomorKk,
+			NamaAyah:     row.NamaAyah,
+			NamaIbu:      row.NamaIbu,
+			Kelurahan:    row.Kelurahan,
+			Kecamatan:    row.Kecamatan,
+			CreatedDate:  row.CreatedDate,
+		}, nil
+	}
+}
@@ -0,0 +1,317 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/dedup"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// MARK: Merge
+
+type mergeDuplikatRequest struct {
+	Id      string `json:"id"`
+	IdUtama string `json:"id_utama"` // surviving record, defaults to the id_utama of the candidate
+}
+
+func (r *mergeDuplikatRequest) validate() error {
+	if r.Id == "" {
+		return fmt.Errorf("candidate ID is required")
+	}
+	return nil
+}
+
+type mergeDuplikatResponse struct {
+	Id          string        `json:"id"`
+	Entitas     string        `json:"entitas"`
+	IdUtama     string        `json:"id_utama"`
+	IdDuplikat  string        `json:"id_duplikat"`
+	Message     string        `json:"message"`
+	Dipindahkan cascadeResult `json:"dipindahkan"` // rows moved to the surviving record
+}
+
+// # DuplikatMerge handles merging a candidate duplicate
+//
+// @Summary Merge a candidate duplicate
+// @Description Merge the duplikat record of a candidate into the surviving record (Admin only)
+// @Description
+// @Description By default the id_utama of the candidate survives, pass id_utama to keep the other
+// @Description record instead. In one transaction:
+// @Description - keluarga: every balita of the duplikat is moved to the surviving keluarga
+// @Description - balita: every laporan masyarakat, riwayat pemeriksaan and intervensi of the duplikat
+// @Description is moved to the surviving balita
+// @Description - the duplikat is soft deleted and recorded in the audit log with action merge
+// @Description - other pending candidates of the duplikat are removed
+// @Description
+// @Description Every moved row is recorded in the audit log. Balita of merged keluarga that are
+// @Description themselves duplicates are found by the next run of the dedup command.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param duplikat body mergeDuplikatRequest true "Candidate to merge"
+// @Success 200 {object} object.Response{data=mergeDuplikatResponse} "Candidate merged successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Candidate or record not found"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/duplikat/merge [post]
+func (s *Service) DuplikatMerge(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req mergeDuplikatRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	result := mergeDuplikatResponse{Id: req.Id}
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		entitas, idUtama, idDuplikat, err := lockPendingDuplikat(tx, req.Id)
+		if err != nil {
+			return err
+		}
+
+		// Keep the other record when asked to
+		switch req.IdUtama {
+		case "", idUtama:
+		case idDuplikat:
+			idUtama, idDuplikat = idDuplikat, idUtama
+		default:
+			return object.NewHTTPError(http.StatusBadRequest, "id_utama must be one of the records of the candidate")
+		}
+		result.Entitas, result.IdUtama, result.IdDuplikat = entitas, idUtama, idDuplikat
+
+		// Both records must still be active
+		for _, id := range []string{idUtama, idDuplikat} {
+			var deletedDate sql.NullString
+			checkQuery := fmt.Sprintf("SELECT deleted_date FROM %s WHERE id = ? FOR UPDATE", entitas)
+			err = tx.QueryRow(checkQuery, id).Scan(&deletedDate)
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, fmt.Sprintf("%s %s not found", entitas, id))
+			}
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check "+entitas)
+			}
+			if deletedDate.Valid {
+				return object.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s %s is already deleted", entitas, id))
+			}
+		}
+
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Move the dependent rows, including deleted ones so they are restored with the survivor
+		const moveSet = "%s = ?, updated_id = ?, updated_date = ?"
+		moveArgs := []any{idUtama, principal.UserId, currentTime}
+		switch entitas {
+		case dedup.EntityKeluarga:
+			n, err := cascadeUpdate(tx, r, audit.ActionUpdate, "balita", fmt.Sprintf(moveSet, "id_keluarga"), moveArgs,
+				"id_keluarga = ?", idDuplikat)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to move balita")
+			}
+			result.Dipindahkan.add("balita", n)
+		case dedup.EntityBalita:
+			for _, table := range cascadeTables {
+				n, err := cascadeUpdate(tx, r, audit.ActionUpdate, table, fmt.Sprintf(moveSet, "id_balita"), moveArgs,
+					"id_balita = ?", idDuplikat)
+				if err != nil {
+					return object.NewHTTPError(http.StatusInternalServerError, "Failed to move "+table)
+				}
+				result.Dipindahkan.add(table, n)
+			}
+		}
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, entitas, idDuplikat)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read "+entitas+" for audit log")
+		}
+
+		// Soft delete the duplikat
+		deleteQuery := fmt.Sprintf("UPDATE %s SET deleted_id = ?, deleted_date = ? WHERE id = ? AND deleted_date IS NULL", entitas)
+		_, err = tx.Exec(deleteQuery, principal.UserId, currentTime, idDuplikat)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete "+entitas)
+		}
+
+		// Record the merge in the audit log
+		entry := audit.NewEntry(r, audit.ActionMerge, entitas, idDuplikat)
+		entry.Detail = fmt.Sprintf("Merged into %s %s", entitas, idUtama)
+		err = audit.RecordChange(tx, entry, before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		// Close the candidate with the records as merged
+		updateQuery := `UPDATE duplikat_kandidat SET
+        id_utama = ?, id_duplikat = ?, status = ?, id_pengguna_review = ?, tanggal_review = ?, updated_date = ?
+        WHERE id = ?`
+		_, err = tx.Exec(updateQuery, idUtama, idDuplikat, dedup.StatusDigabung, principal.UserId, currentTime, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update candidate")
+		}
+
+		// The other pending candidates of the duplikat point to a deleted record now
+		staleQuery := `DELETE FROM duplikat_kandidat
+        WHERE entitas = ? AND status = ? AND id <> ? AND (id_utama = ? OR id_duplikat = ?)`
+		_, err = tx.Exec(staleQuery, entitas, dedup.StatusMenunggu, req.Id, idDuplikat, idDuplikat)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to remove stale candidates")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	result.Message = fmt.Sprintf("%s %s merged into %s %s", result.Entitas, result.IdDuplikat, result.Entitas, result.IdUtama)
+	response := object.NewResponse(http.StatusOK, "Candidate merged successfully", result)
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// MARK: Reject
+
+type rejectDuplikatRequest struct {
+	Id string `json:"id"`
+}
+
+func (r *rejectDuplikatRequest) validate() error {
+	if r.Id == "" {
+		return fmt.Errorf("candidate ID is required")
+	}
+	return nil
+}
+
+type rejectDuplikatResponse struct {
+	Id      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// # DuplikatReject handles rejecting a candidate duplicate
+//
+// @Summary Reject a candidate duplicate
+// @Description Mark a candidate as not a duplicate (Admin only)
+// @Description
+// @Description Both records stay unchanged and the pair is not proposed again by the dedup command.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param duplikat body rejectDuplikatRequest true "Candidate to reject"
+// @Success 200 {object} object.Response{data=rejectDuplikatResponse} "Candidate rejected successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Candidate not found"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/duplikat/reject [post]
+func (s *Service) DuplikatReject(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req rejectDuplikatRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		if _, _, _, err := lockPendingDuplikat(tx, req.Id); err != nil {
+			return err
+		}
+
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+		updateQuery := `UPDATE duplikat_kandidat SET
+        status = ?, id_pengguna_review = ?, tanggal_review = ?, updated_date = ?
+        WHERE id = ?`
+		_, err := tx.Exec(updateQuery, dedup.StatusDitolak, principal.UserId, currentTime, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update candidate")
+		}
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Candidate rejected successfully", rejectDuplikatResponse{
+		Id:      req.Id,
+		Message: "Candidate rejected successfully",
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to lock a candidate that is still waiting for review and
+// get its entity and records
+func lockPendingDuplikat(tx *sql.Tx, id string) (string, string, string, error) {
+	var entitas, idUtama, idDuplikat, status string
+	query := "SELECT entitas, id_utama, id_duplikat, status FROM duplikat_kandidat WHERE id = ? FOR UPDATE"
+	err := tx.QueryRow(query, id).Scan(&entitas, &idUtama, &idDuplikat, &status)
+	if err == sql.ErrNoRows {
+		return "", "", "", object.NewHTTPError(http.StatusNotFound, "Candidate not found")
+	}
+	if err != nil {
+		return "", "", "", object.NewHTTPError(http.StatusInternalServerError, "Failed to get candidate")
+	}
+	if status != dedup.StatusMenunggu {
+		return "", "", "", object.NewHTTPError(http.StatusBadRequest, "Candidate was already reviewed")
+	}
+	return entitas, idUtama, idDuplikat, nil
+}
@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/rifqidaiva/stunting-web/internal/cluster"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// MARK: Balita Clusters
+
+// balitaPointsMinZoom is the lowest zoom level at which the balita are
+// returned as individual points with their names. Below it they are
+// returned as clusters.
+const balitaPointsMinZoom = 16
+
+// Severity of the status gizi, used to color a cluster by its most severe
+// balita
+var statusGiziSeverity = []string{"gizi buruk", "stunting", "normal"}
+
+// balitaPointsView is the bounding box and zoom level of the map.
+type balitaPointsView struct {
+	BBox *cluster.BBox // nil for the whole city
+	Zoom int           // 0 when the zoom is missing, so the balita are clustered
+}
+
+// Helper function to parse the bbox and zoom query parameters
+func parseBalitaPointsView(query url.Values) (balitaPointsView, error) {
+	var view balitaPointsView
+
+	if value := query.Get("bbox"); value != "" {
+		bbox, err := cluster.ParseBBox(value)
+		if err != nil {
+			return balitaPointsView{}, err
+		}
+		view.BBox = &bbox
+	}
+
+	if value := query.Get("zoom"); value != "" {
+		zoom, err := strconv.Atoi(value)
+		if err != nil || zoom < 0 || zoom > cluster.MaxZoom {
+			return balitaPointsView{}, fmt.Errorf("zoom must be a number from 0 to %d", cluster.MaxZoom)
+		}
+		view.Zoom = zoom
+	}
+
+	return view, nil
+}
+
+// Helper function to tell whether the view is zoomed out too far to show
+// individual balita. Only a zoom of at least balitaPointsMinZoom returns
+// the points with their names.
+func (v balitaPointsView) clustered() bool {
+	return v.Zoom < balitaPointsMinZoom
+}
+
+// Helper function to group the balita points into cluster features. A
+// balita with several laporan has a point per laporan, it counts once in
+// jumlah and status_gizi and once per status in status_laporan. Clusters
+// carry no names or identifiers, a single balita is a cluster of one. The
+// expansion zoom is at most balitaPointsMinZoom, where the points appear.
+func clusterBalitaPoints(collection object.GeoJSONFeatureCollection, zoom int) object.GeoJSONFeatureCollection {
+	// One point per balita, the status laporan of its other points are
+	// gathered by balita id
+	var points []cluster.Point
+	var balita []object.GeoJSONFeature
+	statusLaporan := map[string][]string{}
+	for _, feature := range collection.Features {
+		id, _ := feature.Properties["id"].(string)
+		status, _ := feature.Properties["status_laporan"].(string)
+		if _, ok := statusLaporan[id]; !ok {
+			coordinates, ok := feature.Geometry.Coordinates.([]float64)
+			if !ok || len(coordinates) != 2 {
+				continue
+			}
+			points = append(points, cluster.Point{Lon: coordinates[0], Lat: coordinates[1], Index: len(balita)})
+			balita = append(balita, feature)
+		}
+		statusLaporan[id] = appendUnique(statusLaporan[id], status)
+	}
+
+	features := []object.GeoJSONFeature{}
+	for _, c := range cluster.Grid(points, zoom) {
+		statusGiziCount := map[string]int{}
+		statusLaporanCount := map[string]int{}
+		for _, i := range c.Indexes {
+			statusGizi, _ := balita[i].Properties["status_gizi_terakhir"].(string)
+			statusGiziCount[statusGizi]++
+
+			id, _ := balita[i].Properties["id"].(string)
+			for _, status := range statusLaporan[id] {
+				statusLaporanCount[status]++
+			}
+		}
+
+		color := getBalitaPointColor("", "Tidak ada laporan")
+		for _, statusGizi := range statusGiziSeverity {
+			if statusGiziCount[statusGizi] > 0 {
+				color = getBalitaPointColor(statusGizi, "")
+				break
+			}
+		}
+
+		features = append(features, object.GeoJSONFeature{
+			Type: "Feature",
+			Geometry: object.GeoJSONGeometry{
+				Type:        "Point",
+				Coordinates: []float64{c.Lon, c.Lat},
+			},
+			Properties: map[string]any{
+				"jumlah":         len(c.Indexes),
+				"status_gizi":    statusGiziCount,
+				"status_laporan": statusLaporanCount,
+				"expansion_zoom": min(c.ExpansionZoom, balitaPointsMinZoom),
+				"color":          color,
+				"type":           "cluster",
+			},
+		})
+	}
+
+	return object.CreateGeoJSONFeatureCollection(features)
+}
+
+// Helper function to append a value to a list unless it is already in it
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"net/url"
+	"testing"
+)
+
+// Individual balita carry names, so they are only returned when the
+// client asks for a zoom of at least balitaPointsMinZoom.
+func TestBalitaPointsViewClustered(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"bbox=108.50,-6.78,108.62,-6.68", true},
+		{"zoom=0", true},
+		{"zoom=15", true},
+		{"zoom=16", false},
+		{"zoom=20", false},
+	}
+	for _, tt := range tests {
+		query, _ := url.ParseQuery(tt.query)
+		view, err := parseBalitaPointsView(query)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.query, err)
+		}
+		if got := view.clustered(); got != tt.want {
+			t.Errorf("%q: clustered = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	for _, query := range []string{"zoom=-1", "zoom=21", "zoom=x"} {
+		values, _ := url.ParseQuery(query)
+		if _, err := parseBalitaPointsView(values); err == nil {
+			t.Errorf("%q: want an error", query)
+		}
+	}
+}
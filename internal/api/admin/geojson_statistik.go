@@ -0,0 +1,263 @@
+package admin
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// MARK: Statistik Wilayah
+
+// statistikDefaultMonths is the length of the default period, ending at
+// sampai, in which a pemeriksaan counts.
+const statistikDefaultMonths = 12
+
+// statistikMaxAge is the age in years from which a child no longer counts
+// as balita.
+const statistikMaxAge = 5
+
+// Prevalence classes of the WHO and UNICEF thresholds for stunting, from
+// the highest lower bound in percent. Areas without pemeriksaan are
+// classified as tidak ada data.
+var prevalensiKlasifikasi = []struct {
+	min         float64
+	klasifikasi string
+	color       string
+}{
+	{30, "sangat tinggi", "#D73027"},
+	{20, "tinggi", "#FC8D59"},
+	{10, "sedang", "#FEE08B"},
+	{2.5, "rendah", "#91CF60"},
+	{0, "sangat rendah", "#1A9850"},
+}
+
+// statistikPeriode is the period of the statistics: pemeriksaan between
+// dari and sampai, balita and laporan as of sampai.
+type statistikPeriode struct {
+	Dari   string // first day, 2006-01-02
+	Sampai string // last day, 2006-01-02
+}
+
+// Helper function to parse the dari and sampai query parameters, given as
+// a month (2006-01) or a day (2006-01-02). Without sampai the period ends
+// today, without dari it covers the statistikDefaultMonths before sampai.
+func parseStatistikPeriode(query url.Values) (statistikPeriode, error) {
+	sampai := time.Now()
+	if value := query.Get("sampai"); value != "" {
+		if month, err := time.Parse("2006-01", value); err == nil {
+			sampai = month.AddDate(0, 1, -1)
+		} else if day, err := time.Parse("2006-01-02", value); err == nil {
+			sampai = day
+		} else {
+			return statistikPeriode{}, fmt.Errorf("sampai must be a month (YYYY-MM) or a date (YYYY-MM-DD)")
+		}
+	}
+
+	dari := sampai.AddDate(0, -statistikDefaultMonths, 1)
+	if value := query.Get("dari"); value != "" {
+		if month, err := time.Parse("2006-01", value); err == nil {
+			dari = month
+		} else if day, err := time.Parse("2006-01-02", value); err == nil {
+			dari = day
+		} else {
+			return statistikPeriode{}, fmt.Errorf("dari must be a month (YYYY-MM) or a date (YYYY-MM-DD)")
+		}
+	}
+
+	periode := statistikPeriode{Dari: dari.Format("2006-01-02"), Sampai: sampai.Format("2006-01-02")}
+	if periode.Dari > periode.Sampai {
+		return statistikPeriode{}, fmt.Errorf("dari must not be after sampai")
+	}
+	return periode, nil
+}
+
+// Helper function to tell whether the statistik query parameter asks for
+// the statistics
+func wantsStatistik(query url.Values) (bool, error) {
+	value := query.Get("statistik")
+	if value == "" {
+		return false, nil
+	}
+	statistik, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("statistik must be true or false")
+	}
+	return statistik, nil
+}
+
+// wilayahStatistik holds the counts of a kelurahan or kecamatan.
+type wilayahStatistik struct {
+	JumlahBalita         int // balita under five at the end of the period
+	JumlahDiperiksa      int // balita with a pemeriksaan in the period
+	JumlahStunting       int // by their last pemeriksaan in the period
+	JumlahGiziBuruk      int
+	JumlahLaporanTerbuka int // laporan in a status with a next status
+}
+
+// Helper function to add the counts of a kelurahan to a kecamatan
+func (s *wilayahStatistik) add(other wilayahStatistik) {
+	s.JumlahBalita += other.JumlahBalita
+	s.JumlahDiperiksa += other.JumlahDiperiksa
+	s.JumlahStunting += other.JumlahStunting
+	s.JumlahGiziBuruk += other.JumlahGiziBuruk
+	s.JumlahLaporanTerbuka += other.JumlahLaporanTerbuka
+}
+
+// Helper function to add the statistics to the properties of a feature.
+// The prevalence is the percentage of the examined balita with stunting or
+// gizi buruk, null without pemeriksaan.
+func (s wilayahStatistik) setProperties(properties map[string]any, periode statistikPeriode) {
+	properties["jumlah_balita"] = s.JumlahBalita
+	properties["jumlah_diperiksa"] = s.JumlahDiperiksa
+	properties["jumlah_stunting"] = s.JumlahStunting
+	properties["jumlah_gizi_buruk"] = s.JumlahGiziBuruk
+	properties["jumlah_laporan_terbuka"] = s.JumlahLaporanTerbuka
+	properties["periode_dari"] = periode.Dari
+	properties["periode_sampai"] = periode.Sampai
+
+	if s.JumlahDiperiksa == 0 {
+		properties["prevalensi"] = nil
+		properties["klasifikasi"] = "tidak ada data"
+		properties["color"] = "#CCCCCC"
+		return
+	}
+
+	prevalensi := float64(s.JumlahStunting+s.JumlahGiziBuruk) / float64(s.JumlahDiperiksa) * 100
+	properties["prevalensi"] = math.Round(prevalensi*10) / 10
+	for _, class := range prevalensiKlasifikasi {
+		if prevalensi >= class.min {
+			properties["klasifikasi"] = class.klasifikasi
+			properties["color"] = class.color
+			break
+		}
+	}
+}
+
+// wilayahStatistikResult holds the statistics by kelurahan id and by
+// kecamatan id.
+type wilayahStatistikResult struct {
+	Kelurahan map[string]wilayahStatistik
+	Kecamatan map[string]wilayahStatistik
+}
+
+// Helper function to compute the statistics of every kelurahan and
+// kecamatan for a period. Rows count by the kelurahan of their keluarga,
+// and as they were at the end of the period: rows deleted later still
+// count, rows created later do not.
+func getWilayahStatistik(db *sql.DB, periode statistikPeriode) (wilayahStatistikResult, error) {
+	sampaiAkhir := periode.Sampai + " 23:59:59"
+	kelurahan := map[string]wilayahStatistik{}
+	kecamatanOf := map[string]string{}
+
+	// Balita, their last pemeriksaan in the period and its status gizi
+	balitaQuery := `
+        SELECT kel.id, kel.id_kecamatan,
+            COUNT(*),
+            COUNT(rp.id_balita),
+            COALESCE(SUM(rp.status_gizi = 'stunting'), 0),
+            COALESCE(SUM(rp.status_gizi = 'gizi buruk'), 0)
+        FROM balita b
+        JOIN keluarga k ON b.id_keluarga = k.id
+        JOIN kelurahan kel ON k.id_kelurahan = kel.id
+        LEFT JOIN (
+            SELECT id_balita, status_gizi,
+                ROW_NUMBER() OVER (PARTITION BY id_balita ORDER BY tanggal DESC, id DESC) AS rn
+            FROM riwayat_pemeriksaan
+            WHERE tanggal BETWEEN ? AND ? AND (deleted_date IS NULL OR deleted_date > ?)
+        ) rp ON rp.id_balita = b.id AND rp.rn = 1
+        WHERE (b.deleted_date IS NULL OR b.deleted_date > ?)
+            AND (b.created_date IS NULL OR b.created_date <= ?)
+            AND b.tanggal_lahir <= ?
+            AND b.tanggal_lahir > DATE_SUB(?, INTERVAL ? YEAR)
+        GROUP BY kel.id, kel.id_kecamatan
+    `
+	rows, err := db.Query(balitaQuery,
+		periode.Dari, periode.Sampai, sampaiAkhir,
+		sampaiAkhir, periode.Sampai,
+		periode.Sampai, periode.Sampai, statistikMaxAge,
+	)
+	if err != nil {
+		return wilayahStatistikResult{}, err
+	}
+	for rows.Next() {
+		var idKelurahan, idKecamatan string
+		var s wilayahStatistik
+		err := rows.Scan(&idKelurahan, &idKecamatan, &s.JumlahBalita, &s.JumlahDiperiksa, &s.JumlahStunting, &s.JumlahGiziBuruk)
+		if err != nil {
+			rows.Close()
+			return wilayahStatistikResult{}, err
+		}
+		kelurahan[idKelurahan] = s
+		kecamatanOf[idKelurahan] = idKecamatan
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return wilayahStatistikResult{}, err
+	}
+
+	// Laporan reported up to the end of the period whose status at that
+	// time still had a next status, the current one when it has no history
+	laporanQuery := `
+        SELECT kel.id, kel.id_kecamatan, COUNT(*)
+        FROM laporan_masyarakat lm
+        JOIN balita b ON lm.id_balita = b.id
+        JOIN keluarga k ON b.id_keluarga = k.id
+        JOIN kelurahan kel ON k.id_kelurahan = kel.id
+        LEFT JOIN (
+            SELECT id_laporan_masyarakat, id_status_laporan_tujuan,
+                ROW_NUMBER() OVER (PARTITION BY id_laporan_masyarakat ORDER BY created_date DESC, id DESC) AS rn
+            FROM laporan_status_history
+            WHERE created_date <= ?
+        ) h ON h.id_laporan_masyarakat = lm.id AND h.rn = 1
+        WHERE (lm.deleted_date IS NULL OR lm.deleted_date > ?)
+            AND lm.tanggal_laporan <= ?
+            AND COALESCE(h.id_status_laporan_tujuan, lm.id_status_laporan) IN (
+                SELECT id_status_asal FROM status_laporan_transisi WHERE id_status_asal IS NOT NULL
+            )
+        GROUP BY kel.id, kel.id_kecamatan
+    `
+	rows, err = db.Query(laporanQuery, sampaiAkhir, sampaiAkhir, periode.Sampai)
+	if err != nil {
+		return wilayahStatistikResult{}, err
+	}
+	for rows.Next() {
+		var idKelurahan, idKecamatan string
+		var jumlah int
+		if err := rows.Scan(&idKelurahan, &idKecamatan, &jumlah); err != nil {
+			rows.Close()
+			return wilayahStatistikResult{}, err
+		}
+		s := kelurahan[idKelurahan]
+		s.JumlahLaporanTerbuka = jumlah
+		kelurahan[idKelurahan] = s
+		kecamatanOf[idKelurahan] = idKecamatan
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return wilayahStatistikResult{}, err
+	}
+
+	kecamatan := map[string]wilayahStatistik{}
+	for idKelurahan, s := range kelurahan {
+		total := kecamatan[kecamatanOf[idKelurahan]]
+		total.add(s)
+		kecamatan[kecamatanOf[idKelurahan]] = total
+	}
+
+	return wilayahStatistikResult{Kelurahan: kelurahan, Kecamatan: kecamatan}, nil
+}
+
+// Helper function to add the statistics of each area to the properties of
+// its feature, keyed by the id property. Areas without balita get zero
+// counts.
+func addWilayahStatistik(collection object.GeoJSONFeatureCollection, statistik map[string]wilayahStatistik, periode statistikPeriode) {
+	for _, feature := range collection.Features {
+		id, _ := feature.Properties["id"].(string)
+		statistik[id].setProperties(feature.Properties, periode)
+	}
+}
@@ -0,0 +1,322 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/wilayah"
+)
+
+// ImportTables lists the tables accepted by Import with their CSV columns.
+// Balita rows name their keluarga by id_keluarga or by nomor_kk.
+var ImportTables = map[string][]string{
+	"keluarga": {"nomor_kk", "nama_ayah", "nama_ibu", "nik_ayah", "nik_ibu", "alamat", "rt", "rw", "id_kelurahan", "longitude", "latitude"},
+	"balita":   {"id_keluarga|nomor_kk", "nama", "tanggal_lahir", "jenis_kelamin", "berat_lahir", "tinggi_lahir"},
+}
+
+// ImportError is a CSV row that was rejected.
+type ImportError struct {
+	Line    int
+	Message string
+}
+
+// ImportReport is the outcome of an Import.
+type ImportReport struct {
+	Table    string
+	Rows     int
+	Imported int // 0 when any row was rejected or for a dry run
+	Errors   []ImportError
+}
+
+// Import inserts the rows of a CSV file into keluarga or balita. The first
+// line names the columns, see ImportTables. Every row is checked with the
+// same rules as the insert endpoints and all rows are inserted in one
+// transaction: if any row is rejected nothing is imported and the report
+// lists every rejected row. With dryRun the rows are checked only.
+//
+// createdId is the pengguna recorded as creator of the rows, it may be
+// empty. Errors are only returned when the import could not run at all.
+func (s *Service) Import(table string, input io.Reader, createdId string, dryRun bool) (*ImportReport, error) {
+	if _, ok := ImportTables[table]; !ok {
+		return nil, fmt.Errorf("import: unknown table %q", table)
+	}
+
+	reader := csv.NewReader(input)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("import: read header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range ImportTables[table] {
+		found := false
+		for _, name := range strings.Split(required, "|") {
+			if _, ok := columns[name]; ok {
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("import: missing column %s", strings.ReplaceAll(required, "|", " or "))
+		}
+	}
+
+	db := s.db
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var creator any
+	if createdId != "" {
+		creator = createdId
+	}
+
+	report := &ImportReport{Table: table}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				report.Rows++
+				report.Errors = append(report.Errors, ImportError{Line: parseErr.Line, Message: parseErr.Err.Error()})
+				continue
+			}
+			return nil, err
+		}
+		report.Rows++
+		line, _ := reader.FieldPos(0)
+
+		// Missing trailing columns read as empty
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		var rowErr error
+		switch table {
+		case "keluarga":
+			rowErr = importKeluarga(tx, s.store.Keys, get, creator)
+		case "balita":
+			rowErr = importBalita(tx, s.store.Keys, get, creator)
+		}
+
+		var rejected *importRejection
+		if errors.As(rowErr, &rejected) {
+			report.Errors = append(report.Errors, ImportError{Line: line, Message: rejected.message})
+			continue
+		}
+		if rowErr != nil {
+			return nil, fmt.Errorf("import: line %d: %w", line, rowErr)
+		}
+	}
+
+	if len(report.Errors) > 0 || dryRun {
+		return report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	report.Imported = report.Rows
+	return report, nil
+}
+
+// importRejection is a row level error: the row is invalid and the
+// import goes on with the next row.
+type importRejection struct {
+	message string
+}
+
+func (e *importRejection) Error() string {
+	return e.message
+}
+
+// Helper function to reject an import row
+func reject(format string, args ...any) error {
+	return &importRejection{message: fmt.Sprintf(format, args...)}
+}
+
+// Helper function to import one keluarga row
+func importKeluarga(tx *sql.Tx, keys *fieldcrypt.Keyring, get func(string) string, creator any) error {
+	req := insertKeluargaRequest{
+		NomorKk:     get("nomor_kk"),
+		NamaAyah:    get("nama_ayah"),
+		NamaIbu:     get("nama_ibu"),
+		NikAyah:     get("nik_ayah"),
+		NikIbu:      get("nik_ibu"),
+		Alamat:      get("alamat"),
+		Rt:          get("rt"),
+		Rw:          get("rw"),
+		IdKelurahan: get("id_kelurahan"),
+	}
+	for i, name := range []string{"longitude", "latitude"} {
+		value, err := strconv.ParseFloat(get(name), 64)
+		if err != nil {
+			return reject("%s must be a number", name)
+		}
+		req.Koordinat[i] = value
+	}
+	if err := req.validate(); err != nil {
+		return reject("%s", err.Error())
+	}
+
+	// Rows inserted earlier in the same file count as existing
+	var exists int
+	checkQuery := `SELECT COUNT(*) FROM keluarga
+        WHERE (nomor_kk_index = ? OR nik_ayah_index = ? OR nik_ibu_index = ?) AND deleted_date IS NULL`
+	err := tx.QueryRow(checkQuery,
+		keys.BlindIndex(fieldcrypt.NomorKk, req.NomorKk),
+		keys.BlindIndex(fieldcrypt.NikAyah, req.NikAyah),
+		keys.BlindIndex(fieldcrypt.NikIbu, req.NikIbu),
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return reject("nomor KK, NIK ayah or NIK ibu already exists")
+	}
+
+	// An empty id_kelurahan is resolved from the koordinat
+	req.IdKelurahan, err = wilayah.ResolveKelurahan(tx, req.IdKelurahan, req.Koordinat)
+	if err == nil {
+		err = object.CheckKelurahanRegion(tx, req.IdKelurahan, req.NomorKk)
+	}
+	var httpErr *object.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusInternalServerError {
+		return err
+	}
+	if err != nil {
+		return reject("%s", err.Error())
+	}
+
+	insertQuery := `INSERT INTO keluarga
+        (nomor_kk, nama_ayah, nama_ibu, alamat, rt, rw, id_kelurahan, koordinat, created_id, created_date)
+        VALUES ('', ?, ?, ?, ?, ?, ?, ST_GeomFromText(?), ?, ?)`
+	result, err := tx.Exec(insertQuery,
+		req.NamaAyah,
+		req.NamaIbu,
+		req.Alamat,
+		req.Rt,
+		req.Rw,
+		req.IdKelurahan,
+		object.ToWKT(req.Koordinat),
+		creator,
+		time.Now().Format("2006-01-02 15:04:05"),
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	err = keys.WriteRow(tx, "keluarga", strconv.FormatInt(id, 10), req.NomorKk, req.NikAyah, req.NikIbu)
+	if err != nil {
+		return err
+	}
+	return recordImport(tx, "keluarga", result, creator)
+}
+
+// Helper function to import one balita row
+func importBalita(tx *sql.Tx, keys *fieldcrypt.Keyring, get func(string) string, creator any) error {
+	req := insertBalitaRequest{
+		IdKeluarga:   get("id_keluarga"),
+		Nama:         get("nama"),
+		TanggalLahir: get("tanggal_lahir"),
+		JenisKelamin: get("jenis_kelamin"),
+		BeratLahir:   get("berat_lahir"),
+		TinggiLahir:  get("tinggi_lahir"),
+	}
+
+	// Resolve the keluarga by nomor KK, which is known before the import
+	// while the new keluarga ids are not
+	if nomorKk := get("nomor_kk"); req.IdKeluarga == "" && nomorKk != "" {
+		err := tx.QueryRow("SELECT id FROM keluarga WHERE nomor_kk_index = ? AND deleted_date IS NULL", keys.BlindIndex(fieldcrypt.NomorKk, nomorKk)).Scan(&req.IdKeluarga)
+		if errors.Is(err, sql.ErrNoRows) {
+			return reject("keluarga with nomor KK %s not found", nomorKk)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := req.validate(); err != nil {
+		return reject("%s", err.Error())
+	}
+
+	var exists int
+	checkKeluargaQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
+	err := tx.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists == 0 {
+		return reject("keluarga not found")
+	}
+
+	checkDuplicateQuery := `SELECT COUNT(*) FROM balita
+        WHERE id_keluarga = ? AND nama = ? AND tanggal_lahir = ? AND deleted_date IS NULL`
+	err = tx.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return reject("balita with same name and birth date already exists in this keluarga")
+	}
+
+	insertQuery := `INSERT INTO balita
+        (id_keluarga, nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, created_id, created_date)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.Exec(insertQuery,
+		req.IdKeluarga,
+		req.Nama,
+		req.TanggalLahir,
+		req.JenisKelamin,
+		req.BeratLahir,
+		req.TinggiLahir,
+		creator,
+		time.Now().Format("2006-01-02 15:04:05"),
+	)
+	if err != nil {
+		return err
+	}
+	return recordImport(tx, "balita", result, creator)
+}
+
+// Helper function to record an imported row in the audit log
+func recordImport(tx *sql.Tx, table string, result sql.Result, creator any) error {
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	entry := audit.Entry{
+		Action:   audit.ActionCreate,
+		Entity:   table,
+		EntityId: strconv.FormatInt(id, 10),
+		Detail:   "Imported from CSV",
+	}
+	if creator != nil {
+		entry.ActorId = fmt.Sprint(creator)
+	}
+	return audit.RecordChange(tx, entry, nil)
+}
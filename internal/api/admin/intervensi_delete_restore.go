@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -49,47 +51,12 @@ type deleteIntervensiResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Intervensi not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi/delete [delete]
-func IntervensiDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) IntervensiDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteIntervensiRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -109,161 +76,112 @@ func IntervensiDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if intervensi exists and not already soft deleted
-	var exists int
-	var deletedDate sql.NullString
-	var jenis, tanggal, deskripsi, namaBalita string
-	checkQuery := `SELECT COUNT(*), i.deleted_date, i.jenis, i.tanggal, i.deskripsi, b.nama as nama_balita
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if intervensi exists and not already soft deleted
+		var exists int
+		var deletedDate sql.NullString
+		var jenis, tanggal, deskripsi, namaBalita string
+		checkQuery := `SELECT COUNT(*), i.deleted_date, i.jenis, i.tanggal, i.deskripsi, b.nama as nama_balita
         FROM intervensi i
         LEFT JOIN balita b ON i.id_balita = b.id
         WHERE i.id = ? 
         GROUP BY i.deleted_date, i.jenis, i.tanggal, i.deskripsi, b.nama`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &jenis, &tanggal, &deskripsi, &namaBalita)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Intervensi not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &jenis, &tanggal, &deskripsi, &namaBalita)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervensi not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check intervensi existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "Intervensi already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "Intervensi already deleted")
 		}
-		return
-	}
 
-	// Check if intervensi has related petugas records (prevent deletion if has assignments)
-	var petugasCount int
-	checkPetugasQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_intervensi = ?"
-	err = db.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related petugas", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if intervensi has related petugas records (prevent deletion if has assignments)
+		var petugasCount int
+		checkPetugasQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_intervensi = ?"
+		err = tx.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related petugas")
 		}
-		return
-	}
 
-	if petugasCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete intervensi '%s'. There are %d petugas assigned to this intervensi", jenis, petugasCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if petugasCount > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete intervensi '%s'. There are %d petugas assigned to this intervensi", jenis, petugasCount))
 		}
-		return
-	}
 
-	// Check if intervensi has related riwayat pemeriksaan records (prevent deletion if has medical records)
-	var riwayatCount int
-	checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_intervensi = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if intervensi has related riwayat pemeriksaan records (prevent deletion if has medical records)
+		var riwayatCount int
+		checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_intervensi = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	if riwayatCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete intervensi '%s'. There are %d active riwayat pemeriksaan records related to this intervensi", jenis, riwayatCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if riwayatCount > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete intervensi '%s'. There are %d active riwayat pemeriksaan records related to this intervensi", jenis, riwayatCount))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Begin transaction (to handle both intervensi and related junction tables if needed)
-	tx, err := db.Begin()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to begin transaction", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
 		}
-		return
-	}
-	defer tx.Rollback()
 
-	// Perform soft delete on intervensi table
-	deleteIntervensiQuery := `UPDATE intervensi SET 
+		// Perform soft delete on intervensi table
+		deleteIntervensiQuery := `UPDATE intervensi SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := tx.Exec(deleteIntervensiQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deleteIntervensiQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete intervensi")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found or already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found or already deleted")
 		}
-		return
-	}
 
-	// Commit transaction
-	err = tx.Commit()
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Intervensi %s untuk balita '%s' tanggal %s berhasil dihapus",
+			jenis, namaBalita, tanggal)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "intervensi", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to commit transaction", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Intervensi %s untuk balita '%s' tanggal %s berhasil dihapus",
-		jenis, namaBalita, tanggal)
-
 	response := object.NewResponse(http.StatusOK, "Intervensi deleted successfully", deleteIntervensiResponse{
 		Id:      req.Id,
 		Message: message,
@@ -289,47 +207,12 @@ func IntervensiDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Intervensi not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi/restore [post]
-func IntervensiRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) IntervensiRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteIntervensiRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -349,133 +232,109 @@ func IntervensiRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if intervensi exists and is soft deleted
-	var exists int
-	var jenis, tanggal, deskripsi, idBalita, namaBalita string
-	checkQuery := `SELECT COUNT(*), i.jenis, i.tanggal, i.deskripsi, i.id_balita, b.nama as nama_balita
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if intervensi exists and is soft deleted
+		var exists int
+		var jenis, tanggal, deskripsi, idBalita, namaBalita string
+		checkQuery := `SELECT COUNT(*), i.jenis, i.tanggal, i.deskripsi, i.id_balita, b.nama as nama_balita
         FROM intervensi i
         LEFT JOIN balita b ON i.id_balita = b.id
         WHERE i.id = ? AND i.deleted_date IS NOT NULL
         GROUP BY i.jenis, i.tanggal, i.deskripsi, i.id_balita, b.nama`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &jenis, &tanggal, &deskripsi, &idBalita, &namaBalita)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Intervensi not found or not deleted", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &jenis, &tanggal, &deskripsi, &idBalita, &namaBalita)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervensi not found or not deleted")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check intervensi existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found or not deleted")
 		}
-		return
-	}
 
-	// Check for duplicates before restore (same balita, jenis, tanggal, and deskripsi, not soft deleted)
-	var duplicateExists int
-	duplicateQuery := `SELECT COUNT(*) FROM intervensi 
+		// Check for duplicates before restore (same balita, jenis, tanggal, and deskripsi, not soft deleted)
+		var duplicateExists int
+		duplicateQuery := `SELECT COUNT(*) FROM intervensi 
         WHERE id_balita = ? AND jenis = ? AND tanggal = ? AND deskripsi = ? AND id != ? AND deleted_date IS NULL`
-	err = db.QueryRow(duplicateQuery, idBalita, jenis, tanggal, deskripsi, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(duplicateQuery, idBalita, jenis, tanggal, deskripsi, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate intervensi")
 		}
-		return
-	}
 
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot restore intervensi. Another active intervensi for balita '%s' with type '%s', date '%s', and similar description already exists",
-				namaBalita, jenis, tanggal), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot restore intervensi. Another active intervensi for balita '%s' with type '%s', date '%s', and similar description already exists",
+					namaBalita, jenis, tanggal))
 		}
-		return
-	}
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Restore intervensi (clear soft delete fields)
-	restoreQuery := `UPDATE intervensi SET 
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
+		}
+
+		// Restore intervensi (clear soft delete fields)
+		restoreQuery := `UPDATE intervensi SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore intervensi")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found or not deleted")
 		}
-		return
-	}
 
-	// Check if related balita still exists and is not soft deleted
-	var balitaExists int
-	checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaQuery, idBalita).Scan(&balitaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related balita still exists and is not soft deleted
+		var balitaExists int
+		checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaQuery, idBalita).Scan(&balitaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related balita")
 		}
-		return
-	}
 
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore intervensi. Related balita does not exist or is deleted", nil)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore intervensi. Related balita does not exist or is deleted")
+		}
+
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Intervensi %s untuk balita '%s' tanggal %s berhasil dipulihkan",
+			jenis, namaBalita, tanggal)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "intervensi", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Intervensi %s untuk balita '%s' tanggal %s berhasil dipulihkan",
-		jenis, namaBalita, tanggal)
-
 	response := object.NewResponse(http.StatusOK, "Intervensi restored successfully", deleteIntervensiResponse{
 		Id:      req.Id,
 		Message: message,
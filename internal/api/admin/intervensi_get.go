@@ -1,26 +1,30 @@
 package admin
 
 import (
-	"database/sql"
 	"net/http"
 
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type intervensiResponse struct {
-	Id           string `json:"id"`
-	IdBalita     string `json:"id_balita"`     // <- Field baru
-	NamaBalita   string `json:"nama_balita"`   // <- Field baru untuk display
-	Jenis        string `json:"jenis"`
-	Tanggal      string `json:"tanggal"`
-	Deskripsi    string `json:"deskripsi"`
-	Hasil        string `json:"hasil"`
-	PetugasCount int    `json:"petugas_count"` // jumlah petugas yang di-assign
-	RiwayatCount int    `json:"riwayat_count"` // jumlah riwayat pemeriksaan terkait
-	CreatedDate  string `json:"created_date"`
-	UpdatedDate  string `json:"updated_date,omitempty"`
-	CreatedBy    string `json:"created_by,omitempty"` // nama admin yang membuat
-	UpdatedBy    string `json:"updated_by,omitempty"` // nama admin yang mengupdate
+	Id            string `json:"id"`
+	IdBalita      string `json:"id_balita"`     // <- Field baru
+	NamaBalita    string `json:"nama_balita"`   // <- Field baru untuk display
+	Jenis         string `json:"jenis"`
+	Tanggal       string `json:"tanggal"`
+	Deskripsi     string `json:"deskripsi"`
+	Hasil         string `json:"hasil"`
+	Status        string `json:"status"` // planned, in_progress, completed, cancelled
+	StartedDate   string `json:"started_date,omitempty"`
+	CompletedDate string `json:"completed_date,omitempty"`
+	CancelledDate string `json:"cancelled_date,omitempty"`
+	PetugasCount  int    `json:"petugas_count"` // jumlah petugas yang di-assign
+	RiwayatCount  int    `json:"riwayat_count"` // jumlah riwayat pemeriksaan terkait
+	CreatedDate   string `json:"created_date"`
+	UpdatedDate   string `json:"updated_date,omitempty"`
+	CreatedBy     string `json:"created_by,omitempty"` // nama admin yang membuat
+	UpdatedBy     string `json:"updated_by,omitempty"` // nama admin yang mengupdate
 }
 
 type getAllIntervensiResponse struct {
@@ -41,12 +45,13 @@ type getIntervensiByIdResponse struct {
 // @Description - Without id parameter: Returns all intervensi with total count
 // @Description - With id parameter: Returns specific intervensi data
 // @Description
-// @Description Intervensi data includes: jenis, tanggal, deskripsi, hasil, petugas count, riwayat count, creation/update info
+// @Description Intervensi data includes: jenis, tanggal, deskripsi, hasil, status, petugas count, riwayat count, creation/update info
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Intervensi ID"
+// @Param status query string false "Filter by intervensi status (planned, in_progress, completed, cancelled)"
 // @Success 200 {object} object.Response{data=getAllIntervensiResponse} "Intervensi data retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -54,62 +59,25 @@ type getIntervensiByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Intervensi not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi/get [get]
-func IntervensiGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) IntervensiGet(w http.ResponseWriter, r *http.Request) {
+	// Check if ID parameter is provided
+	idParam := r.URL.Query().Get("id")
+	statusParam := r.URL.Query().Get("status")
 
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
+	// Validate status filter
+	if statusParam != "" && !object.IsIntervensiStatus(statusParam) {
+		response := object.NewResponse(http.StatusBadRequest, "status must be one of: planned, in_progress, completed, cancelled", nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
-	defer db.Close()
 
-	// Check if ID parameter is provided
-	idParam := r.URL.Query().Get("id")
 	if idParam != "" {
 		// Get specific intervensi by ID
-		intervensi, err := getIntervensiById(db, idParam)
+		intervensi, err := getIntervensiById(s.store.Intervensi, idParam)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Intervensi not found", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -131,7 +99,7 @@ func IntervensiGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all intervensi
-		intervensiList, total, err := getAllIntervensi(db)
+		intervensiList, total, err := getAllIntervensi(s.store.Intervensi, statusParam)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get intervensi list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -151,146 +119,49 @@ func IntervensiGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get intervensi by ID
-func getIntervensiById(db *sql.DB, id string) (intervensiResponse, error) {
-	var intervensi intervensiResponse
-	var updatedDate sql.NullString
-	var createdBy, updatedBy sql.NullString
-
-	query := `
-        SELECT 
-            i.id, i.id_balita, i.jenis, i.tanggal, i.deskripsi, i.hasil,
-            i.created_date, i.updated_date,
-            b.nama as nama_balita,
-            COALESCE(COUNT(DISTINCT ip.id), 0) as petugas_count,
-            COALESCE(COUNT(DISTINCT rp.id), 0) as riwayat_count,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM intervensi i
-        LEFT JOIN balita b ON i.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN intervensi_petugas ip ON i.id = ip.id_intervensi
-        LEFT JOIN riwayat_pemeriksaan rp ON i.id = rp.id_intervensi AND rp.deleted_date IS NULL
-        LEFT JOIN pengguna pc ON i.created_id = pc.id
-        LEFT JOIN pengguna pu ON i.updated_id = pu.id
-        WHERE i.id = ? AND i.deleted_date IS NULL
-        GROUP BY i.id, i.id_balita, i.jenis, i.tanggal, i.deskripsi, i.hasil,
-                 i.created_date, i.updated_date, b.nama, pc.email, pu.email
-    `
-
-	err := db.QueryRow(query, id).Scan(
-		&intervensi.Id,
-		&intervensi.IdBalita,      // <- Field baru
-		&intervensi.Jenis,
-		&intervensi.Tanggal,
-		&intervensi.Deskripsi,
-		&intervensi.Hasil,
-		&intervensi.CreatedDate,
-		&updatedDate,
-		&intervensi.NamaBalita,    // <- Field baru
-		&intervensi.PetugasCount,
-		&intervensi.RiwayatCount,
-		&createdBy,
-		&updatedBy,
-	)
-
+func getIntervensiById(repo store.IntervensiRepository, id string) (intervensiResponse, error) {
+	row, err := repo.Get(id, store.IntervensiFilter{})
 	if err != nil {
-		return intervensi, err
-	}
-
-	// Handle nullable fields
-	if updatedDate.Valid {
-		intervensi.UpdatedDate = updatedDate.String
-	}
-	if createdBy.Valid {
-		intervensi.CreatedBy = createdBy.String
-	}
-	if updatedBy.Valid {
-		intervensi.UpdatedBy = updatedBy.String
+		return intervensiResponse{}, err
 	}
 
-	return intervensi, nil
+	return newIntervensiResponse(row), nil
 }
 
-// Helper function to get all intervensi
-func getAllIntervensi(db *sql.DB) ([]intervensiResponse, int, error) {
-	var intervensiList []intervensiResponse
-
-	query := `
-        SELECT 
-            i.id, i.id_balita, i.jenis, i.tanggal, i.deskripsi, i.hasil,
-            i.created_date, i.updated_date,
-            b.nama as nama_balita,
-            COALESCE(COUNT(DISTINCT ip.id), 0) as petugas_count,
-            COALESCE(COUNT(DISTINCT rp.id), 0) as riwayat_count,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM intervensi i
-        LEFT JOIN balita b ON i.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN intervensi_petugas ip ON i.id = ip.id_intervensi
-        LEFT JOIN riwayat_pemeriksaan rp ON i.id = rp.id_intervensi AND rp.deleted_date IS NULL
-        LEFT JOIN pengguna pc ON i.created_id = pc.id
-        LEFT JOIN pengguna pu ON i.updated_id = pu.id
-        WHERE i.deleted_date IS NULL
-        GROUP BY i.id, i.id_balita, i.jenis, i.tanggal, i.deskripsi, i.hasil,
-                 i.created_date, i.updated_date, b.nama, pc.email, pu.email
-        ORDER BY i.tanggal DESC, i.created_date DESC
-    `
-
-	rows, err := db.Query(query)
+// Helper function to get all intervensi, optionally filtered by status
+func getAllIntervensi(repo store.IntervensiRepository, statusFilter string) ([]intervensiResponse, int, error) {
+	rows, err := repo.List(store.IntervensiFilter{Status: statusFilter})
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var intervensi intervensiResponse
-		var updatedDate sql.NullString
-		var createdBy, updatedBy sql.NullString
-
-		err := rows.Scan(
-			&intervensi.Id,
-			&intervensi.IdBalita,      // <- Field baru
-			&intervensi.Jenis,
-			&intervensi.Tanggal,
-			&intervensi.Deskripsi,
-			&intervensi.Hasil,
-			&intervensi.CreatedDate,
-			&updatedDate,
-			&intervensi.NamaBalita,    // <- Field baru
-			&intervensi.PetugasCount,
-			&intervensi.RiwayatCount,
-			&createdBy,
-			&updatedBy,
-		)
 
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Handle nullable fields
-		if updatedDate.Valid {
-			intervensi.UpdatedDate = updatedDate.String
-		}
-		if createdBy.Valid {
-			intervensi.CreatedBy = createdBy.String
-		}
-		if updatedBy.Valid {
-			intervensi.UpdatedBy = updatedBy.String
-		}
-
-		intervensiList = append(intervensiList, intervensi)
+	intervensiList := []intervensiResponse{}
+	for _, row := range rows {
+		intervensiList = append(intervensiList, newIntervensiResponse(row))
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
+	return intervensiList, len(intervensiList), nil
+}
 
-	// Get total count
-	var total int
-	countQuery := "SELECT COUNT(*) FROM intervensi WHERE deleted_date IS NULL"
-	err = db.QueryRow(countQuery).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// Helper function to build the response of an intervensi row
+func newIntervensiResponse(row store.Intervensi) intervensiResponse {
+	return intervensiResponse{
+		Id:            row.Id,
+		IdBalita:      row.IdBalita,
+		NamaBalita:    row.NamaBalita,
+		Jenis:         row.Jenis,
+		Tanggal:       row.Tanggal,
+		Deskripsi:     row.Deskripsi,
+		Hasil:         row.Hasil,
+		Status:        row.Status,
+		StartedDate:   row.StartedDate,
+		CompletedDate: row.CompletedDate,
+		CancelledDate: row.CancelledDate,
+		PetugasCount:  row.PetugasCount,
+		RiwayatCount:  row.RiwayatCount,
+		CreatedDate:   row.CreatedDate,
+		UpdatedDate:   row.UpdatedDate,
+		CreatedBy:     row.CreatedBy,
+		UpdatedBy:     row.UpdatedBy,
 	}
-
-	return intervensiList, total, nil
 }
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+// Helper function to build a Service on an in-memory data set with one
+// balita and two intervensi
+func newIntervensiTestService() *Service {
+	m := store.NewMemory()
+	m.Balita = []store.Balita{
+		{Id: "1", IdKeluarga: "1", Nama: "Ahmad", TanggalLahir: "2023-02-10", JenisKelamin: "L", CreatedDate: "2024-01-01 08:00:00"},
+	}
+	m.Intervensi = []store.Intervensi{
+		{Id: "1", IdBalita: "1", Jenis: "gizi", Tanggal: "2024-03-01", Status: "completed", CreatedDate: "2024-03-01 08:00:00"},
+		{Id: "2", IdBalita: "1", Jenis: "kesehatan", Tanggal: "2024-05-01", Status: "planned", CreatedDate: "2024-04-20 08:00:00"},
+	}
+	m.IntervensiPetugas = []store.IntervensiPetugas{
+		{IdIntervensi: "1", IdPetugasKesehatan: "1"},
+		{IdIntervensi: "1", IdPetugasKesehatan: "2"},
+	}
+	m.RiwayatPemeriksaan = []store.RiwayatPemeriksaan{
+		{Id: "1", IdBalita: "1", IdIntervensi: "1", IdLaporanMasyarakat: "1", Tanggal: "2024-03-02"},
+	}
+	return NewService(nil, nil, m.Store())
+}
+
+func TestIntervensiGetList(t *testing.T) {
+	s := newIntervensiTestService()
+
+	tests := []struct {
+		query   string
+		wantIds []string
+	}{
+		{"", []string{"2", "1"}},
+		{"?status=completed", []string{"1"}},
+		{"?status=cancelled", []string{}},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		s.IntervensiGet(w, httptest.NewRequest(http.MethodGet, "/api/admin/intervensi/get"+tt.query, nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("%q: status = %d, want 200", tt.query, w.Code)
+		}
+		var body struct {
+			Data getAllIntervensiResponse `json:"data"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("%q: decode: %v", tt.query, err)
+		}
+		if body.Data.Total != len(tt.wantIds) {
+			t.Errorf("%q: total = %d, want %d", tt.query, body.Data.Total, len(tt.wantIds))
+		}
+		for i, intervensi := range body.Data.Data {
+			if i >= len(tt.wantIds) || intervensi.Id != tt.wantIds[i] {
+				t.Errorf("%q: intervensi %d = %s, want order %v", tt.query, i, intervensi.Id, tt.wantIds)
+			}
+		}
+	}
+}
+
+func TestIntervensiGetById(t *testing.T) {
+	s := newIntervensiTestService()
+
+	w := httptest.NewRecorder()
+	s.IntervensiGet(w, httptest.NewRequest(http.MethodGet, "/api/admin/intervensi/get?id=1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	var body struct {
+		Data getIntervensiByIdResponse `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	got := body.Data.Data
+	if got.NamaBalita != "Ahmad" || got.PetugasCount != 2 || got.RiwayatCount != 1 {
+		t.Errorf("got nama_balita %q, petugas_count %d, riwayat_count %d, want Ahmad, 2, 1",
+			got.NamaBalita, got.PetugasCount, got.RiwayatCount)
+	}
+
+	w = httptest.NewRecorder()
+	s.IntervensiGet(w, httptest.NewRequest(http.MethodGet, "/api/admin/intervensi/get?id=99", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unknown id: status = %d, want 404", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	s.IntervensiGet(w, httptest.NewRequest(http.MethodGet, "/api/admin/intervensi/get?status=done", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid status: status = %d, want 400", w.Code)
+	}
+}
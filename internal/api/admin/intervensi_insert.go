@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -66,11 +68,8 @@ func (r *insertIntervensiRequest) validate() error {
 		return fmt.Errorf("deskripsi must be between 10-1000 characters")
 	}
 
-	// Hasil validation
-	if r.Hasil == "" {
-		return fmt.Errorf("hasil is required")
-	}
-	if len(r.Hasil) < 5 || len(r.Hasil) > 500 {
+	// Hasil validation: optional, planned intervensi has no result yet
+	if r.Hasil != "" && (len(r.Hasil) < 5 || len(r.Hasil) > 500) {
 		return fmt.Errorf("hasil must be between 5-500 characters")
 	}
 
@@ -78,7 +77,8 @@ func (r *insertIntervensiRequest) validate() error {
 }
 
 type insertIntervensiResponse struct {
-	Id string `json:"id"`
+	Id     string `json:"id"`
+	Status string `json:"status"`
 }
 
 // # IntervensiInsert handles inserting new intervensi data
@@ -91,8 +91,10 @@ type insertIntervensiResponse struct {
 // @Description - jenis: type of intervention (gizi, kesehatan, sosial)
 // @Description - tanggal: intervention date (YYYY-MM-DD format)
 // @Description - deskripsi: detailed description of the intervention
-// @Description - hasil: results or outcomes of the intervention
+// @Description - hasil: optional results or outcomes of the intervention
 // @Description - Validates intervention type and date constraints
+// @Description
+// @Description New intervensi always starts with status planned.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -104,47 +106,12 @@ type insertIntervensiResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi/insert [post]
-func IntervensiInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) IntervensiInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertIntervensiRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -164,107 +131,92 @@ func IntervensiInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if balita exists and not soft deleted
-	var balitaExists int
-	var namaBalita string
-	checkBalitaQuery := `SELECT COUNT(*), b.nama 
+	db := s.db
+
+	var message string
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists and not soft deleted
+		var balitaExists int
+		var namaBalita string
+		checkBalitaQuery := `SELECT COUNT(*), b.nama 
         FROM balita b WHERE b.id = ? AND b.deleted_date IS NULL 
         GROUP BY b.nama`
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 		}
-		return
-	}
 
-	// Check for duplicate intervensi (same balita, jenis, tanggal, and similar deskripsi)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi 
+		// Check for duplicate intervensi (same balita, jenis, tanggal, and similar deskripsi)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi 
         WHERE id_balita = ? AND jenis = ? AND tanggal = ? AND deskripsi = ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.Jenis, req.Tanggal, req.Deskripsi).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.Jenis, req.Tanggal, req.Deskripsi).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate intervensi")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Intervensi with same balita '%s', type, date, and description already exists", namaBalita), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Intervensi with same balita '%s', type, date, and description already exists", namaBalita))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Insert intervensi
+		insertQuery := `INSERT INTO intervensi 
+        (id_balita, jenis, tanggal, deskripsi, hasil, status, created_id, created_date) 
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+		result, err := tx.Exec(insertQuery,
+			req.IdBalita,    // <- Parameter baru
+			req.Jenis,
+			req.Tanggal,
+			req.Deskripsi,
+			req.Hasil,
+			object.IntervensiPlanned,
+			principal.UserId,
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert intervensi")
+		}
 
-	// Insert intervensi
-	insertQuery := `INSERT INTO intervensi 
-        (id_balita, jenis, tanggal, deskripsi, hasil, created_id, created_date) 
-        VALUES (?, ?, ?, ?, ?, ?, ?)`
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
+		}
 
-	result, err := db.Exec(insertQuery,
-		req.IdBalita,    // <- Parameter baru
-		req.Jenis,
-		req.Tanggal,
-		req.Deskripsi,
-		req.Hasil,
-		userId,
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Prepare success response with additional info
+		message = fmt.Sprintf("Intervensi %s untuk balita '%s' berhasil ditambahkan untuk tanggal %s",
+			req.Jenis, namaBalita, req.Tanggal)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "intervensi", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare success response with additional info
-	message := fmt.Sprintf("Intervensi %s untuk balita '%s' berhasil ditambahkan untuk tanggal %s",
-		req.Jenis, namaBalita, req.Tanggal)
-
 	response := object.NewResponse(http.StatusOK, message, insertIntervensiResponse{
-		Id: strconv.FormatInt(insertedId, 10),
+		Id:     strconv.FormatInt(insertedId, 10),
+		Status: object.IntervensiPlanned,
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
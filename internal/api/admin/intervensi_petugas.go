@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -93,54 +95,9 @@ type getIntervensiPetugasByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Assignment not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi-petugas/get [get]
-func IntervensiPetugasGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) IntervensiPetugasGet(w http.ResponseWriter, r *http.Request) {
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Check query parameters
 	idParam := r.URL.Query().Get("id")
@@ -238,6 +195,7 @@ func IntervensiPetugasGet(w http.ResponseWriter, r *http.Request) {
 // @Description - Prevents duplicate assignments
 // @Description - Ensures petugas is active and not soft deleted
 // @Description - Ensures intervensi is active and not soft deleted
+// @Description - Rejects completed or cancelled intervensi
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -249,47 +207,10 @@ func IntervensiPetugasGet(w http.ResponseWriter, r *http.Request) {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi-petugas/assign [post]
-func IntervensiPetugasAssign(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) IntervensiPetugasAssign(w http.ResponseWriter, r *http.Request) {
 	// Parse request body
 	var req assignIntervensiPetugasRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -309,123 +230,100 @@ func IntervensiPetugasAssign(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if intervensi exists and not soft deleted
-	var intervensiExists int
-	var jenisIntervensi, tanggalIntervensi string
-	checkIntervensiQuery := `SELECT COUNT(*), jenis, tanggal 
+	db := s.db
+
+	var message string
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if intervensi exists and not soft deleted
+		var intervensiExists int
+		var jenisIntervensi, tanggalIntervensi, statusIntervensi string
+		checkIntervensiQuery := `SELECT COUNT(*), jenis, tanggal, status 
         FROM intervensi WHERE id = ? AND deleted_date IS NULL 
-        GROUP BY jenis, tanggal`
-	err = db.QueryRow(checkIntervensiQuery, req.IdIntervensi).Scan(&intervensiExists, &jenisIntervensi, &tanggalIntervensi)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Intervensi not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+        GROUP BY jenis, tanggal, status`
+		err = tx.QueryRow(checkIntervensiQuery, req.IdIntervensi).Scan(&intervensiExists, &jenisIntervensi, &tanggalIntervensi, &statusIntervensi)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Intervensi not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check intervensi existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if intervensiExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Intervensi not found")
 		}
-		return
-	}
-	if intervensiExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Intervensi not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Business logic: Completed or cancelled intervensi cannot get new petugas
+		if object.IsIntervensiFinal(statusIntervensi) {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot assign petugas. Intervensi %s is already %s", jenisIntervensi, statusIntervensi))
 		}
-		return
-	}
 
-	// Check if petugas kesehatan exists and not soft deleted
-	var petugasExists int
-	var namaPetugas, skpdPetugas string
-	checkPetugasQuery := `SELECT COUNT(*), pk.nama, s.skpd 
+		// Check if petugas kesehatan exists and not soft deleted
+		var petugasExists int
+		var namaPetugas, skpdPetugas string
+		checkPetugasQuery := `SELECT COUNT(*), pk.nama, s.skpd 
         FROM petugas_kesehatan pk 
         LEFT JOIN skpd s ON pk.id_skpd = s.id
         WHERE pk.id = ? AND pk.deleted_date IS NULL 
         GROUP BY pk.nama, s.skpd`
-	err = db.QueryRow(checkPetugasQuery, req.IdPetugasKesehatan).Scan(&petugasExists, &namaPetugas, &skpdPetugas)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Petugas kesehatan not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkPetugasQuery, req.IdPetugasKesehatan).Scan(&petugasExists, &namaPetugas, &skpdPetugas)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Petugas kesehatan not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check petugas kesehatan existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check petugas kesehatan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if petugasExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Petugas kesehatan not found")
 		}
-		return
-	}
-	if petugasExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Petugas kesehatan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check for duplicate assignment
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi_petugas 
+		// Check for duplicate assignment
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi_petugas 
         WHERE id_intervensi = ? AND id_petugas_kesehatan = ?`
-	err = db.QueryRow(checkDuplicateQuery, req.IdIntervensi, req.IdPetugasKesehatan).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate assignment", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdIntervensi, req.IdPetugasKesehatan).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate assignment")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Petugas '%s' sudah di-assign ke intervensi %s pada tanggal %s",
-				namaPetugas, jenisIntervensi, tanggalIntervensi), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Petugas '%s' sudah di-assign ke intervensi %s pada tanggal %s",
+					namaPetugas, jenisIntervensi, tanggalIntervensi))
 		}
-		return
-	}
 
-	// Insert assignment
-	insertQuery := `INSERT INTO intervensi_petugas (id_intervensi, id_petugas_kesehatan) VALUES (?, ?)`
-	result, err := db.Exec(insertQuery, req.IdIntervensi, req.IdPetugasKesehatan)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to assign petugas to intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Insert assignment
+		insertQuery := `INSERT INTO intervensi_petugas (id_intervensi, id_petugas_kesehatan) VALUES (?, ?)`
+		result, err := tx.Exec(insertQuery, req.IdIntervensi, req.IdPetugasKesehatan)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to assign petugas to intervensi")
+		}
+
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve assignment ID")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Prepare success response with detailed information
+		message = fmt.Sprintf("Petugas '%s' dari %s berhasil di-assign ke intervensi %s pada tanggal %s",
+			namaPetugas, skpdPetugas, jenisIntervensi, tanggalIntervensi)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "intervensi_petugas", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve assignment ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare success response with detailed information
-	message := fmt.Sprintf("Petugas '%s' dari %s berhasil di-assign ke intervensi %s pada tanggal %s",
-		namaPetugas, skpdPetugas, jenisIntervensi, tanggalIntervensi)
-
 	response := object.NewResponse(http.StatusOK, "Petugas assigned to intervensi successfully", assignIntervensiPetugasResponse{
 		Id:      fmt.Sprintf("%d", insertedId),
 		Message: message,
@@ -455,47 +353,10 @@ func IntervensiPetugasAssign(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Assignment not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi-petugas/remove [delete]
-func IntervensiPetugasRemove(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) IntervensiPetugasRemove(w http.ResponseWriter, r *http.Request) {
 	// Parse request body
 	var req removeIntervensiPetugasRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -515,100 +376,88 @@ func IntervensiPetugasRemove(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if assignment exists and get details
-	var exists int
-	var namaPetugas, jenisIntervensi, tanggalIntervensi, skpdPetugas string
-	checkQuery := `SELECT COUNT(*), pk.nama, i.jenis, i.tanggal, s.skpd
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if assignment exists and get details
+		var exists int
+		var namaPetugas, jenisIntervensi, tanggalIntervensi, skpdPetugas string
+		checkQuery := `SELECT COUNT(*), pk.nama, i.jenis, i.tanggal, s.skpd
         FROM intervensi_petugas ip
         LEFT JOIN petugas_kesehatan pk ON ip.id_petugas_kesehatan = pk.id
         LEFT JOIN intervensi i ON ip.id_intervensi = i.id
         LEFT JOIN skpd s ON pk.id_skpd = s.id
         WHERE ip.id = ?
         GROUP BY pk.nama, i.jenis, i.tanggal, s.skpd`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &namaPetugas, &jenisIntervensi, &tanggalIntervensi, &skpdPetugas)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Assignment not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &namaPetugas, &jenisIntervensi, &tanggalIntervensi, &skpdPetugas)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Assignment not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check assignment existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check assignment existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Assignment not found")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Assignment not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if there are related riwayat pemeriksaan records for this assignment
-	var riwayatCount int
-	checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp
+		// Check if there are related riwayat pemeriksaan records for this assignment
+		var riwayatCount int
+		checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp
         INNER JOIN intervensi_petugas ip ON rp.id_intervensi = ip.id_intervensi
         WHERE ip.id = ? AND rp.deleted_date IS NULL`
-	err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related medical records", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related medical records")
 		}
-		return
-	}
 
-	// Remove assignment
-	deleteQuery := `DELETE FROM intervensi_petugas WHERE id = ?`
-	result, err := db.Exec(deleteQuery, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to remove assignment", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi_petugas", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read assignment for audit log")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check remove result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Remove assignment
+		deleteQuery := `DELETE FROM intervensi_petugas WHERE id = ?`
+		result, err := tx.Exec(deleteQuery, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to remove assignment")
+		}
+
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check remove result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Assignment not found", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Assignment not found")
+		}
+
+		// Prepare success response with detailed information
+		message = fmt.Sprintf("Assignment petugas '%s' dari %s untuk intervensi %s pada tanggal %s berhasil dihapus",
+			namaPetugas, skpdPetugas, jenisIntervensi, tanggalIntervensi)
+
+		if riwayatCount > 0 {
+			message += fmt.Sprintf(" (Note: Terdapat %d riwayat pemeriksaan terkait intervensi ini)", riwayatCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "intervensi_petugas", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare success response with detailed information
-	message := fmt.Sprintf("Assignment petugas '%s' dari %s untuk intervensi %s pada tanggal %s berhasil dihapus",
-		namaPetugas, skpdPetugas, jenisIntervensi, tanggalIntervensi)
-
-	if riwayatCount > 0 {
-		message += fmt.Sprintf(" (Note: Terdapat %d riwayat pemeriksaan terkait intervensi ini)", riwayatCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, "Assignment removed successfully", removeIntervensiPetugasResponse{
 		Id:      req.Id,
 		Message: message,
@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type updateIntervensiStatusRequest struct {
+	Id     string `json:"id"`
+	Status string `json:"status"` // "in_progress", "completed", "cancelled"
+	Hasil  string `json:"hasil"`  // optional, required when completing without hasil
+}
+
+func (r *updateIntervensiStatusRequest) validate() error {
+	// ID validation
+	if r.Id == "" {
+		return fmt.Errorf("intervensi ID is required")
+	}
+
+	// Status validation
+	if r.Status == "" {
+		return fmt.Errorf("status is required")
+	}
+	if !object.IsIntervensiStatus(r.Status) {
+		return fmt.Errorf("status must be one of: planned, in_progress, completed, cancelled")
+	}
+
+	// Hasil validation: optional
+	if r.Hasil != "" && (len(r.Hasil) < 5 || len(r.Hasil) > 500) {
+		return fmt.Errorf("hasil must be between 5-500 characters")
+	}
+
+	return nil
+}
+
+type updateIntervensiStatusResponse struct {
+	Id             string `json:"id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+// # IntervensiStatusUpdate handles changing the status of an intervensi
+//
+// @Summary Update intervensi status
+// @Description Move an intervensi to the next status of its lifecycle (Admin only)
+// @Description
+// @Description Allowed transitions:
+// @Description - planned → in_progress, cancelled
+// @Description - in_progress → completed, cancelled
+// @Description
+// @Description Completed and cancelled are final. The user and time of every transition are recorded.
+// @Description Completing an intervensi requires a hasil, either already stored or sent in the request.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param intervensi body updateIntervensiStatusRequest true "Intervensi status"
+// @Success 200 {object} object.Response{data=updateIntervensiStatusResponse} "Intervensi status updated successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request or transition"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Intervensi not found"
+// @Failure 409 {object} object.Response{data=nil} "Intervensi status changed concurrently"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/intervensi/status [put]
+func (s *Service) IntervensiStatusUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req updateIntervensiStatusRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	var message string
+	var currentStatus string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Get current status and hasil
+		var currentHasil string
+		checkExistQuery := "SELECT status, hasil FROM intervensi WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&currentStatus, &currentHasil)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervensi not found")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
+		}
+
+		// Validate transition
+		err = object.ValidateIntervensiTransition(currentStatus, req.Status)
+		if err != nil {
+			return object.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		// Business logic: Completed intervensi must have a result
+		if req.Status == object.IntervensiCompleted && req.Hasil == "" && currentHasil == "" {
+			return object.NewHTTPError(http.StatusBadRequest, "hasil is required to complete intervensi")
+		}
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
+		}
+
+		// Apply transition
+		err = object.TransitionIntervensi(tx, req.Id, currentStatus, req.Status, req.Hasil, principal.UserId)
+		if err != nil {
+			if errors.Is(err, object.ErrIntervensiStatusChanged) {
+				return object.NewHTTPError(http.StatusConflict, "Intervensi status was changed by another user, please reload")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update intervensi status")
+		}
+
+		message = fmt.Sprintf("Status intervensi berhasil diubah dari %s menjadi %s", currentStatus, req.Status)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "intervensi", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, message, updateIntervensiStatusResponse{
+		Id:             req.Id,
+		PreviousStatus: currentStatus,
+		Status:         req.Status,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"slices"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -71,11 +73,8 @@ func (r *updateIntervensiRequest) validate() error {
 		return fmt.Errorf("deskripsi must be between 10-1000 characters")
 	}
 
-	// Hasil validation
-	if r.Hasil == "" {
-		return fmt.Errorf("hasil is required")
-	}
-	if len(r.Hasil) < 5 || len(r.Hasil) > 500 {
+	// Hasil validation: optional, planned intervensi has no result yet
+	if r.Hasil != "" && (len(r.Hasil) < 5 || len(r.Hasil) > 500) {
 		return fmt.Errorf("hasil must be between 5-500 characters")
 	}
 
@@ -97,9 +96,12 @@ type updateIntervensiResponse struct {
 // @Description - jenis: type of intervention (gizi, kesehatan, sosial)
 // @Description - tanggal: intervention date (YYYY-MM-DD format)
 // @Description - deskripsi: detailed description of the intervention
-// @Description - hasil: results or outcomes of the intervention
+// @Description - hasil: optional results or outcomes of the intervention
 // @Description - Validates intervention type and date constraints
 // @Description - Checks for related records before allowing changes
+// @Description
+// @Description Status is changed through /api/admin/intervensi/status.
+// @Description Completed or cancelled intervensi cannot be updated.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -112,47 +114,12 @@ type updateIntervensiResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Intervensi not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/intervensi/update [put]
-func IntervensiUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) IntervensiUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateIntervensiRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -172,196 +139,166 @@ func IntervensiUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if intervensi exists and not soft deleted, also get current data
-	var exists int
-	var currentIdBalita, currentJenis, currentTanggal, currentDeskripsi, currentHasil string
-	checkExistQuery := `SELECT COUNT(*), id_balita, jenis, tanggal, deskripsi, hasil 
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if intervensi exists and not soft deleted, also get current data
+		var exists int
+		var currentIdBalita, currentJenis, currentTanggal, currentDeskripsi, currentHasil, currentStatus string
+		checkExistQuery := `SELECT COUNT(*), id_balita, jenis, tanggal, deskripsi, hasil, status 
         FROM intervensi 
         WHERE id = ? AND deleted_date IS NULL 
-        GROUP BY id_balita, jenis, tanggal, deskripsi, hasil`
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentIdBalita, &currentJenis, &currentTanggal, &currentDeskripsi, &currentHasil)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Intervensi not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+        GROUP BY id_balita, jenis, tanggal, deskripsi, hasil, status`
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentIdBalita, &currentJenis, &currentTanggal, &currentDeskripsi, &currentHasil, &currentStatus)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervensi not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check intervensi existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Business logic: Completed or cancelled intervensi cannot be changed anymore
+		if object.IsIntervensiFinal(currentStatus) {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Intervensi is already %s and cannot be updated", currentStatus))
 		}
-		return
-	}
 
-	// Check if balita exists and not soft deleted
-	var balitaExists int
-	var namaBalita string
-	checkBalitaQuery := `SELECT COUNT(*), b.nama 
+		// Check if balita exists and not soft deleted
+		var balitaExists int
+		var namaBalita string
+		checkBalitaQuery := `SELECT COUNT(*), b.nama 
         FROM balita b WHERE b.id = ? AND b.deleted_date IS NULL 
         GROUP BY b.nama`
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 		}
-		return
-	}
 
-	// Check for duplicate intervensi (same balita, jenis, tanggal, and similar deskripsi, excluding current record)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi 
+		// Check for duplicate intervensi (same balita, jenis, tanggal, and similar deskripsi, excluding current record)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM intervensi 
         WHERE id_balita = ? AND jenis = ? AND tanggal = ? AND deskripsi = ? AND id != ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.Jenis, req.Tanggal, req.Deskripsi, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.Jenis, req.Tanggal, req.Deskripsi, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate intervensi")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, 
-			fmt.Sprintf("Intervensi with same balita '%s', type, date, and description already exists", namaBalita), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, 
+				fmt.Sprintf("Intervensi with same balita '%s', type, date, and description already exists", namaBalita))
 		}
-		return
-	}
 
-	// Check if intervensi has related petugas assigned
-	var petugasCount int
-	checkPetugasQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_intervensi = ?"
-	err = db.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related petugas", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if intervensi has related petugas assigned
+		var petugasCount int
+		checkPetugasQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_intervensi = ?"
+		err = tx.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related petugas")
 		}
-		return
-	}
 
-	// Check if intervensi has related riwayat pemeriksaan records
-	var riwayatCount int
-	checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_intervensi = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if intervensi has related riwayat pemeriksaan records
+		var riwayatCount int
+		checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_intervensi = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
+		}
 
-	// Update intervensi
-	updateQuery := `UPDATE intervensi SET 
+		// Update intervensi
+		updateQuery := `UPDATE intervensi SET 
         id_balita = ?, jenis = ?, tanggal = ?, deskripsi = ?, hasil = ?, updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(updateQuery,
-		req.IdBalita,    // <- Parameter baru
-		req.Jenis,
-		req.Tanggal,
-		req.Deskripsi,
-		req.Hasil,
-		userId,
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updateQuery,
+			req.IdBalita,    // <- Parameter baru
+			req.Jenis,
+			req.Tanggal,
+			req.Deskripsi,
+			req.Hasil,
+			principal.UserId,
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update intervensi")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Intervensi not found, already deleted or no changes made", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Intervensi not found, already deleted or no changes made")
 		}
-		return
-	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Intervensi %s untuk balita '%s' berhasil diperbarui untuk tanggal %s", 
-        req.Jenis, namaBalita, req.Tanggal)
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Intervensi %s untuk balita '%s' berhasil diperbarui untuk tanggal %s", 
+	        req.Jenis, namaBalita, req.Tanggal)
 
-	// Add information about changes made
-	var changes []string
-	if currentIdBalita != req.IdBalita {
-		changes = append(changes, "balita changed")
-	}
-	if currentJenis != req.Jenis {
-		changes = append(changes, fmt.Sprintf("jenis: %s → %s", currentJenis, req.Jenis))
-	}
-	if currentTanggal != req.Tanggal {
-		changes = append(changes, fmt.Sprintf("tanggal: %s → %s", currentTanggal, req.Tanggal))
-	}
-	if currentDeskripsi != req.Deskripsi {
-		changes = append(changes, "deskripsi updated")
-	}
-	if currentHasil != req.Hasil {
-		changes = append(changes, "hasil updated")
-	}
+		// Add information about changes made
+		var changes []string
+		if currentIdBalita != req.IdBalita {
+			changes = append(changes, "balita changed")
+		}
+		if currentJenis != req.Jenis {
+			changes = append(changes, fmt.Sprintf("jenis: %s → %s", currentJenis, req.Jenis))
+		}
+		if currentTanggal != req.Tanggal {
+			changes = append(changes, fmt.Sprintf("tanggal: %s → %s", currentTanggal, req.Tanggal))
+		}
+		if currentDeskripsi != req.Deskripsi {
+			changes = append(changes, "deskripsi updated")
+		}
+		if currentHasil != req.Hasil {
+			changes = append(changes, "hasil updated")
+		}
 
-	if len(changes) > 0 {
-		message += " (Changes made)"
-	}
+		if len(changes) > 0 {
+			message += " (Changes made)"
+		}
 
-	// Add warnings about related records
-	if petugasCount > 0 {
-		message += fmt.Sprintf(" (Note: %d petugas assigned to this intervensi)", petugasCount)
-	}
-	if riwayatCount > 0 {
-		message += fmt.Sprintf(" (Note: %d riwayat pemeriksaan related to this intervensi)", riwayatCount)
+		// Add warnings about related records
+		if petugasCount > 0 {
+			message += fmt.Sprintf(" (Note: %d petugas assigned to this intervensi)", petugasCount)
+		}
+		if riwayatCount > 0 {
+			message += fmt.Sprintf(" (Note: %d riwayat pemeriksaan related to this intervensi)", riwayatCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "intervensi", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
 	}
 
 	response := object.NewResponse(http.StatusOK, "Intervensi updated successfully", updateIntervensiResponse{
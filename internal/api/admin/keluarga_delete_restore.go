@@ -7,11 +7,14 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
 type deleteKeluargaRequest struct {
-    Id string `json:"id"`
+    Id      string `json:"id"`
+    Cascade bool   `json:"cascade"` // also delete/restore balita, laporan, intervensi and riwayat
 }
 
 func (r *deleteKeluargaRequest) validate() error {
@@ -22,8 +25,9 @@ func (r *deleteKeluargaRequest) validate() error {
 }
 
 type deleteKeluargaResponse struct {
-    Id      string `json:"id"`
-    Message string `json:"message"`
+    Id      string         `json:"id"`
+    Message string         `json:"message"`
+    Cascade *cascadeResult `json:"cascade,omitempty"`
 }
 
 // # KeluargaDelete handles soft deleting keluarga data
@@ -36,6 +40,10 @@ type deleteKeluargaResponse struct {
 // @Description - Sets deleted_id to current user ID
 // @Description - Data remains in database but is excluded from queries
 // @Description - Can be restored if needed in the future
+// @Description
+// @Description Without cascade, keluarga with active balita or laporan cannot be deleted.
+// @Description With cascade, the active balita of the keluarga and their laporan, intervensi and
+// @Description riwayat pemeriksaan are soft deleted together with the keluarga.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -48,47 +56,12 @@ type deleteKeluargaResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Keluarga not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/keluarga/delete [delete]
-func KeluargaDelete(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodDelete {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    userId, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is admin
-    if role != "admin" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+func (s *Service) KeluargaDelete(w http.ResponseWriter, r *http.Request) {
+    principal := middleware.GetPrincipal(r)
 
     // Parse request body
     var req deleteKeluargaRequest
-    err = json.NewDecoder(r.Body).Decode(&req)
+    err := json.NewDecoder(r.Body).Decode(&req)
     if err != nil {
         response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
         if err := response.WriteJson(w); err != nil {
@@ -108,136 +81,132 @@ func KeluargaDelete(w http.ResponseWriter, r *http.Request) {
     }
 
     // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-    defer db.Close()
-
-    // Check if keluarga exists and not already soft deleted
-    var exists int
-    var deletedDate sql.NullString
-    checkQuery := "SELECT COUNT(*), deleted_date FROM keluarga WHERE id = ? GROUP BY deleted_date"
-    err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
-    if err != nil {
-        if err == sql.ErrNoRows {
-            response := object.NewResponse(http.StatusNotFound, "Keluarga not found", nil)
-            if err := response.WriteJson(w); err != nil {
-                http.Error(w, err.Error(), http.StatusInternalServerError)
+    db := s.db
+
+    var cascade *cascadeResult
+    err = object.RunInTx(db, func(tx *sql.Tx) error {
+        // Check if keluarga exists and not already soft deleted
+        var exists int
+        var deletedDate sql.NullString
+        checkQuery := "SELECT COUNT(*), deleted_date FROM keluarga WHERE id = ? GROUP BY deleted_date FOR UPDATE"
+        err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
+        if err != nil {
+            if err == sql.ErrNoRows {
+                return object.NewHTTPError(http.StatusNotFound, "Keluarga not found")
             }
-            return
-        }
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check keluarga existence", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check keluarga existence")
         }
-        return
-    }
 
-    if exists == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Keluarga not found", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if exists == 0 {
+            return object.NewHTTPError(http.StatusNotFound, "Keluarga not found")
         }
-        return
-    }
 
-    // Check if already soft deleted
-    if deletedDate.Valid {
-        response := object.NewResponse(http.StatusBadRequest, "Keluarga already deleted", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if already soft deleted
+        if deletedDate.Valid {
+            return object.NewHTTPError(http.StatusBadRequest, "Keluarga already deleted")
         }
-        return
-    }
 
-    // Check if keluarga has related balita records (prevent deletion if has children)
-    var balitaCount int
-    checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
-    err = db.QueryRow(checkBalitaQuery, req.Id).Scan(&balitaCount)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check related balita", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Check if keluarga has related balita records (prevent deletion if has children, unless cascading)
+        var balitaCount int
+        checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
+        err = tx.QueryRow(checkBalitaQuery, req.Id).Scan(&balitaCount)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related balita")
         }
-        return
-    }
 
-    if balitaCount > 0 {
-        response := object.NewResponse(http.StatusBadRequest, 
-            fmt.Sprintf("Cannot delete keluarga. There are %d active balita records related to this keluarga", balitaCount), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if balitaCount > 0 && !req.Cascade {
+            return object.NewHTTPError(http.StatusBadRequest, 
+                fmt.Sprintf("Cannot delete keluarga. There are %d active balita records related to this keluarga", balitaCount))
         }
-        return
-    }
 
-    // Check if keluarga has related laporan masyarakat records (prevent deletion if has reports)
-    var laporanCount int
-    checkLaporanQuery := `SELECT COUNT(*) FROM laporan_masyarakat lm 
+        // Check if keluarga has related laporan masyarakat records (prevent deletion if has reports, unless cascading)
+        var laporanCount int
+        checkLaporanQuery := `SELECT COUNT(*) FROM laporan_masyarakat lm 
         JOIN balita b ON lm.id_balita = b.id 
         WHERE b.id_keluarga = ? AND lm.deleted_date IS NULL AND b.deleted_date IS NULL`
-    err = db.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check related laporan", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        err = tx.QueryRow(checkLaporanQuery, req.Id).Scan(&laporanCount)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related laporan")
         }
-        return
-    }
 
-    if laporanCount > 0 {
-        response := object.NewResponse(http.StatusBadRequest, 
-            fmt.Sprintf("Cannot delete keluarga. There are %d active laporan records related to this keluarga", laporanCount), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        if laporanCount > 0 && !req.Cascade {
+            return object.NewHTTPError(http.StatusBadRequest, 
+                fmt.Sprintf("Cannot delete keluarga. There are %d active laporan records related to this keluarga", laporanCount))
         }
-        return
-    }
 
-    // Current timestamp
-    currentTime := time.Now().Format("2006-01-02 15:04:05")
+        // Current timestamp
+        currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+        // Soft delete the related data with the same timestamp, so it can be restored together
+        if req.Cascade {
+            cascade = &cascadeResult{}
 
-    // Perform soft delete
-    deleteQuery := `UPDATE keluarga SET 
+            // Dependents first, while their balita are still active
+            err = cascadeDelete(tx, r, cascade, currentTime, "id_keluarga = ? AND deleted_date IS NULL", req.Id)
+            if err != nil {
+                return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete related data")
+            }
+
+            balitaDeleted, err := cascadeUpdate(tx, r, audit.ActionDelete, "balita", cascadeDeleteSet, []any{principal.UserId, currentTime},
+                "id_keluarga = ? AND deleted_date IS NULL", req.Id)
+            if err != nil {
+                return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete related balita")
+            }
+            cascade.add("balita", balitaDeleted)
+        }
+
+        // Keep the current row for the audit log
+        before, err := audit.Snapshot(tx, "keluarga", req.Id)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to read keluarga for audit log")
+        }
+
+        // Perform soft delete
+        deleteQuery := `UPDATE keluarga SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-    result, err := db.Exec(deleteQuery, userId, currentTime, req.Id)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to delete keluarga", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        result, err := tx.Exec(deleteQuery, principal.UserId, currentTime, req.Id)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete keluarga")
+        }
+
+        // Check if any rows were affected
+        rowsAffected, err := result.RowsAffected()
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
         }
-        return
-    }
 
-    // Check if any rows were affected
-    rowsAffected, err := result.RowsAffected()
+        if rowsAffected == 0 {
+            return object.NewHTTPError(http.StatusNotFound, "Keluarga not found or already deleted")
+        }
+
+        // Record the change in the audit log
+        err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "keluarga", req.Id), before)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+        }
+
+        return nil
+    })
     if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
         return
     }
 
-    if rowsAffected == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Keluarga not found or already deleted", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
+    message := "Data keluarga berhasil dihapus"
+    if cascade != nil {
+        message = "Data keluarga beserta data terkait berhasil dihapus"
     }
 
     response := object.NewResponse(http.StatusOK, "Keluarga deleted successfully", deleteKeluargaResponse{
         Id:      req.Id,
-        Message: "Data keluarga berhasil dihapus",
+        Message: message,
+        Cascade: cascade,
     })
     if err := response.WriteJson(w); err != nil {
         http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -248,6 +217,9 @@ func KeluargaDelete(w http.ResponseWriter, r *http.Request) {
 //
 // @Summary Restore deleted keluarga data
 // @Description Restore soft deleted keluarga data by clearing deleted_date and deleted_id (Admin only)
+// @Description
+// @Description With cascade, the balita, laporan, intervensi and riwayat pemeriksaan that were deleted
+// @Description together with the keluarga (same deleted_id and deleted_date) are restored as well.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -260,47 +232,12 @@ func KeluargaDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Keluarga not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/keluarga/restore [post]
-func KeluargaRestore(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodPost {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    userId, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is admin
-    if role != "admin" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+func (s *Service) KeluargaRestore(w http.ResponseWriter, r *http.Request) {
+    principal := middleware.GetPrincipal(r)
 
     // Parse request body
     var req deleteKeluargaRequest
-    err = json.NewDecoder(r.Body).Decode(&req)
+    err := json.NewDecoder(r.Body).Decode(&req)
     if err != nil {
         response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
         if err := response.WriteJson(w); err != nil {
@@ -320,77 +257,96 @@ func KeluargaRestore(w http.ResponseWriter, r *http.Request) {
     }
 
     // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+    db := s.db
+
+    var cascade *cascadeResult
+    err = object.RunInTx(db, func(tx *sql.Tx) error {
+        // Check if keluarga exists and is soft deleted
+        var deletedId sql.NullString
+        var deletedDate string
+        checkQuery := "SELECT deleted_id, deleted_date FROM keluarga WHERE id = ? AND deleted_date IS NOT NULL FOR UPDATE"
+        err = tx.QueryRow(checkQuery, req.Id).Scan(&deletedId, &deletedDate)
+        if err != nil {
+            if err == sql.ErrNoRows {
+                return object.NewHTTPError(http.StatusNotFound, "Keluarga not found or not deleted")
+            }
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check keluarga existence")
         }
-        return
-    }
-    defer db.Close()
 
-    // Check if keluarga exists and is soft deleted
-    var exists int
-    checkQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NOT NULL"
-    err = db.QueryRow(checkQuery, req.Id).Scan(&exists)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check keluarga existence", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+        // Current timestamp for updated_date
+        currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-    if exists == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Keluarga not found or not deleted", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        // Restore the related data that was deleted together with the keluarga
+        if req.Cascade {
+            cascade = &cascadeResult{}
+
+            err = cascadeRestore(tx, r, cascade, currentTime, deletedId, deletedDate, "id_keluarga = ?", req.Id)
+            if err != nil {
+                return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore related data")
+            }
+
+            balitaRestored, err := cascadeUpdate(tx, r, audit.ActionRestore, "balita", cascadeRestoreSet, []any{principal.UserId, currentTime},
+                "id_keluarga = ? AND deleted_date = ? AND deleted_id <=> ?", req.Id, deletedDate, deletedId)
+            if err != nil {
+                return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore related balita")
+            }
+            cascade.add("balita", balitaRestored)
         }
-        return
-    }
 
-    // Current timestamp for updated_date
-    currentTime := time.Now().Format("2006-01-02 15:04:05")
+        // Keep the current row for the audit log
+        before, err := audit.Snapshot(tx, "keluarga", req.Id)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to read keluarga for audit log")
+        }
 
-    // Restore keluarga (clear soft delete fields)
-    restoreQuery := `UPDATE keluarga SET 
+        // Restore keluarga (clear soft delete fields)
+        restoreQuery := `UPDATE keluarga SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-    result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to restore keluarga", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
+        result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore keluarga")
+        }
+
+        // Check if any rows were affected
+        rowsAffected, err := result.RowsAffected()
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
         }
-        return
-    }
 
-    // Check if any rows were affected
-    rowsAffected, err := result.RowsAffected()
+        if rowsAffected == 0 {
+            return object.NewHTTPError(http.StatusNotFound, "Keluarga not found or not deleted")
+        }
+
+        // Record the change in the audit log
+        err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "keluarga", req.Id), before)
+        if err != nil {
+            return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+        }
+
+        return nil
+    })
     if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
         return
     }
 
-    if rowsAffected == 0 {
-        response := object.NewResponse(http.StatusNotFound, "Keluarga not found or not deleted", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
+    message := "Data keluarga berhasil dipulihkan"
+    if cascade != nil {
+        message = "Data keluarga beserta data terkait berhasil dipulihkan"
     }
 
     response := object.NewResponse(http.StatusOK, "Keluarga restored successfully", deleteKeluargaResponse{
         Id:      req.Id,
-        Message: "Data keluarga berhasil dipulihkan",
+        Message: message,
+        Cascade: cascade,
     })
     if err := response.WriteJson(w); err != nil {
         http.Error(w, err.Error(), http.StatusInternalServerError)
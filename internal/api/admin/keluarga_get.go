@@ -1,19 +1,21 @@
 package admin
 
 import (
-	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type keluargaResponse struct {
     Id          string    `json:"id"`
-    NomorKk     string    `json:"nomor_kk"`
+    NomorKk     string    `json:"nomor_kk" pii:"nomor_kk"`
     NamaAyah    string    `json:"nama_ayah"`
     NamaIbu     string    `json:"nama_ibu"`
-    NikAyah     string    `json:"nik_ayah"`
-    NikIbu      string    `json:"nik_ibu"`
+    NikAyah     string    `json:"nik_ayah" pii:"nik"`
+    NikIbu      string    `json:"nik_ibu" pii:"nik"`
     Alamat      string    `json:"alamat"`
     Rt          string    `json:"rt"`
     Rw          string    `json:"rw"`
@@ -44,11 +46,14 @@ type getKeluargaByIdResponse struct {
 // @Description - With id parameter: Returns specific keluarga data
 // @Description
 // @Description Keluarga data includes: nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu, alamat, rt, rw, kelurahan, kecamatan, koordinat
+// @Description
+// @Description nomor_kk, nik_ayah and nik_ibu are masked unless lengkap=true, which is logged as a privileged read.
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Keluarga ID"
+// @Param lengkap query bool false "Return the unmasked NIK and nomor KK"
 // @Success 200 {object} object.Response{data=getAllKeluargaResponse} "Keluarga data retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -56,62 +61,24 @@ type getKeluargaByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Keluarga not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/keluarga/get [get]
-func KeluargaGet(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodGet {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
+func (s *Service) KeluargaGet(w http.ResponseWriter, r *http.Request) {
+    // Mask the personal identifiers unless the unmasked view is requested
+    masker, err := pii.DefaultPolicy.Masker(r)
     if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
         return
     }
 
-    _, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is admin
-    if role != "admin" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-    defer db.Close()
-
     // Check if ID parameter is provided
     idParam := r.URL.Query().Get("id")
     if idParam != "" {
         // Get specific keluarga by ID
-        keluarga, err := getKeluargaById(db, idParam)
+        keluarga, err := getKeluargaById(s.store.Keluarga, idParam)
         if err != nil {
-            if err == sql.ErrNoRows {
+            if err == store.ErrNotFound {
                 response := object.NewResponse(http.StatusNotFound, "Keluarga not found", nil)
                 if err := response.WriteJson(w); err != nil {
                     http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -125,6 +92,19 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
             return
         }
 
+        // Record the read in the access log
+        access := audit.NewAccess(r, "keluarga", []string{keluarga.Id})
+        access.Privileged = masker.Unmasked()
+        err = audit.RecordAccess(s.db, access)
+        if err != nil {
+            response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+            if err := response.WriteJson(w); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+            }
+            return
+        }
+
+        masker.Apply(&keluarga)
         response := object.NewResponse(http.StatusOK, "Keluarga retrieved successfully", getKeluargaByIdResponse{
             Data: keluarga,
         })
@@ -133,7 +113,7 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
         }
     } else {
         // Get all keluarga
-        keluargaList, total, err := getAllKeluarga(db)
+        keluargaList, total, err := getAllKeluarga(s.store.Keluarga)
         if err != nil {
             response := object.NewResponse(http.StatusInternalServerError, "Failed to get keluarga list", nil)
             if err := response.WriteJson(w); err != nil {
@@ -142,6 +122,23 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
             return
         }
 
+        // Record the read in the access log
+        ids := make([]string, len(keluargaList))
+        for i, keluarga := range keluargaList {
+            ids[i] = keluarga.Id
+        }
+        access := audit.NewAccess(r, "keluarga", ids)
+        access.Privileged = masker.Unmasked()
+        err = audit.RecordAccess(s.db, access)
+        if err != nil {
+            response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+            if err := response.WriteJson(w); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+            }
+            return
+        }
+
+        masker.Apply(&keluargaList)
         response := object.NewResponse(http.StatusOK, "All keluarga retrieved successfully", getAllKeluargaResponse{
             Data:  keluargaList,
             Total: total,
@@ -153,130 +150,47 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get keluarga by ID
-func getKeluargaById(db *sql.DB, id string) (keluargaResponse, error) {
-    var keluarga keluargaResponse
-    var koordinatWKT string
-    var updatedDate sql.NullString
-
-    query := `
-        SELECT 
-            k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu, k.nik_ayah, k.nik_ibu,
-            k.alamat, k.rt, k.rw, k.id_kelurahan,
-            kel.kelurahan, kec.kecamatan,
-            ST_AsText(k.koordinat) as koordinat_wkt,
-            k.created_date, k.updated_date
-        FROM keluarga k
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE k.id = ? AND k.deleted_date IS NULL
-    `
-
-    err := db.QueryRow(query, id).Scan(
-        &keluarga.Id,
-        &keluarga.NomorKk,
-        &keluarga.NamaAyah,
-        &keluarga.NamaIbu,
-        &keluarga.NikAyah,
-        &keluarga.NikIbu,
-        &keluarga.Alamat,
-        &keluarga.Rt,
-        &keluarga.Rw,
-        &keluarga.IdKelurahan,
-        &keluarga.Kelurahan,
-        &keluarga.Kecamatan,
-        &koordinatWKT,
-        &keluarga.CreatedDate,
-        &updatedDate,
-    )
-
+func getKeluargaById(repo store.KeluargaRepository, id string) (keluargaResponse, error) {
+    row, err := repo.Get(id, store.KeluargaFilter{})
     if err != nil {
-        return keluarga, err
+        return keluargaResponse{}, err
     }
 
-    // Parse WKT coordinates
-    keluarga.Koordinat = object.ParseWKT(koordinatWKT)
-
-    // Handle nullable updated_date
-    if updatedDate.Valid {
-        keluarga.UpdatedDate = updatedDate.String
-    }
-
-    return keluarga, nil
+    return newKeluargaResponse(row), nil
 }
 
 // Helper function to get all keluarga
-func getAllKeluarga(db *sql.DB) ([]keluargaResponse, int, error) {
-    var keluargaList []keluargaResponse
-
-    query := `
-        SELECT 
-            k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu, k.nik_ayah, k.nik_ibu,
-            k.alamat, k.rt, k.rw, k.id_kelurahan,
-            kel.kelurahan, kec.kecamatan,
-            ST_AsText(k.koordinat) as koordinat_wkt,
-            k.created_date, k.updated_date
-        FROM keluarga k
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE k.deleted_date IS NULL
-        ORDER BY k.created_date DESC
-    `
-
-    rows, err := db.Query(query)
+func getAllKeluarga(repo store.KeluargaRepository) ([]keluargaResponse, int, error) {
+    rows, err := repo.List(store.KeluargaFilter{})
     if err != nil {
         return nil, 0, err
     }
-    defer rows.Close()
-
-    for rows.Next() {
-        var keluarga keluargaResponse
-        var koordinatWKT string
-        var updatedDate sql.NullString
-
-        err := rows.Scan(
-            &keluarga.Id,
-            &keluarga.NomorKk,
-            &keluarga.NamaAyah,
-            &keluarga.NamaIbu,
-            &keluarga.NikAyah,
-            &keluarga.NikIbu,
-            &keluarga.Alamat,
-            &keluarga.Rt,
-            &keluarga.Rw,
-            &keluarga.IdKelurahan,
-            &keluarga.Kelurahan,
-            &keluarga.Kecamatan,
-            &koordinatWKT,
-            &keluarga.CreatedDate,
-            &updatedDate,
-        )
-
-        if err != nil {
-            return nil, 0, err
-        }
-
-        // Parse WKT coordinates
-        keluarga.Koordinat = object.ParseWKT(koordinatWKT)
-
-        // Handle nullable updated_date
-        if updatedDate.Valid {
-            keluarga.UpdatedDate = updatedDate.String
-        }
 
-        keluargaList = append(keluargaList, keluarga)
+    keluargaList := []keluargaResponse{}
+    for _, row := range rows {
+        keluargaList = append(keluargaList, newKeluargaResponse(row))
     }
 
-    if err = rows.Err(); err != nil {
-        return nil, 0, err
-    }
+    return keluargaList, len(keluargaList), nil
+}
 
-    // Get total count
-    var total int
-    countQuery := "SELECT COUNT(*) FROM keluarga WHERE deleted_date IS NULL"
-    err = db.QueryRow(countQuery).Scan(&total)
-    if err != nil {
-        return nil, 0, err
+// Helper function to build the response of a keluarga row
+func newKeluargaResponse(row store.Keluarga) keluargaResponse {
+    return keluargaResponse{
+        Id:          row.Id,
+        NomorKk:     row.NomorKk,
+        NamaAyah:    row.NamaAyah,
+        NamaIbu:     row.NamaIbu,
+        NikAyah:     row.NikAyah,
+        NikIbu:      row.NikIbu,
+        Alamat:      row.Alamat,
+        Rt:          row.Rt,
+        Rw:          row.Rw,
+        IdKelurahan: row.IdKelurahan,
+        Kelurahan:   row.Kelurahan,
+        Kecamatan:   row.Kecamatan,
+        Koordinat:   row.Koordinat,
+        CreatedDate: row.CreatedDate,
+        UpdatedDate: row.UpdatedDate,
     }
-
-    return keluargaList, total, nil
-}
\ No newline at end of file
+}
@@ -1,13 +1,19 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/nik"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/wilayah"
 )
 
 type updateKeluargaRequest struct {
@@ -30,13 +36,12 @@ func (r *updateKeluargaRequest) validate() error {
 		return fmt.Errorf("keluarga ID is required")
 	}
 
-	// Nomor KK validation: 16 digits
+	// Nomor KK validation: 16 digits with a valid region code and serial
 	if r.NomorKk == "" {
-		return fmt.Errorf("nomor KK is required")
+		return object.NewFieldError("nomor_kk", "nomor KK is required")
 	}
-	kkRegex := regexp.MustCompile(`^\d{16}$`)
-	if !kkRegex.MatchString(r.NomorKk) {
-		return fmt.Errorf("nomor KK must be exactly 16 digits")
+	if _, err := nik.ParseKK(r.NomorKk); err != nil {
+		return object.NewFieldError("nomor_kk", "nomor KK %v", err)
 	}
 
 	// Nama Ayah validation
@@ -56,21 +61,29 @@ func (r *updateKeluargaRequest) validate() error {
 		return fmt.Errorf("nama ibu must be 2-50 characters and contain only letters and spaces")
 	}
 
-	// NIK Ayah validation: 16 digits
+	// NIK Ayah validation: region code, birth date of a man and serial
 	if r.NikAyah == "" {
-		return fmt.Errorf("NIK ayah is required")
+		return object.NewFieldError("nik_ayah", "NIK ayah is required")
 	}
-	nikRegex := regexp.MustCompile(`^\d{16}$`)
-	if !nikRegex.MatchString(r.NikAyah) {
-		return fmt.Errorf("NIK ayah must be exactly 16 digits")
+	ayah, err := nik.Parse(r.NikAyah)
+	if err != nil {
+		return object.NewFieldError("nik_ayah", "NIK ayah %v", err)
+	}
+	if ayah.Female {
+		return object.NewFieldError("nik_ayah", "NIK ayah must encode a male birth date")
 	}
 
-	// NIK Ibu validation: 16 digits
+	// NIK Ibu validation: region code, birth date of a woman (day plus 40)
+	// and serial
 	if r.NikIbu == "" {
-		return fmt.Errorf("NIK ibu is required")
+		return object.NewFieldError("nik_ibu", "NIK ibu is required")
+	}
+	ibu, err := nik.Parse(r.NikIbu)
+	if err != nil {
+		return object.NewFieldError("nik_ibu", "NIK ibu %v", err)
 	}
-	if !nikRegex.MatchString(r.NikIbu) {
-		return fmt.Errorf("NIK ibu must be exactly 16 digits")
+	if !ibu.Female {
+		return object.NewFieldError("nik_ibu", "NIK ibu must encode a female birth date")
 	}
 
 	// Alamat validation
@@ -99,25 +112,21 @@ func (r *updateKeluargaRequest) validate() error {
 		return fmt.Errorf("RW must be 1-3 digits")
 	}
 
-	// ID Kelurahan validation
-	if r.IdKelurahan == "" {
-		return fmt.Errorf("id kelurahan is required")
-	}
+	// ID Kelurahan is resolved from the koordinat when empty, see
+	// wilayah.ResolveKelurahan
 
-	// Koordinat validation
-	// if r.Koordinat[0] < -180 || r.Koordinat[0] > 180 {
-	// 	return fmt.Errorf("longitude must be between -180 and 180")
-	// }
-	// if r.Koordinat[1] < -90 || r.Koordinat[1] > 90 {
-	// 	return fmt.Errorf("latitude must be between -90 and 90")
-	// }
+	// Koordinat validation: [longitude, latitude] within range
+	if err := wilayah.CheckKoordinat(r.Koordinat); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 type updateKeluargaResponse struct {
-	Id      string `json:"id"`
-	Message string `json:"message"`
+	Id          string `json:"id"`
+	IdKelurahan string `json:"id_kelurahan"` // chosen or resolved from the koordinat
+	Message     string `json:"message"`
 }
 
 // # KeluargaUpdate handles updating keluarga data
@@ -129,59 +138,33 @@ type updateKeluargaResponse struct {
 // @Description - nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu
 // @Description - alamat, rt, rw, id_kelurahan, koordinat
 // @Description - Validates uniqueness of nomor_kk and NIK (excluding current record)
+// @Description
+// @Description Nomor KK and NIK are checked for a valid region code and serial, the nomor KK must
+// @Description start with the code of the kecamatan and NIK ibu must encode a female birth date
+// @Description (day plus 40). Errors of a single field return it in data (object.FieldError).
+// @Description
+// @Description id_kelurahan may be left empty, it is then resolved from the koordinat. A chosen
+// @Description id_kelurahan must contain the koordinat, otherwise the located kelurahan is returned
+// @Description as error on id_kelurahan. Koordinat outside every kelurahan are rejected.
+// @Description Koordinat is [longitude, latitude], pairs out of range are rejected on koordinat.
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param keluarga body updateKeluargaRequest true "Updated keluarga data"
 // @Success 200 {object} object.Response{data=updateKeluargaResponse} "Keluarga updated successfully"
-// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 400 {object} object.Response{data=object.FieldError} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 404 {object} object.Response{data=nil} "Keluarga not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/keluarga/update [put]
-func KeluargaUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) KeluargaUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateKeluargaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -193,164 +176,135 @@ func KeluargaUpdate(w http.ResponseWriter, r *http.Request) {
 	// Validate request
 	err = req.validate()
 	if err != nil {
-		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		response := object.ValidationResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	// The duplicate checks compare blind indexes, the values are encrypted
+	// once the row is updated
+	keys := s.store.Keys
 
-	// Check if keluarga exists and not soft deleted
-	var exists int
-	checkExistQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check keluarga existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	// Connect to database
+	db := s.db
+
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if keluarga exists and not soft deleted
+		var exists int
+		checkExistQuery := "SELECT COUNT(*) FROM keluarga WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check keluarga existence")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Keluarga not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Keluarga not found")
 		}
-		return
-	}
 
-	// Check if Nomor KK already exists (excluding current record and not soft deleted)
-	checkKKQuery := "SELECT COUNT(*) FROM keluarga WHERE nomor_kk = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkKKQuery, req.NomorKk, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check nomor KK", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if Nomor KK already exists (excluding current record and not soft deleted)
+		checkKKQuery := "SELECT COUNT(*) FROM keluarga WHERE nomor_kk_index = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkKKQuery, keys.BlindIndex(fieldcrypt.NomorKk, req.NomorKk), req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check nomor KK")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Nomor KK already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Nomor KK already exists")
 		}
-		return
-	}
 
-	// Check if NIK Ayah already exists (excluding current record and not soft deleted)
-	checkNikAyahQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ayah = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNikAyahQuery, req.NikAyah, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check NIK ayah", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if NIK Ayah already exists (excluding current record and not soft deleted)
+		checkNikAyahQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ayah_index = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNikAyahQuery, keys.BlindIndex(fieldcrypt.NikAyah, req.NikAyah), req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check NIK ayah")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "NIK ayah already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "NIK ayah already exists")
 		}
-		return
-	}
 
-	// Check if NIK Ibu already exists (excluding current record and not soft deleted)
-	checkNikIbuQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ibu = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNikIbuQuery, req.NikIbu, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check NIK ibu", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if NIK Ibu already exists (excluding current record and not soft deleted)
+		checkNikIbuQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ibu_index = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNikIbuQuery, keys.BlindIndex(fieldcrypt.NikIbu, req.NikIbu), req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check NIK ibu")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "NIK ibu already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "NIK ibu already exists")
 		}
-		return
-	}
 
-	// Check if kelurahan exists
-	var kelurahanExists int
-	checkKelurahanQuery := "SELECT COUNT(*) FROM kelurahan WHERE id = ?"
-	err = db.QueryRow(checkKelurahanQuery, req.IdKelurahan).Scan(&kelurahanExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check kelurahan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Resolve the kelurahan from the koordinat, or check the chosen one
+		req.IdKelurahan, err = wilayah.ResolveKelurahan(tx, req.IdKelurahan, req.Koordinat)
+		if err != nil {
+			return err
 		}
-		return
-	}
-	if kelurahanExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Kelurahan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Check if kelurahan exists and issued the nomor KK
+		err = object.CheckKelurahanRegion(tx, req.IdKelurahan, req.NomorKk)
+		if err != nil {
+			return err
 		}
-		return
-	}
 
-	// Convert coordinates to WKT format
-	koordinatWKT := object.ToWKT(req.Koordinat)
+		// Convert coordinates to WKT format
+		koordinatWKT := object.ToWKT(req.Koordinat)
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Update keluarga
-	updateQuery := `UPDATE keluarga SET 
-        nomor_kk = ?, nama_ayah = ?, nama_ibu = ?, nik_ayah = ?, nik_ibu = ?,
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "keluarga", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read keluarga for audit log")
+		}
+
+		// Update keluarga
+		updateQuery := `UPDATE keluarga SET 
+        nama_ayah = ?, nama_ibu = ?,
         alamat = ?, rt = ?, rw = ?, id_kelurahan = ?, 
         koordinat = ST_GeomFromText(?), updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(updateQuery,
-		req.NomorKk,
-		req.NamaAyah,
-		req.NamaIbu,
-		req.NikAyah,
-		req.NikIbu,
-		req.Alamat,
-		req.Rt,
-		req.Rw,
-		req.IdKelurahan,
-		koordinatWKT,
-		userId,
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updateQuery,
+			req.NamaAyah,
+			req.NamaIbu,
+			req.Alamat,
+			req.Rt,
+			req.Rw,
+			req.IdKelurahan,
+			koordinatWKT,
+			principal.UserId,
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update keluarga")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Keluarga not found, already deleted or no changes made")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Keluarga not found, already deleted or no changes made", nil)
+		// Encrypt the nomor KK and NIK, bound to the row
+		err = keys.WriteRow(tx, "keluarga", req.Id, req.NomorKk, req.NikAyah, req.NikIbu)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to encrypt keluarga data")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "keluarga", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -358,8 +312,9 @@ func KeluargaUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := object.NewResponse(http.StatusOK, "Keluarga updated successfully", updateKeluargaResponse{
-		Id:      req.Id,
-		Message: "Data keluarga berhasil diperbarui",
+		Id:          req.Id,
+		IdKelurahan: req.IdKelurahan,
+		Message:     "Data keluarga berhasil diperbarui",
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
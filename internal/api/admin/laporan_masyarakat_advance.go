@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type advanceLaporanMasyarakatRequest struct {
+	Id              string `json:"id"`
+	IdStatusLaporan string `json:"id_status_laporan"` // status tujuan
+	Catatan         string `json:"catatan"`           // wajib, alasan perubahan status
+}
+
+func (r *advanceLaporanMasyarakatRequest) validate() error {
+	// ID validation
+	if r.Id == "" {
+		return fmt.Errorf("laporan masyarakat ID is required")
+	}
+
+	// ID Status Laporan validation (wajib)
+	if r.IdStatusLaporan == "" {
+		return fmt.Errorf("id status laporan is required")
+	}
+
+	// Catatan validation (wajib)
+	if r.Catatan == "" {
+		return fmt.Errorf("catatan is required")
+	}
+	if len(r.Catatan) < 5 || len(r.Catatan) > 500 {
+		return fmt.Errorf("catatan must be between 5-500 characters")
+	}
+
+	return nil
+}
+
+type advanceLaporanMasyarakatResponse struct {
+	Id                    string `json:"id"`
+	IdStatusLaporanAsal   string `json:"id_status_laporan_asal"`
+	StatusLaporanAsal     string `json:"status_laporan_asal"`
+	IdStatusLaporanTujuan string `json:"id_status_laporan_tujuan"`
+	StatusLaporanTujuan   string `json:"status_laporan_tujuan"`
+}
+
+// # LaporanMasyarakatAdvance handles moving laporan masyarakat to the next status
+//
+// @Summary Advance laporan masyarakat status
+// @Description Move a laporan masyarakat to another status of the workflow (Admin only)
+// @Description
+// @Description Allowed transitions are defined in the status_laporan_transisi table:
+// @Description - Belum diproses → Diproses dan data tidak sesuai, Diproses dan data sesuai
+// @Description - Diproses dan data tidak sesuai → Diproses dan data sesuai
+// @Description - Diproses dan data sesuai → Belum ditindaklanjuti
+// @Description - Belum ditindaklanjuti → Sudah ditindaklanjuti
+// @Description - Sudah ditindaklanjuti → Sudah perbaikan gizi
+// @Description
+// @Description Every change is written to laporan_status_history together with the mandatory catatan.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param laporan body advanceLaporanMasyarakatRequest true "Status tujuan dan catatan"
+// @Success 200 {object} object.Response{data=advanceLaporanMasyarakatResponse} "Laporan masyarakat status updated successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request or transition"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Laporan masyarakat not found"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/laporan-masyarakat/advance [post]
+func (s *Service) LaporanMasyarakatAdvance(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req advanceLaporanMasyarakatRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	var message string
+	var targetStatus string
+	var currentStatusId, currentStatus string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Get current status and lock the laporan until the transaction ends
+		checkExistQuery := `SELECT lm.id_status_laporan, sl.status 
+        FROM laporan_masyarakat lm
+        JOIN status_laporan sl ON lm.id_status_laporan = sl.id
+        WHERE lm.id = ? AND lm.deleted_date IS NULL
+        FOR UPDATE`
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&currentStatusId, &currentStatus)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
+		}
+
+		// Check if status laporan exists
+		checkStatusQuery := "SELECT status FROM status_laporan WHERE id = ?"
+		err = tx.QueryRow(checkStatusQuery, req.IdStatusLaporan).Scan(&targetStatus)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Status laporan not found")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check status laporan existence")
+		}
+
+		// Validate transition
+		err = object.ValidateLaporanTransition(tx, currentStatusId, req.IdStatusLaporan)
+		if err != nil {
+			if errors.Is(err, object.ErrInvalidLaporanTransition) {
+				return object.NewHTTPError(http.StatusBadRequest,
+					fmt.Sprintf("Status laporan cannot change from '%s' to '%s'", currentStatus, targetStatus))
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check status laporan transition")
+		}
+
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "laporan_masyarakat", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read laporan masyarakat for audit log")
+		}
+
+		// Update status laporan
+		updateQuery := `UPDATE laporan_masyarakat SET id_status_laporan = ?, updated_id = ?, updated_date = ?
+        WHERE id = ? AND deleted_date IS NULL`
+		_, err = tx.Exec(updateQuery, req.IdStatusLaporan, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update status laporan")
+		}
+
+		// Record status history
+		err = object.RecordLaporanStatus(tx, req.Id, currentStatusId, req.IdStatusLaporan, req.Catatan, principal.UserId)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record status laporan history")
+		}
+
+		message = fmt.Sprintf("Status laporan berhasil diubah dari '%s' menjadi '%s'", currentStatus, targetStatus)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "laporan_masyarakat", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, message, advanceLaporanMasyarakatResponse{
+		Id:                    req.Id,
+		IdStatusLaporanAsal:   currentStatusId,
+		StatusLaporanAsal:     currentStatus,
+		IdStatusLaporanTujuan: req.IdStatusLaporan,
+		StatusLaporanTujuan:   targetStatus,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"slices"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -50,47 +52,12 @@ type deleteLaporanMasyarakatResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Laporan masyarakat not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/laporan-masyarakat/delete [delete]
-func LaporanMasyarakatDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanMasyarakatDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteLaporanMasyarakatRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -110,152 +77,128 @@ func LaporanMasyarakatDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if laporan masyarakat exists and not already soft deleted
-	var exists int
-	var deletedDate sql.NullString
-	checkQuery := "SELECT COUNT(*), deleted_date FROM laporan_masyarakat WHERE id = ? GROUP BY deleted_date"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if laporan masyarakat exists and not already soft deleted
+		var exists int
+		var deletedDate sql.NullString
+		checkQuery := "SELECT COUNT(*), deleted_date FROM laporan_masyarakat WHERE id = ? GROUP BY deleted_date FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check laporan masyarakat existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "Laporan masyarakat already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "Laporan masyarakat already deleted")
 		}
-		return
-	}
 
-	// Get laporan details for additional information
-	var idBalita, statusLaporan, jenisLaporan string
-	var idMasyarakat sql.NullString
-	detailQuery := `
+		// Get laporan details for additional information
+		var idBalita, statusLaporan, jenisLaporan string
+		var idMasyarakat sql.NullString
+		detailQuery := `
         SELECT lm.id_balita, lm.id_masyarakat, sl.status
         FROM laporan_masyarakat lm
         LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
         WHERE lm.id = ?
     `
-	err = db.QueryRow(detailQuery, req.Id).Scan(&idBalita, &idMasyarakat, &statusLaporan)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get laporan details", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(detailQuery, req.Id).Scan(&idBalita, &idMasyarakat, &statusLaporan)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get laporan details")
 		}
-		return
-	}
 
-	// Determine laporan type
-	if idMasyarakat.Valid {
-		jenisLaporan = "masyarakat"
-	} else {
-		jenisLaporan = "admin"
-	}
+		// Determine laporan type
+		if idMasyarakat.Valid {
+			jenisLaporan = "masyarakat"
+		} else {
+			jenisLaporan = "admin"
+		}
 
-	// Check if laporan has related riwayat pemeriksaan records (warn user if balita has medical history)
-	var riwayatCount int
-	checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkRiwayatQuery, idBalita).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if laporan has related riwayat pemeriksaan records (warn user if balita has medical history)
+		var riwayatCount int
+		checkRiwayatQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE id_balita = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkRiwayatQuery, idBalita).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Prevent deletion if laporan is in processed status (optional business rule)
-	processedStatuses := []string{"Diproses dan data sesuai", "Sudah ditindaklanjuti", "Sudah perbaikan gizi"}
-	if slices.Contains(processedStatuses, statusLaporan) {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete laporan masyarakat. Status '%s' indicates this report has been processed", statusLaporan), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Prevent deletion if laporan is in processed status (optional business rule)
+		processedStatuses := []string{"Diproses dan data sesuai", "Sudah ditindaklanjuti", "Sudah perbaikan gizi"}
+		if slices.Contains(processedStatuses, statusLaporan) {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete laporan masyarakat. Status '%s' indicates this report has been processed", statusLaporan))
 		}
-		return
-	}
 
-	// Additional check: if laporan from masyarakat and has been responded, warn before deletion
-	if jenisLaporan == "masyarakat" && statusLaporan != "Belum diproses" {
-		// Could add a confirmation parameter here for frontend to handle
-		// For now, we'll proceed with warning in response message
-	}
+		// Additional check: if laporan from masyarakat and has been responded, warn before deletion
+		if jenisLaporan == "masyarakat" && statusLaporan != "Belum diproses" {
+			// Could add a confirmation parameter here for frontend to handle
+			// For now, we'll proceed with warning in response message
+		}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "laporan_masyarakat", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read laporan masyarakat for audit log")
+		}
 
-	// Perform soft delete
-	deleteQuery := `UPDATE laporan_masyarakat SET 
+		// Perform soft delete
+		deleteQuery := `UPDATE laporan_masyarakat SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(deleteQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete laporan masyarakat", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deleteQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete laporan masyarakat")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found or already deleted")
+		}
+
+		// Prepare response message with additional information
+		message = "Data laporan masyarakat berhasil dihapus"
+		if riwayatCount > 0 {
+			message += fmt.Sprintf(" (Note: Balita terkait memiliki %d riwayat pemeriksaan)", riwayatCount)
+		}
+		if jenisLaporan == "masyarakat" && statusLaporan != "Belum diproses" {
+			message += " (Warning: This was a processed community report)"
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found or already deleted", nil)
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "laporan_masyarakat", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := "Data laporan masyarakat berhasil dihapus"
-	if riwayatCount > 0 {
-		message += fmt.Sprintf(" (Note: Balita terkait memiliki %d riwayat pemeriksaan)", riwayatCount)
-	}
-	if jenisLaporan == "masyarakat" && statusLaporan != "Belum diproses" {
-		message += " (Warning: This was a processed community report)"
-	}
-
 	response := object.NewResponse(http.StatusOK, "Laporan masyarakat deleted successfully", deleteLaporanMasyarakatResponse{
 		Id:      req.Id,
 		Message: message,
@@ -281,47 +224,12 @@ func LaporanMasyarakatDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Laporan masyarakat not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/laporan-masyarakat/restore [post]
-func LaporanMasyarakatRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanMasyarakatRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteLaporanMasyarakatRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -341,132 +249,103 @@ func LaporanMasyarakatRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
-	// Check if laporan masyarakat exists and is soft deleted
-	var exists int
-	checkQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id = ? AND deleted_date IS NOT NULL"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check laporan masyarakat existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if laporan masyarakat exists and is soft deleted
+		var exists int
+		checkQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id = ? AND deleted_date IS NOT NULL FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found or not deleted")
 		}
-		return
-	}
 
-	// Check if related balita still exists and is not soft deleted
-	var balitaExists int
-	var laporanIdBalita string
-	getBalitaQuery := "SELECT id_balita FROM laporan_masyarakat WHERE id = ?"
-	err = db.QueryRow(getBalitaQuery, req.Id).Scan(&laporanIdBalita)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get laporan balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related balita still exists and is not soft deleted
+		var balitaExists int
+		var laporanIdBalita string
+		getBalitaQuery := "SELECT id_balita FROM laporan_masyarakat WHERE id = ?"
+		err = tx.QueryRow(getBalitaQuery, req.Id).Scan(&laporanIdBalita)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get laporan balita")
 		}
-		return
-	}
 
-	checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaQuery, laporanIdBalita).Scan(&balitaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaQuery, laporanIdBalita).Scan(&balitaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related balita")
 		}
-		return
-	}
 
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore laporan masyarakat. Related balita does not exist or is deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore laporan masyarakat. Related balita does not exist or is deleted")
 		}
-		return
-	}
 
-	// Check if masyarakat still exists (if laporan from masyarakat)
-	var idMasyarakat sql.NullString
-	getMasyarakatQuery := "SELECT id_masyarakat FROM laporan_masyarakat WHERE id = ?"
-	err = db.QueryRow(getMasyarakatQuery, req.Id).Scan(&idMasyarakat)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get laporan masyarakat", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if masyarakat still exists (if laporan from masyarakat)
+		var idMasyarakat sql.NullString
+		getMasyarakatQuery := "SELECT id_masyarakat FROM laporan_masyarakat WHERE id = ?"
+		err = tx.QueryRow(getMasyarakatQuery, req.Id).Scan(&idMasyarakat)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get laporan masyarakat")
 		}
-		return
-	}
 
-	if idMasyarakat.Valid {
-		var masyarakatExists int
-		checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
-		err = db.QueryRow(checkMasyarakatQuery, idMasyarakat.String).Scan(&masyarakatExists)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to check related masyarakat", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		if idMasyarakat.Valid {
+			var masyarakatExists int
+			checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
+			err = tx.QueryRow(checkMasyarakatQuery, idMasyarakat.String).Scan(&masyarakatExists)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related masyarakat")
 			}
-			return
-		}
 
-		if masyarakatExists == 0 {
-			response := object.NewResponse(http.StatusBadRequest, "Cannot restore laporan masyarakat. Related masyarakat does not exist", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			if masyarakatExists == 0 {
+				return object.NewHTTPError(http.StatusBadRequest, "Cannot restore laporan masyarakat. Related masyarakat does not exist")
 			}
-			return
 		}
-	}
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Restore laporan masyarakat (clear soft delete fields)
-	restoreQuery := `UPDATE laporan_masyarakat SET 
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "laporan_masyarakat", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read laporan masyarakat for audit log")
+		}
+
+		// Restore laporan masyarakat (clear soft delete fields)
+		restoreQuery := `UPDATE laporan_masyarakat SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore laporan masyarakat", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore laporan masyarakat")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found or not deleted")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found or not deleted", nil)
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "laporan_masyarakat", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -1,10 +1,12 @@
 package admin
 
 import (
-	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type laporanMasyarakatResponse struct {
@@ -16,7 +18,7 @@ type laporanMasyarakatResponse struct {
 	NamaBalita            string `json:"nama_balita"`
 	NamaAyah              string `json:"nama_ayah"`
 	NamaIbu               string `json:"nama_ibu"`
-	NomorKk               string `json:"nomor_kk"`
+	NomorKk               string `json:"nomor_kk" pii:"nomor_kk"`
 	Alamat                string `json:"alamat"`
 	Kelurahan             string `json:"kelurahan"`
 	Kecamatan             string `json:"kecamatan"`
@@ -24,11 +26,15 @@ type laporanMasyarakatResponse struct {
 	StatusLaporan         string `json:"status_laporan"`
 	TanggalLaporan        string `json:"tanggal_laporan"`
 	HubunganDenganBalita  string `json:"hubungan_dengan_balita"`
-	NomorHpPelapor        string `json:"nomor_hp_pelapor"`
-	NomorHpKeluargaBalita string `json:"nomor_hp_keluarga_balita"`
+	NomorHpPelapor        string `json:"nomor_hp_pelapor" pii:"phone"`
+	NomorHpKeluargaBalita string `json:"nomor_hp_keluarga_balita" pii:"phone"`
 	JenisLaporan          string `json:"jenis_laporan"` // "masyarakat" atau "admin"
 	CreatedDate           string `json:"created_date"`
 	UpdatedDate           string `json:"updated_date,omitempty"`
+
+	// Workflow status laporan (hanya untuk detail laporan)
+	RiwayatStatus []object.LaporanStatusHistory `json:"riwayat_status,omitempty"`
+	NextStatus    []string                      `json:"next_status,omitempty"` // id status yang dapat dituju
 }
 
 type getAllLaporanMasyarakatResponse struct {
@@ -47,14 +53,18 @@ type getLaporanMasyarakatByIdResponse struct {
 // @Description
 // @Description Response data varies by parameter:
 // @Description - Without id parameter: Returns all laporan masyarakat with total count
-// @Description - With id parameter: Returns specific laporan masyarakat data
+// @Description - With id parameter: Returns specific laporan masyarakat data, including the
+// @Description   status history (riwayat_status) and the statuses it may advance to (next_status)
 // @Description
 // @Description Laporan masyarakat data includes: pelapor info, balita info, keluarga info, status laporan, contact details
+// @Description
+// @Description nomor_kk and the phone numbers are masked unless lengkap=true, which is logged as a privileged read.
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Laporan Masyarakat ID"
+// @Param lengkap query bool false "Return the unmasked nomor KK and phone numbers"
 // @Success 200 {object} object.Response{data=getAllLaporanMasyarakatResponse} "Laporan masyarakat data retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -62,38 +72,11 @@ type getLaporanMasyarakatByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Laporan masyarakat not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/laporan-masyarakat/get [get]
-func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
+func (s *Service) LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -101,23 +84,15 @@ func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Check if ID parameter is provided
 	idParam := r.URL.Query().Get("id")
 	if idParam != "" {
 		// Get specific laporan masyarakat by ID
-		laporan, err := getLaporanMasyarakatById(db, idParam)
+		laporan, err := getLaporanMasyarakatById(s.store.LaporanMasyarakat, idParam)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -131,6 +106,37 @@ func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Get status history and allowed next statuses
+		laporan.RiwayatStatus, err = object.GetLaporanStatusHistory(db, idParam)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get status laporan history", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		laporan.NextStatus, err = object.GetNextStatusLaporan(db, laporan.IdStatusLaporan)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get next status laporan", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		// Record the read in the access log
+		access := audit.NewAccess(r, "laporan_masyarakat", []string{laporan.Id})
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&laporan)
 		response := object.NewResponse(http.StatusOK, "Laporan masyarakat retrieved successfully", getLaporanMasyarakatByIdResponse{
 			Data: laporan,
 		})
@@ -139,7 +145,7 @@ func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all laporan masyarakat
-		laporanList, total, err := getAllLaporanMasyarakat(db)
+		laporanList, total, err := getAllLaporanMasyarakat(s.store.LaporanMasyarakat)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get laporan masyarakat list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -148,6 +154,23 @@ func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		ids := make([]string, len(laporanList))
+		for i, laporan := range laporanList {
+			ids[i] = laporan.Id
+		}
+		access := audit.NewAccess(r, "laporan_masyarakat", ids)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&laporanList)
 		response := object.NewResponse(http.StatusOK, "All laporan masyarakat retrieved successfully", getAllLaporanMasyarakatResponse{
 			Data:  laporanList,
 			Total: total,
@@ -159,180 +182,61 @@ func LaporanMasyarakatGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get laporan masyarakat by ID
-func getLaporanMasyarakatById(db *sql.DB, id string) (laporanMasyarakatResponse, error) {
-	var laporan laporanMasyarakatResponse
-	var idMasyarakat, namaPelapor, emailPelapor sql.NullString
-	var updatedDate sql.NullString
-
-	query := `
-        SELECT 
-            lm.id, lm.id_masyarakat, lm.id_balita, lm.id_status_laporan,
-            lm.tanggal_laporan, lm.hubungan_dengan_balita, lm.nomor_hp_pelapor, 
-            lm.nomor_hp_keluarga_balita, lm.created_date, lm.updated_date,
-            b.nama as nama_balita,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu, k.alamat,
-            kel.kelurahan, kec.kecamatan,
-            sl.status as status_laporan,
-            m.nama as nama_pelapor,
-            p.email as email_pelapor
-        FROM laporan_masyarakat lm
-        LEFT JOIN balita b ON lm.id_balita = b.id
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN masyarakat m ON lm.id_masyarakat = m.id
-        LEFT JOIN pengguna p ON m.id_pengguna = p.id
-        WHERE lm.id = ? AND lm.deleted_date IS NULL
-    `
-
-	err := db.QueryRow(query, id).Scan(
-		&laporan.Id,
-		&idMasyarakat,
-		&laporan.IdBalita,
-		&laporan.IdStatusLaporan,
-		&laporan.TanggalLaporan,
-		&laporan.HubunganDenganBalita,
-		&laporan.NomorHpPelapor,
-		&laporan.NomorHpKeluargaBalita,
-		&laporan.CreatedDate,
-		&updatedDate,
-		&laporan.NamaBalita,
-		&laporan.NomorKk,
-		&laporan.NamaAyah,
-		&laporan.NamaIbu,
-		&laporan.Alamat,
-		&laporan.Kelurahan,
-		&laporan.Kecamatan,
-		&laporan.StatusLaporan,
-		&namaPelapor,
-		&emailPelapor,
-	)
-
+func getLaporanMasyarakatById(repo store.LaporanMasyarakatRepository, id string) (laporanMasyarakatResponse, error) {
+	row, err := repo.Get(id, store.LaporanMasyarakatFilter{})
 	if err != nil {
-		return laporan, err
-	}
-
-	// Handle nullable fields
-	if idMasyarakat.Valid {
-		laporan.IdMasyarakat = idMasyarakat.String
-		laporan.JenisLaporan = "masyarakat"
-		if namaPelapor.Valid {
-			laporan.NamaPelapor = namaPelapor.String
-		}
-		if emailPelapor.Valid {
-			laporan.EmailPelapor = emailPelapor.String
-		}
-	} else {
-		laporan.JenisLaporan = "admin"
-		laporan.NamaPelapor = "Admin"
-	}
-
-	if updatedDate.Valid {
-		laporan.UpdatedDate = updatedDate.String
+		return laporanMasyarakatResponse{}, err
 	}
 
-	return laporan, nil
+	return newLaporanMasyarakatResponse(row), nil
 }
 
 // Helper function to get all laporan masyarakat
-func getAllLaporanMasyarakat(db *sql.DB) ([]laporanMasyarakatResponse, int, error) {
-	var laporanList []laporanMasyarakatResponse
-
-	query := `
-        SELECT 
-            lm.id, lm.id_masyarakat, lm.id_balita, lm.id_status_laporan,
-            lm.tanggal_laporan, lm.hubungan_dengan_balita, lm.nomor_hp_pelapor, 
-            lm.nomor_hp_keluarga_balita, lm.created_date, lm.updated_date,
-            b.nama as nama_balita,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu, k.alamat,
-            kel.kelurahan, kec.kecamatan,
-            sl.status as status_laporan,
-            m.nama as nama_pelapor,
-            p.email as email_pelapor
-        FROM laporan_masyarakat lm
-        LEFT JOIN balita b ON lm.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN masyarakat m ON lm.id_masyarakat = m.id
-        LEFT JOIN pengguna p ON m.id_pengguna = p.id
-        WHERE lm.deleted_date IS NULL
-        ORDER BY lm.created_date DESC
-    `
-
-	rows, err := db.Query(query)
+func getAllLaporanMasyarakat(repo store.LaporanMasyarakatRepository) ([]laporanMasyarakatResponse, int, error) {
+	rows, err := repo.List(store.LaporanMasyarakatFilter{})
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var laporan laporanMasyarakatResponse
-		var idMasyarakat, namaPelapor, emailPelapor sql.NullString
-		var updatedDate sql.NullString
-
-		err := rows.Scan(
-			&laporan.Id,
-			&idMasyarakat,
-			&laporan.IdBalita,
-			&laporan.IdStatusLaporan,
-			&laporan.TanggalLaporan,
-			&laporan.HubunganDenganBalita,
-			&laporan.NomorHpPelapor,
-			&laporan.NomorHpKeluargaBalita,
-			&laporan.CreatedDate,
-			&updatedDate,
-			&laporan.NamaBalita,
-			&laporan.NomorKk,
-			&laporan.NamaAyah,
-			&laporan.NamaIbu,
-			&laporan.Alamat,
-			&laporan.Kelurahan,
-			&laporan.Kecamatan,
-			&laporan.StatusLaporan,
-			&namaPelapor,
-			&emailPelapor,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Handle nullable fields
-		if idMasyarakat.Valid {
-			laporan.IdMasyarakat = idMasyarakat.String
-			laporan.JenisLaporan = "masyarakat"
-			if namaPelapor.Valid {
-				laporan.NamaPelapor = namaPelapor.String
-			}
-			if emailPelapor.Valid {
-				laporan.EmailPelapor = emailPelapor.String
-			}
-		} else {
-			laporan.JenisLaporan = "admin"
-			laporan.NamaPelapor = "Admin"
-		}
-
-		if updatedDate.Valid {
-			laporan.UpdatedDate = updatedDate.String
-		}
 
-		laporanList = append(laporanList, laporan)
+	laporanList := []laporanMasyarakatResponse{}
+	for _, row := range rows {
+		laporanList = append(laporanList, newLaporanMasyarakatResponse(row))
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	return laporanList, len(laporanList), nil
+}
+
+// Helper function to build the response of a laporan masyarakat row
+func newLaporanMasyarakatResponse(row store.LaporanMasyarakat) laporanMasyarakatResponse {
+	laporan := laporanMasyarakatResponse{
+		Id:                    row.Id,
+		IdMasyarakat:          row.IdMasyarakat,
+		NamaPelapor:           row.NamaPelapor,
+		EmailPelapor:          row.EmailPelapor,
+		IdBalita:              row.IdBalita,
+		NamaBalita:            row.NamaBalita,
+		NamaAyah:              row.NamaAyah,
+		NamaIbu:               row.NamaIbu,
+		NomorKk:               row.NomorKk,
+		Alamat:                row.Alamat,
+		Kelurahan:             row.Kelurahan,
+		Kecamatan:             row.Kecamatan,
+		IdStatusLaporan:       row.IdStatusLaporan,
+		StatusLaporan:         row.StatusLaporan,
+		TanggalLaporan:        row.TanggalLaporan,
+		HubunganDenganBalita:  row.HubunganDenganBalita,
+		NomorHpPelapor:        row.NomorHpPelapor,
+		NomorHpKeluargaBalita: row.NomorHpKeluargaBalita,
+		JenisLaporan:          "masyarakat",
+		CreatedDate:           row.CreatedDate,
+		UpdatedDate:           row.UpdatedDate,
 	}
 
-	// Get total count
-	var total int
-	countQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE deleted_date IS NULL"
-	err = db.QueryRow(countQuery).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+	// Laporan without a masyarakat were made by an admin
+	if row.IdMasyarakat == "" {
+		laporan.JenisLaporan = "admin"
+		laporan.NamaPelapor = "Admin"
 	}
 
-	return laporanList, total, nil
-}
\ No newline at end of file
+	return laporan
+}
@@ -3,12 +3,15 @@ package admin
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -101,6 +104,9 @@ type insertLaporanMasyarakatResponse struct {
 // @Description - id_masyarakat (optional, null if admin report), id_balita, id_status_laporan
 // @Description - tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita
 // @Description - Validates balita existence, status laporan, and masyarakat (if provided)
+// @Description
+// @Description id_status_laporan must be an initial status of the workflow (Belum diproses).
+// @Description The initial status is recorded in laporan_status_history.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -112,47 +118,12 @@ type insertLaporanMasyarakatResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/laporan-masyarakat/insert [post]
-func LaporanMasyarakatInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanMasyarakatInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertLaporanMasyarakatRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -172,159 +143,146 @@ func LaporanMasyarakatInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if balita exists and not soft deleted
-	var balitaExists int
-	checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists and not soft deleted
+		var balitaExists int
+		checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 		}
-		return
-	}
 
-	// Check if status laporan exists
-	var statusExists int
-	checkStatusQuery := "SELECT COUNT(*) FROM status_laporan WHERE id = ?"
-	err = db.QueryRow(checkStatusQuery, req.IdStatusLaporan).Scan(&statusExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check status laporan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if status laporan exists
+		var statusExists int
+		checkStatusQuery := "SELECT COUNT(*) FROM status_laporan WHERE id = ?"
+		err = tx.QueryRow(checkStatusQuery, req.IdStatusLaporan).Scan(&statusExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check status laporan existence")
 		}
-		return
-	}
-	if statusExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Status laporan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if statusExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Status laporan not found")
 		}
-		return
-	}
 
-	// Check if masyarakat exists (if provided)
-	if req.IdMasyarakat != "" {
-		var masyarakatExists int
-		checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
-		err = db.QueryRow(checkMasyarakatQuery, req.IdMasyarakat).Scan(&masyarakatExists)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to check masyarakat existence", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if masyarakat exists (if provided)
+		if req.IdMasyarakat != "" {
+			var masyarakatExists int
+			checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
+			err = tx.QueryRow(checkMasyarakatQuery, req.IdMasyarakat).Scan(&masyarakatExists)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check masyarakat existence")
 			}
-			return
-		}
-		if masyarakatExists == 0 {
-			response := object.NewResponse(http.StatusBadRequest, "Masyarakat not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			if masyarakatExists == 0 {
+				return object.NewHTTPError(http.StatusBadRequest, "Masyarakat not found")
 			}
-			return
 		}
-	}
 
-	// Check for duplicate laporan (same balita, tanggal laporan yang sama, dan pelapor yang sama)
-	var duplicateExists int
-	var checkDuplicateQuery string
-	if req.IdMasyarakat != "" {
-		checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
+		// Check for duplicate laporan (same balita, tanggal laporan yang sama, dan pelapor yang sama)
+		var duplicateExists int
+		var checkDuplicateQuery string
+		if req.IdMasyarakat != "" {
+			checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
             WHERE id_balita = ? AND tanggal_laporan = ? AND id_masyarakat = ? AND deleted_date IS NULL`
-		err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.IdMasyarakat).Scan(&duplicateExists)
-	} else {
-		checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
+			err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.IdMasyarakat).Scan(&duplicateExists)
+		} else {
+			checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
             WHERE id_balita = ? AND tanggal_laporan = ? AND id_masyarakat IS NULL AND deleted_date IS NULL`
-		err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan).Scan(&duplicateExists)
-	}
+			err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan).Scan(&duplicateExists)
+		}
 
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate laporan")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Laporan with same balita and date already exists from this reporter", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Laporan with same balita and date already exists from this reporter")
+		}
+
+		// Business logic: New laporan must start at an initial status of the workflow
+		err = object.ValidateLaporanTransition(tx, "", req.IdStatusLaporan)
+		if err != nil {
+			if errors.Is(err, object.ErrInvalidLaporanTransition) {
+				return object.NewHTTPError(http.StatusBadRequest, "Status laporan cannot be used for a new laporan, use 'Belum diproses'")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check status laporan transition")
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Prepare insert query based on whether id_masyarakat is provided
-	var insertQuery string
-	var result sql.Result
+		// Prepare insert query based on whether id_masyarakat is provided
+		var insertQuery string
+		var result sql.Result
 
-	if req.IdMasyarakat != "" {
-		// Insert laporan with masyarakat
-		insertQuery = `INSERT INTO laporan_masyarakat 
+		if req.IdMasyarakat != "" {
+			// Insert laporan with masyarakat
+			insertQuery = `INSERT INTO laporan_masyarakat 
             (id_masyarakat, id_balita, id_status_laporan, tanggal_laporan, hubungan_dengan_balita, 
-            nomor_hp_pelapor, nomor_hp_keluarga_balita, created_id, created_date) 
-            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
-
-		result, err = db.Exec(insertQuery,
-			req.IdMasyarakat,
-			req.IdBalita,
-			req.IdStatusLaporan,
-			req.TanggalLaporan,
-			req.HubunganDenganBalita,
-			req.NomorHpPelapor,
-			req.NomorHpKeluargaBalita,
-			userId,
-			currentTime,
-		)
-	} else {
-		// Insert laporan tanpa masyarakat (laporan admin)
-		insertQuery = `INSERT INTO laporan_masyarakat 
+            nomor_hp_keluarga_balita, created_id, created_date) 
+            VALUES (?, ?, ?, ?, ?, '', ?, ?)`
+
+			result, err = tx.Exec(insertQuery,
+				req.IdMasyarakat,
+				req.IdBalita,
+				req.IdStatusLaporan,
+				req.TanggalLaporan,
+				req.HubunganDenganBalita,
+				principal.UserId,
+				currentTime,
+			)
+		} else {
+			// Insert laporan tanpa masyarakat (laporan admin)
+			insertQuery = `INSERT INTO laporan_masyarakat 
             (id_masyarakat, id_balita, id_status_laporan, tanggal_laporan, hubungan_dengan_balita, 
-            nomor_hp_pelapor, nomor_hp_keluarga_balita, created_id, created_date) 
-            VALUES (NULL, ?, ?, ?, ?, ?, ?, ?, ?)`
-
-		result, err = db.Exec(insertQuery,
-			req.IdBalita,
-			req.IdStatusLaporan,
-			req.TanggalLaporan,
-			req.HubunganDenganBalita,
-			req.NomorHpPelapor,
-			req.NomorHpKeluargaBalita,
-			userId,
-			currentTime,
-		)
-	}
+            nomor_hp_keluarga_balita, created_id, created_date) 
+            VALUES (NULL, ?, ?, ?, ?, '', ?, ?)`
+
+			result, err = tx.Exec(insertQuery,
+				req.IdBalita,
+				req.IdStatusLaporan,
+				req.TanggalLaporan,
+				req.HubunganDenganBalita,
+				principal.UserId,
+				currentTime,
+			)
+		}
 
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert laporan masyarakat", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert laporan masyarakat")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
+		}
+
+		// Encrypt the phone numbers, bound to the row
+		err = s.store.Keys.WriteRow(tx, "laporan_masyarakat", strconv.FormatInt(insertedId, 10), req.NomorHpPelapor, req.NomorHpKeluargaBalita)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to encrypt laporan data")
+		}
+
+		// Record initial status
+		err = object.RecordLaporanStatus(tx, strconv.FormatInt(insertedId, 10), "", req.IdStatusLaporan, "Laporan dibuat oleh admin", principal.UserId)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record status laporan history")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "laporan_masyarakat", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
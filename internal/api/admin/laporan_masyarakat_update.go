@@ -8,6 +8,8 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -15,8 +17,8 @@ type updateLaporanMasyarakatRequest struct {
 	Id                    string `json:"id"`
 	IdMasyarakat          string `json:"id_masyarakat"` // dapat null jika laporan dari admin
 	IdBalita              string `json:"id_balita"`
-	IdStatusLaporan       string `json:"id_status_laporan"`
-	TanggalLaporan        string `json:"tanggal_laporan"` // Format: YYYY-MM-DD
+	IdStatusLaporan       string `json:"id_status_laporan"` // optional, status diubah melalui endpoint advance
+	TanggalLaporan        string `json:"tanggal_laporan"`   // Format: YYYY-MM-DD
 	HubunganDenganBalita  string `json:"hubungan_dengan_balita"`
 	NomorHpPelapor        string `json:"nomor_hp_pelapor"`
 	NomorHpKeluargaBalita string `json:"nomor_hp_keluarga_balita"`
@@ -33,11 +35,6 @@ func (r *updateLaporanMasyarakatRequest) validate() error {
 		return fmt.Errorf("id balita is required")
 	}
 
-	// ID Status Laporan validation (wajib)
-	if r.IdStatusLaporan == "" {
-		return fmt.Errorf("id status laporan is required")
-	}
-
 	// Tanggal Laporan validation: YYYY-MM-DD format
 	if r.TanggalLaporan == "" {
 		return fmt.Errorf("tanggal laporan is required")
@@ -104,9 +101,12 @@ type updateLaporanMasyarakatResponse struct {
 // @Description Update existing laporan masyarakat data (Admin only)
 // @Description
 // @Description Updates laporan masyarakat record with new data including:
-// @Description - id_masyarakat (optional, null if admin report), id_balita, id_status_laporan
+// @Description - id_masyarakat (optional, null if admin report), id_balita
 // @Description - tanggal_laporan, hubungan_dengan_balita, nomor_hp_pelapor, nomor_hp_keluarga_balita
-// @Description - Validates balita existence, status laporan, masyarakat (if provided), and prevents duplicates
+// @Description - Validates balita existence, masyarakat (if provided), and prevents duplicates
+// @Description
+// @Description Status laporan cannot be changed here, use /api/admin/laporan-masyarakat/advance.
+// @Description id_status_laporan is optional and must match the current status when sent.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -119,47 +119,12 @@ type updateLaporanMasyarakatResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Laporan masyarakat not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/laporan-masyarakat/update [put]
-func LaporanMasyarakatUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanMasyarakatUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateLaporanMasyarakatRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -179,215 +144,168 @@ func LaporanMasyarakatUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if laporan masyarakat exists and not soft deleted
-	var exists int
-	checkExistQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check laporan masyarakat existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if laporan masyarakat exists and not soft deleted, also get current status
+		var currentStatusId string
+		checkExistQuery := "SELECT id_status_laporan FROM laporan_masyarakat WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&currentStatusId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
 		}
-		return
-	}
 
-	// Check if balita exists and not soft deleted
-	var balitaExists int
-	checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Business logic: Status laporan only changes through the workflow
+		if req.IdStatusLaporan != "" && req.IdStatusLaporan != currentStatusId {
+			return object.NewHTTPError(http.StatusBadRequest,
+				"Status laporan cannot be changed here, use /api/admin/laporan-masyarakat/advance")
 		}
-		return
-	}
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if status laporan exists
-	var statusExists int
-	checkStatusQuery := "SELECT COUNT(*) FROM status_laporan WHERE id = ?"
-	err = db.QueryRow(checkStatusQuery, req.IdStatusLaporan).Scan(&statusExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check status laporan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if balita exists and not soft deleted
+		var balitaExists int
+		checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
-	if statusExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Status laporan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 		}
-		return
-	}
 
-	// Check if masyarakat exists (if provided)
-	if req.IdMasyarakat != "" {
-		var masyarakatExists int
-		checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
-		err = db.QueryRow(checkMasyarakatQuery, req.IdMasyarakat).Scan(&masyarakatExists)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to check masyarakat existence", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if masyarakat exists (if provided)
+		if req.IdMasyarakat != "" {
+			var masyarakatExists int
+			checkMasyarakatQuery := "SELECT COUNT(*) FROM masyarakat WHERE id = ?"
+			err = tx.QueryRow(checkMasyarakatQuery, req.IdMasyarakat).Scan(&masyarakatExists)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check masyarakat existence")
 			}
-			return
-		}
-		if masyarakatExists == 0 {
-			response := object.NewResponse(http.StatusBadRequest, "Masyarakat not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			if masyarakatExists == 0 {
+				return object.NewHTTPError(http.StatusBadRequest, "Masyarakat not found")
 			}
-			return
 		}
-	}
 
-	// Check for duplicate laporan (same balita, tanggal laporan yang sama, dan pelapor yang sama, excluding current record)
-	var duplicateExists int
-	var checkDuplicateQuery string
-	if req.IdMasyarakat != "" {
-		checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
+		// Check for duplicate laporan (same balita, tanggal laporan yang sama, dan pelapor yang sama, excluding current record)
+		var duplicateExists int
+		var checkDuplicateQuery string
+		if req.IdMasyarakat != "" {
+			checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
             WHERE id_balita = ? AND tanggal_laporan = ? AND id_masyarakat = ? AND id != ? AND deleted_date IS NULL`
-		err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.IdMasyarakat, req.Id).Scan(&duplicateExists)
-	} else {
-		checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
+			err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.IdMasyarakat, req.Id).Scan(&duplicateExists)
+		} else {
+			checkDuplicateQuery = `SELECT COUNT(*) FROM laporan_masyarakat 
             WHERE id_balita = ? AND tanggal_laporan = ? AND id_masyarakat IS NULL AND id != ? AND deleted_date IS NULL`
-		err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.Id).Scan(&duplicateExists)
-	}
+			err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, req.Id).Scan(&duplicateExists)
+		}
 
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate laporan")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Laporan with same balita and date already exists from this reporter", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Laporan with same balita and date already exists from this reporter")
 		}
-		return
-	}
 
-	// Check if laporan has related riwayat pemeriksaan records (warn user)
-	var riwayatCount int
-	checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
+		// Check if laporan has related riwayat pemeriksaan records (warn user)
+		var riwayatCount int
+		checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
         WHERE id_balita = ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkRiwayatQuery, req.IdBalita).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkRiwayatQuery, req.IdBalita).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Prepare update query based on whether id_masyarakat is provided
-	var updateQuery string
-	var result sql.Result
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "laporan_masyarakat", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read laporan masyarakat for audit log")
+		}
 
-	if req.IdMasyarakat != "" {
-		// Update laporan with masyarakat
-		updateQuery = `UPDATE laporan_masyarakat SET 
-            id_masyarakat = ?, id_balita = ?, id_status_laporan = ?, tanggal_laporan = ?,
-            hubungan_dengan_balita = ?, nomor_hp_pelapor = ?, nomor_hp_keluarga_balita = ?,
-            updated_id = ?, updated_date = ?
+		// Prepare update query based on whether id_masyarakat is provided
+		var updateQuery string
+		var result sql.Result
+
+		if req.IdMasyarakat != "" {
+			// Update laporan with masyarakat
+			updateQuery = `UPDATE laporan_masyarakat SET 
+            id_masyarakat = ?, id_balita = ?, tanggal_laporan = ?,
+            hubungan_dengan_balita = ?, updated_id = ?, updated_date = ?
             WHERE id = ? AND deleted_date IS NULL`
 
-		result, err = db.Exec(updateQuery,
-			req.IdMasyarakat,
-			req.IdBalita,
-			req.IdStatusLaporan,
-			req.TanggalLaporan,
-			req.HubunganDenganBalita,
-			req.NomorHpPelapor,
-			req.NomorHpKeluargaBalita,
-			userId,
-			currentTime,
-			req.Id,
-		)
-	} else {
-		// Update laporan tanpa masyarakat (admin report)
-		updateQuery = `UPDATE laporan_masyarakat SET 
-            id_masyarakat = NULL, id_balita = ?, id_status_laporan = ?, tanggal_laporan = ?,
-            hubungan_dengan_balita = ?, nomor_hp_pelapor = ?, nomor_hp_keluarga_balita = ?,
-            updated_id = ?, updated_date = ?
+			result, err = tx.Exec(updateQuery,
+				req.IdMasyarakat,
+				req.IdBalita,
+				req.TanggalLaporan,
+				req.HubunganDenganBalita,
+				principal.UserId,
+				currentTime,
+				req.Id,
+			)
+		} else {
+			// Update laporan tanpa masyarakat (admin report)
+			updateQuery = `UPDATE laporan_masyarakat SET 
+            id_masyarakat = NULL, id_balita = ?, tanggal_laporan = ?,
+            hubungan_dengan_balita = ?, updated_id = ?, updated_date = ?
             WHERE id = ? AND deleted_date IS NULL`
 
-		result, err = db.Exec(updateQuery,
-			req.IdBalita,
-			req.IdStatusLaporan,
-			req.TanggalLaporan,
-			req.HubunganDenganBalita,
-			req.NomorHpPelapor,
-			req.NomorHpKeluargaBalita,
-			userId,
-			currentTime,
-			req.Id,
-		)
-	}
+			result, err = tx.Exec(updateQuery,
+				req.IdBalita,
+				req.TanggalLaporan,
+				req.HubunganDenganBalita,
+				principal.UserId,
+				currentTime,
+				req.Id,
+			)
+		}
 
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update laporan masyarakat", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update laporan masyarakat")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Laporan masyarakat not found, already deleted or no changes made", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Laporan masyarakat not found, already deleted or no changes made")
+		}
+
+		// Prepare response message with warnings if applicable
+		message = "Data laporan masyarakat berhasil diperbarui"
+		if riwayatCount > 0 {
+			message += fmt.Sprintf(" (Note: This balita has %d related riwayat pemeriksaan)", riwayatCount)
+		}
+
+		// Encrypt the phone numbers, bound to the row
+		err = s.store.Keys.WriteRow(tx, "laporan_masyarakat", req.Id, req.NomorHpPelapor, req.NomorHpKeluargaBalita)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to encrypt laporan data")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "laporan_masyarakat", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with warnings if applicable
-	message := "Data laporan masyarakat berhasil diperbarui"
-	if riwayatCount > 0 {
-		message += fmt.Sprintf(" (Note: This balita has %d related riwayat pemeriksaan)", riwayatCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, "Laporan masyarakat updated successfully", updateLaporanMasyarakatResponse{
 		Id:      req.Id,
 		Message: message,
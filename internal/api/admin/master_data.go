@@ -4,7 +4,13 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/cluster"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/geom"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 // ==================== STATUS LAPORAN ====================
@@ -32,57 +38,9 @@ type getAllStatusLaporanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/master-status-laporan [get]
-func StatusLaporanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) StatusLaporanGet(w http.ResponseWriter, r *http.Request) {
 	// Get all status laporan
-	statusList, total, err := getAllStatusLaporan(db)
+	statusList, total, err := getAllStatusLaporan(s.store.StatusLaporan)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get status laporan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -127,54 +85,9 @@ type getAllMasyarakatResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/master-masyarakat [get]
-func MasyarakatGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) MasyarakatGet(w http.ResponseWriter, r *http.Request) {
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Get all masyarakat
 	masyarakatList, total, err := getAllMasyarakat(db)
@@ -220,57 +133,9 @@ type getAllKecamatanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/master-kecamatan [get]
-func KecamatanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) KecamatanGet(w http.ResponseWriter, r *http.Request) {
 	// Get all kecamatan
-	kecamatanList, total, err := getAllKecamatan(db)
+	kecamatanList, total, err := getAllKecamatan(s.store.Wilayah)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get kecamatan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -311,65 +176,20 @@ type getAllKelurahanResponse struct {
 // @Produce json
 // @Security Bearer
 // @Param id_kecamatan query string false "Filter by Kecamatan ID"
+// @Param statistik query bool false "Add the stunting statistics to the properties"
+// @Param dari query string false "Start of the period (YYYY-MM or YYYY-MM-DD), default 12 months before sampai"
+// @Param sampai query string false "End of the period (YYYY-MM or YYYY-MM-DD), default today"
 // @Success 200 {object} object.Response{data=getAllKelurahanResponse} "Kelurahan data retrieved successfully"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/master-kelurahan [get]
-func KelurahanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) KelurahanGet(w http.ResponseWriter, r *http.Request) {
 	// Check for kecamatan filter
 	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
 
 	// Get kelurahan data
-	kelurahanList, total, err := getAllKelurahan(db, idKecamatanParam)
+	kelurahanList, total, err := getAllKelurahan(s.store.Wilayah, idKecamatanParam)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get kelurahan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -419,54 +239,9 @@ type getAllSkpdMasterResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/master-skpd [get]
-func SkpdMasterGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) SkpdMasterGet(w http.ResponseWriter, r *http.Request) {
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Check for jenis filter
 	jenisParam := r.URL.Query().Get("jenis")
@@ -501,67 +276,55 @@ func SkpdMasterGet(w http.ResponseWriter, r *http.Request) {
 //
 // @Summary Get kecamatan area GeoJSON
 // @Description Get kecamatan boundary areas as GeoJSON MultiPolygon (Admin only)
+// @Description
+// @Description With statistik=true each feature also carries the stunting statistics of the
+// @Description kecamatan: jumlah_balita (under five at the end of the period), jumlah_diperiksa,
+// @Description jumlah_stunting and jumlah_gizi_buruk by the last pemeriksaan in the period,
+// @Description prevalensi (percentage of the examined balita with stunting or gizi buruk, null
+// @Description without pemeriksaan), jumlah_laporan_terbuka (laporan not yet in a final status),
+// @Description klasifikasi with its color (sangat rendah < 2.5, rendah < 10, sedang < 20,
+// @Description tinggi < 30, sangat tinggi, or tidak ada data) and the periode_dari and periode_sampai.
 // @Tags admin
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Specific Kecamatan ID"
+// @Param statistik query bool false "Add the stunting statistics to the properties"
+// @Param dari query string false "Start of the period (YYYY-MM or YYYY-MM-DD), default 12 months before sampai"
+// @Param sampai query string false "End of the period (YYYY-MM or YYYY-MM-DD), default today"
 // @Success 200 {object} object.Response{data=object.GeoJSONFeatureCollection} "Kecamatan GeoJSON retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/geojson-kecamatan [get]
-func KecamatanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) KecamatanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
+	// Connect to database
+	db := s.db
 
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+	// Check for specific kecamatan filter
+	idParam := r.URL.Query().Get("id")
 
-	_, role, err := object.ParseJWT(token)
+	// Parse the statistics period when the statistics are requested
+	statistik, err := wantsStatistik(r.URL.Query())
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	var periode statistikPeriode
+	if statistik {
+		periode, err = parseStatistikPeriode(r.URL.Query())
+		if err != nil {
+			response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
 		}
-		return
 	}
-	defer db.Close()
-
-	// Check for specific kecamatan filter
-	idParam := r.URL.Query().Get("id")
 
 	// Get kecamatan GeoJSON
 	geoJSONCollection, err := getKecamatanGeoJSON(db, idParam)
@@ -573,6 +336,19 @@ func KecamatanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Add the statistics of each kecamatan
+	if statistik {
+		result, err := getWilayahStatistik(db, periode)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get kecamatan statistics", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		addWilayahStatistik(geoJSONCollection, result.Kecamatan, periode)
+	}
+
 	message := "Kecamatan GeoJSON retrieved successfully"
 	if idParam != "" {
 		message = "Kecamatan GeoJSON by ID retrieved successfully"
@@ -588,6 +364,14 @@ func KecamatanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 //
 // @Summary Get kelurahan area GeoJSON
 // @Description Get kelurahan boundary areas as GeoJSON MultiPolygon (Admin only)
+// @Description
+// @Description With statistik=true each feature also carries the stunting statistics of the
+// @Description kelurahan: jumlah_balita (under five at the end of the period), jumlah_diperiksa,
+// @Description jumlah_stunting and jumlah_gizi_buruk by the last pemeriksaan in the period,
+// @Description prevalensi (percentage of the examined balita with stunting or gizi buruk, null
+// @Description without pemeriksaan), jumlah_laporan_terbuka (laporan not yet in a final status),
+// @Description klasifikasi with its color (sangat rendah < 2.5, rendah < 10, sedang < 20,
+// @Description tinggi < 30, sangat tinggi, or tidak ada data) and the periode_dari and periode_sampai.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -595,62 +379,39 @@ func KecamatanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 // @Param id query string false "Specific Kelurahan ID"
 // @Param id_kecamatan query string false "Filter by Kecamatan ID"
 // @Success 200 {object} object.Response{data=object.GeoJSONFeatureCollection} "Kelurahan GeoJSON retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/geojson-kelurahan [get]
-func KelurahanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) KelurahanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
+	// Connect to database
+	db := s.db
 
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+	// Check for filters
+	idParam := r.URL.Query().Get("id")
+	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
 
-	_, role, err := object.ParseJWT(token)
+	// Parse the statistics period when the statistics are requested
+	statistik, err := wantsStatistik(r.URL.Query())
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	var periode statistikPeriode
+	if statistik {
+		periode, err = parseStatistikPeriode(r.URL.Query())
+		if err != nil {
+			response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
 		}
-		return
 	}
-	defer db.Close()
-
-	// Check for filters
-	idParam := r.URL.Query().Get("id")
-	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
 
 	// Get kelurahan GeoJSON
 	geoJSONCollection, err := getKelurahanGeoJSON(db, idParam, idKecamatanParam)
@@ -662,6 +423,19 @@ func KelurahanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Add the statistics of each kelurahan
+	if statistik {
+		result, err := getWilayahStatistik(db, periode)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get kelurahan statistics", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		addWilayahStatistik(geoJSONCollection, result.Kelurahan, periode)
+	}
+
 	message := "Kelurahan GeoJSON retrieved successfully"
 	if idParam != "" {
 		message = "Kelurahan GeoJSON by ID retrieved successfully"
@@ -679,6 +453,15 @@ func KelurahanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 //
 // @Summary Get balita points GeoJSON
 // @Description Get balita locations as GeoJSON points with status laporan (Admin only)
+// @Description
+// @Description nomor_kk is masked unless lengkap=true, which is logged as a privileged read.
+// @Description
+// @Description bbox limits the balita to the visible part of the map. Below zoom 16 the balita are
+// @Description grouped into clusters of about 60 pixels instead: cluster features (type cluster)
+// @Description with jumlah, the number of balita per status_gizi and per status_laporan, the color
+// @Description of the most severe status gizi and the expansion_zoom at which the cluster splits.
+// @Description Clusters carry no names and are not recorded in the access log. Without zoom the
+// @Description balita are clustered as at zoom 0, individual points need a zoom of 16 or more.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -686,69 +469,74 @@ func KelurahanGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 // @Param status_laporan query string false "Filter by status laporan"
 // @Param id_kecamatan query string false "Filter by kecamatan"
 // @Param id_kelurahan query string false "Filter by kelurahan"
+// @Param bbox query string false "Visible area as minLon,minLat,maxLon,maxLat"
+// @Param zoom query int false "Zoom level of the map (0-20), clusters below 16 and when missing"
+// @Param lengkap query bool false "Return the unmasked nomor KK"
 // @Success 200 {object} object.Response{data=object.GeoJSONFeatureCollection} "Balita points GeoJSON retrieved successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/geojson-balita-points [get]
-func BalitaPointsGeoJSONGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
+func (s *Service) BalitaPointsGeoJSONGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
+	// Connect to database
+	db := s.db
+
+	// Check for filters
+	statusLaporanParam := r.URL.Query().Get("status_laporan")
+	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
+	idKelurahanParam := r.URL.Query().Get("id_kelurahan")
+
+	view, err := parseBalitaPointsView(r.URL.Query())
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	_, role, err := object.ParseJWT(token)
+	// Get balita points GeoJSON
+	geoJSONCollection, err := getBalitaPointsGeoJSON(db, s.store.Keys, masker, statusLaporanParam, idKecamatanParam, idKelurahanParam, view.BBox)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to get balita points GeoJSON", nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
+	// Zoomed out, return clusters without personal data instead of points
+	if view.clustered() {
+		response := object.NewResponse(http.StatusOK, "Balita clusters GeoJSON retrieved successfully", clusterBalitaPoints(geoJSONCollection, view.Zoom))
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	// Record the read in the access log
+	ids := make([]string, 0, len(geoJSONCollection.Features))
+	for _, feature := range geoJSONCollection.Features {
+		if id, ok := feature.Properties["id"].(string); ok {
+			ids = append(ids, id)
 		}
-		return
 	}
-	defer db.Close()
-
-	// Check for filters
-	statusLaporanParam := r.URL.Query().Get("status_laporan")
-	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
-	idKelurahanParam := r.URL.Query().Get("id_kelurahan")
-
-	// Get balita points GeoJSON
-	geoJSONCollection, err := getBalitaPointsGeoJSON(db, statusLaporanParam, idKecamatanParam, idKelurahanParam)
+	access := audit.NewAccess(r, "balita", ids)
+	access.Privileged = masker.Unmasked()
+	err = audit.RecordAccess(db, access)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get balita points GeoJSON", nil)
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -766,28 +554,15 @@ func BalitaPointsGeoJSONGet(w http.ResponseWriter, r *http.Request) {
 // ==================== HELPER FUNCTIONS ====================
 
 // Helper function to get all status laporan
-func getAllStatusLaporan(db *sql.DB) ([]statusLaporanResponse, int, error) {
-	var statusList []statusLaporanResponse
-
-	query := "SELECT id, status FROM status_laporan ORDER BY id ASC"
-
-	rows, err := db.Query(query)
+func getAllStatusLaporan(repo store.StatusLaporanRepository) ([]statusLaporanResponse, int, error) {
+	rows, err := repo.List()
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var status statusLaporanResponse
-		err := rows.Scan(&status.Id, &status.Status)
-		if err != nil {
-			return nil, 0, err
-		}
-		statusList = append(statusList, status)
-	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	statusList := []statusLaporanResponse{}
+	for _, row := range rows {
+		statusList = append(statusList, statusLaporanResponse{Id: row.Id, Status: row.Status})
 	}
 
 	return statusList, len(statusList), nil
@@ -827,74 +602,35 @@ func getAllMasyarakat(db *sql.DB) ([]masyarakatResponse, int, error) {
 }
 
 // Helper function to get all kecamatan
-func getAllKecamatan(db *sql.DB) ([]kecamatanResponse, int, error) {
-	var kecamatanList []kecamatanResponse
-
-	query := "SELECT id, kecamatan FROM kecamatan ORDER BY kecamatan ASC"
-
-	rows, err := db.Query(query)
+func getAllKecamatan(repo store.WilayahRepository) ([]kecamatanResponse, int, error) {
+	rows, err := repo.ListKecamatan()
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var kecamatan kecamatanResponse
-		err := rows.Scan(&kecamatan.Id, &kecamatan.Kecamatan)
-		if err != nil {
-			return nil, 0, err
-		}
-		kecamatanList = append(kecamatanList, kecamatan)
-	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	kecamatanList := []kecamatanResponse{}
+	for _, row := range rows {
+		kecamatanList = append(kecamatanList, kecamatanResponse{Id: row.Id, Kecamatan: row.Kecamatan})
 	}
 
 	return kecamatanList, len(kecamatanList), nil
 }
 
 // Helper function to get all kelurahan with optional kecamatan filter
-func getAllKelurahan(db *sql.DB, idKecamatan string) ([]kelurahanResponse, int, error) {
-	var kelurahanList []kelurahanResponse
-	var query string
-	var args []any
-
-	if idKecamatan != "" {
-		query = `
-            SELECT kel.id, kel.id_kecamatan, kel.kelurahan, kec.kecamatan
-            FROM kelurahan kel
-            LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-            WHERE kel.id_kecamatan = ?
-            ORDER BY kel.kelurahan ASC
-        `
-		args = append(args, idKecamatan)
-	} else {
-		query = `
-            SELECT kel.id, kel.id_kecamatan, kel.kelurahan, kec.kecamatan
-            FROM kelurahan kel
-            LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-            ORDER BY kec.kecamatan ASC, kel.kelurahan ASC
-        `
-	}
-
-	rows, err := db.Query(query, args...)
+func getAllKelurahan(repo store.WilayahRepository, idKecamatan string) ([]kelurahanResponse, int, error) {
+	rows, err := repo.ListKelurahan(idKecamatan)
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var kelurahan kelurahanResponse
-		err := rows.Scan(&kelurahan.Id, &kelurahan.IdKecamatan, &kelurahan.Kelurahan, &kelurahan.Kecamatan)
-		if err != nil {
-			return nil, 0, err
-		}
-		kelurahanList = append(kelurahanList, kelurahan)
-	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	kelurahanList := []kelurahanResponse{}
+	for _, row := range rows {
+		kelurahanList = append(kelurahanList, kelurahanResponse{
+			Id:          row.Id,
+			IdKecamatan: row.IdKecamatan,
+			Kelurahan:   row.Kelurahan,
+			Kecamatan:   row.Kecamatan,
+		})
 	}
 
 	return kelurahanList, len(kelurahanList), nil
@@ -953,14 +689,14 @@ func getKecamatanGeoJSON(db *sql.DB, idKecamatan string) (object.GeoJSONFeatureC
 
 	if idKecamatan != "" {
 		query = `
-            SELECT id, kecamatan, ST_AsText(area) as area_wkt
+            SELECT id, kecamatan, area
             FROM kecamatan
             WHERE id = ? AND area IS NOT NULL
         `
 		args = append(args, idKecamatan)
 	} else {
 		query = `
-            SELECT id, kecamatan, ST_AsText(area) as area_wkt
+            SELECT id, kecamatan, area
             FROM kecamatan
             WHERE area IS NOT NULL
             ORDER BY kecamatan ASC
@@ -974,8 +710,9 @@ func getKecamatanGeoJSON(db *sql.DB, idKecamatan string) (object.GeoJSONFeatureC
 	defer rows.Close()
 
 	for rows.Next() {
-		var id, kecamatan, areaWKT string
-		err := rows.Scan(&id, &kecamatan, &areaWKT)
+		var id, kecamatan string
+		var area geom.NullGeometry
+		err := rows.Scan(&id, &kecamatan, &area)
 		if err != nil {
 			return object.GeoJSONFeatureCollection{}, err
 		}
@@ -988,10 +725,7 @@ func getKecamatanGeoJSON(db *sql.DB, idKecamatan string) (object.GeoJSONFeatureC
 		}
 
 		// Create GeoJSON feature
-		feature, err := object.CreateGeoJSONFeature(areaWKT, properties)
-		if err != nil {
-			continue // Skip if unable to parse geometry
-		}
+		feature := object.NewGeoJSONFeature(area.Geometry, properties)
 
 		features = append(features, feature)
 	}
@@ -1011,7 +745,7 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 
 	if idKelurahan != "" {
 		query = `
-            SELECT kel.id, kel.kelurahan, kec.kecamatan, ST_AsText(kel.area) as area_wkt
+            SELECT kel.id, kel.kelurahan, kec.kecamatan, kel.area
             FROM kelurahan kel
             LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
             WHERE kel.id = ? AND kel.area IS NOT NULL
@@ -1019,7 +753,7 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 		args = append(args, idKelurahan)
 	} else if idKecamatan != "" {
 		query = `
-            SELECT kel.id, kel.kelurahan, kec.kecamatan, ST_AsText(kel.area) as area_wkt
+            SELECT kel.id, kel.kelurahan, kec.kecamatan, kel.area
             FROM kelurahan kel
             LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
             WHERE kel.id_kecamatan = ? AND kel.area IS NOT NULL
@@ -1028,7 +762,7 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 		args = append(args, idKecamatan)
 	} else {
 		query = `
-            SELECT kel.id, kel.kelurahan, kec.kecamatan, ST_AsText(kel.area) as area_wkt
+            SELECT kel.id, kel.kelurahan, kec.kecamatan, kel.area
             FROM kelurahan kel
             LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
             WHERE kel.area IS NOT NULL
@@ -1043,8 +777,9 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 	defer rows.Close()
 
 	for rows.Next() {
-		var id, kelurahan, kecamatan, areaWKT string
-		err := rows.Scan(&id, &kelurahan, &kecamatan, &areaWKT)
+		var id, kelurahan, kecamatan string
+		var area geom.NullGeometry
+		err := rows.Scan(&id, &kelurahan, &kecamatan, &area)
 		if err != nil {
 			return object.GeoJSONFeatureCollection{}, err
 		}
@@ -1058,10 +793,7 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 		}
 
 		// Create GeoJSON feature
-		feature, err := object.CreateGeoJSONFeature(areaWKT, properties)
-		if err != nil {
-			continue // Skip if unable to parse geometry
-		}
+		feature := object.NewGeoJSONFeature(area.Geometry, properties)
 
 		features = append(features, feature)
 	}
@@ -1074,7 +806,7 @@ func getKelurahanGeoJSON(db *sql.DB, idKelurahan, idKecamatan string) (object.Ge
 }
 
 // Helper function to get balita points GeoJSON with status laporan
-func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan string) (object.GeoJSONFeatureCollection, error) {
+func getBalitaPointsGeoJSON(db *sql.DB, keys *fieldcrypt.Keyring, masker *pii.Masker, statusLaporan, idKecamatan, idKelurahan string, bbox *cluster.BBox) (object.GeoJSONFeatureCollection, error) {
 	var features []object.GeoJSONFeature
 	var query string
 	var args []any
@@ -1083,9 +815,9 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
         SELECT DISTINCT
             b.id, b.nama, b.jenis_kelamin,
             TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
+            k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu,
             kel.kelurahan, kec.kecamatan,
-            ST_AsText(k.koordinat) as koordinat_wkt,
+            k.koordinat,
             COALESCE(sl.status, 'Tidak ada laporan') as status_laporan,
             COALESCE(lm.tanggal_laporan, '') as tanggal_laporan,
             CASE 
@@ -1131,6 +863,11 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
 		args = append(args, idKelurahan)
 	}
 
+	if bbox != nil {
+		conditions = append(conditions, "ST_X(k.koordinat) BETWEEN ? AND ? AND ST_Y(k.koordinat) BETWEEN ? AND ?")
+		args = append(args, bbox.MinLon, bbox.MaxLon, bbox.MinLat, bbox.MaxLat)
+	}
+
 	if len(conditions) > 0 {
 		query += " AND " + conditions[0]
 		for i := 1; i < len(conditions); i++ {
@@ -1148,15 +885,16 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
 
 	for rows.Next() {
 		var id, nama, jenisKelamin, umurBulan string
-		var nomorKk, namaAyah, namaIbu, kelurahan, kecamatan string
-		var koordinatWKT, statusLaporanDB, tanggalLaporan, jenisLaporan string
+		var idKeluarga, nomorKk, namaAyah, namaIbu, kelurahan, kecamatan string
+		var koordinat geom.NullGeometry
+		var statusLaporanDB, tanggalLaporan, jenisLaporan string
 		var statusGiziTerakhir, tanggalPemeriksaanTerakhir string
 
 		err := rows.Scan(
 			&id, &nama, &jenisKelamin, &umurBulan,
-			&nomorKk, &namaAyah, &namaIbu,
+			&idKeluarga, &nomorKk, &namaAyah, &namaIbu,
 			&kelurahan, &kecamatan,
-			&koordinatWKT,
+			&koordinat,
 			&statusLaporanDB, &tanggalLaporan, &jenisLaporan,
 			&statusGiziTerakhir, &tanggalPemeriksaanTerakhir,
 		)
@@ -1164,6 +902,12 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
 			return object.GeoJSONFeatureCollection{}, err
 		}
 
+		// Decrypt the nomor KK
+		nomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, idKeluarga, nomorKk)
+		if err != nil {
+			return object.GeoJSONFeatureCollection{}, err
+		}
+
 		// Format umur
 		umurFormatted := formatUmurBalita(umurBulan)
 
@@ -1176,7 +920,7 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
 			"nama":                         nama,
 			"jenis_kelamin":                jenisKelamin,
 			"umur":                         umurFormatted,
-			"nomor_kk":                     nomorKk,
+			"nomor_kk":                     masker.Mask(pii.NomorKK, nomorKk),
 			"nama_ayah":                    namaAyah,
 			"nama_ibu":                     namaIbu,
 			"kelurahan":                    kelurahan,
@@ -1191,10 +935,7 @@ func getBalitaPointsGeoJSON(db *sql.DB, statusLaporan, idKecamatan, idKelurahan
 		}
 
 		// Create GeoJSON feature
-		feature, err := object.CreateGeoJSONFeature(koordinatWKT, properties)
-		if err != nil {
-			continue // Skip if unable to parse geometry
-		}
+		feature := object.NewGeoJSONFeature(koordinat.Geometry, properties)
 
 		features = append(features, feature)
 	}
@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type revokeSessionsRequest struct {
+	IdPengguna string `json:"id_pengguna"`
+}
+
+func (r *revokeSessionsRequest) validate() error {
+	if r.IdPengguna == "" {
+		return fmt.Errorf("pengguna ID is required")
+	}
+	return nil
+}
+
+type revokeSessionsResponse struct {
+	IdPengguna string `json:"id_pengguna"`
+	Revoked    int64  `json:"revoked"` // number of sessions revoked
+}
+
+// # PenggunaRevokeSessions revokes every session of a pengguna
+//
+// @Summary Revoke all sessions of a pengguna
+// @Description Revoke every active session of a pengguna (Admin only). The access tokens and
+// @Description refresh tokens of those sessions stop working immediately, so the pengguna has
+// @Description to log in again. Use it for leaked credentials or staff leaving the service.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param pengguna body revokeSessionsRequest true "Pengguna ID"
+// @Success 200 {object} object.Response{data=revokeSessionsResponse} "Sessions revoked successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Pengguna not found"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/admin/pengguna/revoke-sessions [post]
+func (s *Service) PenggunaRevokeSessions(w http.ResponseWriter, r *http.Request) {
+	// Parse request body
+	var req revokeSessionsRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	db := s.db
+
+	var revoked int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if pengguna exists
+		var email string
+		err = tx.QueryRow("SELECT email FROM pengguna WHERE id = ?", req.IdPengguna).Scan(&email)
+		if err == sql.ErrNoRows {
+			return object.NewHTTPError(http.StatusNotFound, "Pengguna not found")
+		}
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Database query error")
+		}
+
+		// Revoke every active session together with the audit log entry
+		revoked, err = s.store.Sesi.RevokeAllForPenggunaTx(tx, req.IdPengguna)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to revoke sessions")
+		}
+
+		entry := audit.NewEntry(r, audit.ActionRevoke, "pengguna", req.IdPengguna)
+		entry.Detail = fmt.Sprintf("Revoked %d sessions of %s", revoked, email)
+		err = audit.Record(tx, entry)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Sessions revoked successfully", revokeSessionsResponse{
+		IdPengguna: req.IdPengguna,
+		Revoked:    revoked,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
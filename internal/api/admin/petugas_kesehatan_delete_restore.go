@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -49,47 +51,12 @@ type deletePetugasKesehatanResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Petugas kesehatan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/petugas-kesehatan/delete [delete]
-func PetugasKesehatanDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) PetugasKesehatanDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deletePetugasKesehatanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -109,167 +76,118 @@ func PetugasKesehatanDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if petugas kesehatan exists and not already soft deleted
-	var exists int
-	var deletedDate sql.NullString
-	var idPengguna, nama, skpdName, jenisSkpd string
-	checkQuery := `SELECT COUNT(*), pk.deleted_date, pk.id_pengguna, pk.nama, s.skpd, s.jenis
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if petugas kesehatan exists and not already soft deleted
+		var exists int
+		var deletedDate sql.NullString
+		var idPengguna, nama, skpdName, jenisSkpd string
+		checkQuery := `SELECT COUNT(*), pk.deleted_date, pk.id_pengguna, pk.nama, s.skpd, s.jenis
         FROM petugas_kesehatan pk
         LEFT JOIN skpd s ON pk.id_skpd = s.id
         WHERE pk.id = ? 
         GROUP BY pk.deleted_date, pk.id_pengguna, pk.nama, s.skpd, s.jenis`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &idPengguna, &nama, &skpdName, &jenisSkpd)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &idPengguna, &nama, &skpdName, &jenisSkpd)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check petugas kesehatan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check petugas kesehatan existence")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "Petugas kesehatan already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "Petugas kesehatan already deleted")
 		}
-		return
-	}
 
-	// Check if petugas kesehatan has related intervensi records (prevent deletion if has interventions)
-	var intervensiCount int
-	checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_petugas_kesehatan = ?"
-	err = db.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if petugas kesehatan has related intervensi records (prevent deletion if has interventions)
+		var intervensiCount int
+		checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_petugas_kesehatan = ?"
+		err = tx.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related intervensi")
 		}
-		return
-	}
 
-	if intervensiCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete petugas kesehatan '%s'. There are %d intervensi records related to this petugas", nama, intervensiCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if intervensiCount > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete petugas kesehatan '%s'. There are %d intervensi records related to this petugas", nama, intervensiCount))
 		}
-		return
-	}
 
-	// Check if petugas kesehatan has related riwayat pemeriksaan records (prevent deletion if has medical records)
-	var riwayatCount int
-	checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp 
+		// Check if petugas kesehatan has related riwayat pemeriksaan records (prevent deletion if has medical records)
+		var riwayatCount int
+		checkRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp 
         JOIN intervensi i ON rp.id_intervensi = i.id 
         JOIN intervensi_petugas ip ON i.id = ip.id_intervensi 
         WHERE ip.id_petugas_kesehatan = ? AND rp.deleted_date IS NULL`
-	err = db.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkRiwayatQuery, req.Id).Scan(&riwayatCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related riwayat pemeriksaan")
 		}
-		return
-	}
 
-	if riwayatCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete petugas kesehatan '%s'. There are %d riwayat pemeriksaan records related to this petugas", nama, riwayatCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if riwayatCount > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete petugas kesehatan '%s'. There are %d riwayat pemeriksaan records related to this petugas", nama, riwayatCount))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Begin transaction (to handle both petugas_kesehatan and pengguna tables)
-	tx, err := db.Begin()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to begin transaction", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "petugas_kesehatan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read petugas kesehatan for audit log")
 		}
-		return
-	}
-	defer tx.Rollback()
 
-	// Perform soft delete on petugas_kesehatan table
-	deletePetugasQuery := `UPDATE petugas_kesehatan SET 
+		// Perform soft delete on petugas_kesehatan table
+		deletePetugasQuery := `UPDATE petugas_kesehatan SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := tx.Exec(deletePetugasQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deletePetugasQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete petugas kesehatan")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found or already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found or already deleted")
 		}
-		return
-	}
 
-	// Optional: You might want to deactivate the related pengguna account
-	// This depends on your business requirements
-	// For now, we'll keep the pengguna account active but add a comment field if needed
+		// Optional: You might want to deactivate the related pengguna account
+		// This depends on your business requirements
+		// For now, we'll keep the pengguna account active but add a comment field if needed
+
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Data petugas kesehatan '%s' dari %s '%s' berhasil dihapus", nama, jenisSkpd, skpdName)
 
-	// Commit transaction
-	err = tx.Commit()
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "petugas_kesehatan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to commit transaction", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Data petugas kesehatan '%s' dari %s '%s' berhasil dihapus", nama, jenisSkpd, skpdName)
-
 	response := object.NewResponse(http.StatusOK, "Petugas kesehatan deleted successfully", deletePetugasKesehatanResponse{
 		Id:      req.Id,
 		Message: message,
@@ -295,47 +213,12 @@ func PetugasKesehatanDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Petugas kesehatan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/petugas-kesehatan/restore [post]
-func PetugasKesehatanRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) PetugasKesehatanRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deletePetugasKesehatanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -355,154 +238,122 @@ func PetugasKesehatanRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if petugas kesehatan exists and is soft deleted
-	var exists int
-	var nama, email, idSkpd, skpdName, jenisSkpd string
-	checkQuery := `SELECT COUNT(*), pk.nama, p.email, pk.id_skpd, s.skpd, s.jenis
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if petugas kesehatan exists and is soft deleted
+		var exists int
+		var nama, email, idSkpd, skpdName, jenisSkpd string
+		checkQuery := `SELECT COUNT(*), pk.nama, p.email, pk.id_skpd, s.skpd, s.jenis
         FROM petugas_kesehatan pk
         LEFT JOIN pengguna p ON pk.id_pengguna = p.id
         LEFT JOIN skpd s ON pk.id_skpd = s.id
         WHERE pk.id = ? AND pk.deleted_date IS NOT NULL
         GROUP BY pk.nama, p.email, pk.id_skpd, s.skpd, s.jenis`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &nama, &email, &idSkpd, &skpdName, &jenisSkpd)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found or not deleted", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &nama, &email, &idSkpd, &skpdName, &jenisSkpd)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found or not deleted")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check petugas kesehatan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check petugas kesehatan existence")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found or not deleted")
 		}
-		return
-	}
 
-	// Check if related SKPD still exists and is not soft deleted
-	var skpdExists int
-	checkSkpdQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkSkpdQuery, idSkpd).Scan(&skpdExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related SKPD", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related SKPD still exists and is not soft deleted
+		var skpdExists int
+		checkSkpdQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkSkpdQuery, idSkpd).Scan(&skpdExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related SKPD")
 		}
-		return
-	}
 
-	if skpdExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore petugas kesehatan. Related SKPD does not exist or is deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if skpdExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore petugas kesehatan. Related SKPD does not exist or is deleted")
 		}
-		return
-	}
 
-	// Check for naming conflicts before restore (same name in same SKPD, not soft deleted)
-	var nameConflictExists int
-	nameConflictQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(nameConflictQuery, nama, idSkpd, req.Id).Scan(&nameConflictExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check naming conflicts", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check for naming conflicts before restore (same name in same SKPD, not soft deleted)
+		var nameConflictExists int
+		nameConflictQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(nameConflictQuery, nama, idSkpd, req.Id).Scan(&nameConflictExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check naming conflicts")
 		}
-		return
-	}
 
-	if nameConflictExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot restore petugas kesehatan. Another petugas with name '%s' already exists in %s '%s'", nama, jenisSkpd, skpdName), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if nameConflictExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot restore petugas kesehatan. Another petugas with name '%s' already exists in %s '%s'", nama, jenisSkpd, skpdName))
 		}
-		return
-	}
 
-	// Check for email conflicts before restore
-	var emailConflictExists int
-	emailConflictQuery := `SELECT COUNT(*) FROM pengguna p1 
+		// Check for email conflicts before restore
+		var emailConflictExists int
+		emailConflictQuery := `SELECT COUNT(*) FROM pengguna p1 
         JOIN petugas_kesehatan pk1 ON p1.id = pk1.id_pengguna 
         WHERE p1.email = ? AND pk1.id != ? AND pk1.deleted_date IS NULL`
-	err = db.QueryRow(emailConflictQuery, email, req.Id).Scan(&emailConflictExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check email conflicts", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(emailConflictQuery, email, req.Id).Scan(&emailConflictExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check email conflicts")
 		}
-		return
-	}
 
-	if emailConflictExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot restore petugas kesehatan. Another active petugas with email '%s' already exists", email), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if emailConflictExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot restore petugas kesehatan. Another active petugas with email '%s' already exists", email))
 		}
-		return
-	}
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "petugas_kesehatan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read petugas kesehatan for audit log")
+		}
 
-	// Restore petugas kesehatan (clear soft delete fields)
-	restoreQuery := `UPDATE petugas_kesehatan SET 
+		// Restore petugas kesehatan (clear soft delete fields)
+		restoreQuery := `UPDATE petugas_kesehatan SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore petugas kesehatan")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found or not deleted", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found or not deleted")
+		}
+
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Data petugas kesehatan '%s' dari %s '%s' berhasil dipulihkan", nama, jenisSkpd, skpdName)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "petugas_kesehatan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Data petugas kesehatan '%s' dari %s '%s' berhasil dipulihkan", nama, jenisSkpd, skpdName)
-
 	response := object.NewResponse(http.StatusOK, "Petugas kesehatan restored successfully", deletePetugasKesehatanResponse{
 		Id:      req.Id,
 		Message: message,
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -88,47 +90,12 @@ type insertPetugasKesehatanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/petugas-kesehatan/insert [post]
-func PetugasKesehatanInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) PetugasKesehatanInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertPetugasKesehatanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -148,170 +115,116 @@ func PetugasKesehatanInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if email already exists in pengguna table
-	var emailExists int
-	checkEmailQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ?"
-	err = db.QueryRow(checkEmailQuery, req.Email).Scan(&emailExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check email existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	if emailExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Email already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if SKPD exists and not soft deleted
-	var skpdExists int
-	var skpdName, jenisSkpd string
-	checkSkpdQuery := "SELECT COUNT(*), skpd, jenis FROM skpd WHERE id = ? AND deleted_date IS NULL GROUP BY skpd, jenis"
-	err = db.QueryRow(checkSkpdQuery, req.IdSkpd).Scan(&skpdExists, &skpdName, &jenisSkpd)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "SKPD not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	var petugasId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if email already exists in pengguna table
+		var emailExists int
+		checkEmailQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ? FOR UPDATE"
+		err = tx.QueryRow(checkEmailQuery, req.Email).Scan(&emailExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check email existence")
+		}
+		if emailExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Email already exists")
+		}
+
+		// Check if SKPD exists and not soft deleted
+		var skpdExists int
+		var skpdName, jenisSkpd string
+		checkSkpdQuery := "SELECT COUNT(*), skpd, jenis FROM skpd WHERE id = ? AND deleted_date IS NULL GROUP BY skpd, jenis"
+		err = tx.QueryRow(checkSkpdQuery, req.IdSkpd).Scan(&skpdExists, &skpdName, &jenisSkpd)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "SKPD not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if skpdExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "SKPD not found")
 		}
-		return
-	}
-	if skpdExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "SKPD not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if nama already exists in the same SKPD (prevent duplicate names in same organization)
-	var nameExists int
-	checkNameQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNameQuery, req.Nama, req.IdSkpd).Scan(&nameExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check name existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if nama already exists in the same SKPD (prevent duplicate names in same organization)
+		var nameExists int
+		checkNameQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNameQuery, req.Nama, req.IdSkpd).Scan(&nameExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check name existence")
 		}
-		return
-	}
-	if nameExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Petugas kesehatan with name '%s' already exists in %s '%s'", req.Nama, jenisSkpd, skpdName), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if nameExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Petugas kesehatan with name '%s' already exists in %s '%s'", req.Nama, jenisSkpd, skpdName))
 		}
-		return
-	}
 
-	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to hash password", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Hash password
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to hash password")
 		}
-		return
-	}
-
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Begin transaction
-	tx, err := db.Begin()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to begin transaction", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer tx.Rollback()
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Insert into pengguna table
-	insertPenggunaQuery := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
-	resultPengguna, err := tx.Exec(insertPenggunaQuery, req.Email, hashedPassword, "petugas kesehatan")
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert pengguna", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Insert into pengguna table
+		insertPenggunaQuery := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
+		resultPengguna, err := tx.Exec(insertPenggunaQuery, req.Email, hashedPassword, "petugas kesehatan")
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert pengguna")
 		}
-		return
-	}
 
-	// Get the inserted pengguna ID
-	penggunaId, err := resultPengguna.LastInsertId()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve pengguna ID", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get the inserted pengguna ID
+		penggunaId, err := resultPengguna.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve pengguna ID")
 		}
-		return
-	}
 
-	// Insert into petugas_kesehatan table
-	insertPetugasQuery := `INSERT INTO petugas_kesehatan 
+		// Insert into petugas_kesehatan table
+		insertPetugasQuery := `INSERT INTO petugas_kesehatan 
         (id_pengguna, id_skpd, nama, created_id, created_date) 
         VALUES (?, ?, ?, ?, ?)`
 
-	resultPetugas, err := tx.Exec(insertPetugasQuery,
-		penggunaId,
-		req.IdSkpd,
-		req.Nama,
-		userId,
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		resultPetugas, err := tx.Exec(insertPetugasQuery,
+			penggunaId,
+			req.IdSkpd,
+			req.Nama,
+			principal.UserId,
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert petugas kesehatan")
 		}
-		return
-	}
 
-	// Get the inserted petugas kesehatan ID
-	petugasId, err := resultPetugas.LastInsertId()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve petugas kesehatan ID", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get the inserted petugas kesehatan ID
+		petugasId, err = resultPetugas.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve petugas kesehatan ID")
 		}
-		return
-	}
 
-	// Commit transaction
-	err = tx.Commit()
+		// Prepare success response with additional info
+		message = fmt.Sprintf("Petugas kesehatan '%s' successfully created for %s '%s'", req.Nama, jenisSkpd, skpdName)
+
+		// Record the changes in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "pengguna", strconv.FormatInt(penggunaId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "petugas_kesehatan", strconv.FormatInt(petugasId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to commit transaction", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare success response with additional info
-	message := fmt.Sprintf("Petugas kesehatan '%s' successfully created for %s '%s'", req.Nama, jenisSkpd, skpdName)
-
 	response := object.NewResponse(http.StatusOK, message, insertPetugasKesehatanResponse{
 		Id: strconv.FormatInt(petugasId, 10),
 	})
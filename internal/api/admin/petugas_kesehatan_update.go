@@ -8,6 +8,8 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -97,47 +99,12 @@ type updatePetugasKesehatanResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Petugas kesehatan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/petugas-kesehatan/update [put]
-func PetugasKesehatanUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) PetugasKesehatanUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updatePetugasKesehatanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -157,284 +124,215 @@ func PetugasKesehatanUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if petugas kesehatan exists and not soft deleted, also get current data
-	var exists int
-	var currentIdPengguna, currentIdSkpd, currentNama, currentPasswordHash string
-	checkExistQuery := `SELECT COUNT(*), pk.id_pengguna, pk.id_skpd, pk.nama, p.password_hash 
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if petugas kesehatan exists and not soft deleted, also get current data
+		var exists int
+		var currentIdPengguna, currentIdSkpd, currentNama, currentPasswordHash string
+		checkExistQuery := `SELECT COUNT(*), pk.id_pengguna, pk.id_skpd, pk.nama, p.password_hash 
         FROM petugas_kesehatan pk
         JOIN pengguna p ON pk.id_pengguna = p.id
         WHERE pk.id = ? AND pk.deleted_date IS NULL 
         GROUP BY pk.id_pengguna, pk.id_skpd, pk.nama, p.password_hash`
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentIdPengguna, &currentIdSkpd, &currentNama, &currentPasswordHash)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentIdPengguna, &currentIdSkpd, &currentNama, &currentPasswordHash)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check petugas kesehatan existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check petugas kesehatan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if email already exists (excluding current record)
-	var emailExists int
-	checkEmailQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ? AND id != ?"
-	err = db.QueryRow(checkEmailQuery, req.Email, currentIdPengguna).Scan(&emailExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check email existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if email already exists (excluding current record)
+		var emailExists int
+		checkEmailQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ? AND id != ?"
+		err = tx.QueryRow(checkEmailQuery, req.Email, currentIdPengguna).Scan(&emailExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check email existence")
 		}
-		return
-	}
-	if emailExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Email already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if emailExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Email already exists")
 		}
-		return
-	}
 
-	// Check if new SKPD exists and not soft deleted
-	var newSkpdExists int
-	var newSkpdName, newJenisSkpd string
-	checkNewSkpdQuery := "SELECT COUNT(*), skpd, jenis FROM skpd WHERE id = ? AND deleted_date IS NULL GROUP BY skpd, jenis"
-	err = db.QueryRow(checkNewSkpdQuery, req.IdSkpd).Scan(&newSkpdExists, &newSkpdName, &newJenisSkpd)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "SKPD not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if new SKPD exists and not soft deleted
+		var newSkpdExists int
+		var newSkpdName, newJenisSkpd string
+		checkNewSkpdQuery := "SELECT COUNT(*), skpd, jenis FROM skpd WHERE id = ? AND deleted_date IS NULL GROUP BY skpd, jenis"
+		err = tx.QueryRow(checkNewSkpdQuery, req.IdSkpd).Scan(&newSkpdExists, &newSkpdName, &newJenisSkpd)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "SKPD not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check new SKPD existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check new SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if newSkpdExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "SKPD not found")
 		}
-		return
-	}
-	if newSkpdExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "SKPD not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if nama already exists in the new SKPD (excluding current record)
-	var nameExists int
-	checkNameQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNameQuery, req.Nama, req.IdSkpd, req.Id).Scan(&nameExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check name existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if nama already exists in the new SKPD (excluding current record)
+		var nameExists int
+		checkNameQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE nama = ? AND id_skpd = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNameQuery, req.Nama, req.IdSkpd, req.Id).Scan(&nameExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check name existence")
 		}
-		return
-	}
-	if nameExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Petugas kesehatan with name '%s' already exists in %s '%s'", req.Nama, newJenisSkpd, newSkpdName), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if nameExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Petugas kesehatan with name '%s' already exists in %s '%s'", req.Nama, newJenisSkpd, newSkpdName))
 		}
-		return
-	}
 
-	// Check if petugas has related intervensi records when changing SKPD
-	var intervensiCount int
-	if currentIdSkpd != req.IdSkpd {
-		checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_petugas_kesehatan = ?"
-		err = db.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to check related intervensi", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if petugas has related intervensi records when changing SKPD
+		var intervensiCount int
+		if currentIdSkpd != req.IdSkpd {
+			checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi_petugas WHERE id_petugas_kesehatan = ?"
+			err = tx.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related intervensi")
 			}
-			return
-		}
 
-		if intervensiCount > 0 {
-			response := object.NewResponse(http.StatusBadRequest,
-				fmt.Sprintf("Cannot change SKPD. Petugas kesehatan has %d related intervensi records", intervensiCount), nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			if intervensiCount > 0 {
+				return object.NewHTTPError(http.StatusBadRequest,
+					fmt.Sprintf("Cannot change SKPD. Petugas kesehatan has %d related intervensi records", intervensiCount))
 			}
-			return
 		}
-	}
 
-	// Check if password is same as current password
-	var passwordChanged bool
-	err = bcrypt.CompareHashAndPassword([]byte(currentPasswordHash), []byte(req.Password))
-	if err != nil {
-		// Password is different, needs to be updated
-		passwordChanged = true
-	} else {
-		// Password is the same
-		passwordChanged = false
-	}
+		// Check if password is same as current password
+		var passwordChanged bool
+		err = bcrypt.CompareHashAndPassword([]byte(currentPasswordHash), []byte(req.Password))
+		if err != nil {
+			// Password is different, needs to be updated
+			passwordChanged = true
+		} else {
+			// Password is the same
+			passwordChanged = false
+		}
 
-	// Get current SKPD info for response message
-	var currentSkpdName, currentJenisSkpd string
-	getCurrentSkpdQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
-	err = db.QueryRow(getCurrentSkpdQuery, currentIdSkpd).Scan(&currentSkpdName, &currentJenisSkpd)
-	if err != nil {
-		// Not critical, continue with update
-		currentSkpdName = "Unknown"
-		currentJenisSkpd = "unknown"
-	}
+		// Get current SKPD info for response message
+		var currentSkpdName, currentJenisSkpd string
+		getCurrentSkpdQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
+		err = tx.QueryRow(getCurrentSkpdQuery, currentIdSkpd).Scan(&currentSkpdName, &currentJenisSkpd)
+		if err != nil {
+			// Not critical, continue with update
+			currentSkpdName = "Unknown"
+			currentJenisSkpd = "unknown"
+		}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Begin transaction
-	tx, err := db.Begin()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to begin transaction", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Keep the current rows for the audit log
+		beforePengguna, err := audit.Snapshot(tx, "pengguna", currentIdPengguna)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read pengguna for audit log")
+		}
+		before, err := audit.Snapshot(tx, "petugas_kesehatan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read petugas kesehatan for audit log")
 		}
-		return
-	}
-	defer tx.Rollback()
 
-	// Update pengguna table (email and password if changed)
-	var updatePenggunaQuery string
-	var updatePenggunaArgs []any
+		// Update pengguna table (email and password if changed)
+		var updatePenggunaQuery string
+		var updatePenggunaArgs []any
 
-	if passwordChanged {
-		// Hash new password
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to hash password", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		if passwordChanged {
+			// Hash new password
+			hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to hash password")
 			}
-			return
+			updatePenggunaQuery = "UPDATE pengguna SET email = ?, password_hash = ? WHERE id = ?"
+			updatePenggunaArgs = []any{req.Email, hashedPassword, currentIdPengguna}
+		} else {
+			// Only update email, keep current password
+			updatePenggunaQuery = "UPDATE pengguna SET email = ? WHERE id = ?"
+			updatePenggunaArgs = []any{req.Email, currentIdPengguna}
 		}
-		updatePenggunaQuery = "UPDATE pengguna SET email = ?, password_hash = ? WHERE id = ?"
-		updatePenggunaArgs = []any{req.Email, hashedPassword, currentIdPengguna}
-	} else {
-		// Only update email, keep current password
-		updatePenggunaQuery = "UPDATE pengguna SET email = ? WHERE id = ?"
-		updatePenggunaArgs = []any{req.Email, currentIdPengguna}
-	}
 
-	_, err = tx.Exec(updatePenggunaQuery, updatePenggunaArgs...)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update pengguna", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		_, err = tx.Exec(updatePenggunaQuery, updatePenggunaArgs...)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update pengguna")
 		}
-		return
-	}
 
-	// Update petugas_kesehatan table
-	updatePetugasQuery := `UPDATE petugas_kesehatan SET 
+		// Update petugas_kesehatan table
+		updatePetugasQuery := `UPDATE petugas_kesehatan SET 
         id_skpd = ?, nama = ?, updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := tx.Exec(updatePetugasQuery,
-		req.IdSkpd,
-		req.Nama,
-		userId,
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updatePetugasQuery,
+			req.IdSkpd,
+			req.Nama,
+			principal.UserId,
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update petugas kesehatan")
 		}
-		return
-	}
 
-	// Check if any rows were affected in petugas_kesehatan table
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected in petugas_kesehatan table
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
 		}
-		return
-	}
 
-	// Check if there were actual changes to petugas_kesehatan data
-	petugasDataChanged := (currentIdSkpd != req.IdSkpd) || (currentNama != req.Nama)
+		// Check if there were actual changes to petugas_kesehatan data
+		petugasDataChanged := (currentIdSkpd != req.IdSkpd) || (currentNama != req.Nama)
 
-	// If no changes to petugas_kesehatan table but password changed, it's still a valid update
-	if rowsAffected == 0 && !passwordChanged {
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found, already deleted or no changes made", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// If no changes to petugas_kesehatan table but password changed, it's still a valid update
+		if rowsAffected == 0 && !passwordChanged {
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found, already deleted or no changes made")
 		}
-		return
-	}
 
-	// If no changes to petugas_kesehatan but password changed, that's still a successful update
-	if rowsAffected == 0 && passwordChanged && !petugasDataChanged {
-		// This is fine - only password was updated
-	} else if rowsAffected == 0 {
-		// No changes at all
-		response := object.NewResponse(http.StatusNotFound, "Petugas kesehatan not found or already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// If no changes to petugas_kesehatan but password changed, that's still a successful update
+		if rowsAffected == 0 && passwordChanged && !petugasDataChanged {
+			// This is fine - only password was updated
+		} else if rowsAffected == 0 {
+			// No changes at all
+			return object.NewHTTPError(http.StatusNotFound, "Petugas kesehatan not found or already deleted")
 		}
-		return
-	}
 
-	// Commit transaction
-	err = tx.Commit()
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Data petugas kesehatan '%s' berhasil diperbarui", req.Nama)
+
+		// Add SKPD change information
+		if currentIdSkpd != req.IdSkpd {
+			message += fmt.Sprintf(" (SKPD changed from %s '%s' to %s '%s')",
+				currentJenisSkpd, currentSkpdName, newJenisSkpd, newSkpdName)
+		} else {
+			message += fmt.Sprintf(" (SKPD: %s '%s')", newJenisSkpd, newSkpdName)
+		}
+
+		// Add password change information
+		if passwordChanged {
+			message += " (Password updated)"
+		} else {
+			message += " (Password unchanged)"
+		}
+
+		// Record the changes in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "pengguna", currentIdPengguna), beforePengguna)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "petugas_kesehatan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to commit transaction", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Data petugas kesehatan '%s' berhasil diperbarui", req.Nama)
-
-	// Add SKPD change information
-	if currentIdSkpd != req.IdSkpd {
-		message += fmt.Sprintf(" (SKPD changed from %s '%s' to %s '%s')",
-			currentJenisSkpd, currentSkpdName, newJenisSkpd, newSkpdName)
-	} else {
-		message += fmt.Sprintf(" (SKPD: %s '%s')", newJenisSkpd, newSkpdName)
-	}
-
-	// Add password change information
-	if passwordChanged {
-		message += " (Password updated)"
-	} else {
-		message += " (Password unchanged)"
-	}
-
 	response := object.NewResponse(http.StatusOK, "Petugas kesehatan updated successfully", updatePetugasKesehatanResponse{
 		Id:      req.Id,
 		Message: message,
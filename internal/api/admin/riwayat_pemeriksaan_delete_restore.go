@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -49,47 +51,12 @@ type deleteRiwayatPemeriksaanResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Riwayat pemeriksaan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/riwayat-pemeriksaan/delete [delete]
-func RiwayatPemeriksaanDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) RiwayatPemeriksaanDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteRiwayatPemeriksaanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -109,137 +76,121 @@ func RiwayatPemeriksaanDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if riwayat pemeriksaan exists and not already soft deleted, also get current data
-	var exists int
-	var deletedDate sql.NullString
-	var tanggal, statusGizi, namaBalita, jenisIntervensi string
-	checkQuery := `SELECT COUNT(*), rp.deleted_date, rp.tanggal, rp.status_gizi, 
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if riwayat pemeriksaan exists and not already soft deleted, also get current data
+		var exists int
+		var deletedDate sql.NullString
+		var tanggal, statusGizi, namaBalita, jenisIntervensi string
+		checkQuery := `SELECT COUNT(*), rp.deleted_date, rp.tanggal, rp.status_gizi, 
         b.nama as nama_balita, i.jenis as jenis_intervensi
         FROM riwayat_pemeriksaan rp
         LEFT JOIN balita b ON rp.id_balita = b.id
         LEFT JOIN intervensi i ON rp.id_intervensi = i.id
         WHERE rp.id = ? 
         GROUP BY rp.deleted_date, rp.tanggal, rp.status_gizi, b.nama, i.jenis`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &tanggal, &statusGizi, &namaBalita, &jenisIntervensi)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate, &tanggal, &statusGizi, &namaBalita, &jenisIntervensi)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "Riwayat pemeriksaan already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "Riwayat pemeriksaan already deleted")
 		}
-		return
-	}
 
-	// Additional business rule checks can be added here if needed
-	// For example, preventing deletion of recent medical records or critical status records
+		// Additional business rule checks can be added here if needed
+		// For example, preventing deletion of recent medical records or critical status records
 
-	// Check if this is a critical medical record (e.g., stunting or gizi buruk status)
-	var isCritical bool
-	if statusGizi == "stunting" || statusGizi == "gizi buruk" {
-		isCritical = true
-	}
+		// Check if this is a critical medical record (e.g., stunting or gizi buruk status)
+		var isCritical bool
+		if statusGizi == "stunting" || statusGizi == "gizi buruk" {
+			isCritical = true
+		}
 
-	// Count total riwayat pemeriksaan for this balita (to prevent deletion of last record)
-	var totalRiwayatBalita int
-	countRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp
+		// Count total riwayat pemeriksaan for this balita (to prevent deletion of last record)
+		var totalRiwayatBalita int
+		countRiwayatQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan rp
         LEFT JOIN balita b ON rp.id_balita = b.id
         WHERE b.nama = ? AND rp.deleted_date IS NULL`
-	err = db.QueryRow(countRiwayatQuery, namaBalita).Scan(&totalRiwayatBalita)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita medical history count", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(countRiwayatQuery, namaBalita).Scan(&totalRiwayatBalita)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita medical history count")
 		}
-		return
-	}
 
-	// Warning if this is the only medical record for this balita
-	var warningMessage string
-	if totalRiwayatBalita == 1 {
-		warningMessage = " (Warning: This is the only medical record for this balita)"
-	}
+		// Warning if this is the only medical record for this balita
+		var warningMessage string
+		if totalRiwayatBalita == 1 {
+			warningMessage = " (Warning: This is the only medical record for this balita)"
+		}
+
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "riwayat_pemeriksaan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read riwayat pemeriksaan for audit log")
+		}
 
-	// Perform soft delete
-	deleteQuery := `UPDATE riwayat_pemeriksaan SET 
+		// Perform soft delete
+		deleteQuery := `UPDATE riwayat_pemeriksaan SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(deleteQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deleteQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found or already deleted", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found or already deleted")
+		}
+
+		// Prepare response message with detailed information
+		message = fmt.Sprintf("Riwayat pemeriksaan balita '%s' untuk intervensi %s pada tanggal %s berhasil dihapus",
+			namaBalita, jenisIntervensi, tanggal)
+
+		// Add critical status warning if applicable
+		if isCritical {
+			message += fmt.Sprintf(" (Note: This was a critical medical record with status '%s')", statusGizi)
+		}
+
+		// Add warning message if applicable
+		message += warningMessage
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "riwayat_pemeriksaan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with detailed information
-	message := fmt.Sprintf("Riwayat pemeriksaan balita '%s' untuk intervensi %s pada tanggal %s berhasil dihapus",
-		namaBalita, jenisIntervensi, tanggal)
-
-	// Add critical status warning if applicable
-	if isCritical {
-		message += fmt.Sprintf(" (Note: This was a critical medical record with status '%s')", statusGizi)
-	}
-
-	// Add warning message if applicable
-	message += warningMessage
-
 	response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan deleted successfully", deleteRiwayatPemeriksaanResponse{
 		Id:      req.Id,
 		Message: message,
@@ -265,47 +216,12 @@ func RiwayatPemeriksaanDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "Riwayat pemeriksaan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/riwayat-pemeriksaan/restore [post]
-func RiwayatPemeriksaanRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) RiwayatPemeriksaanRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteRiwayatPemeriksaanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -325,159 +241,127 @@ func RiwayatPemeriksaanRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if riwayat pemeriksaan exists and is soft deleted, also get current data
-	var exists int
-	var tanggal, statusGizi, namaBalita, jenisIntervensi string
-	var idBalita, idIntervensi string
-	checkQuery := `SELECT COUNT(*), rp.tanggal, rp.status_gizi, rp.id_balita, rp.id_intervensi,
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if riwayat pemeriksaan exists and is soft deleted, also get current data
+		var exists int
+		var tanggal, statusGizi, namaBalita, jenisIntervensi string
+		var idBalita, idIntervensi string
+		checkQuery := `SELECT COUNT(*), rp.tanggal, rp.status_gizi, rp.id_balita, rp.id_intervensi,
         b.nama as nama_balita, i.jenis as jenis_intervensi
         FROM riwayat_pemeriksaan rp
         LEFT JOIN balita b ON rp.id_balita = b.id
         LEFT JOIN intervensi i ON rp.id_intervensi = i.id
         WHERE rp.id = ? AND rp.deleted_date IS NOT NULL
         GROUP BY rp.tanggal, rp.status_gizi, rp.id_balita, rp.id_intervensi, b.nama, i.jenis`
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &tanggal, &statusGizi, &idBalita, &idIntervensi, &namaBalita, &jenisIntervensi)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &tanggal, &statusGizi, &idBalita, &idIntervensi, &namaBalita, &jenisIntervensi)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted")
 		}
-		return
-	}
 
-	// Check if related balita still exists and is not soft deleted
-	var balitaExists int
-	checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaQuery, idBalita).Scan(&balitaExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related balita still exists and is not soft deleted
+		var balitaExists int
+		checkBalitaQuery := "SELECT COUNT(*) FROM balita WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaQuery, idBalita).Scan(&balitaExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related balita")
 		}
-		return
-	}
 
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore riwayat pemeriksaan. Related balita does not exist or is deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore riwayat pemeriksaan. Related balita does not exist or is deleted")
 		}
-		return
-	}
 
-	// Check if related intervensi still exists and is not soft deleted
-	var intervensiExists int
-	checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkIntervensiQuery, idIntervensi).Scan(&intervensiExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related intervensi", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if related intervensi still exists and is not soft deleted
+		var intervensiExists int
+		checkIntervensiQuery := "SELECT COUNT(*) FROM intervensi WHERE id = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkIntervensiQuery, idIntervensi).Scan(&intervensiExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related intervensi")
 		}
-		return
-	}
 
-	if intervensiExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Cannot restore riwayat pemeriksaan. Related intervensi does not exist or is deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if intervensiExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Cannot restore riwayat pemeriksaan. Related intervensi does not exist or is deleted")
 		}
-		return
-	}
 
-	// Check for duplicates before restore (same balita, intervensi, and tanggal, not soft deleted)
-	var duplicateExists int
-	duplicateQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
+		// Check for duplicates before restore (same balita, intervensi, and tanggal, not soft deleted)
+		var duplicateExists int
+		duplicateQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
         WHERE id_balita = ? AND id_intervensi = ? AND tanggal = ? AND id != ? AND deleted_date IS NULL`
-	err = db.QueryRow(duplicateQuery, idBalita, idIntervensi, tanggal, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(duplicateQuery, idBalita, idIntervensi, tanggal, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate riwayat pemeriksaan")
 		}
-		return
-	}
 
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot restore riwayat pemeriksaan. Another active medical record for balita '%s' on date '%s' in intervensi '%s' already exists",
-				namaBalita, tanggal, jenisIntervensi), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot restore riwayat pemeriksaan. Another active medical record for balita '%s' on date '%s' in intervensi '%s' already exists",
+					namaBalita, tanggal, jenisIntervensi))
 		}
-		return
-	}
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Restore riwayat pemeriksaan (clear soft delete fields)
-	restoreQuery := `UPDATE riwayat_pemeriksaan SET 
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "riwayat_pemeriksaan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read riwayat pemeriksaan for audit log")
+		}
+
+		// Restore riwayat pemeriksaan (clear soft delete fields)
+		restoreQuery := `UPDATE riwayat_pemeriksaan SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found or not deleted")
+		}
+
+		// Prepare response message with detailed information
+		message = fmt.Sprintf("Riwayat pemeriksaan balita '%s' untuk intervensi %s pada tanggal %s berhasil dipulihkan",
+			namaBalita, jenisIntervensi, tanggal)
+
+		// Add status information
+		message += fmt.Sprintf(" (Status gizi: %s)", statusGizi)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "riwayat_pemeriksaan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with detailed information
-	message := fmt.Sprintf("Riwayat pemeriksaan balita '%s' untuk intervensi %s pada tanggal %s berhasil dipulihkan",
-		namaBalita, jenisIntervensi, tanggal)
-
-	// Add status information
-	message += fmt.Sprintf(" (Status gizi: %s)", statusGizi)
-
 	response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan restored successfully", deleteRiwayatPemeriksaanResponse{
 		Id:      req.Id,
 		Message: message,
@@ -5,36 +5,42 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type riwayatPemeriksaanResponse struct {
-	Id                  string `json:"id"`
-	IdBalita            string `json:"id_balita"`
-	NamaBalita          string `json:"nama_balita"`
-	UmurBalita          string `json:"umur_balita"`
-	JenisKelamin        string `json:"jenis_kelamin"`
-	NamaAyah            string `json:"nama_ayah"`
-	NamaIbu             string `json:"nama_ibu"`
-	NomorKk             string `json:"nomor_kk"`
-	IdIntervensi        string `json:"id_intervensi"`
-	JenisIntervensi     string `json:"jenis_intervensi"`
-	TanggalIntervensi   string `json:"tanggal_intervensi"`
-	IdLaporanMasyarakat string `json:"id_laporan_masyarakat"` // <- Field baru
-	StatusLaporan       string `json:"status_laporan"`        // <- Field baru
-	TanggalLaporan      string `json:"tanggal_laporan"`       // <- Field baru
-	JenisLaporan        string `json:"jenis_laporan"`         // <- Field baru (masyarakat/admin)
-	Tanggal             string `json:"tanggal"`
-	BeratBadan          string `json:"berat_badan"`
-	TinggiBadan         string `json:"tinggi_badan"`
-	StatusGizi          string `json:"status_gizi"`
-	Keterangan          string `json:"keterangan"`
-	Kelurahan           string `json:"kelurahan"`
-	Kecamatan           string `json:"kecamatan"`
-	CreatedDate         string `json:"created_date"`
-	UpdatedDate         string `json:"updated_date,omitempty"`
-	CreatedBy           string `json:"created_by,omitempty"`
-	UpdatedBy           string `json:"updated_by,omitempty"`
+	Id                  string   `json:"id"`
+	IdBalita            string   `json:"id_balita"`
+	NamaBalita          string   `json:"nama_balita"`
+	UmurBalita          string   `json:"umur_balita"`
+	JenisKelamin        string   `json:"jenis_kelamin"`
+	NamaAyah            string   `json:"nama_ayah"`
+	NamaIbu             string   `json:"nama_ibu"`
+	NomorKk             string   `json:"nomor_kk" pii:"nomor_kk"`
+	IdIntervensi        string   `json:"id_intervensi"`
+	JenisIntervensi     string   `json:"jenis_intervensi"`
+	TanggalIntervensi   string   `json:"tanggal_intervensi"`
+	IdLaporanMasyarakat string   `json:"id_laporan_masyarakat"` // <- Field baru
+	StatusLaporan       string   `json:"status_laporan"`        // <- Field baru
+	TanggalLaporan      string   `json:"tanggal_laporan"`       // <- Field baru
+	JenisLaporan        string   `json:"jenis_laporan"`         // <- Field baru (masyarakat/admin)
+	Tanggal             string   `json:"tanggal"`
+	BeratBadan          string   `json:"berat_badan"`
+	TinggiBadan         string   `json:"tinggi_badan"`
+	StatusGizi          string   `json:"status_gizi"`
+	ZScoreTBU           *float64 `json:"zscore_tb_u,omitempty"`
+	ZScoreBBU           *float64 `json:"zscore_bb_u,omitempty"`
+	ZScoreBBTB          *float64 `json:"zscore_bb_tb,omitempty"`
+	Keterangan          string   `json:"keterangan"`
+	Kelurahan           string   `json:"kelurahan"`
+	Kecamatan           string   `json:"kecamatan"`
+	CreatedDate         string   `json:"created_date"`
+	UpdatedDate         string   `json:"updated_date,omitempty"`
+	CreatedBy           string   `json:"created_by,omitempty"`
+	UpdatedBy           string   `json:"updated_by,omitempty"`
 }
 
 type getAllRiwayatPemeriksaanResponse struct {
@@ -59,6 +65,8 @@ type getRiwayatPemeriksaanByIdResponse struct {
 // @Description - With id_intervensi parameter: Returns all riwayat pemeriksaan for specific intervensi
 // @Description
 // @Description Riwayat pemeriksaan data includes: balita info, intervensi info, laporan info, examination details, location info
+// @Description
+// @Description nomor_kk is masked unless lengkap=true, which is logged as a privileged read of the balita.
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -67,6 +75,7 @@ type getRiwayatPemeriksaanByIdResponse struct {
 // @Param id_balita query string false "Balita ID"
 // @Param id_laporan_masyarakat query string false "Laporan Masyarakat ID"
 // @Param id_intervensi query string false "Intervensi ID"
+// @Param lengkap query bool false "Return the unmasked nomor KK"
 // @Success 200 {object} object.Response{data=getAllRiwayatPemeriksaanResponse} "Riwayat pemeriksaan data retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -74,38 +83,11 @@ type getRiwayatPemeriksaanByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Riwayat pemeriksaan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/riwayat-pemeriksaan/get [get]
-func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
+func (s *Service) RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -113,15 +95,7 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Check query parameters
 	idParam := r.URL.Query().Get("id")
@@ -131,9 +105,9 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 
 	if idParam != "" {
 		// Get specific riwayat pemeriksaan by ID
-		riwayat, err := getRiwayatPemeriksaanById(db, idParam)
+		riwayat, err := getRiwayatPemeriksaanById(s.store.RiwayatPemeriksaan, idParam)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -147,6 +121,17 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the unmasked read in the access log
+		err = recordRiwayatPemeriksaanAccess(db, r, masker, []riwayatPemeriksaanResponse{riwayat})
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&riwayat)
 		response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan retrieved successfully", getRiwayatPemeriksaanByIdResponse{
 			Data: riwayat,
 		})
@@ -155,7 +140,7 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else if idBalitaParam != "" {
 		// Get all riwayat pemeriksaan for specific balita
-		riwayatList, total, err := getRiwayatPemeriksaanByBalita(db, idBalitaParam)
+		riwayatList, total, err := getRiwayatPemeriksaanList(s.store.RiwayatPemeriksaan, store.RiwayatPemeriksaanFilter{IdBalita: idBalitaParam})
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get riwayat pemeriksaan by balita", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -164,6 +149,17 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the unmasked read in the access log
+		err = recordRiwayatPemeriksaanAccess(db, r, masker, riwayatList)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&riwayatList)
 		response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan by balita retrieved successfully", getAllRiwayatPemeriksaanResponse{
 			Data:  riwayatList,
 			Total: total,
@@ -173,7 +169,7 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else if idLaporanParam != "" {
 		// Get all riwayat pemeriksaan for specific laporan masyarakat
-		riwayatList, total, err := getRiwayatPemeriksaanByLaporan(db, idLaporanParam)
+		riwayatList, total, err := getRiwayatPemeriksaanList(s.store.RiwayatPemeriksaan, store.RiwayatPemeriksaanFilter{IdLaporanMasyarakat: idLaporanParam})
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get riwayat pemeriksaan by laporan", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -182,6 +178,17 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the unmasked read in the access log
+		err = recordRiwayatPemeriksaanAccess(db, r, masker, riwayatList)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&riwayatList)
 		response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan by laporan retrieved successfully", getAllRiwayatPemeriksaanResponse{
 			Data:  riwayatList,
 			Total: total,
@@ -191,7 +198,7 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else if idIntervensiParam != "" {
 		// Get all riwayat pemeriksaan for specific intervensi
-		riwayatList, total, err := getRiwayatPemeriksaanByIntervensi(db, idIntervensiParam)
+		riwayatList, total, err := getRiwayatPemeriksaanList(s.store.RiwayatPemeriksaan, store.RiwayatPemeriksaanFilter{IdIntervensi: idIntervensiParam})
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get riwayat pemeriksaan by intervensi", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -200,6 +207,17 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the unmasked read in the access log
+		err = recordRiwayatPemeriksaanAccess(db, r, masker, riwayatList)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&riwayatList)
 		response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan by intervensi retrieved successfully", getAllRiwayatPemeriksaanResponse{
 			Data:  riwayatList,
 			Total: total,
@@ -209,7 +227,7 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all riwayat pemeriksaan
-		riwayatList, total, err := getAllRiwayatPemeriksaan(db)
+		riwayatList, total, err := getRiwayatPemeriksaanList(s.store.RiwayatPemeriksaan, store.RiwayatPemeriksaanFilter{})
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get riwayat pemeriksaan list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -218,6 +236,17 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the unmasked read in the access log
+		err = recordRiwayatPemeriksaanAccess(db, r, masker, riwayatList)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&riwayatList)
 		response := object.NewResponse(http.StatusOK, "All riwayat pemeriksaan retrieved successfully", getAllRiwayatPemeriksaanResponse{
 			Data:  riwayatList,
 			Total: total,
@@ -228,209 +257,85 @@ func RiwayatPemeriksaanGet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Helper function to get riwayat pemeriksaan by ID
-func getRiwayatPemeriksaanById(db *sql.DB, id string) (riwayatPemeriksaanResponse, error) {
-	var riwayat riwayatPemeriksaanResponse
-	var updatedDate sql.NullString
-	var createdBy, updatedBy sql.NullString
-	var idMasyarakatLaporan sql.NullString
-
-	query := `
-        SELECT 
-            rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
-            rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
-            rp.created_date, rp.updated_date,
-            b.nama as nama_balita, b.jenis_kelamin,
-            TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan,
-            i.jenis as jenis_intervensi, i.tanggal as tanggal_intervensi,
-            lm.id_masyarakat, sl.status as status_laporan, lm.tanggal_laporan,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM riwayat_pemeriksaan rp
-        LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
-        LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN pengguna pc ON rp.created_id = pc.id
-        LEFT JOIN pengguna pu ON rp.updated_id = pu.id
-        WHERE rp.id = ? AND rp.deleted_date IS NULL
-    `
-
-	err := db.QueryRow(query, id).Scan(
-		&riwayat.Id,
-		&riwayat.IdBalita,
-		&riwayat.IdIntervensi,
-		&riwayat.IdLaporanMasyarakat,
-		&riwayat.Tanggal,
-		&riwayat.BeratBadan,
-		&riwayat.TinggiBadan,
-		&riwayat.StatusGizi,
-		&riwayat.Keterangan,
-		&riwayat.CreatedDate,
-		&updatedDate,
-		&riwayat.NamaBalita,
-		&riwayat.JenisKelamin,
-		&riwayat.UmurBalita,
-		&riwayat.NomorKk,
-		&riwayat.NamaAyah,
-		&riwayat.NamaIbu,
-		&riwayat.Kelurahan,
-		&riwayat.Kecamatan,
-		&riwayat.JenisIntervensi,
-		&riwayat.TanggalIntervensi,
-		&idMasyarakatLaporan,
-		&riwayat.StatusLaporan,
-		&riwayat.TanggalLaporan,
-		&createdBy,
-		&updatedBy,
-	)
-
-	if err != nil {
-		return riwayat, err
+// Helper function to record an unmasked read of riwayat pemeriksaan in the
+// access log, as a read of the balita whose nomor KK was returned
+func recordRiwayatPemeriksaanAccess(db *sql.DB, r *http.Request, masker *pii.Masker, riwayatList []riwayatPemeriksaanResponse) error {
+	if !masker.Unmasked() {
+		return nil
 	}
 
-	// Format umur balita
-	riwayat.UmurBalita = formatUmurBalita(riwayat.UmurBalita)
-
-	// Determine jenis laporan
-	if idMasyarakatLaporan.Valid {
-		riwayat.JenisLaporan = "masyarakat"
-	} else {
-		riwayat.JenisLaporan = "admin"
+	ids := make([]string, len(riwayatList))
+	for i, riwayat := range riwayatList {
+		ids[i] = riwayat.IdBalita
 	}
+	access := audit.NewAccess(r, "balita", ids)
+	access.Privileged = true
+	return audit.RecordAccess(db, access)
+}
 
-	// Handle nullable fields
-	if updatedDate.Valid {
-		riwayat.UpdatedDate = updatedDate.String
-	}
-	if createdBy.Valid {
-		riwayat.CreatedBy = createdBy.String
-	}
-	if updatedBy.Valid {
-		riwayat.UpdatedBy = updatedBy.String
+// Helper function to get riwayat pemeriksaan by ID
+func getRiwayatPemeriksaanById(repo store.RiwayatPemeriksaanRepository, id string) (riwayatPemeriksaanResponse, error) {
+	row, err := repo.Get(id, store.RiwayatPemeriksaanFilter{})
+	if err != nil {
+		return riwayatPemeriksaanResponse{}, err
 	}
 
-	return riwayat, nil
+	return newRiwayatPemeriksaanResponse(row), nil
 }
 
-// Helper function to get all riwayat pemeriksaan
-func getAllRiwayatPemeriksaan(db *sql.DB) ([]riwayatPemeriksaanResponse, int, error) {
-	var riwayatList []riwayatPemeriksaanResponse
-
-	query := `
-        SELECT 
-            rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
-            rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
-            rp.created_date, rp.updated_date,
-            b.nama as nama_balita, b.jenis_kelamin,
-            TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan,
-            i.jenis as jenis_intervensi, i.tanggal as tanggal_intervensi,
-            lm.id_masyarakat, sl.status as status_laporan, lm.tanggal_laporan,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM riwayat_pemeriksaan rp
-        LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
-        LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN pengguna pc ON rp.created_id = pc.id
-        LEFT JOIN pengguna pu ON rp.updated_id = pu.id
-        WHERE rp.deleted_date IS NULL
-        ORDER BY rp.tanggal DESC, rp.created_date DESC
-    `
-
-	rows, err := db.Query(query)
+// Helper function to get the riwayat pemeriksaan matching a filter
+func getRiwayatPemeriksaanList(repo store.RiwayatPemeriksaanRepository, filter store.RiwayatPemeriksaanFilter) ([]riwayatPemeriksaanResponse, int, error) {
+	rows, err := repo.List(filter)
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var riwayat riwayatPemeriksaanResponse
-		var updatedDate sql.NullString
-		var createdBy, updatedBy sql.NullString
-		var idMasyarakatLaporan sql.NullString
-
-		err := rows.Scan(
-			&riwayat.Id,
-			&riwayat.IdBalita,
-			&riwayat.IdIntervensi,
-			&riwayat.IdLaporanMasyarakat,
-			&riwayat.Tanggal,
-			&riwayat.BeratBadan,
-			&riwayat.TinggiBadan,
-			&riwayat.StatusGizi,
-			&riwayat.Keterangan,
-			&riwayat.CreatedDate,
-			&updatedDate,
-			&riwayat.NamaBalita,
-			&riwayat.JenisKelamin,
-			&riwayat.UmurBalita,
-			&riwayat.NomorKk,
-			&riwayat.NamaAyah,
-			&riwayat.NamaIbu,
-			&riwayat.Kelurahan,
-			&riwayat.Kecamatan,
-			&riwayat.JenisIntervensi,
-			&riwayat.TanggalIntervensi,
-			&idMasyarakatLaporan,
-			&riwayat.StatusLaporan,
-			&riwayat.TanggalLaporan,
-			&createdBy,
-			&updatedBy,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Format umur balita
-		riwayat.UmurBalita = formatUmurBalita(riwayat.UmurBalita)
-
-		// Determine jenis laporan
-		if idMasyarakatLaporan.Valid {
-			riwayat.JenisLaporan = "masyarakat"
-		} else {
-			riwayat.JenisLaporan = "admin"
-		}
 
-		// Handle nullable fields
-		if updatedDate.Valid {
-			riwayat.UpdatedDate = updatedDate.String
-		}
-		if createdBy.Valid {
-			riwayat.CreatedBy = createdBy.String
-		}
-		if updatedBy.Valid {
-			riwayat.UpdatedBy = updatedBy.String
-		}
-
-		riwayatList = append(riwayatList, riwayat)
+	riwayatList := []riwayatPemeriksaanResponse{}
+	for _, row := range rows {
+		riwayatList = append(riwayatList, newRiwayatPemeriksaanResponse(row))
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
+	return riwayatList, len(riwayatList), nil
+}
 
-	// Get total count
-	var total int
-	countQuery := "SELECT COUNT(*) FROM riwayat_pemeriksaan WHERE deleted_date IS NULL"
-	err = db.QueryRow(countQuery).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// Helper function to build the response of a riwayat pemeriksaan row
+func newRiwayatPemeriksaanResponse(row store.RiwayatPemeriksaan) riwayatPemeriksaanResponse {
+	jenisLaporan := "admin"
+	if row.IdMasyarakat != "" {
+		jenisLaporan = "masyarakat"
 	}
 
-	return riwayatList, total, nil
+	return riwayatPemeriksaanResponse{
+		Id:                  row.Id,
+		IdBalita:            row.IdBalita,
+		NamaBalita:          row.NamaBalita,
+		UmurBalita:          calculateAgeInMonths(row.TanggalLahirBalita),
+		JenisKelamin:        row.JenisKelamin,
+		NamaAyah:            row.NamaAyah,
+		NamaIbu:             row.NamaIbu,
+		NomorKk:             row.NomorKk,
+		IdIntervensi:        row.IdIntervensi,
+		JenisIntervensi:     row.JenisIntervensi,
+		TanggalIntervensi:   row.TanggalIntervensi,
+		IdLaporanMasyarakat: row.IdLaporanMasyarakat,
+		StatusLaporan:       row.StatusLaporan,
+		TanggalLaporan:      row.TanggalLaporan,
+		JenisLaporan:        jenisLaporan,
+		Tanggal:             row.Tanggal,
+		BeratBadan:          row.BeratBadan,
+		TinggiBadan:         row.TinggiBadan,
+		StatusGizi:          row.StatusGizi,
+		ZScoreTBU:           row.ZScoreTBU,
+		ZScoreBBU:           row.ZScoreBBU,
+		ZScoreBBTB:          row.ZScoreBBTB,
+		Keterangan:          row.Keterangan,
+		Kelurahan:           row.Kelurahan,
+		Kecamatan:           row.Kecamatan,
+		CreatedDate:         row.CreatedDate,
+		UpdatedDate:         row.UpdatedDate,
+		CreatedBy:           row.CreatedBy,
+		UpdatedBy:           row.UpdatedBy,
+	}
 }
 
 // Helper function to format umur balita
@@ -458,324 +363,3 @@ func formatUmurBalita(umurBulan string) string {
 		return fmt.Sprintf("%d tahun %d bulan", years, remainingMonths)
 	}
 }
-
-// Helper function to get riwayat pemeriksaan by balita ID
-func getRiwayatPemeriksaanByBalita(db *sql.DB, idBalita string) ([]riwayatPemeriksaanResponse, int, error) {
-	var riwayatList []riwayatPemeriksaanResponse
-
-	query := `
-        SELECT 
-            rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
-            rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
-            rp.created_date, rp.updated_date,
-            b.nama as nama_balita, b.jenis_kelamin,
-            TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan,
-            i.jenis as jenis_intervensi, i.tanggal as tanggal_intervensi,
-            lm.id_masyarakat, sl.status as status_laporan, lm.tanggal_laporan,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM riwayat_pemeriksaan rp
-        LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
-        LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN pengguna pc ON rp.created_id = pc.id
-        LEFT JOIN pengguna pu ON rp.updated_id = pu.id
-        WHERE rp.id_balita = ? AND rp.deleted_date IS NULL
-        ORDER BY rp.tanggal DESC, rp.created_date DESC
-    `
-
-	rows, err := db.Query(query, idBalita)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var riwayat riwayatPemeriksaanResponse
-		var updatedDate sql.NullString
-		var createdBy, updatedBy sql.NullString
-		var idMasyarakatLaporan sql.NullString
-
-		err := rows.Scan(
-			&riwayat.Id,
-			&riwayat.IdBalita,
-			&riwayat.IdIntervensi,
-			&riwayat.IdLaporanMasyarakat,
-			&riwayat.Tanggal,
-			&riwayat.BeratBadan,
-			&riwayat.TinggiBadan,
-			&riwayat.StatusGizi,
-			&riwayat.Keterangan,
-			&riwayat.CreatedDate,
-			&updatedDate,
-			&riwayat.NamaBalita,
-			&riwayat.JenisKelamin,
-			&riwayat.UmurBalita,
-			&riwayat.NomorKk,
-			&riwayat.NamaAyah,
-			&riwayat.NamaIbu,
-			&riwayat.Kelurahan,
-			&riwayat.Kecamatan,
-			&riwayat.JenisIntervensi,
-			&riwayat.TanggalIntervensi,
-			&idMasyarakatLaporan,
-			&riwayat.StatusLaporan,
-			&riwayat.TanggalLaporan,
-			&createdBy,
-			&updatedBy,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Format umur balita
-		riwayat.UmurBalita = formatUmurBalita(riwayat.UmurBalita)
-
-		// Determine jenis laporan
-		if idMasyarakatLaporan.Valid {
-			riwayat.JenisLaporan = "masyarakat"
-		} else {
-			riwayat.JenisLaporan = "admin"
-		}
-
-		// Handle nullable fields
-		if updatedDate.Valid {
-			riwayat.UpdatedDate = updatedDate.String
-		}
-		if createdBy.Valid {
-			riwayat.CreatedBy = createdBy.String
-		}
-		if updatedBy.Valid {
-			riwayat.UpdatedBy = updatedBy.String
-		}
-
-		riwayatList = append(riwayatList, riwayat)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
-
-	return riwayatList, len(riwayatList), nil
-}
-
-// Helper function to get riwayat pemeriksaan by laporan masyarakat ID
-func getRiwayatPemeriksaanByLaporan(db *sql.DB, idLaporan string) ([]riwayatPemeriksaanResponse, int, error) {
-	var riwayatList []riwayatPemeriksaanResponse
-
-	query := `
-        SELECT 
-            rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
-            rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
-            rp.created_date, rp.updated_date,
-            b.nama as nama_balita, b.jenis_kelamin,
-            TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan,
-            i.jenis as jenis_intervensi, i.tanggal as tanggal_intervensi,
-            lm.id_masyarakat, sl.status as status_laporan, lm.tanggal_laporan,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM riwayat_pemeriksaan rp
-        LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
-        LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN pengguna pc ON rp.created_id = pc.id
-        LEFT JOIN pengguna pu ON rp.updated_id = pu.id
-        WHERE rp.id_laporan_masyarakat = ? AND rp.deleted_date IS NULL
-        ORDER BY rp.tanggal DESC, rp.created_date DESC
-    `
-
-	rows, err := db.Query(query, idLaporan)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var riwayat riwayatPemeriksaanResponse
-		var updatedDate sql.NullString
-		var createdBy, updatedBy sql.NullString
-		var idMasyarakatLaporan sql.NullString
-
-		err := rows.Scan(
-			&riwayat.Id,
-			&riwayat.IdBalita,
-			&riwayat.IdIntervensi,
-			&riwayat.IdLaporanMasyarakat,
-			&riwayat.Tanggal,
-			&riwayat.BeratBadan,
-			&riwayat.TinggiBadan,
-			&riwayat.StatusGizi,
-			&riwayat.Keterangan,
-			&riwayat.CreatedDate,
-			&updatedDate,
-			&riwayat.NamaBalita,
-			&riwayat.JenisKelamin,
-			&riwayat.UmurBalita,
-			&riwayat.NomorKk,
-			&riwayat.NamaAyah,
-			&riwayat.NamaIbu,
-			&riwayat.Kelurahan,
-			&riwayat.Kecamatan,
-			&riwayat.JenisIntervensi,
-			&riwayat.TanggalIntervensi,
-			&idMasyarakatLaporan,
-			&riwayat.StatusLaporan,
-			&riwayat.TanggalLaporan,
-			&createdBy,
-			&updatedBy,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Format umur balita
-		riwayat.UmurBalita = formatUmurBalita(riwayat.UmurBalita)
-
-		// Determine jenis laporan
-		if idMasyarakatLaporan.Valid {
-			riwayat.JenisLaporan = "masyarakat"
-		} else {
-			riwayat.JenisLaporan = "admin"
-		}
-
-		// Handle nullable fields
-		if updatedDate.Valid {
-			riwayat.UpdatedDate = updatedDate.String
-		}
-		if createdBy.Valid {
-			riwayat.CreatedBy = createdBy.String
-		}
-		if updatedBy.Valid {
-			riwayat.UpdatedBy = updatedBy.String
-		}
-
-		riwayatList = append(riwayatList, riwayat)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
-
-	return riwayatList, len(riwayatList), nil
-}
-
-// Helper function to get riwayat pemeriksaan by intervensi ID
-func getRiwayatPemeriksaanByIntervensi(db *sql.DB, idIntervensi string) ([]riwayatPemeriksaanResponse, int, error) {
-	var riwayatList []riwayatPemeriksaanResponse
-
-	query := `
-        SELECT 
-            rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
-            rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
-            rp.created_date, rp.updated_date,
-            b.nama as nama_balita, b.jenis_kelamin,
-            TIMESTAMPDIFF(MONTH, b.tanggal_lahir, CURDATE()) as umur_bulan,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan,
-            i.jenis as jenis_intervensi, i.tanggal as tanggal_intervensi,
-            lm.id_masyarakat, sl.status as status_laporan, lm.tanggal_laporan,
-            pc.email as created_by,
-            pu.email as updated_by
-        FROM riwayat_pemeriksaan rp
-        LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
-        LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
-        LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
-        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        LEFT JOIN pengguna pc ON rp.created_id = pc.id
-        LEFT JOIN pengguna pu ON rp.updated_id = pu.id
-        WHERE rp.id_intervensi = ? AND rp.deleted_date IS NULL
-        ORDER BY rp.tanggal DESC, rp.created_date DESC
-    `
-
-	rows, err := db.Query(query, idIntervensi)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var riwayat riwayatPemeriksaanResponse
-		var updatedDate sql.NullString
-		var createdBy, updatedBy sql.NullString
-		var idMasyarakatLaporan sql.NullString
-
-		err := rows.Scan(
-			&riwayat.Id,
-			&riwayat.IdBalita,
-			&riwayat.IdIntervensi,
-			&riwayat.IdLaporanMasyarakat,
-			&riwayat.Tanggal,
-			&riwayat.BeratBadan,
-			&riwayat.TinggiBadan,
-			&riwayat.StatusGizi,
-			&riwayat.Keterangan,
-			&riwayat.CreatedDate,
-			&updatedDate,
-			&riwayat.NamaBalita,
-			&riwayat.JenisKelamin,
-			&riwayat.UmurBalita,
-			&riwayat.NomorKk,
-			&riwayat.NamaAyah,
-			&riwayat.NamaIbu,
-			&riwayat.Kelurahan,
-			&riwayat.Kecamatan,
-			&riwayat.JenisIntervensi,
-			&riwayat.TanggalIntervensi,
-			&idMasyarakatLaporan,
-			&riwayat.StatusLaporan,
-			&riwayat.TanggalLaporan,
-			&createdBy,
-			&updatedBy,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Format umur balita
-		riwayat.UmurBalita = formatUmurBalita(riwayat.UmurBalita)
-
-		// Determine jenis laporan
-		if idMasyarakatLaporan.Valid {
-			riwayat.JenisLaporan = "masyarakat"
-		} else {
-			riwayat.JenisLaporan = "admin"
-		}
-
-		// Handle nullable fields
-		if updatedDate.Valid {
-			riwayat.UpdatedDate = updatedDate.String
-		}
-		if createdBy.Valid {
-			riwayat.CreatedBy = createdBy.String
-		}
-		if updatedBy.Valid {
-			riwayat.UpdatedBy = updatedBy.String
-		}
-
-		riwayatList = append(riwayatList, riwayat)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
-
-	return riwayatList, len(riwayatList), nil
-}
@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/growth"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -20,7 +23,7 @@ type updateRiwayatPemeriksaanRequest struct {
 	Tanggal             string `json:"tanggal"`               // Format: YYYY-MM-DD
 	BeratBadan          string `json:"berat_badan"`           // in kg (decimal)
 	TinggiBadan         string `json:"tinggi_badan"`          // in cm (decimal)
-	StatusGizi          string `json:"status_gizi"`           // "normal", "stunting", "gizi buruk"
+	StatusGizi          string `json:"status_gizi"`           // optional, computed from WHO growth standards
 	Keterangan          string `json:"keterangan"`
 }
 
@@ -90,19 +93,8 @@ func (r *updateRiwayatPemeriksaanRequest) validate() error {
 		return fmt.Errorf("tinggi badan must be between 30.0-150.0 cm")
 	}
 
-	// Status Gizi validation: must be one of the allowed status
-	if r.StatusGizi == "" {
-		return fmt.Errorf("status gizi is required")
-	}
-	allowedStatus := []string{"normal", "stunting", "gizi buruk"}
-	statusValid := false
-	for _, status := range allowedStatus {
-		if r.StatusGizi == status {
-			statusValid = true
-			break
-		}
-	}
-	if !statusValid {
+	// Status Gizi validation: optional, computed from WHO growth standards
+	if r.StatusGizi != "" && !growth.IsAllowedStatus(r.StatusGizi) {
 		return fmt.Errorf("status gizi must be one of: normal, stunting, gizi buruk")
 	}
 
@@ -118,8 +110,10 @@ func (r *updateRiwayatPemeriksaanRequest) validate() error {
 }
 
 type updateRiwayatPemeriksaanResponse struct {
-	Id      string `json:"id"`
-	Message string `json:"message"`
+	Id         string         `json:"id"`
+	Message    string         `json:"message"`
+	StatusGizi string         `json:"status_gizi"`
+	Assessment *growth.Result `json:"assessment,omitempty"`
 }
 
 // # RiwayatPemeriksaanUpdate handles updating riwayat pemeriksaan data
@@ -134,9 +128,11 @@ type updateRiwayatPemeriksaanResponse struct {
 // @Description - tanggal: examination date (YYYY-MM-DD format)
 // @Description - berat_badan: weight in kg (decimal)
 // @Description - tinggi_badan: height in cm (decimal)
-// @Description - status_gizi: nutritional status (normal, stunting, gizi buruk)
+// @Description - status_gizi: optional nutritional status (normal, stunting, gizi buruk)
 // @Description - keterangan: examination notes and recommendations
 // @Description - Validates existence of balita and intervensi, prevents duplicates
+// @Description - Recomputes status gizi and z-scores from the WHO 2006 Child Growth Standards
+// @Description   and rejects a submitted status gizi that contradicts the computed one
 // @Tags admin
 // @Accept json
 // @Produce json
@@ -149,47 +145,12 @@ type updateRiwayatPemeriksaanResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Riwayat pemeriksaan not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/riwayat-pemeriksaan/update [put]
-func RiwayatPemeriksaanUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) RiwayatPemeriksaanUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateRiwayatPemeriksaanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -209,258 +170,233 @@ func RiwayatPemeriksaanUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if riwayat pemeriksaan exists and not soft deleted, also get current data
-	var exists int
-	var currentBalitaId, currentIntervensiId, currentLaporanId, currentTanggal, currentStatusGizi string
-	checkExistQuery := `SELECT COUNT(*), id_balita, id_intervensi, id_laporan_masyarakat, tanggal, status_gizi 
+	db := s.db
+
+	var message string
+	var statusGizi string
+	var assessment *growth.Result
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if riwayat pemeriksaan exists and not soft deleted, also get current data
+		var exists int
+		var currentBalitaId, currentIntervensiId, currentLaporanId, currentTanggal, currentStatusGizi string
+		checkExistQuery := `SELECT COUNT(*), id_balita, id_intervensi, id_laporan_masyarakat, tanggal, status_gizi 
         FROM riwayat_pemeriksaan 
         WHERE id = ? AND deleted_date IS NULL 
         GROUP BY id_balita, id_intervensi, id_laporan_masyarakat, tanggal, status_gizi`
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentBalitaId, &currentIntervensiId, &currentLaporanId, &currentTanggal, &currentStatusGizi)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists, &currentBalitaId, &currentIntervensiId, &currentLaporanId, &currentTanggal, &currentStatusGizi)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check riwayat pemeriksaan existence")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found")
 		}
-		return
-	}
 
-	// Check if laporan masyarakat exists and validate relationship
-	var laporanExists int
-	var statusLaporan, tanggalLaporan string
-	var idMasyarakatLaporan sql.NullString
-	var laporanBalitaId string
-	checkLaporanQuery := `SELECT COUNT(*), lm.id_masyarakat, sl.status, lm.tanggal_laporan, lm.id_balita
+		// Check if laporan masyarakat exists and validate relationship
+		var laporanExists int
+		var statusLaporan, tanggalLaporan string
+		var idMasyarakatLaporan sql.NullString
+		var laporanBalitaId string
+		checkLaporanQuery := `SELECT COUNT(*), lm.id_masyarakat, sl.status, lm.tanggal_laporan, lm.id_balita
     FROM laporan_masyarakat lm
     LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
     WHERE lm.id = ? AND lm.deleted_date IS NULL
     GROUP BY lm.id_masyarakat, sl.status, lm.tanggal_laporan, lm.id_balita`
-	err = db.QueryRow(checkLaporanQuery, req.IdLaporanMasyarakat).Scan(&laporanExists, &idMasyarakatLaporan, &statusLaporan, &tanggalLaporan, &laporanBalitaId)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Laporan masyarakat not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkLaporanQuery, req.IdLaporanMasyarakat).Scan(&laporanExists, &idMasyarakatLaporan, &statusLaporan, &tanggalLaporan, &laporanBalitaId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Laporan masyarakat not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check laporan masyarakat existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if laporanExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Laporan masyarakat not found")
 		}
-		return
-	}
-	if laporanExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Laporan masyarakat not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	// Check if balita exists and not soft deleted (moved before laporan validation)
-	var balitaExists int
-	var namaBalita string
-	checkBalitaQuery := `SELECT COUNT(*), b.nama 
+		// Check if balita exists and not soft deleted (moved before laporan validation)
+		var balitaExists int
+		var namaBalita, tanggalLahirBalita, jenisKelaminBalita string
+		checkBalitaQuery := `SELECT COUNT(*), b.nama, b.tanggal_lahir, b.jenis_kelamin 
     FROM balita b WHERE b.id = ? AND b.deleted_date IS NULL 
-    GROUP BY b.nama`
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+    GROUP BY b.nama, b.tanggal_lahir, b.jenis_kelamin`
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &namaBalita, &tanggalLahirBalita, &jenisKelaminBalita)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita not found")
 		}
-		return
-	}
 
-	// Now validate that laporan is related to the same balita (namaBalita sudah didefinisikan)
-	if laporanBalitaId != req.IdBalita {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Laporan masyarakat is not related to balita '%s'. Please select the correct laporan.", namaBalita), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Now validate that laporan is related to the same balita (namaBalita sudah didefinisikan)
+		if laporanBalitaId != req.IdBalita {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Laporan masyarakat is not related to balita '%s'. Please select the correct laporan.", namaBalita))
 		}
-		return
-	}
 
-	// Check if intervensi exists and not soft deleted
-	var intervensiExists int
-	var jenisIntervensi, tanggalIntervensi string
-	checkIntervensiQuery := `SELECT COUNT(*), jenis, tanggal 
+		// Check if intervensi exists and not soft deleted
+		var intervensiExists int
+		var jenisIntervensi, tanggalIntervensi, statusIntervensi string
+		checkIntervensiQuery := `SELECT COUNT(*), jenis, tanggal, status 
     FROM intervensi WHERE id = ? AND deleted_date IS NULL 
-    GROUP BY jenis, tanggal`
-	err = db.QueryRow(checkIntervensiQuery, req.IdIntervensi).Scan(&intervensiExists, &jenisIntervensi, &tanggalIntervensi)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusBadRequest, "Intervensi not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+    GROUP BY jenis, tanggal, status`
+		err = tx.QueryRow(checkIntervensiQuery, req.IdIntervensi).Scan(&intervensiExists, &jenisIntervensi, &tanggalIntervensi, &statusIntervensi)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusBadRequest, "Intervensi not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check intervensi existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check intervensi existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if intervensiExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Intervensi not found")
 		}
-		return
-	}
-	if intervensiExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Intervensi not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Business logic: Intervensi must have started and not been cancelled
+		if !object.CanRecordPemeriksaan(statusIntervensi) {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot record medical examination. Intervensi %s has status '%s', required status: in_progress or completed",
+					jenisIntervensi, statusIntervensi))
 		}
-		return
-	}
 
-	// Check for duplicate riwayat pemeriksaan (including laporan, excluding current record)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
+		// Check for duplicate riwayat pemeriksaan (including laporan, excluding current record)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM riwayat_pemeriksaan 
         WHERE id_balita = ? AND id_intervensi = ? AND id_laporan_masyarakat = ? AND tanggal = ? AND id != ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.IdIntervensi, req.IdLaporanMasyarakat, req.Tanggal, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.IdIntervensi, req.IdLaporanMasyarakat, req.Tanggal, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate riwayat pemeriksaan")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Riwayat pemeriksaan for balita '%s' on date '%s' in intervensi '%s' with this laporan already exists",
-				namaBalita, req.Tanggal, jenisIntervensi), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Riwayat pemeriksaan for balita '%s' on date '%s' in intervensi '%s' with this laporan already exists",
+					namaBalita, req.Tanggal, jenisIntervensi))
 		}
-		return
-	}
 
-	// Validate examination date against intervensi date (should not be before intervensi starts)
-	pemeriksaanDate, _ := time.Parse("2006-01-02", req.Tanggal)
-	intervensiDate, err := time.Parse("2006-01-02", tanggalIntervensi)
-	if err == nil && pemeriksaanDate.Before(intervensiDate) {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Tanggal pemeriksaan (%s) cannot be before intervensi date (%s)", req.Tanggal, tanggalIntervensi), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Validate examination date against intervensi date (should not be before intervensi starts)
+		pemeriksaanDate, _ := time.Parse("2006-01-02", req.Tanggal)
+		intervensiDate, err := time.Parse("2006-01-02", tanggalIntervensi)
+		if err == nil && pemeriksaanDate.Before(intervensiDate) {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Tanggal pemeriksaan (%s) cannot be before intervensi date (%s)", req.Tanggal, tanggalIntervensi))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Compute status gizi and z-scores from WHO growth standards
+		assessment, err = growth.AssessRecord(jenisKelaminBalita, tanggalLahirBalita, req.Tanggal, req.BeratBadan, req.TinggiBadan)
+		if err != nil {
+			return object.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		statusGizi = req.StatusGizi
+		var zscoreTBU, zscoreBBU, zscoreBBTB sql.NullFloat64
+		if assessment != nil {
+			if err := assessment.Verify(req.StatusGizi); err != nil {
+				return object.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			statusGizi = assessment.StatusGizi
+			zscoreTBU = sql.NullFloat64{Float64: assessment.ZScoreTBU, Valid: true}
+			zscoreBBU = sql.NullFloat64{Float64: assessment.ZScoreBBU, Valid: true}
+			zscoreBBTB = sql.NullFloat64{Float64: assessment.ZScoreBBTB, Valid: true}
+		} else if statusGizi == "" {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Balita '%s' is outside the WHO growth standard range, status gizi is required", namaBalita))
+		}
 
-	// Update riwayat pemeriksaan dengan id_laporan_masyarakat
-	updateQuery := `UPDATE riwayat_pemeriksaan SET 
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "riwayat_pemeriksaan", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read riwayat pemeriksaan for audit log")
+		}
+
+		// Update riwayat pemeriksaan dengan id_laporan_masyarakat
+		updateQuery := `UPDATE riwayat_pemeriksaan SET 
         id_balita = ?, id_intervensi = ?, id_laporan_masyarakat = ?, tanggal = ?, berat_badan = ?, tinggi_badan = ?, 
-        status_gizi = ?, keterangan = ?, updated_id = ?, updated_date = ?
+        status_gizi = ?, zscore_tb_u = ?, zscore_bb_u = ?, zscore_bb_tb = ?, keterangan = ?, updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(updateQuery,
-		req.IdBalita,
-		req.IdIntervensi,
-		req.IdLaporanMasyarakat, // <- Parameter baru
-		req.Tanggal,
-		req.BeratBadan,
-		req.TinggiBadan,
-		req.StatusGizi,
-		req.Keterangan,
-		userId,
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update riwayat pemeriksaan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updateQuery,
+			req.IdBalita,
+			req.IdIntervensi,
+			req.IdLaporanMasyarakat, // <- Parameter baru
+			req.Tanggal,
+			req.BeratBadan,
+			req.TinggiBadan,
+			statusGizi,
+			zscoreTBU,
+			zscoreBBU,
+			zscoreBBTB,
+			req.Keterangan,
+			principal.UserId,
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update riwayat pemeriksaan")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Riwayat pemeriksaan not found, already deleted or no changes made", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Riwayat pemeriksaan not found, already deleted or no changes made")
 		}
-		return
-	}
 
-	// Prepare response message with information about changes
-	message := fmt.Sprintf("Riwayat pemeriksaan balita '%s' berhasil diperbarui untuk intervensi %s pada tanggal %s",
-		namaBalita, jenisIntervensi, req.Tanggal)
+		// Prepare response message with information about changes
+		message = fmt.Sprintf("Riwayat pemeriksaan balita '%s' berhasil diperbarui untuk intervensi %s pada tanggal %s",
+			namaBalita, jenisIntervensi, req.Tanggal)
 
-	// Add information about significant changes including laporan
-	var changes []string
-	if currentBalitaId != req.IdBalita {
-		changes = append(changes, "balita changed")
-	}
-	if currentIntervensiId != req.IdIntervensi {
-		changes = append(changes, "intervensi changed")
-	}
-	if currentLaporanId != req.IdLaporanMasyarakat {
-		changes = append(changes, "laporan changed")
-	}
-	if currentTanggal != req.Tanggal {
-		changes = append(changes, fmt.Sprintf("tanggal: %s → %s", currentTanggal, req.Tanggal))
-	}
-	if currentStatusGizi != req.StatusGizi {
-		changes = append(changes, fmt.Sprintf("status gizi: %s → %s", currentStatusGizi, req.StatusGizi))
-	}
+		// Add information about significant changes including laporan
+		var changes []string
+		if currentBalitaId != req.IdBalita {
+			changes = append(changes, "balita changed")
+		}
+		if currentIntervensiId != req.IdIntervensi {
+			changes = append(changes, "intervensi changed")
+		}
+		if currentLaporanId != req.IdLaporanMasyarakat {
+			changes = append(changes, "laporan changed")
+		}
+		if currentTanggal != req.Tanggal {
+			changes = append(changes, fmt.Sprintf("tanggal: %s → %s", currentTanggal, req.Tanggal))
+		}
+		if currentStatusGizi != statusGizi {
+			changes = append(changes, fmt.Sprintf("status gizi: %s → %s", currentStatusGizi, statusGizi))
+		}
+
+		if len(changes) > 0 {
+			message += " (Changes made)"
+		}
 
-	if len(changes) > 0 {
-		message += " (Changes made)"
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "riwayat_pemeriksaan", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
 	}
 
 	response := object.NewResponse(http.StatusOK, "Riwayat pemeriksaan updated successfully", updateRiwayatPemeriksaanResponse{
-		Id:      req.Id,
-		Message: message,
+		Id:         req.Id,
+		Message:    message,
+		StatusGizi: statusGizi,
+		Assessment: assessment,
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
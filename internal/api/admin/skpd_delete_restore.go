@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -49,47 +51,12 @@ type deleteSkpdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "SKPD not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/skpd/delete [delete]
-func SKPDDelete(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodDelete {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) SKPDDelete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteSkpdRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -109,151 +76,127 @@ func SKPDDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if SKPD exists and not already soft deleted
-	var exists int
-	var deletedDate sql.NullString
-	checkQuery := "SELECT COUNT(*), deleted_date FROM skpd WHERE id = ? GROUP BY deleted_date"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "SKPD not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if SKPD exists and not already soft deleted
+		var exists int
+		var deletedDate sql.NullString
+		checkQuery := "SELECT COUNT(*), deleted_date FROM skpd WHERE id = ? GROUP BY deleted_date FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists, &deletedDate)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "SKPD not found")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found")
 		}
-		return
-	}
 
-	// Check if already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusBadRequest, "SKPD already deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusBadRequest, "SKPD already deleted")
 		}
-		return
-	}
 
-	// Get SKPD details for additional information
-	var skpdName, jenisSkpd string
-	detailQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
-	err = db.QueryRow(detailQuery, req.Id).Scan(&skpdName, &jenisSkpd)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get SKPD details", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get SKPD details for additional information
+		var skpdName, jenisSkpd string
+		detailQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
+		err = tx.QueryRow(detailQuery, req.Id).Scan(&skpdName, &jenisSkpd)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get SKPD details")
 		}
-		return
-	}
 
-	// Check if SKPD has related petugas kesehatan records (prevent deletion if has staff)
-	var petugasCount int
-	checkPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if SKPD has related petugas kesehatan records (prevent deletion if has staff)
+		var petugasCount int
+		checkPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related petugas kesehatan")
 		}
-		return
-	}
 
-	if petugasCount > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot delete SKPD '%s'. There are %d active petugas kesehatan records related to this SKPD", skpdName, petugasCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if petugasCount > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot delete SKPD '%s'. There are %d active petugas kesehatan records related to this SKPD", skpdName, petugasCount))
 		}
-		return
-	}
 
-	// Check if SKPD has related intervensi records through petugas kesehatan (informational)
-	var intervensiCount int
-	checkIntervensiQuery := `SELECT COUNT(*) FROM intervensi_petugas ip 
+		// Check if SKPD has related intervensi records through petugas kesehatan (informational)
+		var intervensiCount int
+		checkIntervensiQuery := `SELECT COUNT(*) FROM intervensi_petugas ip 
         JOIN petugas_kesehatan pk ON ip.id_petugas_kesehatan = pk.id 
         WHERE pk.id_skpd = ? AND pk.deleted_date IS NULL`
-	err = db.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
-	if err != nil {
-		// Not critical, continue with deletion
-		intervensiCount = 0
-	}
+		err = tx.QueryRow(checkIntervensiQuery, req.Id).Scan(&intervensiCount)
+		if err != nil {
+			// Not critical, continue with deletion
+			intervensiCount = 0
+		}
 
-	// Check if there are any soft deleted petugas kesehatan that could be restored later
-	var deletedPetugasCount int
-	checkDeletedPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NOT NULL"
-	err = db.QueryRow(checkDeletedPetugasQuery, req.Id).Scan(&deletedPetugasCount)
-	if err != nil {
-		// Not critical, continue with deletion
-		deletedPetugasCount = 0
-	}
+		// Check if there are any soft deleted petugas kesehatan that could be restored later
+		var deletedPetugasCount int
+		checkDeletedPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NOT NULL"
+		err = tx.QueryRow(checkDeletedPetugasQuery, req.Id).Scan(&deletedPetugasCount)
+		if err != nil {
+			// Not critical, continue with deletion
+			deletedPetugasCount = 0
+		}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Perform soft delete
-	deleteQuery := `UPDATE skpd SET 
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "skpd", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read SKPD for audit log")
+		}
+
+		// Perform soft delete
+		deleteQuery := `UPDATE skpd SET 
         deleted_id = ?, 
         deleted_date = ? 
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(deleteQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to delete SKPD", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(deleteQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to delete SKPD")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check delete result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check delete result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found or already deleted")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found or already deleted", nil)
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Data SKPD '%s' (jenis: %s) berhasil dihapus", skpdName, jenisSkpd)
+		if deletedPetugasCount > 0 {
+			message += fmt.Sprintf(" (Note: This SKPD had %d deleted petugas kesehatan)", deletedPetugasCount)
+		}
+		if intervensiCount > 0 {
+			message += fmt.Sprintf(" (Note: Related to %d historical intervensi records)", intervensiCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionDelete, "skpd", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Data SKPD '%s' (jenis: %s) berhasil dihapus", skpdName, jenisSkpd)
-	if deletedPetugasCount > 0 {
-		message += fmt.Sprintf(" (Note: This SKPD had %d deleted petugas kesehatan)", deletedPetugasCount)
-	}
-	if intervensiCount > 0 {
-		message += fmt.Sprintf(" (Note: Related to %d historical intervensi records)", intervensiCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, "SKPD deleted successfully", deleteSkpdResponse{
 		Id:      req.Id,
 		Message: message,
@@ -279,47 +222,12 @@ func SKPDDelete(w http.ResponseWriter, r *http.Request) {
 // @Failure 404 {object} object.Response{data=nil} "SKPD not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/skpd/restore [post]
-func SKPDRestore(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) SKPDRestore(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req deleteSkpdRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -339,122 +247,106 @@ func SKPDRestore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if SKPD exists and is soft deleted
+		var exists int
+		checkQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NOT NULL FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD existence")
 		}
-		return
-	}
-	defer db.Close()
 
-	// Check if SKPD exists and is soft deleted
-	var exists int
-	checkQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NOT NULL"
-	err = db.QueryRow(checkQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found or not deleted")
 		}
-		return
-	}
 
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found or not deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get SKPD details for validation and response
+		var skpdName, jenisSkpd string
+		detailQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
+		err = tx.QueryRow(detailQuery, req.Id).Scan(&skpdName, &jenisSkpd)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get SKPD details")
 		}
-		return
-	}
 
-	// Get SKPD details for validation and response
-	var skpdName, jenisSkpd string
-	detailQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
-	err = db.QueryRow(detailQuery, req.Id).Scan(&skpdName, &jenisSkpd)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get SKPD details", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check for naming conflicts before restore (same name and jenis, not soft deleted)
+		var conflictExists int
+		conflictQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(conflictQuery, skpdName, jenisSkpd, req.Id).Scan(&conflictExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check naming conflicts")
 		}
-		return
-	}
 
-	// Check for naming conflicts before restore (same name and jenis, not soft deleted)
-	var conflictExists int
-	conflictQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(conflictQuery, skpdName, jenisSkpd, req.Id).Scan(&conflictExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check naming conflicts", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if conflictExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot restore SKPD. Another SKPD with name '%s' and jenis '%s' already exists", skpdName, jenisSkpd))
 		}
-		return
-	}
 
-	if conflictExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot restore SKPD. Another SKPD with name '%s' and jenis '%s' already exists", skpdName, jenisSkpd), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check for soft deleted petugas kesehatan that could be restored with this SKPD
+		var deletedPetugasCount int
+		checkDeletedPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NOT NULL"
+		err = tx.QueryRow(checkDeletedPetugasQuery, req.Id).Scan(&deletedPetugasCount)
+		if err != nil {
+			// Not critical, continue with restore
+			deletedPetugasCount = 0
 		}
-		return
-	}
 
-	// Check for soft deleted petugas kesehatan that could be restored with this SKPD
-	var deletedPetugasCount int
-	checkDeletedPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NOT NULL"
-	err = db.QueryRow(checkDeletedPetugasQuery, req.Id).Scan(&deletedPetugasCount)
-	if err != nil {
-		// Not critical, continue with restore
-		deletedPetugasCount = 0
-	}
+		// Current timestamp for updated_date
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Current timestamp for updated_date
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "skpd", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read SKPD for audit log")
+		}
 
-	// Restore SKPD (clear soft delete fields)
-	restoreQuery := `UPDATE skpd SET 
+		// Restore SKPD (clear soft delete fields)
+		restoreQuery := `UPDATE skpd SET 
         deleted_id = NULL, 
         deleted_date = NULL,
         updated_id = ?,
         updated_date = ?
         WHERE id = ? AND deleted_date IS NOT NULL`
 
-	result, err := db.Exec(restoreQuery, userId, currentTime, req.Id)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to restore SKPD", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(restoreQuery, principal.UserId, currentTime, req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to restore SKPD")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check restore result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check restore result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found or not deleted", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found or not deleted")
+		}
+
+		// Prepare response message with additional information
+		message = fmt.Sprintf("Data SKPD '%s' (jenis: %s) berhasil dipulihkan", skpdName, jenisSkpd)
+		if deletedPetugasCount > 0 {
+			message += fmt.Sprintf(" (Note: This SKPD has %d deleted petugas kesehatan that can be restored separately)", deletedPetugasCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionRestore, "skpd", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := fmt.Sprintf("Data SKPD '%s' (jenis: %s) berhasil dipulihkan", skpdName, jenisSkpd)
-	if deletedPetugasCount > 0 {
-		message += fmt.Sprintf(" (Note: This SKPD has %d deleted petugas kesehatan that can be restored separately)", deletedPetugasCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, "SKPD restored successfully", deleteSkpdResponse{
 		Id:      req.Id,
 		Message: message,
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,6 +10,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -68,47 +71,12 @@ type insertSkpdResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/skpd/insert [post]
-func SKPDInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) SKPDInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertSkpdRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -128,84 +96,79 @@ func SKPDInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Check if SKPD name already exists within the same jenis (not soft deleted)
-	var exists int
-	checkQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkQuery, req.Skpd, req.Jenis).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var message string
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if SKPD name already exists within the same jenis (not soft deleted)
+		var exists int
+		checkQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkQuery, req.Skpd, req.Jenis).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD existence")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("SKPD '%s' with jenis '%s' already exists", req.Skpd, req.Jenis), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("SKPD '%s' with jenis '%s' already exists", req.Skpd, req.Jenis))
 		}
-		return
-	}
 
-	// Check for similar SKPD names to warn about potential duplicates
-	var similarCount int
-	similarQuery := "SELECT COUNT(*) FROM skpd WHERE LOWER(skpd) LIKE LOWER(?) AND deleted_date IS NULL"
-	similarPattern := "%" + req.Skpd + "%"
-	err = db.QueryRow(similarQuery, similarPattern).Scan(&similarCount)
-	if err != nil {
-		// Not critical, continue with insertion
-		similarCount = 0
-	}
+		// Check for similar SKPD names to warn about potential duplicates
+		var similarCount int
+		similarQuery := "SELECT COUNT(*) FROM skpd WHERE LOWER(skpd) LIKE LOWER(?) AND deleted_date IS NULL"
+		similarPattern := "%" + req.Skpd + "%"
+		err = tx.QueryRow(similarQuery, similarPattern).Scan(&similarCount)
+		if err != nil {
+			// Not critical, continue with insertion
+			similarCount = 0
+		}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Insert SKPD
-	insertQuery := `INSERT INTO skpd 
+		// Insert SKPD
+		insertQuery := `INSERT INTO skpd 
         (skpd, jenis, created_id, created_date) 
         VALUES (?, ?, ?, ?)`
 
-	result, err := db.Exec(insertQuery,
-		req.Skpd,
-		req.Jenis,
-		userId,
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert SKPD", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(insertQuery,
+			req.Skpd,
+			req.Jenis,
+			principal.UserId,
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert SKPD")
+		}
+
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Prepare response message with additional info if needed
+		message = "SKPD inserted successfully"
+		if similarCount > 0 {
+			message += fmt.Sprintf(" (Note: Found %d similar SKPD names)", similarCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "skpd", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional info if needed
-	message := "SKPD inserted successfully"
-	if similarCount > 0 {
-		message += fmt.Sprintf(" (Note: Found %d similar SKPD names)", similarCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, message, insertSkpdResponse{
 		Id: strconv.FormatInt(insertedId, 10),
 	})
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,8 @@ import (
 	"slices"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -75,47 +78,12 @@ type updateSkpdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "SKPD not found"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/admin/skpd/update [put]
-func SKPDUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is admin
-	if role != "admin" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Admin role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) SKPDUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateSkpdRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -135,149 +103,125 @@ func SKPDUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
-	// Check if SKPD exists and not soft deleted
-	var exists int
-	checkExistQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkExistQuery, req.Id).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if SKPD exists and not soft deleted
+		var exists int
+		checkExistQuery := "SELECT COUNT(*) FROM skpd WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+		err = tx.QueryRow(checkExistQuery, req.Id).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD existence")
 		}
-		return
-	}
-	if exists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found")
 		}
-		return
-	}
 
-	// Get current SKPD data to check for changes
-	var currentSkpd, currentJenis string
-	getCurrentQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
-	err = db.QueryRow(getCurrentQuery, req.Id).Scan(&currentSkpd, &currentJenis)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get current SKPD data", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get current SKPD data to check for changes
+		var currentSkpd, currentJenis string
+		getCurrentQuery := "SELECT skpd, jenis FROM skpd WHERE id = ?"
+		err = tx.QueryRow(getCurrentQuery, req.Id).Scan(&currentSkpd, &currentJenis)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get current SKPD data")
 		}
-		return
-	}
 
-	// Check if SKPD name already exists within the same jenis (excluding current record and not soft deleted)
-	var duplicateExists int
-	checkDuplicateQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND id != ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkDuplicateQuery, req.Skpd, req.Jenis, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check SKPD duplication", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if SKPD name already exists within the same jenis (excluding current record and not soft deleted)
+		var duplicateExists int
+		checkDuplicateQuery := "SELECT COUNT(*) FROM skpd WHERE skpd = ? AND jenis = ? AND id != ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkDuplicateQuery, req.Skpd, req.Jenis, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check SKPD duplication")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("SKPD '%s' with jenis '%s' already exists", req.Skpd, req.Jenis), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("SKPD '%s' with jenis '%s' already exists", req.Skpd, req.Jenis))
 		}
-		return
-	}
 
-	// Check if SKPD has related petugas kesehatan records (warn user if has staff)
-	var petugasCount int
-	checkPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check related petugas kesehatan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if SKPD has related petugas kesehatan records (warn user if has staff)
+		var petugasCount int
+		checkPetugasQuery := "SELECT COUNT(*) FROM petugas_kesehatan WHERE id_skpd = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkPetugasQuery, req.Id).Scan(&petugasCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check related petugas kesehatan")
 		}
-		return
-	}
 
-	// Prevent jenis change if SKPD has related petugas kesehatan and jenis is being changed
-	if petugasCount > 0 && currentJenis != req.Jenis {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Cannot change SKPD jenis. There are %d active petugas kesehatan records related to this SKPD", petugasCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Prevent jenis change if SKPD has related petugas kesehatan and jenis is being changed
+		if petugasCount > 0 && currentJenis != req.Jenis {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Cannot change SKPD jenis. There are %d active petugas kesehatan records related to this SKPD", petugasCount))
 		}
-		return
-	}
 
-	// Check for similar SKPD names to warn about potential conflicts
-	var similarCount int
-	similarQuery := "SELECT COUNT(*) FROM skpd WHERE LOWER(skpd) LIKE LOWER(?) AND id != ? AND deleted_date IS NULL"
-	similarPattern := "%" + req.Skpd + "%"
-	err = db.QueryRow(similarQuery, similarPattern, req.Id).Scan(&similarCount)
-	if err != nil {
-		// Not critical, continue with update
-		similarCount = 0
-	}
+		// Check for similar SKPD names to warn about potential conflicts
+		var similarCount int
+		similarQuery := "SELECT COUNT(*) FROM skpd WHERE LOWER(skpd) LIKE LOWER(?) AND id != ? AND deleted_date IS NULL"
+		similarPattern := "%" + req.Skpd + "%"
+		err = tx.QueryRow(similarQuery, similarPattern, req.Id).Scan(&similarCount)
+		if err != nil {
+			// Not critical, continue with update
+			similarCount = 0
+		}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "skpd", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read SKPD for audit log")
+		}
 
-	// Update SKPD
-	updateQuery := `UPDATE skpd SET 
+		// Update SKPD
+		updateQuery := `UPDATE skpd SET 
         skpd = ?, jenis = ?, updated_id = ?, updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(updateQuery,
-		req.Skpd,
-		req.Jenis,
-		userId,
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update SKPD", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updateQuery,
+			req.Skpd,
+			req.Jenis,
+			principal.UserId,
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update SKPD")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
+		}
+
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "SKPD not found, already deleted or no changes made")
+		}
+
+		// Prepare response message with additional information
+		message = "Data SKPD berhasil diperbarui"
+		if petugasCount > 0 {
+			message += fmt.Sprintf(" (Note: This SKPD has %d related petugas kesehatan)", petugasCount)
+		}
+		if similarCount > 0 {
+			message += fmt.Sprintf(" (Warning: Found %d similar SKPD names)", similarCount)
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "skpd", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "SKPD not found, already deleted or no changes made", nil)
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
-	// Prepare response message with additional information
-	message := "Data SKPD berhasil diperbarui"
-	if petugasCount > 0 {
-		message += fmt.Sprintf(" (Note: This SKPD has %d related petugas kesehatan)", petugasCount)
-	}
-	if similarCount > 0 {
-		message += fmt.Sprintf(" (Warning: Found %d similar SKPD names)", similarCount)
-	}
-
 	response := object.NewResponse(http.StatusOK, "SKPD updated successfully", updateSkpdResponse{
 		Id:      req.Id,
 		Message: message,
@@ -0,0 +1,304 @@
+package admin
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/cluster"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/mvt"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+)
+
+// Layers served as vector tiles
+const (
+	tileLayerKecamatan = "kecamatan"
+	tileLayerKelurahan = "kelurahan"
+	tileLayerBalita    = "balita"
+)
+
+// tileCacheSize is the maximum number of tiles kept in the tile cache.
+const tileCacheSize = 2048
+
+// How long tiles are cached, on the server and by the browser. Boundaries
+// only change with migrations, balita with every change of their data.
+const (
+	tileBoundaryTTL = time.Hour
+	tileBalitaTTL   = time.Minute
+)
+
+// cachedTile is an encoded tile with the ids of the balita it shows as
+// individual points, recorded in the access log whenever it is served.
+type cachedTile struct {
+	Data      []byte
+	BalitaIds []string
+}
+
+// # TileGet handles getting a map layer as a Mapbox Vector Tile
+//
+// @Summary Get map layer vector tile
+// @Description Get a tile of the kecamatan, kelurahan or balita layer as a Mapbox Vector Tile (Admin and health worker)
+// @Description
+// @Description Tiles use the XYZ scheme of Leaflet and OpenStreetMap, e.g. with Leaflet.VectorGrid.
+// @Description The layer in the tile has the name of the requested layer. Boundaries are clipped
+// @Description to the tile and simplified to half a pixel at the zoom level. Below zoom 16 the
+// @Description balita layer holds clusters as returned by /api/admin/geojson-balita-points with
+// @Description a zoom; status_gizi and status_laporan are JSON encoded. From zoom 16 it holds the
+// @Description individual balita for admins, recorded in the access log, with nomor_kk masked
+// @Description unless lengkap=true. Health workers get clusters at every zoom.
+// @Description
+// @Description Tiles are cached on the server, boundaries for an hour and balita for a minute.
+// @Tags admin,health-worker
+// @Produce application/vnd.mapbox-vector-tile
+// @Security Bearer
+// @Param layer path string true "Layer (kecamatan, kelurahan, balita)"
+// @Param z path int true "Zoom level (0-20)"
+// @Param x path int true "Tile column"
+// @Param y path int true "Tile row"
+// @Param status_laporan query string false "Filter the balita by status laporan"
+// @Param id_kecamatan query string false "Filter the balita by kecamatan"
+// @Param id_kelurahan query string false "Filter the balita by kelurahan"
+// @Param lengkap query bool false "Return the unmasked nomor KK"
+// @Success 200 {file} file "Vector tile, empty when the tile has no features"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden"
+// @Failure 404 {object} object.Response{data=nil} "Layer or tile not found"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/tiles/{layer}/{z}/{x}/{y}.mvt [get]
+func (s *Service) TileGet(w http.ResponseWriter, r *http.Request) {
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Parse the layer and tile from the path
+	layer := r.PathValue("layer")
+	switch layer {
+	case tileLayerKecamatan, tileLayerKelurahan, tileLayerBalita:
+	default:
+		response := object.NewResponse(http.StatusNotFound, "Layer not found", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	y, ok := strings.CutSuffix(r.PathValue("y"), ".mvt")
+	if !ok {
+		response := object.NewResponse(http.StatusNotFound, "Tile not found, tiles end in .mvt", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	tile, err := mvt.ParseTile(r.PathValue("z"), r.PathValue("x"), y)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Check for balita filters
+	statusLaporanParam := r.URL.Query().Get("status_laporan")
+	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
+	idKelurahanParam := r.URL.Query().Get("id_kelurahan")
+
+	// Connect to database
+	db := s.db
+
+	// Only admins see individual balita, health workers get clusters at
+	// every zoom
+	clustered := tile.Z < balitaPointsMinZoom || middleware.GetPrincipal(r).Role != middleware.RoleAdmin
+
+	ttl := tileBoundaryTTL
+	key := layer + "/" + tile.String()
+	if layer == tileLayerBalita {
+		ttl = tileBalitaTTL
+		key += fmt.Sprintf("?status_laporan=%s&id_kecamatan=%s&id_kelurahan=%s&lengkap=%t&clustered=%t",
+			statusLaporanParam, idKecamatanParam, idKelurahanParam, masker.Unmasked(), clustered)
+	}
+
+	cached, ok := s.tiles.Get(key)
+	if !ok {
+		switch layer {
+		case tileLayerKecamatan:
+			cached, err = getKecamatanTile(db, tile)
+		case tileLayerKelurahan:
+			cached, err = getKelurahanTile(db, tile)
+		default:
+			cached, err = getBalitaTile(db, s.store.Keys, masker, tile, clustered, statusLaporanParam, idKecamatanParam, idKelurahanParam)
+		}
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get tile", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		s.tiles.Set(key, cached, ttl)
+	}
+
+	// Record the read of individual balita in the access log
+	if len(cached.BalitaIds) > 0 {
+		access := audit.NewAccess(r, "balita", cached.BalitaIds)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", mvt.ContentType)
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(ttl.Seconds())))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(cached.Data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to get the kecamatan boundaries of a tile
+func getKecamatanTile(db *sql.DB, tile mvt.Tile) (cachedTile, error) {
+	query := `
+        SELECT id, kecamatan, area
+        FROM kecamatan
+        WHERE area IS NOT NULL AND MBRIntersects(area, ST_GeomFromText(?))
+        ORDER BY id
+    `
+
+	rows, err := db.Query(query, tileEnvelopeWKT(tile))
+	if err != nil {
+		return cachedTile{}, err
+	}
+	defer rows.Close()
+
+	layer := tile.NewLayer(tileLayerKecamatan)
+	for rows.Next() {
+		var id, kecamatan string
+		var area geom.NullGeometry
+		if err := rows.Scan(&id, &kecamatan, &area); err != nil {
+			return cachedTile{}, err
+		}
+
+		addTilePolygon(layer, area.Geometry, map[string]any{
+			"id":        id,
+			"kecamatan": kecamatan,
+			"type":      "kecamatan",
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return cachedTile{}, err
+	}
+
+	return cachedTile{Data: mvt.Encode(layer)}, nil
+}
+
+// Helper function to get the kelurahan boundaries of a tile
+func getKelurahanTile(db *sql.DB, tile mvt.Tile) (cachedTile, error) {
+	query := `
+        SELECT kel.id, kel.kelurahan, kec.kecamatan, kel.area
+        FROM kelurahan kel
+        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+        WHERE kel.area IS NOT NULL AND MBRIntersects(kel.area, ST_GeomFromText(?))
+        ORDER BY kel.id
+    `
+
+	rows, err := db.Query(query, tileEnvelopeWKT(tile))
+	if err != nil {
+		return cachedTile{}, err
+	}
+	defer rows.Close()
+
+	layer := tile.NewLayer(tileLayerKelurahan)
+	for rows.Next() {
+		var id, kelurahan, kecamatan string
+		var area geom.NullGeometry
+		if err := rows.Scan(&id, &kelurahan, &kecamatan, &area); err != nil {
+			return cachedTile{}, err
+		}
+
+		addTilePolygon(layer, area.Geometry, map[string]any{
+			"id":        id,
+			"kelurahan": kelurahan,
+			"kecamatan": kecamatan,
+			"type":      "kelurahan",
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return cachedTile{}, err
+	}
+
+	return cachedTile{Data: mvt.Encode(layer)}, nil
+}
+
+// Helper function to get the balita of a tile, as clusters when clustered
+// is set. The balita are loaded from a cluster cell beyond the buffer of
+// the tile, so that every cluster drawn in the tile holds the same balita
+// as in its neighbours.
+func getBalitaTile(db *sql.DB, keys *fieldcrypt.Keyring, masker *pii.Masker, tile mvt.Tile, clustered bool, statusLaporan, idKecamatan, idKelurahan string) (cachedTile, error) {
+	bbox := tile.Bounds(mvt.Buffer + cluster.Radius*mvt.Extent/cluster.TileSize)
+	collection, err := getBalitaPointsGeoJSON(db, keys, masker, statusLaporan, idKecamatan, idKelurahan, &bbox)
+	if err != nil {
+		return cachedTile{}, err
+	}
+
+	if clustered {
+		collection = clusterBalitaPoints(collection, tile.Z)
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	layer := tile.NewLayer(tileLayerBalita)
+	for _, feature := range collection.Features {
+		coordinates, ok := feature.Geometry.Coordinates.([]float64)
+		if !ok || len(coordinates) != 2 {
+			continue
+		}
+		if !layer.AddPoint(coordinates[0], coordinates[1], feature.Properties) || clustered {
+			continue
+		}
+		if id, ok := feature.Properties["id"].(string); ok && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return cachedTile{Data: mvt.Encode(layer), BalitaIds: ids}, nil
+}
+
+// Helper function to add a POLYGON or MULTIPOLYGON to a layer
+func addTilePolygon(layer *mvt.Layer, g geom.Geometry, properties map[string]any) {
+	switch g := g.(type) {
+	case geom.MultiPolygon:
+		layer.AddMultiPolygon(g, properties)
+	case geom.Polygon:
+		layer.AddMultiPolygon(geom.MultiPolygon{g}, properties)
+	}
+}
+
+// Helper function to get the envelope of a tile and its buffer as WKT
+func tileEnvelopeWKT(tile mvt.Tile) string {
+	b := tile.Bounds(mvt.Buffer)
+	return geom.WKT(geom.Polygon{{
+		{b.MinLon, b.MinLat}, {b.MaxLon, b.MinLat}, {b.MaxLon, b.MaxLat}, {b.MinLon, b.MaxLat}, {b.MinLon, b.MinLat},
+	}})
+}
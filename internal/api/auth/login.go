@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -26,13 +29,16 @@ func (l *loginRequest) validate() error {
 }
 
 type loginResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // seconds until token expires
 }
 
 // # Login handles user login requests
 //
 // @Summary User login
-// @Description Login with email and password
+// @Description Login with email and password. Returns a short-lived access token and a
+// @Description refresh token that can be exchanged for new tokens at /api/auth/refresh.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -42,15 +48,7 @@ type loginResponse struct {
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/auth/login [post]
-func Login(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) Login(w http.ResponseWriter, r *http.Request) {
 	var req loginRequest
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
@@ -70,15 +68,7 @@ func Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Check user credentials
 	var storedUser object.Pengguna
@@ -109,8 +99,34 @@ func Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Generate a JWT token
-	token, err := object.GenerateJWT(storedUser.Id, storedUser.Role)
+	// Start a session and generate the tokens
+	refreshToken, refreshHash, err := object.NewRefreshToken()
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to generate token", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	now := time.Now()
+	sessionId, err := s.store.Sesi.Create(store.Sesi{
+		IdPengguna:       storedUser.Id,
+		RefreshTokenHash: refreshHash,
+		UserAgent:        truncate(r.UserAgent(), 255),
+		IPAddress:        audit.ClientIP(r),
+		ExpiresDate:      now.Add(s.config.JWT.RefreshDuration()).Format("2006-01-02 15:04:05"),
+		CreatedDate:      now.Format("2006-01-02 15:04:05"),
+	})
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to create session", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	token, err := object.GenerateJWT(storedUser.Id, storedUser.Role, sessionId, s.config.JWT.Secret, s.config.JWT.Duration())
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to generate token", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -120,9 +136,19 @@ func Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := object.NewResponse(http.StatusOK, "Login successful", loginResponse{
-		Token: token,
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.config.JWT.Duration().Seconds()),
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// Helper function to cut a string to at most n bytes for a varchar column
+func truncate(value string, n int) string {
+	if len(value) > n {
+		return value[:n]
+	}
+	return value
+}
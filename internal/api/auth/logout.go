@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// # Logout ends the session of the current access token
+//
+// @Summary User logout
+// @Description Revoke the session of the access token. The access token and the refresh
+// @Description token of the session stop working immediately.
+// @Tags auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} object.Response{data=nil} "Logout successful"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/auth/logout [post]
+func (s *Service) Logout(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	if err := s.store.Sesi.Revoke(principal.SessionId); err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to revoke session", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Logout successful", nil)
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (r *refreshRequest) validate() error {
+	if r.RefreshToken == "" {
+		return fmt.Errorf("refresh_token is required")
+	}
+	return nil
+}
+
+// # Refresh exchanges a refresh token for new tokens
+//
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new access token and a new refresh token.
+// @Description Every refresh token can be used once. Presenting a refresh token that was
+// @Description already exchanged revokes the whole session, since it indicates the token leaked.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param refresh body refreshRequest true "Refresh request"
+// @Success 200 {object} object.Response{data=loginResponse} "Token refreshed successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Invalid, expired or revoked refresh token"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/auth/refresh [post]
+func (s *Service) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req refreshRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Find the session of the refresh token
+	hash := object.HashRefreshToken(req.RefreshToken)
+	sesi, err := s.store.Sesi.FindByTokenHash(hash)
+	if errors.Is(err, store.ErrNotFound) {
+		response := object.NewResponse(http.StatusUnauthorized, "Invalid refresh token", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to find session", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if sesi.RevokedDate != "" {
+		response := object.NewResponse(http.StatusUnauthorized, "Session has been revoked", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// A refresh token that was already exchanged is being replayed, so
+	// either the client or an attacker holds a stolen copy. Revoke the
+	// session to log both out.
+	if sesi.RefreshTokenHash != hash {
+		if err := s.store.Sesi.Revoke(sesi.Id); err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to revoke session", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		response := object.NewResponse(http.StatusUnauthorized, "Refresh token was already used, session revoked", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	now := time.Now()
+	if sesi.ExpiresDate < now.Format("2006-01-02 15:04:05") {
+		response := object.NewResponse(http.StatusUnauthorized, "Refresh token expired", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	db := s.db
+
+	// Read the current role, it may have changed since login
+	var role string
+	query := "SELECT role FROM pengguna WHERE id = ?"
+	err = db.QueryRow(query, sesi.IdPengguna).Scan(&role)
+	if err == sql.ErrNoRows {
+		response := object.NewResponse(http.StatusUnauthorized, "User not found", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Database query error", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Rotate the refresh token
+	refreshToken, refreshHash, err := object.NewRefreshToken()
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to generate token", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	expiresDate := now.Add(s.config.JWT.RefreshDuration()).Format("2006-01-02 15:04:05")
+	err = s.store.Sesi.Rotate(sesi.Id, hash, refreshHash, expiresDate)
+	if errors.Is(err, store.ErrNotFound) {
+		// Another request exchanged the same token first
+		response := object.NewResponse(http.StatusUnauthorized, "Refresh token was already used", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to rotate refresh token", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	token, err := object.GenerateJWT(sesi.IdPengguna, role, sesi.Id, s.config.JWT.Secret, s.config.JWT.Duration())
+	if err != nil {
+		response := object.NewResponse(http.StatusInternalServerError, "Failed to generate token", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, "Token refreshed successfully", loginResponse{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.config.JWT.Duration().Seconds()),
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
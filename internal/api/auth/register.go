@@ -1,13 +1,16 @@
 package auth
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
 
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -19,13 +22,8 @@ type registerRequest struct {
 }
 
 func (r *registerRequest) validate() error {
-	// Email validation: not empty, valid format
-	if r.Email == "" {
-		return fmt.Errorf("email is required")
-	}
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(r.Email) {
-		return fmt.Errorf("invalid email format")
+	if err := validateEmail(r.Email); err != nil {
+		return err
 	}
 
 	// Nama validation: not empty, min 2 chars, only letters and spaces
@@ -37,17 +35,8 @@ func (r *registerRequest) validate() error {
 		return fmt.Errorf("nama must be at least 2 characters and contain only letters and spaces")
 	}
 
-	// Password validation: not empty, min 8 chars, at least 1 number, 1 letter
-	if r.Password == "" {
-		return fmt.Errorf("password is required")
-	}
-	if len(r.Password) < 8 {
-		return fmt.Errorf("password must be at least 8 characters")
-	}
-	passLetter := regexp.MustCompile(`[A-Za-z]`)
-	passNumber := regexp.MustCompile(`[0-9]`)
-	if !passLetter.MatchString(r.Password) || !passNumber.MatchString(r.Password) {
-		return fmt.Errorf("password must contain at least one letter and one number")
+	if err := validatePassword(r.Password); err != nil {
+		return err
 	}
 
 	// Alamat validation: not empty, min 5 chars
@@ -61,6 +50,35 @@ func (r *registerRequest) validate() error {
 	return nil
 }
 
+// Helper function to validate an email address: not empty, valid format
+func validateEmail(email string) error {
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+	if !emailRegex.MatchString(email) {
+		return fmt.Errorf("invalid email format")
+	}
+	return nil
+}
+
+// Helper function to validate a password: not empty, min 8 chars, at least
+// 1 number, 1 letter
+func validatePassword(password string) error {
+	if password == "" {
+		return fmt.Errorf("password is required")
+	}
+	if len(password) < 8 {
+		return fmt.Errorf("password must be at least 8 characters")
+	}
+	passLetter := regexp.MustCompile(`[A-Za-z]`)
+	passNumber := regexp.MustCompile(`[0-9]`)
+	if !passLetter.MatchString(password) || !passNumber.MatchString(password) {
+		return fmt.Errorf("password must contain at least one letter and one number")
+	}
+	return nil
+}
+
 // # Register handles user registration requests
 //
 // @Summary User registration
@@ -73,15 +91,7 @@ func (r *registerRequest) validate() error {
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/auth/register [post]
-func Register(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
+func (s *Service) Register(w http.ResponseWriter, r *http.Request) {
 	var req registerRequest
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
@@ -101,70 +111,69 @@ func Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if email already exists
+		var exists int
+		checkQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ? FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Email).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check email")
+		}
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Email sudah terdaftar")
 		}
-		return
-	}
-	defer db.Close()
 
-	// Check if email already exists
-	var exists int
-	checkQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ?"
-	err = db.QueryRow(checkQuery, req.Email).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check email", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to hash password")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Email sudah terdaftar", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Insert into pengguna table
+		queryPengguna := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
+		result, err := tx.Exec(queryPengguna, req.Email, hashedPassword, "masyarakat")
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to register user")
 		}
-		return
-	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to hash password", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get the inserted pengguna id
+		penggunaId, err := result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve user ID")
 		}
-		return
-	}
 
-	// Insert into pengguna table
-	queryPengguna := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
-	result, err := db.Exec(queryPengguna, req.Email, hashedPassword, "masyarakat")
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to register user", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Insert into masyarakat table
+		queryMasyarakat := "INSERT INTO masyarakat (id_pengguna, nama, alamat) VALUES (?, ?, ?)"
+		result, err = tx.Exec(queryMasyarakat, penggunaId, req.Nama, req.Alamat)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to register masyarakat")
 		}
-		return
-	}
 
-	// Get the inserted pengguna id
-	penggunaId, err := result.LastInsertId()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve user ID", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		masyarakatId, err := result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve masyarakat ID")
+		}
+
+		// Record the new account in the audit log, the new user is its own actor
+		penggunaEntry := audit.NewEntry(r, audit.ActionCreate, "pengguna", strconv.FormatInt(penggunaId, 10))
+		penggunaEntry.ActorId = penggunaEntry.EntityId
+		err = audit.RecordChange(tx, penggunaEntry, nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		masyarakatEntry := audit.NewEntry(r, audit.ActionCreate, "masyarakat", strconv.FormatInt(masyarakatId, 10))
+		masyarakatEntry.ActorId = penggunaEntry.EntityId
+		err = audit.RecordChange(tx, masyarakatEntry, nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
 		}
-		return
-	}
 
-	// Insert into masyarakat table
-	queryMasyarakat := "INSERT INTO masyarakat (id_pengguna, nama, alamat) VALUES (?, ?, ?)"
-	_, err = db.Exec(queryMasyarakat, penggunaId, req.Nama, req.Alamat)
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to register masyarakat", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -1,36 +1,70 @@
 package auth
 
 import (
+	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// bootstrapTokenHeader carries the bootstrap token used to create the
+// first admin account.
+const bootstrapTokenHeader = "X-Bootstrap-Token"
+
 type registerAdminRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
-func (r *registerAdminRequest) Validate() error {
-	if r.Email == "" {
-		return fmt.Errorf("email is required")
+func (r *registerAdminRequest) validate() error {
+	if err := validateEmail(r.Email); err != nil {
+		return err
 	}
-	if r.Password == "" {
-		return fmt.Errorf("password is required")
+	if err := validatePassword(r.Password); err != nil {
+		return err
 	}
 	return nil
 }
 
-func RegisterAdmin(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+// # RegisterAdmin handles admin registration requests
+//
+// @Summary Admin registration
+// @Description Register a new admin account. The caller must either be logged in as admin,
+// @Description or send the configured bootstrap token in the X-Bootstrap-Token header.
+// @Description The bootstrap token is only accepted while no admin account exists yet.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param X-Bootstrap-Token header string false "Bootstrap token for creating the first admin"
+// @Param register body registerAdminRequest true "Register admin request"
+// @Success 200 {object} object.Response{data=map[string]int64} "Admin registered successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden - Admin role or valid bootstrap token required"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/auth/register_admin [post]
+func (s *Service) RegisterAdmin(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Anonymous callers must present the bootstrap token
+	bootstrap := principal.Role != middleware.RoleAdmin
+	if bootstrap {
+		status, message := s.checkBootstrapToken(r.Header.Get(bootstrapTokenHeader))
+		if status != 0 {
+			response := object.NewResponse(status, message, nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
 		}
-		return
 	}
 
 	var req registerAdminRequest
@@ -44,7 +78,7 @@ func RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Validate required fields
-	err = req.Validate()
+	err = req.validate()
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
 		if err := response.WriteJson(w); err != nil {
@@ -53,78 +87,147 @@ func RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	db, err := object.ConnectDb()
+	db := s.db
+
+	var adminID int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// The bootstrap token is one-time: it stops working once an admin
+		// exists. Lock the admin rows so concurrent bootstraps cannot both pass.
+		if bootstrap {
+			var adminCount int
+			countQuery := "SELECT COUNT(*) FROM pengguna WHERE role = 'admin' FOR UPDATE"
+			err = tx.QueryRow(countQuery).Scan(&adminCount)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check existing admins")
+			}
+			if adminCount > 0 {
+				return object.NewHTTPError(http.StatusForbidden, "Bootstrap token is no longer valid, an admin must register new admins")
+			}
+		}
+
+		// Check if email already exists
+		var exists int
+		checkQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ? FOR UPDATE"
+		err = tx.QueryRow(checkQuery, req.Email).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check email")
+		}
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Email already registered")
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to hash password")
+		}
+
+		query := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
+		result, err := tx.Exec(query, req.Email, hashedPassword, middleware.RoleAdmin)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to register admin")
+		}
+
+		adminID, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get last insert ID")
+		}
+
+		// Record who created the admin account
+		detail := fmt.Sprintf("Admin %s registered by admin %s", req.Email, principal.UserId)
+		if bootstrap {
+			detail = fmt.Sprintf("Admin %s registered with bootstrap token", req.Email)
+		}
+		entry := audit.NewEntry(r, audit.ActionCreate, "pengguna", fmt.Sprint(adminID))
+		entry.Detail = detail
+		err = audit.RecordChange(tx, entry, nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
-	defer db.Close()
 
-	// Check if email already exists
+	response := object.NewResponse(http.StatusOK, "Admin registered successfully", map[string]any{
+		"admin_id": adminID,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// ErrEmailRegistered is returned by CreateAdmin when the email already
+// belongs to a pengguna.
+var ErrEmailRegistered = errors.New("email already registered")
+
+// CreateAdmin creates an admin account outside of an HTTP request, e.g. from
+// the create-admin command, and records it in the audit log without an
+// actor. It applies the same email and password rules as RegisterAdmin.
+func CreateAdmin(db *sql.DB, email, password string) (int64, error) {
+	req := registerAdminRequest{Email: email, Password: password}
+	if err := req.validate(); err != nil {
+		return 0, err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
 	var exists int
-	checkQuery := "SELECT COUNT(*) FROM pengguna WHERE email = ?"
-	err = db.QueryRow(checkQuery, req.Email).Scan(&exists)
+	err = tx.QueryRow("SELECT COUNT(*) FROM pengguna WHERE email = ? FOR UPDATE", req.Email).Scan(&exists)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check email", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, err
 	}
 	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Email already registered", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, ErrEmailRegistered
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to hash password", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, err
 	}
 
 	query := "INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)"
-	stmt, err := db.Prepare(query)
+	result, err := tx.Exec(query, req.Email, hashedPassword, middleware.RoleAdmin)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to prepare statement", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, err
 	}
-	defer stmt.Close()
-
-	result, err := stmt.Exec(req.Email, hashedPassword, "admin")
+	adminID, err := result.LastInsertId()
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to register admin", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, err
 	}
 
-	adminID, err := result.LastInsertId()
+	err = audit.RecordChange(tx, audit.Entry{
+		Action:   audit.ActionCreate,
+		Entity:   "pengguna",
+		EntityId: fmt.Sprint(adminID),
+		Detail:   fmt.Sprintf("Admin %s created from the command line", req.Email),
+	}, nil)
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get last insert ID", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
+		return 0, err
 	}
 
-	response := object.NewResponse(http.StatusOK, "Admin registered successfully", map[string]any{
-		"admin_id": adminID,
-	})
-	if err := response.WriteJson(w); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	return adminID, tx.Commit()
+}
+
+// Helper function to check the bootstrap token of an anonymous request,
+// returning an HTTP status and message when it is rejected
+func (s *Service) checkBootstrapToken(token string) (int, string) {
+	configured := s.config.Auth.BootstrapToken
+	if token == "" {
+		return http.StatusUnauthorized, "Admin token or bootstrap token required"
+	}
+	if configured == "" || subtle.ConstantTimeCompare([]byte(token), []byte(configured)) != 1 {
+		return http.StatusForbidden, "Invalid bootstrap token"
 	}
+	return 0, ""
 }
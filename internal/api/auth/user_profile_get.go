@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -45,50 +46,15 @@ type petugasKesehatanData struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/auth/profile [get]
-func UserProfileGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) UserProfileGet(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
-	// Extract token from Authorization header using utility function
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Validate and parse the JWT token
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Get basic user info
 	var user object.Pengguna
 	query := "SELECT id, email, role FROM pengguna WHERE id = ?"
-	err = db.QueryRow(query, userId).Scan(&user.Id, &user.Email, &user.Role)
+	err := db.QueryRow(query, principal.UserId).Scan(&user.Id, &user.Email, &user.Role)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			response := object.NewResponse(http.StatusUnauthorized, "User not found", nil)
@@ -111,11 +77,11 @@ func UserProfileGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get role-specific data
-	switch role {
+	switch principal.Role {
 	case "masyarakat":
 		var masyarakat masyarakatData
 		masyarakatQuery := "SELECT id, nama, alamat FROM masyarakat WHERE id_pengguna = ?"
-		err = db.QueryRow(masyarakatQuery, userId).Scan(&masyarakat.Id, &masyarakat.Nama, &masyarakat.Alamat)
+		err = db.QueryRow(masyarakatQuery, principal.UserId).Scan(&masyarakat.Id, &masyarakat.Nama, &masyarakat.Alamat)
 		if err != nil {
 			if err != sql.ErrNoRows {
 				response := object.NewResponse(http.StatusInternalServerError, "Failed to get masyarakat data", nil)
@@ -131,7 +97,7 @@ func UserProfileGet(w http.ResponseWriter, r *http.Request) {
 	case "petugas kesehatan":
 		var petugas petugasKesehatanData
 		petugasQuery := "SELECT id, id_skpd, nama, created_date FROM petugas_kesehatan WHERE id_pengguna = ?"
-		err = db.QueryRow(petugasQuery, userId).Scan(&petugas.Id, &petugas.IdSkpd, &petugas.Nama, &petugas.Created)
+		err = db.QueryRow(petugasQuery, principal.UserId).Scan(&petugas.Id, &petugas.IdSkpd, &petugas.Nama, &petugas.Created)
 		if err != nil {
 			if err != sql.ErrNoRows {
 				response := object.NewResponse(http.StatusInternalServerError, "Failed to get petugas kesehatan data", nil)
@@ -6,13 +6,17 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type balitaResponse struct {
 	Id           string `json:"id"`
 	IdKeluarga   string `json:"id_keluarga"`
-	NomorKk      string `json:"nomor_kk"`
+	NomorKk      string `json:"nomor_kk" pii:"nomor_kk"`
 	NamaAyah     string `json:"nama_ayah"`
 	NamaIbu      string `json:"nama_ibu"`
 	Nama         string `json:"nama"`
@@ -56,6 +60,7 @@ type getBalitaByIdResponse struct {
 // @Description Data includes balita information, laporan status, medical history summary,
 // @Description and action permissions (edit/report capabilities).
 // @Description Users can only access balita from keluarga they have created themselves.
+// @Description nomor_kk is masked.
 // @Tags community
 // @Accept json
 // @Produce json
@@ -68,38 +73,13 @@ type getBalitaByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Balita not found or not owned by user"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/balita/get [get]
-func BalitaGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) BalitaGet(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
-	userId, role, err := object.ParseJWT(token)
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -107,35 +87,15 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+	db := s.db
 
 	// Check if ID parameter is provided
 	idParam := r.URL.Query().Get("id")
 	if idParam != "" {
 		// Get specific balita by ID (only if owned by user)
-		balita, err := getBalitaByIdForUser(db, idParam, userId)
+		balita, err := getBalitaByIdForUser(db, s.store.Balita, idParam, principal.UserId)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Balita not found or not owned by you", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -149,6 +109,19 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		access := audit.NewAccess(r, "balita", []string{balita.Id})
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&balita)
 		response := object.NewResponse(http.StatusOK, "Balita retrieved successfully", getBalitaByIdResponse{
 			Data: balita,
 		})
@@ -157,7 +130,7 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all balita for this user's keluarga
-		balitaList, total, err := getAllBalitaForUser(db, userId)
+		balitaList, total, err := getAllBalitaForUser(db, s.store.Balita, principal.UserId)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get balita list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -166,6 +139,23 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		ids := make([]string, len(balitaList))
+		for i, balita := range balitaList {
+			ids[i] = balita.Id
+		}
+		access := audit.NewAccess(r, "balita", ids)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&balitaList)
 		response := object.NewResponse(http.StatusOK, "All balita retrieved successfully", getAllBalitaResponse{
 			Data:  balitaList,
 			Total: total,
@@ -177,51 +167,13 @@ func BalitaGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get balita by ID for specific user (ownership check)
-func getBalitaByIdForUser(db *sql.DB, id string, userId string) (balitaResponse, error) {
-	var balita balitaResponse
-	var updatedDate sql.NullString
-
-	query := `
-        SELECT 
-            b.id, b.id_keluarga, b.nama, b.tanggal_lahir, b.jenis_kelamin,
-            b.berat_lahir, b.tinggi_lahir, b.created_date, b.updated_date,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan
-        FROM balita b
-        JOIN keluarga k ON b.id_keluarga = k.id
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE b.id = ? AND k.created_id = ? AND b.deleted_date IS NULL AND k.deleted_date IS NULL
-    `
-
-	err := db.QueryRow(query, id, userId).Scan(
-		&balita.Id,
-		&balita.IdKeluarga,
-		&balita.Nama,
-		&balita.TanggalLahir,
-		&balita.JenisKelamin,
-		&balita.BeratLahir,
-		&balita.TinggiLahir,
-		&balita.CreatedDate,
-		&updatedDate,
-		&balita.NomorKk,
-		&balita.NamaAyah,
-		&balita.NamaIbu,
-		&balita.Kelurahan,
-		&balita.Kecamatan,
-	)
-
+func getBalitaByIdForUser(db *sql.DB, repo store.BalitaRepository, id string, userId string) (balitaResponse, error) {
+	row, err := repo.Get(id, store.BalitaFilter{KeluargaCreatedId: userId})
 	if err != nil {
-		return balita, err
+		return balitaResponse{}, err
 	}
 
-	// Calculate age in months
-	balita.Umur = calculateAgeInMonths(balita.TanggalLahir)
-
-	// Handle nullable updated_date
-	if updatedDate.Valid {
-		balita.UpdatedDate = updatedDate.String
-	}
+	balita := newBalitaResponse(row)
 
 	// Get additional information for masyarakat
 	err = getBalitaAdditionalInfo(db, &balita)
@@ -234,61 +186,15 @@ func getBalitaByIdForUser(db *sql.DB, id string, userId string) (balitaResponse,
 }
 
 // Helper function to get all balita for specific user's keluarga
-func getAllBalitaForUser(db *sql.DB, userId string) ([]balitaResponse, int, error) {
-	var balitaList []balitaResponse
-
-	query := `
-        SELECT 
-            b.id, b.id_keluarga, b.nama, b.tanggal_lahir, b.jenis_kelamin,
-            b.berat_lahir, b.tinggi_lahir, b.created_date, b.updated_date,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu,
-            kel.kelurahan, kec.kecamatan
-        FROM balita b
-        JOIN keluarga k ON b.id_keluarga = k.id
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE k.created_id = ? AND b.deleted_date IS NULL AND k.deleted_date IS NULL
-        ORDER BY b.created_date DESC
-    `
-
-	rows, err := db.Query(query, userId)
+func getAllBalitaForUser(db *sql.DB, repo store.BalitaRepository, userId string) ([]balitaResponse, int, error) {
+	rows, err := repo.List(store.BalitaFilter{KeluargaCreatedId: userId})
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var balita balitaResponse
-		var updatedDate sql.NullString
-
-		err := rows.Scan(
-			&balita.Id,
-			&balita.IdKeluarga,
-			&balita.Nama,
-			&balita.TanggalLahir,
-			&balita.JenisKelamin,
-			&balita.BeratLahir,
-			&balita.TinggiLahir,
-			&balita.CreatedDate,
-			&updatedDate,
-			&balita.NomorKk,
-			&balita.NamaAyah,
-			&balita.NamaIbu,
-			&balita.Kelurahan,
-			&balita.Kecamatan,
-		)
-
-		if err != nil {
-			return nil, 0, err
-		}
 
-		// Calculate age in months
-		balita.Umur = calculateAgeInMonths(balita.TanggalLahir)
-
-		// Handle nullable updated_date
-		if updatedDate.Valid {
-			balita.UpdatedDate = updatedDate.String
-		}
+	balitaList := []balitaResponse{}
+	for _, row := range rows {
+		balita := newBalitaResponse(row)
 
 		// Get additional information for masyarakat
 		err = getBalitaAdditionalInfo(db, &balita)
@@ -303,22 +209,29 @@ func getAllBalitaForUser(db *sql.DB, userId string) ([]balitaResponse, int, erro
 		balitaList = append(balitaList, balita)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
+	return balitaList, len(balitaList), nil
+}
 
-	// Get total count for this user
-	var total int
-	countQuery := `SELECT COUNT(*) 
-        FROM balita b 
-        JOIN keluarga k ON b.id_keluarga = k.id 
-        WHERE k.created_id = ? AND b.deleted_date IS NULL AND k.deleted_date IS NULL`
-	err = db.QueryRow(countQuery, userId).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// Helper function to build the response of a balita row, without the
+// additional information for masyarakat
+func newBalitaResponse(row store.Balita) balitaResponse {
+	return balitaResponse{
+		Id:           row.Id,
+		IdKeluarga:   row.IdKeluarga,
+		NomorKk:      row.NomorKk,
+		NamaAyah:     row.NamaAyah,
+		NamaIbu:      row.NamaIbu,
+		Nama:         row.Nama,
+		TanggalLahir: row.TanggalLahir,
+		JenisKelamin: row.JenisKelamin,
+		BeratLahir:   row.BeratLahir,
+		TinggiLahir:  row.TinggiLahir,
+		Umur:         calculateAgeInMonths(row.TanggalLahir),
+		Kelurahan:    row.Kelurahan,
+		Kecamatan:    row.Kecamatan,
+		CreatedDate:  row.CreatedDate,
+		UpdatedDate:  row.UpdatedDate,
 	}
-
-	return balitaList, total, nil
 }
 
 // Helper function to get additional information for balita (laporan status, medical history, permissions, etc.)
@@ -1,6 +1,7 @@
 package community
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -127,47 +130,12 @@ type insertBalitaResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden - Masyarakat role required or not owner of keluarga"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/balita/insert [post]
-func BalitaInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) BalitaInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertBalitaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -187,134 +155,96 @@ func BalitaInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if keluarga exists, not soft deleted, and verify ownership
-	var keluargaExists int
-	var createdId string
-	checkKeluargaQuery := `SELECT COUNT(*), created_id 
+	db := s.db
+
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if keluarga exists, not soft deleted, and verify ownership
+		var keluargaExists int
+		var createdId string
+		checkKeluargaQuery := `SELECT COUNT(*), created_id 
         FROM keluarga 
         WHERE id = ? AND deleted_date IS NULL 
         GROUP BY created_id`
-	err = db.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists, &createdId)
-	if err != nil {
-		response := object.NewResponse(http.StatusBadRequest, "Keluarga not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkKeluargaQuery, req.IdKeluarga).Scan(&keluargaExists, &createdId)
+		if err != nil {
+			return object.NewHTTPError(http.StatusBadRequest, "Keluarga not found")
 		}
-		return
-	}
-	if keluargaExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Keluarga not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if keluargaExists == 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Keluarga not found")
 		}
-		return
-	}
 
-	// Verify ownership - user can only add balita to their own keluarga
-	if createdId != userId {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. You can only add balita to your own keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Verify ownership - user can only add balita to their own keluarga
+		if createdId != principal.UserId {
+			return object.NewHTTPError(http.StatusForbidden, "Access denied. You can only add balita to your own keluarga")
 		}
-		return
-	}
 
-	// Check for duplicate balita (same name, birth date, and keluarga)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
+		// Check for duplicate balita (same name, birth date, and keluarga)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
         WHERE id_keluarga = ? AND nama = ? AND tanggal_lahir = ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate balita")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga")
 		}
-		return
-	}
 
-	// Business logic: Check if keluarga already has maximum balita (optional constraint)
-	var currentBalitaCount int
-	checkBalitaCountQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkBalitaCountQuery, req.IdKeluarga).Scan(&currentBalitaCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita count", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Business logic: Check if keluarga already has maximum balita (optional constraint)
+		var currentBalitaCount int
+		checkBalitaCountQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkBalitaCountQuery, req.IdKeluarga).Scan(&currentBalitaCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita count")
 		}
-		return
-	}
 
-	// Optional: Limit maximum balita per keluarga (example: 10 balita)
-	const maxBalitaPerKeluarga = 10
-	if currentBalitaCount >= maxBalitaPerKeluarga {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Maximum balita limit reached. This keluarga already has %d balita (max: %d)",
-				currentBalitaCount, maxBalitaPerKeluarga), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Optional: Limit maximum balita per keluarga (example: 10 balita)
+		const maxBalitaPerKeluarga = 10
+		if currentBalitaCount >= maxBalitaPerKeluarga {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Maximum balita limit reached. This keluarga already has %d balita (max: %d)",
+					currentBalitaCount, maxBalitaPerKeluarga))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Insert balita with user as creator
-	insertQuery := `INSERT INTO balita 
+		// Insert balita with user as creator
+		insertQuery := `INSERT INTO balita 
         (id_keluarga, nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir, created_id, created_date) 
         VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := db.Exec(insertQuery,
-		req.IdKeluarga,
-		req.Nama,
-		req.TanggalLahir,
-		req.JenisKelamin,
-		req.BeratLahir,
-		req.TinggiLahir,
-		userId, // Use user ID (from JWT) as created_id
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(insertQuery,
+			req.IdKeluarga,
+			req.Nama,
+			req.TanggalLahir,
+			req.JenisKelamin,
+			req.BeratLahir,
+			req.TinggiLahir,
+			principal.UserId, // Use user ID (from JWT) as created_id
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert balita")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "balita", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -138,47 +140,12 @@ type updateBalitaResponse struct {
 // @Failure 409 {object} object.Response{data=nil} "Conflict - Cannot update due to active reports"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/balita/update [put]
-func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPut {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) BalitaUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req updateBalitaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -198,85 +165,46 @@ func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if balita exists, not soft deleted, and verify ownership through keluarga
-	var balitaExists int
-	var currentKeluargaId string
-	var keluargaCreatedId string
-	var deletedDate sql.NullString
-	checkBalitaQuery := `
+	db := s.db
+
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists, not soft deleted, and verify ownership through keluarga
+		var balitaExists int
+		var currentKeluargaId string
+		var keluargaCreatedId string
+		var deletedDate sql.NullString
+		checkBalitaQuery := `
         SELECT COUNT(*), b.id_keluarga, k.created_id, b.deleted_date
         FROM balita b
         JOIN keluarga k ON b.id_keluarga = k.id
         WHERE b.id = ? AND k.deleted_date IS NULL
         GROUP BY b.id_keluarga, k.created_id, b.deleted_date
     `
-	err = db.QueryRow(checkBalitaQuery, req.Id).Scan(&balitaExists, &currentKeluargaId, &keluargaCreatedId, &deletedDate)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Balita not found or keluarga has been deleted", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkBalitaQuery, req.Id).Scan(&balitaExists, &currentKeluargaId, &keluargaCreatedId, &deletedDate)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Balita not found or keluarga has been deleted")
 			}
-			return
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
 
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found")
 		}
-		return
-	}
 
-	// Check if balita is already soft deleted
-	if deletedDate.Valid {
-		response := object.NewResponse(http.StatusNotFound, "Balita has been deleted", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if balita is already soft deleted
+		if deletedDate.Valid {
+			return object.NewHTTPError(http.StatusNotFound, "Balita has been deleted")
 		}
-		return
-	}
 
-	// Verify ownership - user can only update balita from their own keluarga
-	if keluargaCreatedId != userId {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. You can only update balita from your own keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Verify ownership - user can only update balita from their own keluarga
+		if keluargaCreatedId != principal.UserId {
+			return object.NewHTTPError(http.StatusForbidden, "Access denied. You can only update balita from your own keluarga")
 		}
-		return
-	}
 
-	// Business rule: Check if balita has active reports that prevent updates
-	var activeReportsCount int
-	checkActiveReportsQuery := `
+		// Business rule: Check if balita has active reports that prevent updates
+		var activeReportsCount int
+		checkActiveReportsQuery := `
         SELECT COUNT(*) 
         FROM laporan_masyarakat lm
         JOIN status_laporan sl ON lm.id_status_laporan = sl.id
@@ -284,109 +212,79 @@ func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
         AND lm.deleted_date IS NULL 
         AND sl.status IN ('Belum diproses', 'Diproses dan data sesuai', 'Belum ditindaklanjuti')
     `
-	err = db.QueryRow(checkActiveReportsQuery, req.Id).Scan(&activeReportsCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check active reports", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkActiveReportsQuery, req.Id).Scan(&activeReportsCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check active reports")
 		}
-		return
-	}
 
-	if activeReportsCount > 0 {
-		response := object.NewResponse(http.StatusConflict,
-			fmt.Sprintf("Cannot update balita data. There are %d active reports that need to be processed first", activeReportsCount), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if activeReportsCount > 0 {
+			return object.NewHTTPError(http.StatusConflict,
+				fmt.Sprintf("Cannot update balita data. There are %d active reports that need to be processed first", activeReportsCount))
 		}
-		return
-	}
 
-	// Verify that the new keluarga exists, not soft deleted, and is owned by the user
-	if req.IdKeluarga != currentKeluargaId {
-		var newKeluargaExists int
-		var newKeluargaCreatedId string
-		checkNewKeluargaQuery := `SELECT COUNT(*), created_id 
+		// Verify that the new keluarga exists, not soft deleted, and is owned by the user
+		if req.IdKeluarga != currentKeluargaId {
+			var newKeluargaExists int
+			var newKeluargaCreatedId string
+			checkNewKeluargaQuery := `SELECT COUNT(*), created_id 
             FROM keluarga 
             WHERE id = ? AND deleted_date IS NULL 
             GROUP BY created_id`
-		err = db.QueryRow(checkNewKeluargaQuery, req.IdKeluarga).Scan(&newKeluargaExists, &newKeluargaCreatedId)
-		if err != nil {
-			response := object.NewResponse(http.StatusBadRequest, "New keluarga not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			err = tx.QueryRow(checkNewKeluargaQuery, req.IdKeluarga).Scan(&newKeluargaExists, &newKeluargaCreatedId)
+			if err != nil {
+				return object.NewHTTPError(http.StatusBadRequest, "New keluarga not found")
 			}
-			return
-		}
-		if newKeluargaExists == 0 {
-			response := object.NewResponse(http.StatusBadRequest, "New keluarga not found", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			if newKeluargaExists == 0 {
+				return object.NewHTTPError(http.StatusBadRequest, "New keluarga not found")
 			}
-			return
-		}
 
-		// Verify ownership of new keluarga
-		if newKeluargaCreatedId != userId {
-			response := object.NewResponse(http.StatusForbidden, "Access denied. You can only move balita to your own keluarga", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			// Verify ownership of new keluarga
+			if newKeluargaCreatedId != principal.UserId {
+				return object.NewHTTPError(http.StatusForbidden, "Access denied. You can only move balita to your own keluarga")
 			}
-			return
 		}
-	}
 
-	// Check for duplicate balita (same name, birth date, and keluarga, excluding current record)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
+		// Check for duplicate balita (same name, birth date, and keluarga, excluding current record)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM balita 
         WHERE id_keluarga = ? AND nama = ? AND tanggal_lahir = ? AND id != ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir, req.Id).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdKeluarga, req.Nama, req.TanggalLahir, req.Id).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate balita")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Balita with same name and birth date already exists in this keluarga")
 		}
-		return
-	}
 
-	// Business logic: Check if new keluarga already has maximum balita (if moving to different keluarga)
-	if req.IdKeluarga != currentKeluargaId {
-		var currentBalitaCount int
-		checkBalitaCountQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
-		err = db.QueryRow(checkBalitaCountQuery, req.IdKeluarga).Scan(&currentBalitaCount)
-		if err != nil {
-			response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita count", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Business logic: Check if new keluarga already has maximum balita (if moving to different keluarga)
+		if req.IdKeluarga != currentKeluargaId {
+			var currentBalitaCount int
+			checkBalitaCountQuery := "SELECT COUNT(*) FROM balita WHERE id_keluarga = ? AND deleted_date IS NULL"
+			err = tx.QueryRow(checkBalitaCountQuery, req.IdKeluarga).Scan(&currentBalitaCount)
+			if err != nil {
+				return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita count")
 			}
-			return
-		}
 
-		// Optional: Limit maximum balita per keluarga (example: 10 balita)
-		const maxBalitaPerKeluarga = 10
-		if currentBalitaCount >= maxBalitaPerKeluarga {
-			response := object.NewResponse(http.StatusBadRequest,
-				fmt.Sprintf("Maximum balita limit reached. Target keluarga already has %d balita (max: %d)",
-					currentBalitaCount, maxBalitaPerKeluarga), nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+			// Optional: Limit maximum balita per keluarga (example: 10 balita)
+			const maxBalitaPerKeluarga = 10
+			if currentBalitaCount >= maxBalitaPerKeluarga {
+				return object.NewHTTPError(http.StatusBadRequest,
+					fmt.Sprintf("Maximum balita limit reached. Target keluarga already has %d balita (max: %d)",
+						currentBalitaCount, maxBalitaPerKeluarga))
 			}
-			return
 		}
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "balita", req.Id)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read balita for audit log")
+		}
 
-	// Update balita
-	updateQuery := `UPDATE balita SET 
+		// Update balita
+		updateQuery := `UPDATE balita SET 
         id_keluarga = ?, 
         nama = ?, 
         tanggal_lahir = ?, 
@@ -397,37 +295,41 @@ func BalitaUpdate(w http.ResponseWriter, r *http.Request) {
         updated_date = ?
         WHERE id = ? AND deleted_date IS NULL`
 
-	result, err := db.Exec(updateQuery,
-		req.IdKeluarga,
-		req.Nama,
-		req.TanggalLahir,
-		req.JenisKelamin,
-		req.BeratLahir,
-		req.TinggiLahir,
-		userId, // Use user ID (from JWT) as updated_id
-		currentTime,
-		req.Id,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to update balita", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		result, err := tx.Exec(updateQuery,
+			req.IdKeluarga,
+			req.Nama,
+			req.TanggalLahir,
+			req.JenisKelamin,
+			req.BeratLahir,
+			req.TinggiLahir,
+			principal.UserId, // Use user ID (from JWT) as updated_id
+			currentTime,
+			req.Id,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update balita")
 		}
-		return
-	}
 
-	// Check if any rows were affected
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check update result", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if any rows were affected
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check update result")
 		}
-		return
-	}
 
-	if rowsAffected == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found or already deleted", nil)
+		if rowsAffected == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found or already deleted")
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "balita", req.Id), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
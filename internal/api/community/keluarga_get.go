@@ -4,16 +4,20 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type keluargaResponse struct {
     Id          string     `json:"id"`
-    NomorKk     string     `json:"nomor_kk"`
+    NomorKk     string     `json:"nomor_kk" pii:"nomor_kk"`
     NamaAyah    string     `json:"nama_ayah"`
     NamaIbu     string     `json:"nama_ibu"`
-    NikAyah     string     `json:"nik_ayah"`
-    NikIbu      string     `json:"nik_ibu"`
+    NikAyah     string     `json:"nik_ayah" pii:"nik"`
+    NikIbu      string     `json:"nik_ibu" pii:"nik"`
     Alamat      string     `json:"alamat"`
     Rt          string     `json:"rt"`
     Rw          string     `json:"rw"`
@@ -50,6 +54,7 @@ type getKeluargaByIdResponse struct {
 // @Description - With id parameter: Returns specific keluarga data (if owned by user)
 // @Description
 // @Description Data includes family information, balita count, laporan status, and edit permissions.
+// @Description nomor_kk, nik_ayah and nik_ibu are masked.
 // @Description Users can only access keluarga data they have created themselves.
 // @Tags community
 // @Accept json
@@ -63,38 +68,13 @@ type getKeluargaByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Keluarga not found or not owned by user"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/keluarga/get [get]
-func KeluargaGet(w http.ResponseWriter, r *http.Request) {
-    if r.Method != http.MethodGet {
-        response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+func (s *Service) KeluargaGet(w http.ResponseWriter, r *http.Request) {
+    principal := middleware.GetPrincipal(r)
 
-    // Extract and validate JWT token
-    authHeader := r.Header.Get("Authorization")
-    token, err := object.GetJWTFromHeader(authHeader)
+    // Mask the personal identifiers unless the unmasked view is requested
+    masker, err := pii.DefaultPolicy.Masker(r)
     if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    userId, role, err := object.ParseJWT(token)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-
-    // Check if user is masyarakat
-    if role != "masyarakat" {
-        response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
+        response := object.ErrorResponse(err)
         if err := response.WriteJson(w); err != nil {
             http.Error(w, err.Error(), http.StatusInternalServerError)
         }
@@ -102,35 +82,15 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
     }
 
     // Connect to database
-    db, err := object.ConnectDb()
-    if err != nil {
-        response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
-    defer db.Close()
-
-    // Verify user exists and get masyarakat ID
-    var masyarakatId string
-    checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-    err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-    if err != nil {
-        response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-        if err := response.WriteJson(w); err != nil {
-            http.Error(w, err.Error(), http.StatusInternalServerError)
-        }
-        return
-    }
+    db := s.db
 
     // Check if ID parameter is provided
     idParam := r.URL.Query().Get("id")
     if idParam != "" {
         // Get specific keluarga by ID (only if owned by user)
-        keluarga, err := getKeluargaByIdForUser(db, idParam, userId)
+        keluarga, err := getKeluargaByIdForUser(db, s.store.Keluarga, idParam, principal.UserId)
         if err != nil {
-            if err == sql.ErrNoRows {
+            if err == store.ErrNotFound {
                 response := object.NewResponse(http.StatusNotFound, "Keluarga not found or not owned by you", nil)
                 if err := response.WriteJson(w); err != nil {
                     http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -144,6 +104,19 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
             return
         }
 
+        // Record the read in the access log
+        access := audit.NewAccess(r, "keluarga", []string{keluarga.Id})
+        access.Privileged = masker.Unmasked()
+        err = audit.RecordAccess(s.db, access)
+        if err != nil {
+            response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+            if err := response.WriteJson(w); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+            }
+            return
+        }
+
+        masker.Apply(&keluarga)
         response := object.NewResponse(http.StatusOK, "Keluarga retrieved successfully", getKeluargaByIdResponse{
             Data: keluarga,
         })
@@ -152,7 +125,7 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
         }
     } else {
         // Get all keluarga for this user
-        keluargaList, total, err := getAllKeluargaForUser(db, userId)
+        keluargaList, total, err := getAllKeluargaForUser(db, s.store.Keluarga, principal.UserId)
         if err != nil {
             response := object.NewResponse(http.StatusInternalServerError, "Failed to get keluarga list", nil)
             if err := response.WriteJson(w); err != nil {
@@ -161,6 +134,23 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
             return
         }
 
+        // Record the read in the access log
+        ids := make([]string, len(keluargaList))
+        for i, keluarga := range keluargaList {
+            ids[i] = keluarga.Id
+        }
+        access := audit.NewAccess(r, "keluarga", ids)
+        access.Privileged = masker.Unmasked()
+        err = audit.RecordAccess(s.db, access)
+        if err != nil {
+            response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+            if err := response.WriteJson(w); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+            }
+            return
+        }
+
+        masker.Apply(&keluargaList)
         response := object.NewResponse(http.StatusOK, "All keluarga retrieved successfully", getAllKeluargaResponse{
             Data:  keluargaList,
             Total: total,
@@ -172,53 +162,13 @@ func KeluargaGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get keluarga by ID for specific user (ownership check)
-func getKeluargaByIdForUser(db *sql.DB, id string, userId string) (keluargaResponse, error) {
-    var keluarga keluargaResponse
-    var koordinatWKT string
-    var updatedDate sql.NullString
-
-    query := `
-        SELECT 
-            k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu, k.nik_ayah, k.nik_ibu,
-            k.alamat, k.rt, k.rw, k.id_kelurahan,
-            kel.kelurahan, kec.kecamatan,
-            ST_AsText(k.koordinat) as koordinat_wkt,
-            k.created_date, k.updated_date
-        FROM keluarga k
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE k.id = ? AND k.created_id = ? AND k.deleted_date IS NULL
-    `
-
-    err := db.QueryRow(query, id, userId).Scan(
-        &keluarga.Id,
-        &keluarga.NomorKk,
-        &keluarga.NamaAyah,
-        &keluarga.NamaIbu,
-        &keluarga.NikAyah,
-        &keluarga.NikIbu,
-        &keluarga.Alamat,
-        &keluarga.Rt,
-        &keluarga.Rw,
-        &keluarga.IdKelurahan,
-        &keluarga.Kelurahan,
-        &keluarga.Kecamatan,
-        &koordinatWKT,
-        &keluarga.CreatedDate,
-        &updatedDate,
-    )
-
+func getKeluargaByIdForUser(db *sql.DB, repo store.KeluargaRepository, id string, userId string) (keluargaResponse, error) {
+    row, err := repo.Get(id, store.KeluargaFilter{CreatedId: userId})
     if err != nil {
-        return keluarga, err
+        return keluargaResponse{}, err
     }
 
-    // Parse WKT coordinates
-    keluarga.Koordinat = object.ParseWKT(koordinatWKT)
-
-    // Handle nullable updated_date
-    if updatedDate.Valid {
-        keluarga.UpdatedDate = updatedDate.String
-    }
+    keluarga := newKeluargaResponse(row)
 
     // Get additional information for masyarakat
     err = getKeluargaAdditionalInfo(db, &keluarga)
@@ -231,63 +181,15 @@ func getKeluargaByIdForUser(db *sql.DB, id string, userId string) (keluargaRespo
 }
 
 // Helper function to get all keluarga for specific user
-func getAllKeluargaForUser(db *sql.DB, userId string) ([]keluargaResponse, int, error) {
-    var keluargaList []keluargaResponse
-
-    query := `
-        SELECT 
-            k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu, k.nik_ayah, k.nik_ibu,
-            k.alamat, k.rt, k.rw, k.id_kelurahan,
-            kel.kelurahan, kec.kecamatan,
-            ST_AsText(k.koordinat) as koordinat_wkt,
-            k.created_date, k.updated_date
-        FROM keluarga k
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        WHERE k.created_id = ? AND k.deleted_date IS NULL
-        ORDER BY k.created_date DESC
-    `
-
-    rows, err := db.Query(query, userId)
+func getAllKeluargaForUser(db *sql.DB, repo store.KeluargaRepository, userId string) ([]keluargaResponse, int, error) {
+    rows, err := repo.List(store.KeluargaFilter{CreatedId: userId})
     if err != nil {
         return nil, 0, err
     }
-    defer rows.Close()
-
-    for rows.Next() {
-        var keluarga keluargaResponse
-        var koordinatWKT string
-        var updatedDate sql.NullString
-
-        err := rows.Scan(
-            &keluarga.Id,
-            &keluarga.NomorKk,
-            &keluarga.NamaAyah,
-            &keluarga.NamaIbu,
-            &keluarga.NikAyah,
-            &keluarga.NikIbu,
-            &keluarga.Alamat,
-            &keluarga.Rt,
-            &keluarga.Rw,
-            &keluarga.IdKelurahan,
-            &keluarga.Kelurahan,
-            &keluarga.Kecamatan,
-            &koordinatWKT,
-            &keluarga.CreatedDate,
-            &updatedDate,
-        )
-
-        if err != nil {
-            return nil, 0, err
-        }
 
-        // Parse WKT coordinates
-        keluarga.Koordinat = object.ParseWKT(koordinatWKT)
-
-        // Handle nullable updated_date
-        if updatedDate.Valid {
-            keluarga.UpdatedDate = updatedDate.String
-        }
+    keluargaList := []keluargaResponse{}
+    for _, row := range rows {
+        keluarga := newKeluargaResponse(row)
 
         // Get additional information for masyarakat
         err = getKeluargaAdditionalInfo(db, &keluarga)
@@ -302,19 +204,29 @@ func getAllKeluargaForUser(db *sql.DB, userId string) ([]keluargaResponse, int,
         keluargaList = append(keluargaList, keluarga)
     }
 
-    if err = rows.Err(); err != nil {
-        return nil, 0, err
-    }
+    return keluargaList, len(keluargaList), nil
+}
 
-    // Get total count for this user
-    var total int
-    countQuery := "SELECT COUNT(*) FROM keluarga WHERE created_id = ? AND deleted_date IS NULL"
-    err = db.QueryRow(countQuery, userId).Scan(&total)
-    if err != nil {
-        return nil, 0, err
+// Helper function to build the response of a keluarga row, without the
+// additional information for masyarakat
+func newKeluargaResponse(row store.Keluarga) keluargaResponse {
+    return keluargaResponse{
+        Id:          row.Id,
+        NomorKk:     row.NomorKk,
+        NamaAyah:    row.NamaAyah,
+        NamaIbu:     row.NamaIbu,
+        NikAyah:     row.NikAyah,
+        NikIbu:      row.NikIbu,
+        Alamat:      row.Alamat,
+        Rt:          row.Rt,
+        Rw:          row.Rw,
+        IdKelurahan: row.IdKelurahan,
+        Kelurahan:   row.Kelurahan,
+        Kecamatan:   row.Kecamatan,
+        Koordinat:   row.Koordinat,
+        CreatedDate: row.CreatedDate,
+        UpdatedDate: row.UpdatedDate,
     }
-
-    return keluargaList, total, nil
 }
 
 // Helper function to get additional information for keluarga (balita count, laporan status, etc.)
@@ -1,6 +1,7 @@
 package community
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,7 +9,12 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/nik"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/wilayah"
 )
 
 type insertKeluargaRequest struct {
@@ -25,13 +31,12 @@ type insertKeluargaRequest struct {
 }
 
 func (r *insertKeluargaRequest) validate() error {
-	// Nomor KK validation: 16 digits
+	// Nomor KK validation: 16 digits with a valid region code and serial
 	if r.NomorKk == "" {
-		return fmt.Errorf("nomor KK is required")
+		return object.NewFieldError("nomor_kk", "nomor KK is required")
 	}
-	kkRegex := regexp.MustCompile(`^\d{16}$`)
-	if !kkRegex.MatchString(r.NomorKk) {
-		return fmt.Errorf("nomor KK must be exactly 16 digits")
+	if _, err := nik.ParseKK(r.NomorKk); err != nil {
+		return object.NewFieldError("nomor_kk", "nomor KK %v", err)
 	}
 
 	// Nama Ayah validation
@@ -51,21 +56,29 @@ func (r *insertKeluargaRequest) validate() error {
 		return fmt.Errorf("nama ibu must be 2-50 characters and contain only letters and spaces")
 	}
 
-	// NIK Ayah validation: 16 digits
+	// NIK Ayah validation: region code, birth date of a man and serial
 	if r.NikAyah == "" {
-		return fmt.Errorf("NIK ayah is required")
+		return object.NewFieldError("nik_ayah", "NIK ayah is required")
 	}
-	nikRegex := regexp.MustCompile(`^\d{16}$`)
-	if !nikRegex.MatchString(r.NikAyah) {
-		return fmt.Errorf("NIK ayah must be exactly 16 digits")
+	ayah, err := nik.Parse(r.NikAyah)
+	if err != nil {
+		return object.NewFieldError("nik_ayah", "NIK ayah %v", err)
+	}
+	if ayah.Female {
+		return object.NewFieldError("nik_ayah", "NIK ayah must encode a male birth date")
 	}
 
-	// NIK Ibu validation: 16 digits
+	// NIK Ibu validation: region code, birth date of a woman (day plus 40)
+	// and serial
 	if r.NikIbu == "" {
-		return fmt.Errorf("NIK ibu is required")
+		return object.NewFieldError("nik_ibu", "NIK ibu is required")
+	}
+	ibu, err := nik.Parse(r.NikIbu)
+	if err != nil {
+		return object.NewFieldError("nik_ibu", "NIK ibu %v", err)
 	}
-	if !nikRegex.MatchString(r.NikIbu) {
-		return fmt.Errorf("NIK ibu must be exactly 16 digits")
+	if !ibu.Female {
+		return object.NewFieldError("nik_ibu", "NIK ibu must encode a female birth date")
 	}
 
 	// Alamat validation
@@ -94,24 +107,20 @@ func (r *insertKeluargaRequest) validate() error {
 		return fmt.Errorf("RW must be 1-3 digits")
 	}
 
-	// ID Kelurahan validation
-	if r.IdKelurahan == "" {
-		return fmt.Errorf("id kelurahan is required")
-	}
+	// ID Kelurahan is resolved from the koordinat when empty, see
+	// wilayah.ResolveKelurahan
 
-	// Koordinat validation (basic bounds check)
-	// if r.Koordinat[0] < -180 || r.Koordinat[0] > 180 {
-	// 	return fmt.Errorf("longitude must be between -180 and 180")
-	// }
-	// if r.Koordinat[1] < -90 || r.Koordinat[1] > 90 {
-	// 	return fmt.Errorf("latitude must be between -90 and 90")
-	// }
+	// Koordinat validation: [longitude, latitude] within range
+	if err := wilayah.CheckKoordinat(r.Koordinat); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 type insertKeluargaResponse struct {
-	Id string `json:"id"`
+	Id          string `json:"id"`
+	IdKelurahan string `json:"id_kelurahan"` // chosen or resolved from the koordinat
 }
 
 // # KeluargaInsert handles inserting new keluarga data for masyarakat
@@ -127,58 +136,32 @@ type insertKeluargaResponse struct {
 // @Description - Format validation for all fields
 // @Description - Kelurahan existence validation
 // @Description - Coordinate bounds validation
+// @Description
+// @Description Nomor KK and NIK are checked for a valid region code and serial, the nomor KK must
+// @Description start with the code of the kecamatan and NIK ibu must encode a female birth date
+// @Description (day plus 40). Errors of a single field return it in data (object.FieldError).
+// @Description
+// @Description id_kelurahan may be left empty, it is then resolved from the koordinat. A chosen
+// @Description id_kelurahan must contain the koordinat, otherwise the located kelurahan is returned
+// @Description as error on id_kelurahan. Koordinat outside every kelurahan are rejected.
+// @Description Koordinat is [longitude, latitude], pairs out of range are rejected on koordinat.
 // @Tags community
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param keluarga body insertKeluargaRequest true "Keluarga data"
 // @Success 200 {object} object.Response{data=insertKeluargaResponse} "Keluarga inserted successfully"
-// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 400 {object} object.Response{data=object.FieldError} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
 // @Failure 403 {object} object.Response{data=nil} "Forbidden - Masyarakat role required"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/keluarga/insert [post]
-func KeluargaInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) KeluargaInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertKeluargaRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -190,147 +173,113 @@ func KeluargaInsert(w http.ResponseWriter, r *http.Request) {
 	// Validate request
 	err = req.validate()
 	if err != nil {
-		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		response := object.ValidationResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		return
 	}
 
+	// The duplicate checks compare blind indexes, the values are encrypted
+	// once the row has its id
+	keys := s.store.Keys
+
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	db := s.db
+
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if Nomor KK already exists (not soft deleted)
+		var exists int
+		checkKKQuery := "SELECT COUNT(*) FROM keluarga WHERE nomor_kk_index = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkKKQuery, keys.BlindIndex(fieldcrypt.NomorKk, req.NomorKk)).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check nomor KK")
 		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "Nomor KK already exists")
 		}
-		return
-	}
 
-	// Check if Nomor KK already exists (not soft deleted)
-	var exists int
-	checkKKQuery := "SELECT COUNT(*) FROM keluarga WHERE nomor_kk = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkKKQuery, req.NomorKk).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check nomor KK", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if NIK Ayah already exists (not soft deleted)
+		checkNikAyahQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ayah_index = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNikAyahQuery, keys.BlindIndex(fieldcrypt.NikAyah, req.NikAyah)).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check NIK ayah")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Nomor KK already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "NIK ayah already exists")
 		}
-		return
-	}
 
-	// Check if NIK Ayah already exists (not soft deleted)
-	checkNikAyahQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ayah = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNikAyahQuery, req.NikAyah).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check NIK ayah", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Check if NIK Ibu already exists (not soft deleted)
+		checkNikIbuQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ibu_index = ? AND deleted_date IS NULL"
+		err = tx.QueryRow(checkNikIbuQuery, keys.BlindIndex(fieldcrypt.NikIbu, req.NikIbu)).Scan(&exists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check NIK ibu")
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "NIK ayah already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if exists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "NIK ibu already exists")
 		}
-		return
-	}
 
-	// Check if NIK Ibu already exists (not soft deleted)
-	checkNikIbuQuery := "SELECT COUNT(*) FROM keluarga WHERE nik_ibu = ? AND deleted_date IS NULL"
-	err = db.QueryRow(checkNikIbuQuery, req.NikIbu).Scan(&exists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check NIK ibu", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Resolve the kelurahan from the koordinat, or check the chosen one
+		req.IdKelurahan, err = wilayah.ResolveKelurahan(tx, req.IdKelurahan, req.Koordinat)
+		if err != nil {
+			return err
 		}
-		return
-	}
-	if exists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "NIK ibu already exists", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Check if kelurahan exists and issued the nomor KK
+		err = object.CheckKelurahanRegion(tx, req.IdKelurahan, req.NomorKk)
+		if err != nil {
+			return err
 		}
-		return
-	}
 
-	// Check if kelurahan exists
-	var kelurahanExists int
-	checkKelurahanQuery := "SELECT COUNT(*) FROM kelurahan WHERE id = ?"
-	err = db.QueryRow(checkKelurahanQuery, req.IdKelurahan).Scan(&kelurahanExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check kelurahan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Convert coordinates to WKT format
+		koordinatWKT := object.ToWKT(req.Koordinat)
+
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+		// Insert keluarga with masyarakat as creator
+		insertQuery := `INSERT INTO keluarga 
+        (nomor_kk, nama_ayah, nama_ibu, alamat, rt, rw, id_kelurahan, koordinat, created_id, created_date) 
+        VALUES ('', ?, ?, ?, ?, ?, ?, ST_GeomFromText(?), ?, ?)`
+
+		result, err := tx.Exec(insertQuery,
+			req.NamaAyah,
+			req.NamaIbu,
+			req.Alamat,
+			req.Rt,
+			req.Rw,
+			req.IdKelurahan,
+			koordinatWKT,
+			principal.UserId, // Use user ID (from JWT) as created_id
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert keluarga")
 		}
-		return
-	}
-	if kelurahanExists == 0 {
-		response := object.NewResponse(http.StatusBadRequest, "Kelurahan not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
 		}
-		return
-	}
 
-	// Convert coordinates to WKT format
-	koordinatWKT := object.ToWKT(req.Koordinat)
-
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
-
-	// Insert keluarga with masyarakat as creator
-	insertQuery := `INSERT INTO keluarga 
-        (nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu, alamat, rt, rw, id_kelurahan, koordinat, created_id, created_date) 
-        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ST_GeomFromText(?), ?, ?)`
-
-	result, err := db.Exec(insertQuery,
-		req.NomorKk,
-		req.NamaAyah,
-		req.NamaIbu,
-		req.NikAyah,
-		req.NikIbu,
-		req.Alamat,
-		req.Rt,
-		req.Rw,
-		req.IdKelurahan,
-		koordinatWKT,
-		userId, // Use user ID (from JWT) as created_id
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Encrypt the nomor KK and NIK, bound to the new row
+		err = keys.WriteRow(tx, "keluarga", strconv.FormatInt(insertedId, 10), req.NomorKk, req.NikAyah, req.NikIbu)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to encrypt keluarga data")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "keluarga", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -338,7 +287,8 @@ func KeluargaInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := object.NewResponse(http.StatusOK, "Keluarga inserted successfully", insertKeluargaResponse{
-		Id: strconv.FormatInt(insertedId, 10),
+		Id:          strconv.FormatInt(insertedId, 10),
+		IdKelurahan: req.IdKelurahan,
 	})
 	if err := response.WriteJson(w); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1,10 +1,13 @@
 package community
 
 import (
-	"database/sql"
 	"net/http"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type laporanResponse struct {
@@ -13,7 +16,7 @@ type laporanResponse struct {
 	NamaBalita            string `json:"nama_balita"`
 	NamaAyah              string `json:"nama_ayah"`
 	NamaIbu               string `json:"nama_ibu"`
-	NomorKk               string `json:"nomor_kk"`
+	NomorKk               string `json:"nomor_kk" pii:"nomor_kk"`
 	Alamat                string `json:"alamat"`
 	Kelurahan             string `json:"kelurahan"`
 	Kecamatan             string `json:"kecamatan"`
@@ -21,8 +24,8 @@ type laporanResponse struct {
 	StatusLaporan         string `json:"status_laporan"`
 	TanggalLaporan        string `json:"tanggal_laporan"`
 	HubunganDenganBalita  string `json:"hubungan_dengan_balita"`
-	NomorHpPelapor        string `json:"nomor_hp_pelapor"`
-	NomorHpKeluargaBalita string `json:"nomor_hp_keluarga_balita"`
+	NomorHpPelapor        string `json:"nomor_hp_pelapor" pii:"phone"`
+	NomorHpKeluargaBalita string `json:"nomor_hp_keluarga_balita" pii:"phone"`
 	CreatedDate           string `json:"created_date"`
 	UpdatedDate           string `json:"updated_date,omitempty"`
 
@@ -32,6 +35,9 @@ type laporanResponse struct {
 	RiwayatPemeriksaan      int    `json:"riwayat_pemeriksaan"`
 	IntervensiTerkait       int    `json:"intervensi_terkait"`
 	TanggalTerakhirDiproses string `json:"tanggal_terakhir_diproses,omitempty"`
+
+	// Riwayat perubahan status (hanya untuk detail laporan)
+	RiwayatStatus []object.LaporanStatusHistory `json:"riwayat_status,omitempty"`
 }
 
 type getAllLaporanResponse struct {
@@ -50,11 +56,13 @@ type getLaporanByIdResponse struct {
 // @Description
 // @Description Response data varies by parameter:
 // @Description - Without id parameter: Returns all laporan created by the user
-// @Description - With id parameter: Returns specific laporan data (if owned by user)
+// @Description - With id parameter: Returns specific laporan data (if owned by user),
+// @Description   including the status history (riwayat_status) so the reporter can follow the case
 // @Description
 // @Description Data includes laporan information, balita details, keluarga info, status tracking,
 // @Description related medical records count, and action permissions.
 // @Description Users can only access laporan they have created themselves.
+// @Description nomor_kk and the phone numbers are masked.
 // @Tags community
 // @Accept json
 // @Produce json
@@ -67,38 +75,13 @@ type getLaporanByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Laporan not found or not owned by user"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/laporan/get [get]
-func LaporanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanGet(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
-	userId, role, err := object.ParseJWT(token)
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -106,35 +89,15 @@ func LaporanGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+	db := s.db
 
 	// Check if ID parameter is provided
 	idParam := r.URL.Query().Get("id")
 	if idParam != "" {
 		// Get specific laporan by ID (only if owned by user)
-		laporan, err := getLaporanByIdForUser(db, idParam, masyarakatId)
+		laporan, err := getLaporanByIdForUser(s.store, idParam, principal.MasyarakatId)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == store.ErrNotFound {
 				response := object.NewResponse(http.StatusNotFound, "Laporan not found or not owned by you", nil)
 				if err := response.WriteJson(w); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -148,6 +111,34 @@ func LaporanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Get status history
+		laporan.RiwayatStatus, err = object.GetLaporanStatusHistory(db, idParam)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to get status laporan history", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		// Hide who changed the status from masyarakat
+		for i := range laporan.RiwayatStatus {
+			laporan.RiwayatStatus[i].CreatedBy = ""
+		}
+
+		// Record the read in the access log
+		access := audit.NewAccess(r, "laporan_masyarakat", []string{laporan.Id})
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&laporan)
 		response := object.NewResponse(http.StatusOK, "Laporan retrieved successfully", getLaporanByIdResponse{
 			Data: laporan,
 		})
@@ -156,7 +147,7 @@ func LaporanGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all laporan for this user
-		laporanList, total, err := getAllLaporanForUser(db, masyarakatId)
+		laporanList, total, err := getAllLaporanForUser(s.store, principal.MasyarakatId)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get laporan list", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -165,6 +156,23 @@ func LaporanGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		ids := make([]string, len(laporanList))
+		for i, laporan := range laporanList {
+			ids[i] = laporan.Id
+		}
+		access := audit.NewAccess(r, "laporan_masyarakat", ids)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&laporanList)
 		response := object.NewResponse(http.StatusOK, "All laporan retrieved successfully", getAllLaporanResponse{
 			Data:  laporanList,
 			Total: total,
@@ -176,60 +184,16 @@ func LaporanGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get laporan by ID for specific user (ownership check)
-func getLaporanByIdForUser(db *sql.DB, id string, masyarakatId string) (laporanResponse, error) {
-	var laporan laporanResponse
-	var updatedDate sql.NullString
-
-	query := `
-        SELECT 
-            lm.id, lm.id_balita, lm.id_status_laporan,
-            lm.tanggal_laporan, lm.hubungan_dengan_balita, lm.nomor_hp_pelapor, 
-            lm.nomor_hp_keluarga_balita, lm.created_date, lm.updated_date,
-            b.nama as nama_balita,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu, k.alamat,
-            kel.kelurahan, kec.kecamatan,
-            sl.status as status_laporan
-        FROM laporan_masyarakat lm
-        JOIN balita b ON lm.id_balita = b.id
-        JOIN keluarga k ON b.id_keluarga = k.id
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        WHERE lm.id = ? AND lm.id_masyarakat = ? AND lm.deleted_date IS NULL 
-        AND b.deleted_date IS NULL AND k.deleted_date IS NULL
-    `
-
-	err := db.QueryRow(query, id, masyarakatId).Scan(
-		&laporan.Id,
-		&laporan.IdBalita,
-		&laporan.IdStatusLaporan,
-		&laporan.TanggalLaporan,
-		&laporan.HubunganDenganBalita,
-		&laporan.NomorHpPelapor,
-		&laporan.NomorHpKeluargaBalita,
-		&laporan.CreatedDate,
-		&updatedDate,
-		&laporan.NamaBalita,
-		&laporan.NomorKk,
-		&laporan.NamaAyah,
-		&laporan.NamaIbu,
-		&laporan.Alamat,
-		&laporan.Kelurahan,
-		&laporan.Kecamatan,
-		&laporan.StatusLaporan,
-	)
-
+func getLaporanByIdForUser(st *store.Store, id string, masyarakatId string) (laporanResponse, error) {
+	row, err := st.LaporanMasyarakat.Get(id, store.LaporanMasyarakatFilter{IdMasyarakat: masyarakatId})
 	if err != nil {
-		return laporan, err
+		return laporanResponse{}, err
 	}
 
-	// Handle nullable updated_date
-	if updatedDate.Valid {
-		laporan.UpdatedDate = updatedDate.String
-	}
+	laporan := newLaporanResponse(row)
 
 	// Get additional information for masyarakat
-	err = getLaporanAdditionalInfo(db, &laporan)
+	err = getLaporanAdditionalInfo(st, &laporan)
 	if err != nil {
 		// Log error but don't fail the request
 		// Additional info is not critical
@@ -239,69 +203,18 @@ func getLaporanByIdForUser(db *sql.DB, id string, masyarakatId string) (laporanR
 }
 
 // Helper function to get all laporan for specific user
-func getAllLaporanForUser(db *sql.DB, masyarakatId string) ([]laporanResponse, int, error) {
-	var laporanList []laporanResponse
-
-	query := `
-        SELECT 
-            lm.id, lm.id_balita, lm.id_status_laporan,
-            lm.tanggal_laporan, lm.hubungan_dengan_balita, lm.nomor_hp_pelapor, 
-            lm.nomor_hp_keluarga_balita, lm.created_date, lm.updated_date,
-            b.nama as nama_balita,
-            k.nomor_kk, k.nama_ayah, k.nama_ibu, k.alamat,
-            kel.kelurahan, kec.kecamatan,
-            sl.status as status_laporan
-        FROM laporan_masyarakat lm
-        JOIN balita b ON lm.id_balita = b.id AND b.deleted_date IS NULL
-        JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
-        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
-        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-        JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        WHERE lm.id_masyarakat = ? AND lm.deleted_date IS NULL
-        ORDER BY lm.created_date DESC
-    `
-
-	rows, err := db.Query(query, masyarakatId)
+func getAllLaporanForUser(st *store.Store, masyarakatId string) ([]laporanResponse, int, error) {
+	rows, err := st.LaporanMasyarakat.List(store.LaporanMasyarakatFilter{IdMasyarakat: masyarakatId})
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var laporan laporanResponse
-		var updatedDate sql.NullString
-
-		err := rows.Scan(
-			&laporan.Id,
-			&laporan.IdBalita,
-			&laporan.IdStatusLaporan,
-			&laporan.TanggalLaporan,
-			&laporan.HubunganDenganBalita,
-			&laporan.NomorHpPelapor,
-			&laporan.NomorHpKeluargaBalita,
-			&laporan.CreatedDate,
-			&updatedDate,
-			&laporan.NamaBalita,
-			&laporan.NomorKk,
-			&laporan.NamaAyah,
-			&laporan.NamaIbu,
-			&laporan.Alamat,
-			&laporan.Kelurahan,
-			&laporan.Kecamatan,
-			&laporan.StatusLaporan,
-		)
 
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Handle nullable updated_date
-		if updatedDate.Valid {
-			laporan.UpdatedDate = updatedDate.String
-		}
+	laporanList := []laporanResponse{}
+	for _, row := range rows {
+		laporan := newLaporanResponse(row)
 
 		// Get additional information for masyarakat
-		err = getLaporanAdditionalInfo(db, &laporan)
+		err = getLaporanAdditionalInfo(st, &laporan)
 		if err != nil {
 			// Log error but don't fail the request
 			// Set default values
@@ -313,23 +226,34 @@ func getAllLaporanForUser(db *sql.DB, masyarakatId string) ([]laporanResponse, i
 		laporanList = append(laporanList, laporan)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
-	}
+	return laporanList, len(laporanList), nil
+}
 
-	// Get total count for this user
-	var total int
-	countQuery := "SELECT COUNT(*) FROM laporan_masyarakat WHERE id_masyarakat = ? AND deleted_date IS NULL"
-	err = db.QueryRow(countQuery, masyarakatId).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// Helper function to build the response of a laporan masyarakat row
+func newLaporanResponse(row store.LaporanMasyarakat) laporanResponse {
+	return laporanResponse{
+		Id:                    row.Id,
+		IdBalita:              row.IdBalita,
+		NamaBalita:            row.NamaBalita,
+		NamaAyah:              row.NamaAyah,
+		NamaIbu:               row.NamaIbu,
+		NomorKk:               row.NomorKk,
+		Alamat:                row.Alamat,
+		Kelurahan:             row.Kelurahan,
+		Kecamatan:             row.Kecamatan,
+		IdStatusLaporan:       row.IdStatusLaporan,
+		StatusLaporan:         row.StatusLaporan,
+		TanggalLaporan:        row.TanggalLaporan,
+		HubunganDenganBalita:  row.HubunganDenganBalita,
+		NomorHpPelapor:        row.NomorHpPelapor,
+		NomorHpKeluargaBalita: row.NomorHpKeluargaBalita,
+		CreatedDate:           row.CreatedDate,
+		UpdatedDate:           row.UpdatedDate,
 	}
-
-	return laporanList, total, nil
 }
 
 // Helper function to get additional information for laporan (status explanation, related records, permissions, etc.)
-func getLaporanAdditionalInfo(db *sql.DB, laporan *laporanResponse) error {
+func getLaporanAdditionalInfo(st *store.Store, laporan *laporanResponse) error {
 	// Determine if laporan can be edited
 	// Can't edit if status is not "Belum diproses"
 	canEdit := laporan.StatusLaporan == "Belum diproses"
@@ -340,44 +264,22 @@ func getLaporanAdditionalInfo(db *sql.DB, laporan *laporanResponse) error {
 	laporan.StatusKeterangan = statusKeterangan
 
 	// Get count of related riwayat pemeriksaan
-	var riwayatCount int
-	riwayatQuery := `
-        SELECT COUNT(*) 
-        FROM riwayat_pemeriksaan rp
-        WHERE rp.id_balita = ? AND rp.deleted_date IS NULL
-    `
-	err := db.QueryRow(riwayatQuery, laporan.IdBalita).Scan(&riwayatCount)
+	riwayatList, err := st.RiwayatPemeriksaan.List(store.RiwayatPemeriksaanFilter{IdBalita: laporan.IdBalita})
 	if err != nil {
-		riwayatCount = 0
+		return err
 	}
-	laporan.RiwayatPemeriksaan = riwayatCount
+	laporan.RiwayatPemeriksaan = len(riwayatList)
 
 	// Get count of related intervensi
-	var intervensiCount int
-	intervensiQuery := `
-        SELECT COUNT(*) 
-        FROM intervensi i
-        WHERE i.id_balita = ? AND i.deleted_date IS NULL
-    `
-	err = db.QueryRow(intervensiQuery, laporan.IdBalita).Scan(&intervensiCount)
+	intervensiList, err := st.Intervensi.List(store.IntervensiFilter{IdBalita: laporan.IdBalita})
 	if err != nil {
-		intervensiCount = 0
+		return err
 	}
-	laporan.IntervensiTerkait = intervensiCount
-
-	// Get latest processing date (when status was last changed from "Belum diproses")
-	var latestProcessDate sql.NullString
-	processDateQuery := `
-        SELECT lm.updated_date 
-        FROM laporan_masyarakat lm
-        JOIN status_laporan sl ON lm.id_status_laporan = sl.id
-        WHERE lm.id = ? AND sl.status != 'Belum diproses' AND lm.updated_date IS NOT NULL
-        ORDER BY lm.updated_date DESC
-        LIMIT 1
-    `
-	err = db.QueryRow(processDateQuery, laporan.Id).Scan(&latestProcessDate)
-	if err == nil && latestProcessDate.Valid {
-		laporan.TanggalTerakhirDiproses = latestProcessDate.String
+	laporan.IntervensiTerkait = len(intervensiList)
+
+	// The laporan was last processed when its status left "Belum diproses"
+	if !canEdit && laporan.UpdatedDate != "" {
+		laporan.TanggalTerakhirDiproses = laporan.UpdatedDate
 	}
 
 	return nil
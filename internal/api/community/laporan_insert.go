@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
 )
 
@@ -118,47 +120,12 @@ type insertLaporanResponse struct {
 // @Failure 409 {object} object.Response{data=nil} "Conflict - Pending report exists for this balita"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/laporan/insert [post]
-func LaporanInsert(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) LaporanInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
 	// Parse request body
 	var req insertLaporanRequest
-	err = json.NewDecoder(r.Body).Decode(&req)
+	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
 		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -178,75 +145,41 @@ func LaporanInsert(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
-	// Verify user exists and get masyarakat ID
-	var masyarakatId string
-	checkUserQuery := "SELECT m.id FROM masyarakat m JOIN pengguna p ON m.id_pengguna = p.id WHERE p.id = ?"
-	err = db.QueryRow(checkUserQuery, userId).Scan(&masyarakatId)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Masyarakat profile not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if balita exists, not soft deleted, and verify ownership through keluarga
-	var balitaExists int
-	var keluargaCreatedId string
-	var keluargaId string
-	checkBalitaQuery := `
+	db := s.db
+
+	var insertedId int64
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Check if balita exists, not soft deleted, and verify ownership through keluarga
+		var balitaExists int
+		var keluargaCreatedId string
+		var keluargaId string
+		checkBalitaQuery := `
         SELECT COUNT(*), k.created_id, b.id_keluarga
         FROM balita b
         JOIN keluarga k ON b.id_keluarga = k.id
         WHERE b.id = ? AND b.deleted_date IS NULL AND k.deleted_date IS NULL
         GROUP BY k.created_id, b.id_keluarga
     `
-	err = db.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &keluargaCreatedId, &keluargaId)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			response := object.NewResponse(http.StatusNotFound, "Balita not found or keluarga has been deleted", nil)
-			if err := response.WriteJson(w); err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkBalitaQuery, req.IdBalita).Scan(&balitaExists, &keluargaCreatedId, &keluargaId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Balita not found or keluarga has been deleted")
 			}
-			return
-		}
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check balita existence", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check balita existence")
 		}
-		return
-	}
 
-	if balitaExists == 0 {
-		response := object.NewResponse(http.StatusNotFound, "Balita not found", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if balitaExists == 0 {
+			return object.NewHTTPError(http.StatusNotFound, "Balita not found")
 		}
-		return
-	}
 
-	// Verify ownership - user can only report balita from their own keluarga
-	if keluargaCreatedId != userId {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. You can only report balita from your own keluarga", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Verify ownership - user can only report balita from their own keluarga
+		if keluargaCreatedId != principal.UserId {
+			return object.NewHTTPError(http.StatusForbidden, "Access denied. You can only report balita from your own keluarga")
 		}
-		return
-	}
 
-	// Business rule: Check if balita has pending reports (status "Belum diproses")
-	var pendingReportsCount int
-	checkPendingQuery := `
+		// Business rule: Check if balita has pending reports (status "Belum diproses")
+		var pendingReportsCount int
+		checkPendingQuery := `
         SELECT COUNT(*) 
         FROM laporan_masyarakat lm
         JOIN status_laporan sl ON lm.id_status_laporan = sl.id
@@ -254,114 +187,104 @@ func LaporanInsert(w http.ResponseWriter, r *http.Request) {
         AND lm.deleted_date IS NULL 
         AND sl.status = 'Belum diproses'
     `
-	err = db.QueryRow(checkPendingQuery, req.IdBalita).Scan(&pendingReportsCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check pending reports", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkPendingQuery, req.IdBalita).Scan(&pendingReportsCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check pending reports")
 		}
-		return
-	}
 
-	if pendingReportsCount > 0 {
-		response := object.NewResponse(http.StatusConflict,
-			"Cannot create new report. There is already a pending report for this balita that needs to be processed first", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if pendingReportsCount > 0 {
+			return object.NewHTTPError(http.StatusConflict,
+				"Cannot create new report. There is already a pending report for this balita that needs to be processed first")
 		}
-		return
-	}
 
-	// Check for duplicate laporan (same balita, tanggal laporan, dan masyarakat yang sama)
-	var duplicateExists int
-	checkDuplicateQuery := `SELECT COUNT(*) FROM laporan_masyarakat 
+		// Check for duplicate laporan (same balita, tanggal laporan, dan masyarakat yang sama)
+		var duplicateExists int
+		checkDuplicateQuery := `SELECT COUNT(*) FROM laporan_masyarakat 
         WHERE id_balita = ? AND tanggal_laporan = ? AND id_masyarakat = ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, masyarakatId).Scan(&duplicateExists)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check duplicate laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkDuplicateQuery, req.IdBalita, req.TanggalLaporan, principal.MasyarakatId).Scan(&duplicateExists)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate laporan")
 		}
-		return
-	}
-	if duplicateExists > 0 {
-		response := object.NewResponse(http.StatusBadRequest, "You have already reported this balita on the same date", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		if duplicateExists > 0 {
+			return object.NewHTTPError(http.StatusBadRequest, "You have already reported this balita on the same date")
 		}
-		return
-	}
 
-	// Get default status laporan "Belum diproses"
-	var statusLaporanId string
-	getStatusQuery := "SELECT id FROM status_laporan WHERE status = 'Belum diproses'"
-	err = db.QueryRow(getStatusQuery).Scan(&statusLaporanId)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to get default status laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Get default status laporan "Belum diproses"
+		var statusLaporanId string
+		getStatusQuery := "SELECT id FROM status_laporan WHERE status = 'Belum diproses'"
+		err = tx.QueryRow(getStatusQuery).Scan(&statusLaporanId)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get default status laporan")
 		}
-		return
-	}
 
-	// Business logic: Check masyarakat report limit per month (optional)
-	var monthlyReportsCount int
-	currentMonth := time.Now().Format("2006-01")
-	checkMonthlyQuery := `SELECT COUNT(*) FROM laporan_masyarakat 
+		// Business logic: Check masyarakat report limit per month (optional)
+		var monthlyReportsCount int
+		currentMonth := time.Now().Format("2006-01")
+		checkMonthlyQuery := `SELECT COUNT(*) FROM laporan_masyarakat 
         WHERE id_masyarakat = ? AND DATE_FORMAT(tanggal_laporan, '%Y-%m') = ? AND deleted_date IS NULL`
-	err = db.QueryRow(checkMonthlyQuery, masyarakatId, currentMonth).Scan(&monthlyReportsCount)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to check monthly reports", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		err = tx.QueryRow(checkMonthlyQuery, principal.MasyarakatId, currentMonth).Scan(&monthlyReportsCount)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to check monthly reports")
 		}
-		return
-	}
 
-	// Optional: Limit maximum reports per month (example: 10 reports)
-	const maxReportsPerMonth = 10
-	if monthlyReportsCount >= maxReportsPerMonth {
-		response := object.NewResponse(http.StatusBadRequest,
-			fmt.Sprintf("Monthly report limit reached. You have already submitted %d reports this month (max: %d)",
-				monthlyReportsCount, maxReportsPerMonth), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		// Optional: Limit maximum reports per month (example: 10 reports)
+		const maxReportsPerMonth = 10
+		if monthlyReportsCount >= maxReportsPerMonth {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Monthly report limit reached. You have already submitted %d reports this month (max: %d)",
+					monthlyReportsCount, maxReportsPerMonth))
 		}
-		return
-	}
 
-	// Current timestamp
-	currentTime := time.Now().Format("2006-01-02 15:04:05")
+		// Current timestamp
+		currentTime := time.Now().Format("2006-01-02 15:04:05")
 
-	// Insert laporan with masyarakat as reporter
-	insertQuery := `INSERT INTO laporan_masyarakat 
+		// Insert laporan with masyarakat as reporter
+		insertQuery := `INSERT INTO laporan_masyarakat 
         (id_masyarakat, id_balita, id_status_laporan, tanggal_laporan, hubungan_dengan_balita, 
-        nomor_hp_pelapor, nomor_hp_keluarga_balita, created_id, created_date) 
-        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
-
-	result, err := db.Exec(insertQuery,
-		masyarakatId,
-		req.IdBalita,
-		statusLaporanId,
-		req.TanggalLaporan,
-		req.HubunganDenganBalita,
-		req.NomorHpPelapor,
-		req.NomorHpKeluargaBalita,
-		userId, // Use user ID (from JWT) as created_id
-		currentTime,
-	)
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to insert laporan", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+        nomor_hp_keluarga_balita, created_id, created_date) 
+        VALUES (?, ?, ?, ?, ?, '', ?, ?)`
+
+		result, err := tx.Exec(insertQuery,
+			principal.MasyarakatId,
+			req.IdBalita,
+			statusLaporanId,
+			req.TanggalLaporan,
+			req.HubunganDenganBalita,
+			principal.UserId, // Use user ID (from JWT) as created_id
+			currentTime,
+		)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to insert laporan")
+		}
+
+		// Get the inserted ID
+		insertedId, err = result.LastInsertId()
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
+		}
+
+		// Encrypt the phone numbers, bound to the row
+		err = s.store.Keys.WriteRow(tx, "laporan_masyarakat", strconv.FormatInt(insertedId, 10), req.NomorHpPelapor, req.NomorHpKeluargaBalita)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to encrypt laporan data")
+		}
+
+		// Record initial status
+		err = object.RecordLaporanStatus(tx, strconv.FormatInt(insertedId, 10), "", statusLaporanId, "Laporan dibuat oleh masyarakat", principal.UserId)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record status laporan history")
 		}
-		return
-	}
 
-	// Get the inserted ID
-	insertedId, err := result.LastInsertId()
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "laporan_masyarakat", strconv.FormatInt(insertedId, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
 	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Failed to retrieve inserted ID", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -1,10 +1,10 @@
 package community
 
 import (
-	"database/sql"
 	"net/http"
 
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
 )
 
 type kecamatanResponse struct {
@@ -30,57 +30,9 @@ type getAllKecamatanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden - Masyarakat role required"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/master-kecamatan [get]
-func KecamatanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) KecamatanGet(w http.ResponseWriter, r *http.Request) {
 	// Get all kecamatan
-	kecamatanList, total, err := getAllKecamatan(db)
+	kecamatanList, total, err := getAllKecamatan(s.store.Wilayah)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get kecamatan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -124,60 +76,12 @@ type getAllKelurahanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden - Masyarakat role required"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/master-kelurahan [get]
-func KelurahanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) KelurahanGet(w http.ResponseWriter, r *http.Request) {
 	// Check for kecamatan filter
 	idKecamatanParam := r.URL.Query().Get("id_kecamatan")
 
 	// Get kelurahan data
-	kelurahanList, total, err := getAllKelurahan(db, idKecamatanParam)
+	kelurahanList, total, err := getAllKelurahan(s.store.Wilayah, idKecamatanParam)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get kelurahan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -224,57 +128,9 @@ type getAllStatusLaporanResponse struct {
 // @Failure 403 {object} object.Response{data=nil} "Forbidden - Masyarakat role required"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/community/master-status-laporan [get]
-func StatusLaporanGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	_, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is masyarakat
-	if role != "masyarakat" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Masyarakat role required", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
-
+func (s *Service) StatusLaporanGet(w http.ResponseWriter, r *http.Request) {
 	// Get all status laporan
-	statusList, total, err := getAllStatusLaporan(db)
+	statusList, total, err := getAllStatusLaporan(s.store.StatusLaporan)
 	if err != nil {
 		response := object.NewResponse(http.StatusInternalServerError, "Failed to get status laporan list", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -293,102 +149,50 @@ func StatusLaporanGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper function to get all kecamatan
-func getAllKecamatan(db *sql.DB) ([]kecamatanResponse, int, error) {
-	var kecamatanList []kecamatanResponse
-
-	query := "SELECT id, kecamatan FROM kecamatan ORDER BY kecamatan ASC"
-
-	rows, err := db.Query(query)
+func getAllKecamatan(repo store.WilayahRepository) ([]kecamatanResponse, int, error) {
+	rows, err := repo.ListKecamatan()
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var kecamatan kecamatanResponse
-		err := rows.Scan(&kecamatan.Id, &kecamatan.Kecamatan)
-		if err != nil {
-			return nil, 0, err
-		}
-		kecamatanList = append(kecamatanList, kecamatan)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	kecamatanList := []kecamatanResponse{}
+	for _, row := range rows {
+		kecamatanList = append(kecamatanList, kecamatanResponse{Id: row.Id, Kecamatan: row.Kecamatan})
 	}
 
 	return kecamatanList, len(kecamatanList), nil
 }
 
 // Helper function to get all kelurahan with optional kecamatan filter
-func getAllKelurahan(db *sql.DB, idKecamatan string) ([]kelurahanResponse, int, error) {
-	var kelurahanList []kelurahanResponse
-	var query string
-	var args []any
-
-	if idKecamatan != "" {
-		query = `
-            SELECT kel.id, kel.id_kecamatan, kel.kelurahan, kec.kecamatan
-            FROM kelurahan kel
-            LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-            WHERE kel.id_kecamatan = ?
-            ORDER BY kel.kelurahan ASC
-        `
-		args = append(args, idKecamatan)
-	} else {
-		query = `
-            SELECT kel.id, kel.id_kecamatan, kel.kelurahan, kec.kecamatan
-            FROM kelurahan kel
-            LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
-            ORDER BY kec.kecamatan ASC, kel.kelurahan ASC
-        `
-	}
-
-	rows, err := db.Query(query, args...)
+func getAllKelurahan(repo store.WilayahRepository, idKecamatan string) ([]kelurahanResponse, int, error) {
+	rows, err := repo.ListKelurahan(idKecamatan)
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var kelurahan kelurahanResponse
-		err := rows.Scan(&kelurahan.Id, &kelurahan.IdKecamatan, &kelurahan.Kelurahan, &kelurahan.Kecamatan)
-		if err != nil {
-			return nil, 0, err
-		}
-		kelurahanList = append(kelurahanList, kelurahan)
-	}
 
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	kelurahanList := []kelurahanResponse{}
+	for _, row := range rows {
+		kelurahanList = append(kelurahanList, kelurahanResponse{
+			Id:          row.Id,
+			IdKecamatan: row.IdKecamatan,
+			Kelurahan:   row.Kelurahan,
+			Kecamatan:   row.Kecamatan,
+		})
 	}
 
 	return kelurahanList, len(kelurahanList), nil
 }
 
 // Helper function to get all status laporan
-func getAllStatusLaporan(db *sql.DB) ([]statusLaporanResponse, int, error) {
-	var statusList []statusLaporanResponse
-
-	query := "SELECT id, status FROM status_laporan ORDER BY id ASC"
-
-	rows, err := db.Query(query)
+func getAllStatusLaporan(repo store.StatusLaporanRepository) ([]statusLaporanResponse, int, error) {
+	rows, err := repo.List()
 	if err != nil {
 		return nil, 0, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var status statusLaporanResponse
-		err := rows.Scan(&status.Id, &status.Status)
-		if err != nil {
-			return nil, 0, err
-		}
-		statusList = append(statusList, status)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+	statusList := []statusLaporanResponse{}
+	for _, row := range rows {
+		statusList = append(statusList, statusLaporanResponse{Id: row.Id, Status: row.Status})
 	}
 
 	return statusList, len(statusList), nil
@@ -0,0 +1,151 @@
+package healthworker
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type completeAssignmentRequest struct {
+	IdIntervensi string `json:"id_intervensi"`
+	Hasil        string `json:"hasil"` // final result of the intervention
+}
+
+func (r *completeAssignmentRequest) validate() error {
+	// ID Intervensi validation (wajib)
+	if r.IdIntervensi == "" {
+		return fmt.Errorf("id intervensi is required")
+	}
+
+	// Hasil validation
+	if r.Hasil == "" {
+		return fmt.Errorf("hasil is required")
+	}
+	if len(r.Hasil) < 5 || len(r.Hasil) > 500 {
+		return fmt.Errorf("hasil must be between 5-500 characters")
+	}
+
+	return nil
+}
+
+type completeAssignmentResponse struct {
+	IdIntervensi     string `json:"id_intervensi"`
+	Hasil            string `json:"hasil"`
+	StatusIntervensi string `json:"status_intervensi"`
+}
+
+// # AssignmentComplete handles marking an assigned intervention as completed
+//
+// @Summary Complete intervention (Health Worker)
+// @Description Mark an intervention assigned to the authenticated health worker as completed
+// @Description
+// @Description - id_intervensi: assigned intervention
+// @Description - hasil: final result of the intervention (5-500 characters)
+// @Description
+// @Description Only interventions in progress and assigned to the health worker can be completed.
+// @Tags health-worker
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param assignment body completeAssignmentRequest true "Final intervention result"
+// @Success 200 {object} object.Response{data=completeAssignmentResponse} "Intervention completed successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden - Health worker role required"
+// @Failure 404 {object} object.Response{data=nil} "Intervention not found or not assigned to user"
+// @Failure 409 {object} object.Response{data=nil} "Intervention status changed concurrently"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/health-worker/assignment/complete [post]
+func (s *Service) AssignmentComplete(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req completeAssignmentRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Lock the intervention and check if it is assigned to this health worker
+		intervention, err := lockAssignedIntervensiForUser(tx, s.store.Keys, req.IdIntervensi, principal.PetugasKesehatanId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervention not found or not assigned to you")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get intervention")
+		}
+
+		// Business logic: Only interventions in progress can be completed
+		err = object.ValidateIntervensiTransition(intervention.StatusIntervensi, object.IntervensiCompleted)
+		if err != nil {
+			return object.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.IdIntervensi)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
+		}
+
+		// Mark intervention as completed
+		err = object.TransitionIntervensi(tx, req.IdIntervensi, intervention.StatusIntervensi, object.IntervensiCompleted, req.Hasil, principal.UserId)
+		if err != nil {
+			if errors.Is(err, object.ErrIntervensiStatusChanged) {
+				return object.NewHTTPError(http.StatusConflict, "Intervention status was changed by another user, please reload")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to complete intervention")
+		}
+
+		message = fmt.Sprintf("Intervensi %s untuk balita '%s' berhasil diselesaikan",
+			intervention.JenisIntervensi, intervention.NamaBalita)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "intervensi", req.IdIntervensi), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, message, completeAssignmentResponse{
+		IdIntervensi:     req.IdIntervensi,
+		Hasil:            req.Hasil,
+		StatusIntervensi: object.IntervensiCompleted,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
 	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/pii"
 )
 
 type assignedIntervensiResponse struct {
@@ -19,7 +23,7 @@ type assignedIntervensiResponse struct {
 	UmurBalita         string `json:"umur_balita"`
 
 	// Keluarga Info
-	NomorKk   string `json:"nomor_kk"`
+	NomorKk   string `json:"nomor_kk" pii:"nomor_kk"`
 	NamaAyah  string `json:"nama_ayah"`
 	NamaIbu   string `json:"nama_ibu"`
 	Alamat    string `json:"alamat"`
@@ -72,12 +76,13 @@ type getAssignedIntervensiByIdResponse struct {
 // @Description Data includes intervention information, balita details, family info, medical history,
 // @Description related reports, and action permissions based on intervention status.
 // @Description Health workers can only access interventions assigned to them.
+// @Description nomor_kk is masked.
 // @Tags health-worker
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id query string false "Intervention ID"
-// @Param status query string false "Filter by intervention status (pending, in_progress, completed)"
+// @Param status query string false "Filter by intervention status (planned, in_progress, completed, cancelled)"
 // @Success 200 {object} object.Response{data=getAllAssignedIntervensiResponse} "Assigned interventions retrieved successfully"
 // @Failure 400 {object} object.Response{data=nil} "Invalid request"
 // @Failure 401 {object} object.Response{data=nil} "Unauthorized"
@@ -85,38 +90,13 @@ type getAssignedIntervensiByIdResponse struct {
 // @Failure 404 {object} object.Response{data=nil} "Intervention not found or not assigned to user"
 // @Failure 500 {object} object.Response{data=nil} "Internal server error"
 // @Router /api/health-worker/assignment/get [get]
-func AssignmentGet(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		response := object.NewResponse(http.StatusMethodNotAllowed, "Method Not Allowed", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
+func (s *Service) AssignmentGet(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
 
-	// Extract and validate JWT token
-	authHeader := r.Header.Get("Authorization")
-	token, err := object.GetJWTFromHeader(authHeader)
+	// Mask the personal identifiers unless the unmasked view is requested
+	masker, err := pii.DefaultPolicy.Masker(r)
 	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, err.Error(), nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	userId, role, err := object.ParseJWT(token)
-	if err != nil {
-		response := object.NewResponse(http.StatusUnauthorized, "Invalid or expired token", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-
-	// Check if user is petugas kesehatan
-	if role != "petugas kesehatan" {
-		response := object.NewResponse(http.StatusForbidden, "Access denied. Health worker role required", nil)
+		response := object.ErrorResponse(err)
 		if err := response.WriteJson(w); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
@@ -124,15 +104,7 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Connect to database
-	db, err := object.ConnectDb()
-	if err != nil {
-		response := object.NewResponse(http.StatusInternalServerError, "Database connection error", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-		return
-	}
-	defer db.Close()
+	db := s.db
 
 	// Verify user exists and get petugas kesehatan ID
 	var petugasKesehatanId string
@@ -143,7 +115,7 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
         JOIN pengguna p ON pk.id_pengguna = p.id 
         WHERE p.id = ? AND pk.deleted_date IS NULL
     `
-	err = db.QueryRow(checkUserQuery, userId).Scan(&petugasKesehatanId, &skpdId)
+	err = db.QueryRow(checkUserQuery, principal.UserId).Scan(&petugasKesehatanId, &skpdId)
 	if err != nil {
 		response := object.NewResponse(http.StatusUnauthorized, "Health worker profile not found", nil)
 		if err := response.WriteJson(w); err != nil {
@@ -156,9 +128,18 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 	idParam := r.URL.Query().Get("id")
 	statusParam := r.URL.Query().Get("status")
 
+	// Validate status filter
+	if statusParam != "" && !object.IsIntervensiStatus(statusParam) {
+		response := object.NewResponse(http.StatusBadRequest, "status must be one of: planned, in_progress, completed, cancelled", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
 	if idParam != "" {
 		// Get specific assigned intervention by ID
-		intervention, err := getAssignedIntervensiByIdForUser(db, idParam, petugasKesehatanId)
+		intervention, err := getAssignedIntervensiByIdForUser(db, s.store.Keys, idParam, petugasKesehatanId)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				response := object.NewResponse(http.StatusNotFound, "Intervention not found or not assigned to you", nil)
@@ -174,6 +155,19 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Record the read in the access log
+		access := audit.NewAccess(r, "balita", []string{intervention.IdBalita})
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&intervention)
 		response := object.NewResponse(http.StatusOK, "Assigned intervention retrieved successfully", getAssignedIntervensiByIdResponse{
 			Data: intervention,
 		})
@@ -182,7 +176,7 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		// Get all assigned interventions for this user
-		interventionList, total, err := getAllAssignedIntervensiForUser(db, petugasKesehatanId, statusParam)
+		interventionList, total, err := getAllAssignedIntervensiForUser(db, s.store.Keys, petugasKesehatanId, statusParam)
 		if err != nil {
 			response := object.NewResponse(http.StatusInternalServerError, "Failed to get assigned interventions", nil)
 			if err := response.WriteJson(w); err != nil {
@@ -196,6 +190,23 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 			message = "Filtered assigned interventions retrieved successfully"
 		}
 
+		// Record the read in the access log
+		ids := make([]string, len(interventionList))
+		for i, intervention := range interventionList {
+			ids[i] = intervention.IdBalita
+		}
+		access := audit.NewAccess(r, "balita", ids)
+		access.Privileged = masker.Unmasked()
+		err = audit.RecordAccess(s.db, access)
+		if err != nil {
+			response := object.NewResponse(http.StatusInternalServerError, "Failed to record access log", nil)
+			if err := response.WriteJson(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		masker.Apply(&interventionList)
 		response := object.NewResponse(http.StatusOK, message, getAllAssignedIntervensiResponse{
 			Data:  interventionList,
 			Total: total,
@@ -206,8 +217,27 @@ func AssignmentGet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// Helper function to lock an intervention and read it for specific health
+// worker inside a transaction, so its status cannot change before the
+// transaction commits
+func lockAssignedIntervensiForUser(tx *sql.Tx, keys *fieldcrypt.Keyring, interventionId string, petugasKesehatanId string) (assignedIntervensiResponse, error) {
+	var id string
+	lockQuery := "SELECT id FROM intervensi WHERE id = ? AND deleted_date IS NULL FOR UPDATE"
+	err := tx.QueryRow(lockQuery, interventionId).Scan(&id)
+	if err != nil {
+		return assignedIntervensiResponse{}, err
+	}
+
+	return getAssignedIntervensiByIdForUser(tx, keys, interventionId, petugasKesehatanId)
+}
+
 // Helper function to get assigned intervention by ID for specific health worker
-func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugasKesehatanId string) (assignedIntervensiResponse, error) {
+func getAssignedIntervensiByIdForUser(db querier, keys *fieldcrypt.Keyring, interventionId string, petugasKesehatanId string) (assignedIntervensiResponse, error) {
 	var intervention assignedIntervensiResponse
 
 	query := `
@@ -218,11 +248,13 @@ func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugas
             i.jenis as jenis_intervensi,
             i.tanggal as tanggal_intervensi,
             i.deskripsi as deskripsi_intervensi,
-            i.hasil as hasil_intervensi,
+            COALESCE(i.hasil, '') as hasil_intervensi,
+            i.status as status_intervensi,
             i.created_date as tanggal_penugasan,
             b.nama as nama_balita,
             b.tanggal_lahir as tanggal_lahir_balita,
             b.jenis_kelamin as jenis_kelamin_balita,
+            k.id as id_keluarga,
             k.nomor_kk,
             k.nama_ayah,
             k.nama_ibu,
@@ -242,6 +274,7 @@ func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugas
         AND k.deleted_date IS NULL
     `
 
+	var idKeluarga string
 	err := db.QueryRow(query, petugasKesehatanId, interventionId).Scan(
 		&intervention.Id,
 		&intervention.IdIntervensi,
@@ -250,10 +283,12 @@ func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugas
 		&intervention.TanggalIntervensi,
 		&intervention.DeskripsiIntervensi,
 		&intervention.HasilIntervensi,
+		&intervention.StatusIntervensi,
 		&intervention.TanggalPenugasan,
 		&intervention.NamaBalita,
 		&intervention.TanggalLahirBalita,
 		&intervention.JenisKelaminBalita,
+		&idKeluarga,
 		&intervention.NomorKk,
 		&intervention.NamaAyah,
 		&intervention.NamaIbu,
@@ -266,6 +301,12 @@ func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugas
 		return intervention, err
 	}
 
+	// Decrypt the nomor KK
+	intervention.NomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, idKeluarga, intervention.NomorKk)
+	if err != nil {
+		return intervention, err
+	}
+
 	// Calculate age
 	intervention.UmurBalita = calculateAgeInMonths(intervention.TanggalLahirBalita)
 
@@ -273,14 +314,17 @@ func getAssignedIntervensiByIdForUser(db *sql.DB, interventionId string, petugas
 	err = getIntervensiAdditionalInfo(db, &intervention)
 	if err != nil {
 		// Log error but don't fail the request
-		// Additional info is not critical for basic functionality
+		// Additional info is not critical for basic functionality, the
+		// permissions still follow the intervention status
+		intervention.CanAddMedicalRecord = object.CanRecordPemeriksaan(intervention.StatusIntervensi)
+		intervention.CanUpdateStatus = !object.IsIntervensiFinal(intervention.StatusIntervensi)
 	}
 
 	return intervention, nil
 }
 
 // Helper function to get all assigned interventions for specific health worker
-func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, statusFilter string) ([]assignedIntervensiResponse, int, error) {
+func getAllAssignedIntervensiForUser(db *sql.DB, keys *fieldcrypt.Keyring, petugasKesehatanId string, statusFilter string) ([]assignedIntervensiResponse, int, error) {
 	var interventionList []assignedIntervensiResponse
 
 	// Build query based on status filter
@@ -292,11 +336,13 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
             i.jenis as jenis_intervensi,
             i.tanggal as tanggal_intervensi,
             i.deskripsi as deskripsi_intervensi,
-            i.hasil as hasil_intervensi,
+            COALESCE(i.hasil, '') as hasil_intervensi,
+            i.status as status_intervensi,
             i.created_date as tanggal_penugasan,
             b.nama as nama_balita,
             b.tanggal_lahir as tanggal_lahir_balita,
             b.jenis_kelamin as jenis_kelamin_balita,
+            k.id as id_keluarga,
             k.nomor_kk,
             k.nama_ayah,
             k.nama_ibu,
@@ -317,14 +363,8 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 
 	// Add status filter if provided
 	if statusFilter != "" {
-		switch statusFilter {
-		case "pending":
-			query += " AND (i.hasil IS NULL OR i.hasil = '')"
-		case "in_progress":
-			query += " AND i.hasil IS NOT NULL AND i.hasil != '' AND i.hasil NOT LIKE '%selesai%' AND i.hasil NOT LIKE '%completed%'"
-		case "completed":
-			query += " AND (i.hasil LIKE '%selesai%' OR i.hasil LIKE '%completed%')"
-		}
+		query += " AND i.status = ?"
+		args = append(args, statusFilter)
 	}
 
 	query += " ORDER BY i.tanggal DESC, i.created_date DESC"
@@ -337,6 +377,7 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 
 	for rows.Next() {
 		var intervention assignedIntervensiResponse
+		var idKeluarga string
 
 		err := rows.Scan(
 			&intervention.Id,
@@ -346,10 +387,12 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 			&intervention.TanggalIntervensi,
 			&intervention.DeskripsiIntervensi,
 			&intervention.HasilIntervensi,
+			&intervention.StatusIntervensi,
 			&intervention.TanggalPenugasan,
 			&intervention.NamaBalita,
 			&intervention.TanggalLahirBalita,
 			&intervention.JenisKelaminBalita,
+			&idKeluarga,
 			&intervention.NomorKk,
 			&intervention.NamaAyah,
 			&intervention.NamaIbu,
@@ -362,6 +405,12 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 			return nil, 0, err
 		}
 
+		// Decrypt the nomor KK
+		intervention.NomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, idKeluarga, intervention.NomorKk)
+		if err != nil {
+			return nil, 0, err
+		}
+
 		// Calculate age
 		intervention.UmurBalita = calculateAgeInMonths(intervention.TanggalLahirBalita)
 
@@ -369,9 +418,9 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 		err = getIntervensiAdditionalInfo(db, &intervention)
 		if err != nil {
 			// Log error but don't fail the request
-			// Set default values for failed additional info
-			intervention.CanAddMedicalRecord = true
-			intervention.CanUpdateStatus = true
+			// The permissions still follow the intervention status
+			intervention.CanAddMedicalRecord = object.CanRecordPemeriksaan(intervention.StatusIntervensi)
+			intervention.CanUpdateStatus = !object.IsIntervensiFinal(intervention.StatusIntervensi)
 			intervention.JumlahLaporanTerkait = 0
 		}
 
@@ -398,14 +447,8 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 
 	// Add status filter to count query if provided
 	if statusFilter != "" {
-		switch statusFilter {
-		case "pending":
-			countQuery += " AND (i.hasil IS NULL OR i.hasil = '')"
-		case "in_progress":
-			countQuery += " AND i.hasil IS NOT NULL AND i.hasil != '' AND i.hasil NOT LIKE '%selesai%' AND i.hasil NOT LIKE '%completed%'"
-		case "completed":
-			countQuery += " AND (i.hasil LIKE '%selesai%' OR i.hasil LIKE '%completed%')"
-		}
+		countQuery += " AND i.status = ?"
+		countArgs = append(countArgs, statusFilter)
 	}
 
 	err = db.QueryRow(countQuery, countArgs...).Scan(&total)
@@ -417,17 +460,7 @@ func getAllAssignedIntervensiForUser(db *sql.DB, petugasKesehatanId string, stat
 }
 
 // Helper function to get additional information for intervention
-func getIntervensiAdditionalInfo(db *sql.DB, intervention *assignedIntervensiResponse) error {
-	// Determine intervention status based on hasil field
-	if intervention.HasilIntervensi == "" {
-		intervention.StatusIntervensi = "pending"
-	} else if intervention.HasilIntervensi != "" &&
-		!(intervention.HasilIntervensi == "selesai" || intervention.HasilIntervensi == "completed") {
-		intervention.StatusIntervensi = "in_progress"
-	} else {
-		intervention.StatusIntervensi = "completed"
-	}
-
+func getIntervensiAdditionalInfo(db querier, intervention *assignedIntervensiResponse) error {
 	// Get latest medical examination data
 	var latestGiziStatus sql.NullString
 	var latestExamDate sql.NullString
@@ -486,10 +519,10 @@ func getIntervensiAdditionalInfo(db *sql.DB, intervention *assignedIntervensiRes
 
 	// Determine permissions
 	// Can add medical record if intervention is in progress or completed
-	intervention.CanAddMedicalRecord = intervention.StatusIntervensi != "pending"
+	intervention.CanAddMedicalRecord = object.CanRecordPemeriksaan(intervention.StatusIntervensi)
 
-	// Can update status if intervention is not completed
-	intervention.CanUpdateStatus = intervention.StatusIntervensi != "completed"
+	// Can update status if intervention is not completed or cancelled
+	intervention.CanUpdateStatus = !object.IsIntervensiFinal(intervention.StatusIntervensi)
 
 	return nil
 }
@@ -0,0 +1,167 @@
+package healthworker
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type updateAssignmentRequest struct {
+	IdIntervensi string `json:"id_intervensi"`
+	Hasil        string `json:"hasil"`
+}
+
+func (r *updateAssignmentRequest) validate() error {
+	// ID Intervensi validation (wajib)
+	if r.IdIntervensi == "" {
+		return fmt.Errorf("id intervensi is required")
+	}
+
+	// Hasil validation
+	if r.Hasil == "" {
+		return fmt.Errorf("hasil is required")
+	}
+	if len(r.Hasil) < 5 || len(r.Hasil) > 500 {
+		return fmt.Errorf("hasil must be between 5-500 characters")
+	}
+
+	return nil
+}
+
+type updateAssignmentResponse struct {
+	IdIntervensi     string `json:"id_intervensi"`
+	StatusIntervensi string `json:"status_intervensi"`
+}
+
+// # AssignmentUpdate handles reporting intervention progress by health workers
+//
+// @Summary Update intervention progress (Health Worker)
+// @Description Update the hasil (progress notes) of an intervention assigned to the authenticated health worker
+// @Description
+// @Description - id_intervensi: assigned intervention
+// @Description - hasil: progress notes (5-500 characters)
+// @Description
+// @Description Reporting progress on a planned intervention moves it to in_progress.
+// @Description Only interventions assigned to the health worker can be updated.
+// @Description Completed or cancelled interventions cannot be updated anymore.
+// @Tags health-worker
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param assignment body updateAssignmentRequest true "Intervention progress"
+// @Success 200 {object} object.Response{data=updateAssignmentResponse} "Intervention progress updated successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden - Health worker role required"
+// @Failure 404 {object} object.Response{data=nil} "Intervention not found or not assigned to user"
+// @Failure 409 {object} object.Response{data=nil} "Intervention status changed concurrently"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/health-worker/assignment/update [put]
+func (s *Service) AssignmentUpdate(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req updateAssignmentRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	var message string
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Lock the intervention and check if it is assigned to this health worker
+		intervention, err := lockAssignedIntervensiForUser(tx, s.store.Keys, req.IdIntervensi, principal.PetugasKesehatanId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervention not found or not assigned to you")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get intervention")
+		}
+
+		// Business logic: Completed or cancelled interventions cannot be updated
+		if !intervention.CanUpdateStatus {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Intervention %s for balita '%s' is already %s and cannot be updated",
+					intervention.JenisIntervensi, intervention.NamaBalita, intervention.StatusIntervensi))
+		}
+
+		// Keep the current row for the audit log
+		before, err := audit.Snapshot(tx, "intervensi", req.IdIntervensi)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to read intervensi for audit log")
+		}
+
+		// Reporting progress on a planned intervention starts it
+		if intervention.StatusIntervensi == object.IntervensiPlanned {
+			err = object.TransitionIntervensi(tx, req.IdIntervensi, object.IntervensiPlanned, object.IntervensiInProgress, req.Hasil, principal.UserId)
+		} else {
+			err = updateIntervensiHasil(tx, req.IdIntervensi, req.Hasil, principal.UserId)
+		}
+		if err != nil {
+			if errors.Is(err, object.ErrIntervensiStatusChanged) {
+				return object.NewHTTPError(http.StatusConflict, "Intervention status was changed by another user, please reload")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to update intervention progress")
+		}
+
+		message = fmt.Sprintf("Progress intervensi %s untuk balita '%s' berhasil diperbarui",
+			intervention.JenisIntervensi, intervention.NamaBalita)
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionUpdate, "intervensi", req.IdIntervensi), before)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, message, updateAssignmentResponse{
+		IdIntervensi:     req.IdIntervensi,
+		StatusIntervensi: object.IntervensiInProgress,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Helper function to update hasil of an intervention that is in progress
+func updateIntervensiHasil(tx *sql.Tx, interventionId string, hasil string, userId string) error {
+	currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+	updateQuery := `UPDATE intervensi SET hasil = ?, updated_id = ?, updated_date = ?
+        WHERE id = ? AND status = ? AND deleted_date IS NULL`
+	_, err := tx.Exec(updateQuery, hasil, userId, currentTime, interventionId, object.IntervensiInProgress)
+	return err
+}
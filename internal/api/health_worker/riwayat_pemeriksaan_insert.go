@@ -0,0 +1,137 @@
+package healthworker
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/growth"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type insertRiwayatPemeriksaanResponse struct {
+	Id         string         `json:"id"`
+	StatusGizi string         `json:"status_gizi"`
+	Assessment *growth.Result `json:"assessment,omitempty"`
+}
+
+// # RiwayatPemeriksaanInsert handles recording an examination by health workers
+//
+// @Summary Insert riwayat pemeriksaan (Health Worker)
+// @Description Insert new riwayat pemeriksaan data for a balita on an intervention
+// @Description assigned to the authenticated health worker
+// @Description
+// @Description Creates a new riwayat pemeriksaan record with:
+// @Description - id_balita: balita being examined
+// @Description - id_intervensi: related intervention program
+// @Description - id_laporan_masyarakat: related masyarakat report
+// @Description - tanggal: examination date (YYYY-MM-DD format)
+// @Description - berat_badan: weight in kg (decimal)
+// @Description - tinggi_badan: height in cm (decimal)
+// @Description - status_gizi: optional nutritional status (normal, stunting, gizi buruk)
+// @Description - keterangan: examination notes and recommendations
+// @Description
+// @Description Status gizi and z-scores (TB/U, BB/U, BB/TB) are computed from the WHO 2006
+// @Description Child Growth Standards. A submitted status gizi that contradicts the computed
+// @Description one is rejected. Status gizi is only required for balita outside the WHO range.
+// @Description
+// @Description The balita must be the subject of the intervention and the intervention
+// @Description status must be in_progress or completed.
+// @Tags health-worker
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param riwayat body object.RiwayatPemeriksaanInput true "Riwayat pemeriksaan data"
+// @Success 200 {object} object.Response{data=insertRiwayatPemeriksaanResponse} "Riwayat pemeriksaan inserted successfully"
+// @Failure 400 {object} object.Response{data=nil} "Invalid request"
+// @Failure 401 {object} object.Response{data=nil} "Unauthorized"
+// @Failure 403 {object} object.Response{data=nil} "Forbidden - Health worker role required"
+// @Failure 404 {object} object.Response{data=nil} "Intervention not found or not assigned to user"
+// @Failure 500 {object} object.Response{data=nil} "Internal server error"
+// @Router /api/health-worker/riwayat-pemeriksaan/insert [post]
+func (s *Service) RiwayatPemeriksaanInsert(w http.ResponseWriter, r *http.Request) {
+	principal := middleware.GetPrincipal(r)
+
+	// Parse request body
+	var req object.RiwayatPemeriksaanInput
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, "Invalid request body", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Validate request
+	err = req.Validate()
+	if err != nil {
+		response := object.NewResponse(http.StatusBadRequest, err.Error(), nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Connect to database
+	db := s.db
+
+	var inserted object.InsertedPemeriksaan
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		// Lock the intervention and check if it is assigned to this health worker
+		intervention, err := lockAssignedIntervensiForUser(tx, s.store.Keys, req.IdIntervensi, principal.PetugasKesehatanId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return object.NewHTTPError(http.StatusNotFound, "Intervention not found or not assigned to you")
+			}
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to get intervention")
+		}
+
+		// Validate that balita is the subject of the intervention
+		if intervention.IdBalita != req.IdBalita {
+			return object.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("Balita is not the subject of intervensi %s. Intervensi is for balita '%s'.",
+					intervention.JenisIntervensi, intervention.NamaBalita))
+		}
+
+		inserted, err = object.InsertRiwayatPemeriksaan(tx, req, object.PemeriksaanSubject{
+			NamaBalita:         intervention.NamaBalita,
+			TanggalLahirBalita: intervention.TanggalLahirBalita,
+			JenisKelaminBalita: intervention.JenisKelaminBalita,
+			JenisIntervensi:    intervention.JenisIntervensi,
+			TanggalIntervensi:  intervention.TanggalIntervensi,
+			StatusIntervensi:   intervention.StatusIntervensi,
+		}, principal.UserId)
+		if err != nil {
+			return err
+		}
+
+		// Record the change in the audit log
+		err = audit.RecordChange(tx, audit.NewEntry(r, audit.ActionCreate, "riwayat_pemeriksaan", strconv.FormatInt(inserted.Id, 10)), nil)
+		if err != nil {
+			return object.NewHTTPError(http.StatusInternalServerError, "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		response := object.ErrorResponse(err)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := object.NewResponse(http.StatusOK, inserted.Message, insertRiwayatPemeriksaanResponse{
+		Id:         strconv.FormatInt(inserted.Id, 10),
+		StatusGizi: inserted.StatusGizi,
+		Assessment: inserted.Assessment,
+	})
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
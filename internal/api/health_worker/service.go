@@ -0,0 +1,21 @@
+package healthworker
+
+import (
+	"database/sql"
+
+	"github.com/rifqidaiva/stunting-web/internal/config"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+// Service holds the dependencies shared by the petugas kesehatan (health worker) handlers.
+type Service struct {
+	config *config.Config
+	db     *sql.DB
+	store  *store.Store
+}
+
+// NewService creates a Service using the given configuration, the shared
+// database pool and the repositories built on top of it.
+func NewService(cfg *config.Config, db *sql.DB, st *store.Store) *Service {
+	return &Service{config: cfg, db: db, store: st}
+}
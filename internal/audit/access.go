@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+)
+
+// MARK: Access
+
+// PurposeHeader is the request header in which the client states why it
+// reads personal data. The tujuan query parameter is accepted as well.
+const PurposeHeader = "X-Access-Purpose"
+
+// accessBatchSize is the number of ids inserted per statement.
+const accessBatchSize = 500
+
+// Access is a read of personal data, stored in access_log with the ids of
+// the returned records in access_log_record.
+type Access struct {
+	ActorId   string // pengguna.id of the viewer
+	RequestId string
+	Endpoint  string // path of the request
+	Purpose   string
+	IPAddress string
+
+	Entity string   // table name of the returned records, e.g. "balita"
+	Ids    []string // ids of the returned records
+
+	// Privileged marks a read of the unmasked personal identifiers.
+	Privileged bool
+}
+
+// NewAccess creates an Access for the records of entity returned to the
+// caller of r.
+func NewAccess(r *http.Request, entity string, ids []string) Access {
+	purpose := r.Header.Get(PurposeHeader)
+	if purpose == "" {
+		purpose = r.URL.Query().Get("tujuan")
+	}
+	if len(purpose) > 255 {
+		purpose = purpose[:255]
+	}
+
+	return Access{
+		ActorId:   middleware.GetPrincipal(r).UserId,
+		RequestId: middleware.GetRequestId(r),
+		Endpoint:  r.URL.Path,
+		Purpose:   strings.TrimSpace(purpose),
+		IPAddress: ClientIP(r),
+		Entity:    entity,
+		Ids:       ids,
+	}
+}
+
+// RecordAccess writes a to the access log. Handlers call it before sending
+// the records, so data is never returned without a trace.
+func RecordAccess(db *sql.DB, a Access) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var actorId any
+	if a.ActorId != "" {
+		actorId = a.ActorId
+	}
+	var requestId any
+	if a.RequestId != "" {
+		requestId = a.RequestId
+	}
+
+	query := `
+        INSERT INTO access_log (id_pengguna, id_request, endpoint, tujuan, entitas, jumlah, privileged, ip_address, created_date)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	result, err := tx.Exec(query,
+		actorId,
+		requestId,
+		a.Endpoint,
+		a.Purpose,
+		a.Entity,
+		len(a.Ids),
+		a.Privileged,
+		a.IPAddress,
+		time.Now().Format("2006-01-02 15:04:05"),
+	)
+	if err != nil {
+		return err
+	}
+	accessId, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	// The same record can appear twice in a listing, e.g. a balita with
+	// several laporan
+	ids := make([]string, 0, len(a.Ids))
+	seen := make(map[string]bool, len(a.Ids))
+	for _, id := range a.Ids {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for start := 0; start < len(ids); start += accessBatchSize {
+		batch := ids[start:min(start+accessBatchSize, len(ids))]
+
+		placeholders := make([]string, len(batch))
+		args := make([]any, 0, 2*len(batch))
+		for i, id := range batch {
+			placeholders[i] = "(?, ?)"
+			args = append(args, accessId, id)
+		}
+
+		_, err = tx.Exec("INSERT INTO access_log_record (id_access_log, id_entitas) VALUES "+strings.Join(placeholders, ", "), args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,111 @@
+// Package audit records security relevant actions and every change to the
+// domain tables in the audit_log table.
+//
+// Changes are recorded with the values of the changed columns before and
+// after, see Snapshot and RecordChange. Entries are written with the same
+// transaction as the change they describe, so an action is never stored
+// without its audit entry and an audit entry never outlives a rolled back
+// change.
+package audit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+)
+
+// Actions stored in audit_log.aksi.
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+	ActionRevoke  = "revoke"
+	ActionMerge   = "merge"
+)
+
+// Execer is implemented by *sql.DB and *sql.Tx.
+type Execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// Entry is a single audit_log row.
+type Entry struct {
+	// ActorId is the pengguna.id that performed the action. It is empty for
+	// actions without an authenticated user, such as the admin bootstrap.
+	ActorId string
+
+	Action   string // one of the Action constants
+	Entity   string // table name of the affected row, e.g. "pengguna"
+	EntityId string // id of the affected row
+
+	Detail    string
+	IPAddress string
+	RequestId string // id given by middleware.WithRequestId
+
+	// Changes holds the changed columns of the row, see Diff.
+	Changes map[string]Change
+}
+
+// NewEntry creates an entry for an action performed by the caller of r.
+func NewEntry(r *http.Request, action, entity, entityId string) Entry {
+	return Entry{
+		ActorId:   middleware.GetPrincipal(r).UserId,
+		Action:    action,
+		Entity:    entity,
+		EntityId:  entityId,
+		IPAddress: ClientIP(r),
+		RequestId: middleware.GetRequestId(r),
+	}
+}
+
+// Record writes e to the audit log.
+func Record(exec Execer, e Entry) error {
+	query := `
+        INSERT INTO audit_log (id_pengguna, aksi, entitas, id_entitas, id_request, keterangan, perubahan, ip_address, created_date)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	var actorId any
+	if e.ActorId != "" {
+		actorId = e.ActorId
+	}
+	var requestId any
+	if e.RequestId != "" {
+		requestId = e.RequestId
+	}
+	var changes any
+	if e.Changes != nil {
+		encoded, err := json.Marshal(e.Changes)
+		if err != nil {
+			return err
+		}
+		changes = string(encoded)
+	}
+
+	_, err := exec.Exec(query,
+		actorId,
+		e.Action,
+		e.Entity,
+		e.EntityId,
+		requestId,
+		e.Detail,
+		changes,
+		e.IPAddress,
+		time.Now().Format("2006-01-02 15:04:05"),
+	)
+	return err
+}
+
+// ClientIP returns the address of the peer that sent r. Forwarding headers
+// are ignored because they can be set by any client.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
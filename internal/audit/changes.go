@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// MARK: Changes
+
+// Row holds the column values of a table row. NULL columns are nil, every
+// other value is its text form as returned by the database.
+type Row map[string]any
+
+// Change is the value of a column before and after an action.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Tx is implemented by *sql.Tx and *sql.DB.
+type Tx interface {
+	Execer
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// ignoredColumns are kept out of the changes, the entry itself stores who
+// made the change and when.
+var ignoredColumns = []string{"created_id", "created_date", "updated_id", "updated_date"}
+
+// redactedColumns are recorded as changed without their values.
+var redactedColumns = []string{"password_hash"}
+
+// Redacted replaces the value of a redacted column in a Change.
+const Redacted = "[redacted]"
+
+// Snapshot reads the row of table with the given id. It returns a nil Row
+// when the row does not exist, e.g. before an insert.
+//
+// table must be a table name from the code, it is not escaped.
+func Snapshot(tx Tx, table, id string) (Row, error) {
+	rows, err := tx.Query(fmt.Sprintf("SELECT * FROM %s WHERE id = ?", table), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	row := make(Row, len(columns))
+	for i, column := range columns {
+		if values[i].Valid {
+			row[column] = values[i].String
+		} else {
+			row[column] = nil
+		}
+	}
+	return row, rows.Err()
+}
+
+// Diff returns the columns whose value differs between before and after.
+// A nil before is an inserted row, a nil after a removed one.
+//
+// Encrypted columns, the ones with a blind index column, are compared by
+// their index since every encryption gives a different value, and are
+// recorded without their values. The index columns are left out.
+func Diff(before, after Row) map[string]Change {
+	changes := make(map[string]Change)
+	for _, row := range []Row{before, after} {
+		for column := range row {
+			if slices.Contains(ignoredColumns, column) {
+				continue
+			}
+			if _, done := changes[column]; done {
+				continue
+			}
+			if base, ok := strings.CutSuffix(column, fieldcrypt.IndexColumn("")); ok && hasColumn(row, base) {
+				continue
+			}
+
+			oldValue, newValue := before[column], after[column]
+			if index := fieldcrypt.IndexColumn(column); hasColumn(row, index) {
+				if before[index] == after[index] && before != nil && after != nil {
+					continue
+				}
+				changes[column] = Change{Old: redact(oldValue), New: redact(newValue)}
+				continue
+			}
+
+			if oldValue == newValue {
+				continue
+			}
+			if slices.Contains(redactedColumns, column) {
+				oldValue, newValue = Redacted, Redacted
+			}
+			changes[column] = Change{Old: oldValue, New: newValue}
+		}
+	}
+	return changes
+}
+
+// Helper function to check whether row has column
+func hasColumn(row Row, column string) bool {
+	_, ok := row[column]
+	return ok
+}
+
+// Helper function to hide a non-NULL value
+func redact(value any) any {
+	if value == nil {
+		return nil
+	}
+	return Redacted
+}
+
+// RecordChange reads the current row of e.Entity with id e.EntityId and
+// records e with the columns that differ from before, the Snapshot taken
+// before the change. It must be called with the transaction of the change.
+func RecordChange(tx Tx, e Entry, before Row) error {
+	after, err := Snapshot(tx, e.Entity, e.EntityId)
+	if err != nil {
+		return err
+	}
+	e.Changes = Diff(before, after)
+	return Record(tx, e)
+}
@@ -0,0 +1,146 @@
+// Package cluster groups map points into clusters for the low zoom levels
+// of a web map.
+//
+// Points are projected to Web Mercator pixels, as used by Leaflet with 256
+// pixel tiles, and grouped by a grid of Radius pixels at the zoom level.
+// A cluster is placed at the mean of its points, so the clusters do not
+// reveal where a single household lives.
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TileSize is the size in pixels of a map tile.
+const TileSize = 256
+
+// Radius is the size in pixels of a grid cell.
+const Radius = 60
+
+// MaxZoom is the highest zoom level of the map.
+const MaxZoom = 20
+
+// BBox is a bounding box in degrees.
+type BBox struct {
+	MinLon, MinLat, MaxLon, MaxLat float64
+}
+
+// ParseBBox parses a bounding box given as "minLon,minLat,maxLon,maxLat",
+// the format of Leaflet's LatLngBounds.toBBoxString.
+func ParseBBox(value string) (BBox, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return BBox{}, fmt.Errorf("bbox must be minLon,minLat,maxLon,maxLat")
+	}
+
+	var values [4]float64
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return BBox{}, fmt.Errorf("bbox must be minLon,minLat,maxLon,maxLat")
+		}
+		values[i] = v
+	}
+
+	b := BBox{MinLon: values[0], MinLat: values[1], MaxLon: values[2], MaxLat: values[3]}
+	if b.MinLon > b.MaxLon || b.MinLat > b.MaxLat {
+		return BBox{}, fmt.Errorf("bbox minimum must not be greater than its maximum")
+	}
+	if b.MinLon < -180 || b.MaxLon > 180 || b.MinLat < -90 || b.MaxLat > 90 {
+		return BBox{}, fmt.Errorf("bbox must be within -180,-90,180,90")
+	}
+	return b, nil
+}
+
+// Contains reports whether the point lies in the bounding box.
+func (b BBox) Contains(lon, lat float64) bool {
+	return lon >= b.MinLon && lon <= b.MaxLon && lat >= b.MinLat && lat <= b.MaxLat
+}
+
+// Point is a point to cluster. Index refers to the item it belongs to,
+// e.g. its position in a feature list.
+type Point struct {
+	Lon, Lat float64
+	Index    int
+}
+
+// Cluster is a group of points.
+type Cluster struct {
+	Lon, Lat float64 // mean of the points
+	Indexes  []int   // of the points, in input order
+
+	// ExpansionZoom is the lowest zoom level at which the points no longer
+	// fall in a single cell, MaxZoom when they never split, e.g. points at
+	// the same koordinat.
+	ExpansionZoom int
+}
+
+// Grid groups the points by grid cell at the zoom level. Clusters are
+// ordered from west to east, then north to south, so the result does not
+// depend on the order of the points.
+func Grid(points []Point, zoom int) []Cluster {
+	type cell struct{ x, y int }
+	byCell := map[cell][]Point{}
+	var cells []cell
+	for _, p := range points {
+		x, y := project(p.Lon, p.Lat, zoom)
+		c := cell{int(math.Floor(x / Radius)), int(math.Floor(y / Radius))}
+		if _, ok := byCell[c]; !ok {
+			cells = append(cells, c)
+		}
+		byCell[c] = append(byCell[c], p)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].x != cells[j].x {
+			return cells[i].x < cells[j].x
+		}
+		return cells[i].y < cells[j].y
+	})
+
+	clusters := make([]Cluster, 0, len(cells))
+	for _, c := range cells {
+		members := byCell[c]
+		cluster := Cluster{Indexes: make([]int, len(members)), ExpansionZoom: expansionZoom(members, zoom)}
+		for i, p := range members {
+			cluster.Lon += p.Lon
+			cluster.Lat += p.Lat
+			cluster.Indexes[i] = p.Index
+		}
+		cluster.Lon /= float64(len(members))
+		cluster.Lat /= float64(len(members))
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+// Helper function to find the lowest zoom level above zoom at which the
+// points fall in more than one cell
+func expansionZoom(points []Point, zoom int) int {
+	for z := zoom + 1; z < MaxZoom; z++ {
+		x0, y0 := project(points[0].Lon, points[0].Lat, z)
+		for _, p := range points[1:] {
+			x, y := project(p.Lon, p.Lat, z)
+			if math.Floor(x/Radius) != math.Floor(x0/Radius) || math.Floor(y/Radius) != math.Floor(y0/Radius) {
+				return z
+			}
+		}
+	}
+	return MaxZoom
+}
+
+// Helper function to project a point to Web Mercator pixels at the zoom
+// level. Latitudes beyond the Mercator limit are clamped.
+func project(lon, lat float64, zoom int) (float64, float64) {
+	const maxLat = 85.05112878
+	lat = math.Max(-maxLat, math.Min(maxLat, lat))
+
+	size := TileSize * math.Exp2(float64(zoom))
+	sin := math.Sin(lat * math.Pi / 180)
+	x := (lon + 180) / 360 * size
+	y := (0.5 - math.Log((1+sin)/(1-sin))/(4*math.Pi)) * size
+	return x, y
+}
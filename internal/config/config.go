@@ -0,0 +1,488 @@
+// Package config loads the application configuration from an optional JSON
+// file and environment variables.
+//
+// Values are resolved in this order, later sources override earlier ones:
+//  1. built-in defaults (suitable for local development only)
+//  2. the JSON file named by STUNTING_CONFIG, if set
+//  3. STUNTING_* environment variables
+//
+// The resulting Config is validated before it is returned, so a
+// misconfigured deployment fails at startup instead of at the first request.
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Supported values for Config.Env.
+const (
+	EnvDevelopment = "development"
+	EnvStaging     = "staging"
+	EnvProduction  = "production"
+)
+
+// DefaultJWTSecret is the signing key used when none is configured.
+// It is public knowledge and only accepted in development mode.
+const DefaultJWTSecret = "secret_key"
+
+// Development encryption keys, used in development mode when none are
+// configured. Like DefaultJWTSecret they are public knowledge.
+const (
+	DefaultEncryptionKeyId = "development"
+	DefaultEncryptionKey   = "ZGV2ZWxvcG1lbnQgZW5jcnlwdGlvbiBrZXkgMDAwMDA="
+	DefaultIndexKey        = "ZGV2ZWxvcG1lbnQgYmxpbmQgaW5kZXgga2V5IDAwMDA="
+)
+
+// encryptionKeySize is the decoded size of every encryption key.
+const encryptionKeySize = 32
+
+// minJWTSecretLength is the minimum secret length outside development mode.
+const minJWTSecretLength = 32
+
+// minBootstrapTokenLength is the minimum bootstrap token length outside
+// development mode.
+const minBootstrapTokenLength = 32
+
+// Config is the complete application configuration.
+type Config struct {
+	// Env is one of "development", "staging" or "production".
+	Env string `json:"env"`
+
+	Server   ServerConfig   `json:"server"`
+	Database DatabaseConfig `json:"database"`
+	JWT      JWTConfig      `json:"jwt"`
+	Auth     AuthConfig     `json:"auth"`
+	CORS     CORSConfig     `json:"cors"`
+
+	Encryption EncryptionConfig `json:"encryption"`
+}
+
+// ServerConfig configures the HTTP listener.
+type ServerConfig struct {
+	// Addr is the listen address, e.g. "localhost:8080" or ":8080".
+	Addr string `json:"addr"`
+
+	// PublicURL is the externally visible base URL of the API, used for the
+	// Swagger documentation, e.g. "https://stunting.example.go.id".
+	PublicURL string `json:"public_url"`
+}
+
+// DatabaseConfig configures the MySQL connection and its pool.
+type DatabaseConfig struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+	Host     string `json:"host"`
+	Port     string `json:"port"`
+	Name     string `json:"name"`
+
+	// MaxOpenConns limits the connections the pool opens to MySQL. Keep it
+	// well below the server's max_connections.
+	MaxOpenConns int `json:"max_open_conns"`
+
+	// MaxIdleConns is the number of idle connections kept for reuse.
+	MaxIdleConns int `json:"max_idle_conns"`
+
+	// ConnMaxLifetime closes connections after this age, so they are
+	// recycled before MySQL's wait_timeout drops them.
+	ConnMaxLifetime Duration `json:"conn_max_lifetime"`
+
+	// ConnMaxIdleTime closes connections that were idle this long.
+	ConnMaxIdleTime Duration `json:"conn_max_idle_time"`
+}
+
+// JWTConfig configures token signing.
+type JWTConfig struct {
+	Secret string `json:"secret"`
+
+	// TTL is the lifetime of an access token. Keep it short, clients renew
+	// it with their refresh token.
+	TTL Duration `json:"ttl"`
+
+	// RefreshTTL is the lifetime of a refresh token. Every refresh issues a
+	// new refresh token valid for RefreshTTL.
+	RefreshTTL Duration `json:"refresh_ttl"`
+}
+
+// AuthConfig configures account management.
+type AuthConfig struct {
+	// BootstrapToken allows creating the first admin account through
+	// /api/auth/register_admin while no admin exists yet. Leave it empty to
+	// only let existing admins create admin accounts.
+	BootstrapToken string `json:"bootstrap_token"`
+}
+
+// CORSConfig configures cross-origin requests from the web frontend.
+type CORSConfig struct {
+	// AllowedOrigins lists the origins allowed to call the API.
+	// An empty list disables CORS headers, "*" allows every origin.
+	AllowedOrigins []string `json:"allowed_origins"`
+}
+
+// EncryptionConfig configures the encryption of personal data at rest, see
+// package fieldcrypt. Keys are base64 encoded 32 byte values, e.g. the
+// output of "openssl rand -base64 32".
+type EncryptionConfig struct {
+	// Keys maps a key id to a key encryption key. A key must stay listed
+	// until the rotate-keys command re-wrapped every value it encrypted.
+	Keys map[string]string `json:"keys"`
+
+	// ActiveKey is the id of the key that encrypts new values.
+	ActiveKey string `json:"active_key"`
+
+	// IndexKey is the HMAC key of the blind indexes used for equality
+	// lookups. Run rotate-keys after changing it to rebuild the indexes.
+	IndexKey string `json:"index_key"`
+}
+
+// Duration is a time.Duration that is written as a string such as "24h"
+// in the JSON config file.
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string like "24h" or "30m".
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"24h\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string like "24h0m0s".
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Default returns the built-in configuration. The connection settings match
+// a local MySQL install, but Env defaults to production so that a deployment
+// that forgot to set a JWT secret refuses to start. Set STUNTING_ENV to
+// "development" to run locally with the defaults.
+func Default() *Config {
+	return &Config{
+		Env: EnvProduction,
+		Server: ServerConfig{
+			Addr:      "localhost:8080",
+			PublicURL: "http://localhost:8080",
+		},
+		Database: DatabaseConfig{
+			User: "root",
+			Host: "127.0.0.1",
+			Port: "3306",
+			Name: "stuntingdb_new",
+
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: Duration(5 * time.Minute),
+			ConnMaxIdleTime: Duration(time.Minute),
+		},
+		JWT: JWTConfig{
+			Secret:     DefaultJWTSecret,
+			TTL:        Duration(15 * time.Minute),
+			RefreshTTL: Duration(30 * 24 * time.Hour),
+		},
+	}
+}
+
+// Load builds the configuration from the defaults, the JSON file named by
+// STUNTING_CONFIG and the STUNTING_* environment variables, then validates it.
+func Load() (*Config, error) {
+	return LoadFile(os.Getenv("STUNTING_CONFIG"))
+}
+
+// LoadFile is like Load but reads the JSON file at path instead of the one
+// named by STUNTING_CONFIG. An empty path skips the file.
+func LoadFile(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	// Development installs work without configuring keys
+	if cfg.IsDevelopment() {
+		if len(cfg.Encryption.Keys) == 0 {
+			cfg.Encryption.Keys = map[string]string{DefaultEncryptionKeyId: DefaultEncryptionKey}
+			cfg.Encryption.ActiveKey = DefaultEncryptionKeyId
+		}
+		if cfg.Encryption.IndexKey == "" {
+			cfg.Encryption.IndexKey = DefaultIndexKey
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// loadFile overrides cfg with the values present in a JSON file.
+// Keys missing from the file keep their current value.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: read %s: %w", path, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("config: parse %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// loadEnv overrides cfg with the STUNTING_* environment variables that are set.
+func (c *Config) loadEnv() error {
+	setString := func(key string, target *string) {
+		if value, ok := os.LookupEnv(key); ok {
+			*target = value
+		}
+	}
+	setInt := func(key string, target *int) error {
+		if value, ok := os.LookupEnv(key); ok {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("config: %s: %w", key, err)
+			}
+			*target = parsed
+		}
+		return nil
+	}
+	setDuration := func(key string, target *Duration) error {
+		if value, ok := os.LookupEnv(key); ok {
+			parsed, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("config: %s: %w", key, err)
+			}
+			*target = Duration(parsed)
+		}
+		return nil
+	}
+
+	setString("STUNTING_ENV", &c.Env)
+
+	setString("STUNTING_ADDR", &c.Server.Addr)
+	setString("STUNTING_PUBLIC_URL", &c.Server.PublicURL)
+
+	setString("STUNTING_DB_USER", &c.Database.User)
+	setString("STUNTING_DB_PASSWORD", &c.Database.Password)
+	setString("STUNTING_DB_HOST", &c.Database.Host)
+	setString("STUNTING_DB_PORT", &c.Database.Port)
+	setString("STUNTING_DB_NAME", &c.Database.Name)
+	if err := setInt("STUNTING_DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns); err != nil {
+		return err
+	}
+	if err := setInt("STUNTING_DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns); err != nil {
+		return err
+	}
+	if err := setDuration("STUNTING_DB_CONN_MAX_LIFETIME", &c.Database.ConnMaxLifetime); err != nil {
+		return err
+	}
+	if err := setDuration("STUNTING_DB_CONN_MAX_IDLE_TIME", &c.Database.ConnMaxIdleTime); err != nil {
+		return err
+	}
+
+	setString("STUNTING_JWT_SECRET", &c.JWT.Secret)
+	if err := setDuration("STUNTING_JWT_TTL", &c.JWT.TTL); err != nil {
+		return err
+	}
+	if err := setDuration("STUNTING_JWT_REFRESH_TTL", &c.JWT.RefreshTTL); err != nil {
+		return err
+	}
+
+	setString("STUNTING_BOOTSTRAP_TOKEN", &c.Auth.BootstrapToken)
+
+	// STUNTING_ENCRYPTION_KEYS lists the keys as "id:key,id:key"
+	if value, ok := os.LookupEnv("STUNTING_ENCRYPTION_KEYS"); ok {
+		c.Encryption.Keys = make(map[string]string)
+		for _, entry := range strings.Split(value, ",") {
+			if entry = strings.TrimSpace(entry); entry == "" {
+				continue
+			}
+			id, key, found := strings.Cut(entry, ":")
+			if !found {
+				return errors.New("config: STUNTING_ENCRYPTION_KEYS must list keys as id:key")
+			}
+			c.Encryption.Keys[strings.TrimSpace(id)] = strings.TrimSpace(key)
+		}
+	}
+	setString("STUNTING_ENCRYPTION_ACTIVE_KEY", &c.Encryption.ActiveKey)
+	setString("STUNTING_ENCRYPTION_INDEX_KEY", &c.Encryption.IndexKey)
+
+	if value, ok := os.LookupEnv("STUNTING_CORS_ORIGINS"); ok {
+		c.CORS.AllowedOrigins = nil
+		for _, origin := range strings.Split(value, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				c.CORS.AllowedOrigins = append(c.CORS.AllowedOrigins, origin)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the configuration and reports every problem found.
+func (c *Config) Validate() error {
+	var errs []error
+
+	switch c.Env {
+	case EnvDevelopment, EnvStaging, EnvProduction:
+	default:
+		errs = append(errs, fmt.Errorf("env must be one of: %s, %s, %s", EnvDevelopment, EnvStaging, EnvProduction))
+	}
+
+	// Server
+	if c.Server.Addr == "" {
+		errs = append(errs, errors.New("server.addr is required"))
+	}
+	if c.Server.PublicURL != "" {
+		if u, err := url.Parse(c.Server.PublicURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, errors.New("server.public_url must be an absolute URL"))
+		}
+	}
+
+	// Database
+	if c.Database.User == "" {
+		errs = append(errs, errors.New("database.user is required"))
+	}
+	if c.Database.Host == "" {
+		errs = append(errs, errors.New("database.host is required"))
+	}
+	if _, err := strconv.Atoi(c.Database.Port); err != nil {
+		errs = append(errs, errors.New("database.port must be a number"))
+	}
+	if c.Database.Name == "" {
+		errs = append(errs, errors.New("database.name is required"))
+	}
+	if c.Database.MaxOpenConns <= 0 {
+		errs = append(errs, errors.New("database.max_open_conns must be positive"))
+	}
+	if c.Database.MaxIdleConns < 0 || c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		errs = append(errs, errors.New("database.max_idle_conns must be between 0 and max_open_conns"))
+	}
+	if c.Database.ConnMaxLifetime < 0 || c.Database.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("database connection lifetimes must not be negative"))
+	}
+
+	// JWT
+	if c.JWT.Secret == "" {
+		errs = append(errs, errors.New("jwt.secret is required"))
+	}
+	if !c.IsDevelopment() {
+		if c.JWT.Secret == DefaultJWTSecret {
+			errs = append(errs, fmt.Errorf("jwt.secret must be changed from the default outside %s mode", EnvDevelopment))
+		} else if len(c.JWT.Secret) < minJWTSecretLength {
+			errs = append(errs, fmt.Errorf("jwt.secret must be at least %d characters outside %s mode", minJWTSecretLength, EnvDevelopment))
+		}
+	}
+	if c.JWT.TTL <= 0 {
+		errs = append(errs, errors.New("jwt.ttl must be positive"))
+	}
+	if c.JWT.RefreshTTL <= c.JWT.TTL {
+		errs = append(errs, errors.New("jwt.refresh_ttl must be longer than jwt.ttl"))
+	}
+
+	// Auth
+	if c.Auth.BootstrapToken != "" && !c.IsDevelopment() && len(c.Auth.BootstrapToken) < minBootstrapTokenLength {
+		errs = append(errs, fmt.Errorf("auth.bootstrap_token must be at least %d characters outside %s mode", minBootstrapTokenLength, EnvDevelopment))
+	}
+
+	// Encryption
+	for id, key := range c.Encryption.Keys {
+		if id == "" || strings.Contains(id, ":") {
+			errs = append(errs, fmt.Errorf("encryption.keys: invalid key id %q", id))
+		}
+		if !validEncryptionKey(key) {
+			errs = append(errs, fmt.Errorf("encryption.keys: key %q must be %d base64 encoded bytes", id, encryptionKeySize))
+		}
+	}
+	if len(c.Encryption.Keys) == 0 {
+		errs = append(errs, errors.New("encryption.keys is required"))
+	} else if _, ok := c.Encryption.Keys[c.Encryption.ActiveKey]; !ok {
+		errs = append(errs, errors.New("encryption.active_key must name one of encryption.keys"))
+	}
+	if !validEncryptionKey(c.Encryption.IndexKey) {
+		errs = append(errs, fmt.Errorf("encryption.index_key must be %d base64 encoded bytes", encryptionKeySize))
+	}
+	if !c.IsDevelopment() {
+		if c.Encryption.Keys[c.Encryption.ActiveKey] == DefaultEncryptionKey {
+			errs = append(errs, fmt.Errorf("encryption.active_key must not be the development key outside %s mode", EnvDevelopment))
+		}
+		if c.Encryption.IndexKey == DefaultIndexKey {
+			errs = append(errs, fmt.Errorf("encryption.index_key must be changed from the default outside %s mode", EnvDevelopment))
+		}
+	}
+
+	// CORS
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins: invalid origin %q", origin))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// validEncryptionKey reports whether key is a base64 encoded key of the
+// right size.
+func validEncryptionKey(key string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	return err == nil && len(decoded) == encryptionKeySize
+}
+
+// IsDevelopment reports whether the application runs in development mode.
+func (c *Config) IsDevelopment() bool {
+	return c.Env == EnvDevelopment
+}
+
+// DSN returns the go-sql-driver/mysql data source name. It is built by
+// the driver, so a password with characters like @, / or : is kept intact.
+func (d DatabaseConfig) DSN() string {
+	cfg := mysql.NewConfig()
+	cfg.User = d.User
+	cfg.Passwd = d.Password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(d.Host, d.Port)
+	cfg.DBName = d.Name
+	return cfg.FormatDSN()
+}
+
+// Duration returns the access token lifetime as a time.Duration.
+func (j JWTConfig) Duration() time.Duration {
+	return time.Duration(j.TTL)
+}
+
+// RefreshDuration returns the refresh token lifetime as a time.Duration.
+func (j JWTConfig) RefreshDuration() time.Duration {
+	return time.Duration(j.RefreshTTL)
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestDSNKeepsSpecialCharacters(t *testing.T) {
+	d := DatabaseConfig{
+		User:     "stunting",
+		Password: "p@ss/w:rd?x=1",
+		Host:     "db.internal",
+		Port:     "3307",
+		Name:     "stuntingdb_new",
+	}
+
+	parsed, err := mysql.ParseDSN(d.DSN())
+	if err != nil {
+		t.Fatalf("ParseDSN(%q): %v", d.DSN(), err)
+	}
+	if parsed.User != d.User || parsed.Passwd != d.Password {
+		t.Errorf("credentials = %q/%q, want %q/%q", parsed.User, parsed.Passwd, d.User, d.Password)
+	}
+	if parsed.Net != "tcp" || parsed.Addr != "db.internal:3307" {
+		t.Errorf("address = %s(%s), want tcp(db.internal:3307)", parsed.Net, parsed.Addr)
+	}
+	if parsed.DBName != d.Name {
+		t.Errorf("database = %q, want %q", parsed.DBName, d.Name)
+	}
+	if !parsed.AllowNativePasswords {
+		t.Error("native password authentication is disabled")
+	}
+}
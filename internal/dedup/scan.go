@@ -0,0 +1,295 @@
+package dedup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// Entities of a duplikat_kandidat row.
+const (
+	EntityKeluarga = "keluarga"
+	EntityBalita   = "balita"
+)
+
+// Status of a duplikat_kandidat row.
+const (
+	StatusMenunggu = "menunggu"
+	StatusDigabung = "digabung"
+	StatusDitolak  = "ditolak"
+)
+
+// gridSize is the size in degrees of the cells grouping keluarga living
+// close to each other, about 550 meters.
+const gridSize = 0.005
+
+// maxBlockSize is the number of records from which a block is skipped, as
+// comparing all of its pairs would cost more than it finds.
+const maxBlockSize = 500
+
+// Result counts the records and pairs compared by Scan for an entity.
+type Result struct {
+	Entity     string
+	Records    int
+	Compared   int
+	Candidates int
+}
+
+// candidate is a pair scoring at least the threshold.
+type candidate struct {
+	idUtama    string
+	idDuplikat string
+	score      Score
+}
+
+// Scan compares the active keluarga and balita and stores the pairs scoring
+// at least their threshold in duplikat_kandidat. Pending candidates that are
+// no longer found, e.g. because a record was corrected or deleted, are
+// removed; merged and rejected ones are kept, so a rejected pair is not
+// proposed again.
+//
+// NIK and nomor KK are compared by their blind index, values written before
+// encryption was enabled only match after the rotate-keys command ran.
+func Scan(ctx context.Context, db *sql.DB) ([]Result, error) {
+	keluarga, err := loadKeluarga(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("dedup: keluarga: %w", err)
+	}
+	balita, err := loadBalita(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("dedup: balita: %w", err)
+	}
+
+	keluargaResult, keluargaCandidates := compare(EntityKeluarga, keluarga, keluargaKeys, ScoreKeluarga,
+		func(k Keluarga) string { return k.Id }, KeluargaThreshold)
+	balitaResult, balitaCandidates := compare(EntityBalita, balita, balitaKeys, ScoreBalita,
+		func(b Balita) string { return b.Id }, BalitaThreshold)
+
+	scanTime := time.Now().Format("2006-01-02 15:04:05")
+	err = object.RunInTx(db, func(tx *sql.Tx) error {
+		if err := store(ctx, tx, EntityKeluarga, keluargaCandidates, scanTime); err != nil {
+			return err
+		}
+		return store(ctx, tx, EntityBalita, balitaCandidates, scanTime)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dedup: %w", err)
+	}
+
+	return []Result{keluargaResult, balitaResult}, nil
+}
+
+// Helper function to score every pair of records sharing a blocking key
+func compare[T any](entity string, records []T, keys func(T) []string, score func(a, b T) Score, id func(T) string, threshold float64) (Result, []candidate) {
+	result := Result{Entity: entity, Records: len(records)}
+
+	blocks := map[string][]int{}
+	for i, record := range records {
+		for _, key := range keys(record) {
+			blocks[key] = append(blocks[key], i)
+		}
+	}
+
+	seen := map[[2]int]bool{}
+	var candidates []candidate
+	for _, key := range slices.Sorted(maps.Keys(blocks)) {
+		block := blocks[key]
+		if len(block) > maxBlockSize {
+			continue
+		}
+		for x := 0; x < len(block); x++ {
+			for y := x + 1; y < len(block); y++ {
+				pair := [2]int{min(block[x], block[y]), max(block[x], block[y])}
+				if seen[pair] {
+					continue
+				}
+				seen[pair] = true
+				result.Compared++
+
+				a, b := records[pair[0]], records[pair[1]]
+				s := score(a, b)
+				if s.Value < threshold {
+					continue
+				}
+
+				// The older record survives unless the admin picks otherwise
+				idA, idB := id(a), id(b)
+				if lessId(idB, idA) {
+					idA, idB = idB, idA
+				}
+				candidates = append(candidates, candidate{idUtama: idA, idDuplikat: idB, score: s})
+			}
+		}
+	}
+	result.Candidates = len(candidates)
+	return result, candidates
+}
+
+// Helper function to order numeric ids
+func lessId(a, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return x < y
+}
+
+// Helper function to list the blocking keys of a keluarga. Blind indexes
+// are keyed per column, so a NIK ayah never matches a NIK ibu
+func keluargaKeys(k Keluarga) []string {
+	var keys []string
+	if k.NomorKkIndex != "" {
+		keys = append(keys, "kk:"+k.NomorKkIndex)
+	}
+	if k.NikAyahIndex != "" {
+		keys = append(keys, "nik_ayah:"+k.NikAyahIndex)
+	}
+	if k.NikIbuIndex != "" {
+		keys = append(keys, "nik_ibu:"+k.NikIbuIndex)
+	}
+	if first := firstName(k.NamaIbu); first != "" {
+		keys = append(keys, "ibu:"+first+":"+k.IdKelurahan)
+	}
+	if k.Koordinat != nil {
+		keys = append(keys, "grid:"+gridCell(*k.Koordinat))
+	}
+	return keys
+}
+
+// Helper function to list the blocking keys of a balita
+func balitaKeys(b Balita) []string {
+	var keys []string
+	if b.IdKeluarga != "" {
+		keys = append(keys, "keluarga:"+b.IdKeluarga)
+	}
+	if b.NomorKkIndex != "" {
+		keys = append(keys, "kk:"+b.NomorKkIndex)
+	}
+	if !b.TanggalLahir.IsZero() {
+		keys = append(keys, "lahir:"+b.TanggalLahir.Format("2006-01-02"))
+		if first := firstName(b.Nama); first != "" {
+			keys = append(keys, "nama:"+first+":"+b.TanggalLahir.Format("2006-01"))
+		}
+	}
+	return keys
+}
+
+// Helper function to get the first part of a normalized name
+func firstName(name string) string {
+	first, _, _ := strings.Cut(NormalizeName(name), " ")
+	return first
+}
+
+// Helper function to get the grid cell of a koordinat
+func gridCell(p [2]float64) string {
+	return fmt.Sprintf("%d:%d", int(math.Floor(p[0]/gridSize)), int(math.Floor(p[1]/gridSize)))
+}
+
+// MARK: Database
+
+// Helper function to load the active keluarga
+func loadKeluarga(ctx context.Context, db *sql.DB) ([]Keluarga, error) {
+	query := `SELECT id, nomor_kk_index, nik_ayah_index, nik_ibu_index, nama_ayah, nama_ibu,
+	id_kelurahan, koordinat
+	FROM keluarga WHERE deleted_date IS NULL ORDER BY id`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []Keluarga
+	for rows.Next() {
+		var k Keluarga
+		var kkIndex, nikAyahIndex, nikIbuIndex, namaAyah, namaIbu, idKelurahan sql.NullString
+		var koordinat geom.NullGeometry
+		err := rows.Scan(&k.Id, &kkIndex, &nikAyahIndex, &nikIbuIndex, &namaAyah, &namaIbu, &idKelurahan, &koordinat)
+		if err != nil {
+			return nil, err
+		}
+		k.NomorKkIndex = kkIndex.String
+		k.NikAyahIndex = nikAyahIndex.String
+		k.NikIbuIndex = nikIbuIndex.String
+		k.NamaAyah = namaAyah.String
+		k.NamaIbu = namaIbu.String
+		k.IdKelurahan = idKelurahan.String
+		k.Koordinat = parseKoordinat(koordinat)
+		list = append(list, k)
+	}
+	return list, rows.Err()
+}
+
+// Helper function to load the active balita with the nomor KK index and
+// koordinat of their keluarga
+func loadBalita(ctx context.Context, db *sql.DB) ([]Balita, error) {
+	query := `SELECT b.id, b.id_keluarga, b.nama, DATE_FORMAT(b.tanggal_lahir, '%Y-%m-%d'), b.jenis_kelamin,
+	k.nomor_kk_index, k.koordinat
+	FROM balita b
+	LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
+	WHERE b.deleted_date IS NULL ORDER BY b.id`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []Balita
+	for rows.Next() {
+		var b Balita
+		var idKeluarga, tanggalLahir, kkIndex sql.NullString
+		var koordinat geom.NullGeometry
+		err := rows.Scan(&b.Id, &idKeluarga, &b.Nama, &tanggalLahir, &b.JenisKelamin, &kkIndex, &koordinat)
+		if err != nil {
+			return nil, err
+		}
+		b.IdKeluarga = idKeluarga.String
+		b.NomorKkIndex = kkIndex.String
+		b.TanggalLahir, _ = time.Parse("2006-01-02", tanggalLahir.String)
+		b.Koordinat = parseKoordinat(koordinat)
+		list = append(list, b)
+	}
+	return list, rows.Err()
+}
+
+// Helper function to get a koordinat, which is missing when NULL or 0 0
+func parseKoordinat(koordinat geom.NullGeometry) *[2]float64 {
+	p, ok := koordinat.Point()
+	if !ok || p == (geom.Point{0, 0}) {
+		return nil
+	}
+	k := [2]float64(p)
+	return &k
+}
+
+// Helper function to store the candidates of an entity and remove the
+// pending ones of an earlier scan that were not found again
+func store(ctx context.Context, tx *sql.Tx, entity string, candidates []candidate, scanTime string) error {
+	upsertQuery := `INSERT INTO duplikat_kandidat (entitas, id_utama, id_duplikat, skor, alasan, status, created_date, updated_date)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE
+		skor = IF(status = ?, VALUES(skor), skor),
+		alasan = IF(status = ?, VALUES(alasan), alasan),
+		updated_date = VALUES(updated_date)`
+	for _, c := range candidates {
+		_, err := tx.ExecContext(ctx, upsertQuery,
+			entity, c.idUtama, c.idDuplikat, math.Round(c.score.Value*1000)/1000, strings.Join(c.score.Reasons, ","),
+			StatusMenunggu, scanTime, scanTime, StatusMenunggu, StatusMenunggu)
+		if err != nil {
+			return err
+		}
+	}
+
+	deleteQuery := "DELETE FROM duplikat_kandidat WHERE entitas = ? AND status = ? AND updated_date < ?"
+	_, err := tx.ExecContext(ctx, deleteQuery, entity, StatusMenunggu, scanTime)
+	return err
+}
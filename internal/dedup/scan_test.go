@@ -0,0 +1,94 @@
+package dedup
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestCompareKeluarga(t *testing.T) {
+	keluarga := []Keluarga{
+		{Id: "10", NomorKkIndex: "kk-1", NikAyahIndex: "nik-1", NikIbuIndex: "nik-2",
+			NamaAyah: "Moh. Djoko Santosso", NamaIbu: "Siti Noer'aini", IdKelurahan: "5", Koordinat: koordinat(108.5512, -6.7301)},
+		// Recorded earlier by a masyarakat, it shares every blocking key with
+		// the first one but is compared once
+		{Id: "9", NomorKkIndex: "kk-1", NikAyahIndex: "nik-1", NikIbuIndex: "nik-2",
+			NamaAyah: "Muhammad Joko Santoso", NamaIbu: "St. Nuraini", IdKelurahan: "5", Koordinat: koordinat(108.5513, -6.7302)},
+		// The NIK ayah of the first keluarga as NIK ibu is another column's
+		// blind index, so no block is shared
+		{Id: "11", NikIbuIndex: "nik-1", NamaAyah: "Ahmad Fauzi", NamaIbu: "Dewi Lestari",
+			IdKelurahan: "6", Koordinat: koordinat(108.7000, -6.9000)},
+		// A neighbour is compared but scores below the threshold
+		{Id: "12", NomorKkIndex: "kk-3", NamaAyah: "Budi", NamaIbu: "Ani", IdKelurahan: "5",
+			Koordinat: koordinat(108.5514, -6.7303)},
+	}
+
+	result, candidates := compare(EntityKeluarga, keluarga, keluargaKeys, ScoreKeluarga,
+		func(k Keluarga) string { return k.Id }, KeluargaThreshold)
+
+	if want := (Result{Entity: EntityKeluarga, Records: 4, Compared: 3, Candidates: 1}); result != want {
+		t.Errorf("result %+v, want %+v", result, want)
+	}
+	if len(candidates) != 1 {
+		t.Fatalf("candidates %+v, want 1", candidates)
+	}
+	// The lower id is kept, compared as numbers
+	if c := candidates[0]; c.idUtama != "9" || c.idDuplikat != "10" || c.score.Value < 0.95 {
+		t.Errorf("candidate %+v, want 9 kept over 10", c)
+	}
+}
+
+func TestKeluargaKeys(t *testing.T) {
+	k := Keluarga{
+		NomorKkIndex: "kk-1",
+		NikAyahIndex: "nik-1",
+		NikIbuIndex:  "nik-2",
+		NamaIbu:      "St. Noer'aini",
+		IdKelurahan:  "5",
+		Koordinat:    koordinat(108.5512, -6.7301),
+	}
+	want := []string{"kk:kk-1", "nik_ayah:nik-1", "nik_ibu:nik-2", "ibu:siti:5", "grid:21710:-1347"}
+	if got := keluargaKeys(k); !reflect.DeepEqual(got, want) {
+		t.Errorf("keluargaKeys = %q, want %q", got, want)
+	}
+	if got := keluargaKeys(Keluarga{}); len(got) != 0 {
+		t.Errorf("keluargaKeys of an empty record = %q, want none", got)
+	}
+}
+
+func TestCompareBalita(t *testing.T) {
+	balita := []Balita{
+		{Id: "1", IdKeluarga: "1", Nama: "Muhammad Rizky", TanggalLahir: date(t, "2023-03-05"), JenisKelamin: "L", NomorKkIndex: "kk-1"},
+		{Id: "2", IdKeluarga: "1", Nama: "Hasan", TanggalLahir: date(t, "2023-03-05"), JenisKelamin: "L", NomorKkIndex: "kk-1"},
+		// The same child in the keluarga recorded twice
+		{Id: "3", IdKeluarga: "9", Nama: "M. Rizki", TanggalLahir: date(t, "2023-03-05"), JenisKelamin: "L", NomorKkIndex: "kk-1"},
+		// Born the same month with a similar name, but in another keluarga
+		{Id: "4", IdKeluarga: "7", Nama: "Muhamad Rizal", TanggalLahir: date(t, "2023-03-20"), JenisKelamin: "L", NomorKkIndex: "kk-7"},
+		// Nothing in common with the others
+		{Id: "5", IdKeluarga: "8", Nama: "Putri", TanggalLahir: date(t, "2022-01-10"), JenisKelamin: "P", NomorKkIndex: "kk-8"},
+	}
+
+	result, candidates := compare(EntityBalita, balita, balitaKeys, ScoreBalita,
+		func(b Balita) string { return b.Id }, BalitaThreshold)
+
+	// 1, 2 and 3 share a nomor KK, 1, 3 and 4 the name muhamad and month
+	if want := (Result{Entity: EntityBalita, Records: 5, Compared: 5, Candidates: 1}); result != want {
+		t.Errorf("result %+v, want %+v", result, want)
+	}
+	if len(candidates) != 1 || candidates[0].idUtama != "1" || candidates[0].idDuplikat != "3" {
+		t.Errorf("candidates %+v, want 1 and 3", candidates)
+	}
+}
+
+func TestCompareSkipsLargeBlocks(t *testing.T) {
+	var keluarga []Keluarga
+	for i := range maxBlockSize + 1 {
+		keluarga = append(keluarga, Keluarga{Id: strconv.Itoa(i + 1), IdKelurahan: "5", NamaIbu: "Siti"})
+	}
+
+	result, candidates := compare(EntityKeluarga, keluarga, keluargaKeys, ScoreKeluarga,
+		func(k Keluarga) string { return k.Id }, KeluargaThreshold)
+	if result.Compared != 0 || len(candidates) != 0 {
+		t.Errorf("compared %d pairs of a block of %d, want none", result.Compared, len(keluarga))
+	}
+}
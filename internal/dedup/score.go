@@ -0,0 +1,317 @@
+// Package dedup finds keluarga and balita that were recorded twice, e.g.
+// once by a masyarakat and once by an admin with a slightly different
+// spelling.
+//
+// Scan compares the active records that share a blocking key (the same
+// nomor KK, NIK, keluarga, birth date, name prefix or neighbourhood) and
+// scores every pair from 0 to 1 on nomor KK, NIK, normalized names, birth
+// date and the distance between the koordinat of the keluarga. Pairs
+// scoring at least the threshold are stored in duplikat_kandidat, where an
+// admin merges or rejects them.
+package dedup
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Thresholds of the score from which a pair is stored as candidate.
+const (
+	KeluargaThreshold = 0.5
+	BalitaThreshold   = 0.7
+)
+
+// Distances in meters between the koordinat of two keluarga: up to
+// nearDistance counts fully, from farDistance not at all.
+const (
+	nearDistance = 50.0
+	farDistance  = 500.0
+)
+
+// Reasons of a score, stored in duplikat_kandidat.alasan.
+const (
+	ReasonNomorKk      = "nomor_kk"
+	ReasonNikAyah      = "nik_ayah"
+	ReasonNikIbu       = "nik_ibu"
+	ReasonNamaAyah     = "nama_ayah"
+	ReasonNamaIbu      = "nama_ibu"
+	ReasonNama         = "nama"
+	ReasonKeluarga     = "keluarga"
+	ReasonTanggalLahir = "tanggal_lahir"
+	ReasonJenisKelamin = "jenis_kelamin"
+	ReasonJarak        = "jarak"
+)
+
+// nameMatch is the similarity from which a name counts as a reason.
+const nameMatch = 0.85
+
+// minBalitaName is the similarity below which the names of two balita count
+// as different. Without it twins, who share everything but their name,
+// would score as duplicates.
+const minBalitaName = 0.7
+
+// Keluarga is the part of a keluarga row compared by ScoreKeluarga. The
+// identifiers are blind indexes, see package fieldcrypt.
+type Keluarga struct {
+	Id           string
+	NomorKkIndex string
+	NikAyahIndex string
+	NikIbuIndex  string
+	NamaAyah     string
+	NamaIbu      string
+	IdKelurahan  string
+	Koordinat    *[2]float64 // longitude, latitude
+}
+
+// Balita is the part of a balita row compared by ScoreBalita, with the
+// keluarga it belongs to.
+type Balita struct {
+	Id           string
+	IdKeluarga   string
+	Nama         string
+	TanggalLahir time.Time
+	JenisKelamin string
+	NomorKkIndex string
+	Koordinat    *[2]float64
+}
+
+// Score is the likelihood that two records are the same, with the features
+// that matched.
+type Score struct {
+	Value   float64
+	Reasons []string
+}
+
+// Helper function to add a feature weighted by its similarity
+func (s *Score) add(reason string, weight, similarity, match float64) {
+	s.Value += weight * similarity
+	if similarity >= match {
+		s.Reasons = append(s.Reasons, reason)
+	}
+}
+
+// ScoreKeluarga scores two keluarga. The weights add up to 1.
+func ScoreKeluarga(a, b Keluarga) Score {
+	var s Score
+	s.add(ReasonNomorKk, 0.35, equalIndex(a.NomorKkIndex, b.NomorKkIndex), 1)
+	s.add(ReasonNikAyah, 0.15, equalIndex(a.NikAyahIndex, b.NikAyahIndex), 1)
+	s.add(ReasonNikIbu, 0.15, equalIndex(a.NikIbuIndex, b.NikIbuIndex), 1)
+	s.add(ReasonNamaAyah, 0.1, NameSimilarity(a.NamaAyah, b.NamaAyah), nameMatch)
+	s.add(ReasonNamaIbu, 0.1, NameSimilarity(a.NamaIbu, b.NamaIbu), nameMatch)
+	s.add(ReasonJarak, 0.15, closeness(a.Koordinat, b.Koordinat), 1)
+	return s
+}
+
+// ScoreBalita scores two balita. The weights add up to 1, the distance
+// between their keluarga only counts when they belong to different ones.
+func ScoreBalita(a, b Balita) Score {
+	var s Score
+
+	sameKeluarga := 0.0
+	if a.IdKeluarga != "" && a.IdKeluarga == b.IdKeluarga {
+		sameKeluarga = 1
+	} else if equalIndex(a.NomorKkIndex, b.NomorKkIndex) == 1 {
+		sameKeluarga = 1
+	}
+	s.add(ReasonKeluarga, 0.3, sameKeluarga, 1)
+
+	nama := NameSimilarity(a.Nama, b.Nama)
+	if nama < minBalitaName {
+		nama = 0
+	}
+	s.add(ReasonNama, 0.3, nama, nameMatch)
+	s.add(ReasonTanggalLahir, 0.25, dateSimilarity(a.TanggalLahir, b.TanggalLahir), 1)
+
+	sameSex := 0.0
+	if a.JenisKelamin == b.JenisKelamin {
+		sameSex = 1
+	}
+	s.add(ReasonJenisKelamin, 0.05, sameSex, 1)
+
+	// The koordinat of a keluarga says nothing new about its own balita
+	if sameKeluarga == 0 {
+		s.add(ReasonJarak, 0.1, closeness(a.Koordinat, b.Koordinat), 1)
+	}
+	return s
+}
+
+// Helper function to compare two blind indexes, empty ones never match
+func equalIndex(a, b string) float64 {
+	if a != "" && a == b {
+		return 1
+	}
+	return 0
+}
+
+// Helper function to score two birth dates: the same day, day and month
+// swapped or a typo in the day within the month count partly
+func dateSimilarity(a, b time.Time) float64 {
+	if a.IsZero() || b.IsZero() {
+		return 0
+	}
+	if a.Equal(b) {
+		return 1
+	}
+	if a.Year() == b.Year() && a.Day() == int(b.Month()) && int(a.Month()) == b.Day() {
+		return 0.6
+	}
+	if a.Year() == b.Year() && a.Month() == b.Month() {
+		return 0.4
+	}
+	return 0
+}
+
+// Helper function to score the distance between two koordinat
+func closeness(a, b *[2]float64) float64 {
+	if a == nil || b == nil {
+		return 0
+	}
+	d := Distance(*a, *b)
+	switch {
+	case d <= nearDistance:
+		return 1
+	case d >= farDistance:
+		return 0
+	default:
+		return (farDistance - d) / (farDistance - nearDistance)
+	}
+}
+
+// Distance returns the distance in meters between two longitude, latitude
+// points.
+func Distance(a, b [2]float64) float64 {
+	const earthRadius = 6371000.0
+	lat1, lat2 := a[1]*math.Pi/180, b[1]*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b[0] - a[0]) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// MARK: Names
+
+// oldSpellings maps the Ejaan Soewandi spellings still common in names to
+// the current ones.
+var oldSpellings = strings.NewReplacer(
+	"oe", "u",
+	"dj", "j",
+	"tj", "c",
+	"nj", "ny",
+	"sj", "sy",
+	"ch", "kh",
+)
+
+// nameAliases maps common abbreviations and variants of a name part.
+var nameAliases = map[string]string{
+	"m":        "muhamad",
+	"muh":      "muhamad",
+	"moh":      "muhamad",
+	"mohamad":  "muhamad",
+	"mochamad": "muhamad",
+	"mukhamad": "muhamad",
+	"mokhamad": "muhamad",
+	"moch":     "muhamad",
+	"mohd":     "muhamad",
+	"st":       "siti",
+}
+
+// NormalizeName lowercases a name, keeps letters only, converts the old
+// spelling, expands common abbreviations and removes doubled letters, so
+// "Moh. Djoko Santosso" and "Muhammad Joko Santoso" become equal.
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	parts := strings.Fields(oldSpellings.Replace(b.String()))
+	for i, part := range parts {
+		part = squeeze(part)
+		if alias, ok := nameAliases[part]; ok {
+			part = alias
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, " ")
+}
+
+// Helper function to remove repeated letters, "hh" becomes "h"
+func squeeze(s string) string {
+	var b strings.Builder
+	var last rune
+	for _, r := range s {
+		if r != last {
+			b.WriteRune(r)
+		}
+		last = r
+	}
+	return b.String()
+}
+
+// NameSimilarity returns the Jaro-Winkler similarity of the normalized
+// names, from 0 to 1. Empty names never match.
+func NameSimilarity(a, b string) float64 {
+	a, b = NormalizeName(a), NormalizeName(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	return jaroWinkler([]rune(a), []rune(b))
+}
+
+// Helper function to compute the Jaro-Winkler similarity
+func jaroWinkler(a, b []rune) float64 {
+	if string(a) == string(b) {
+		return 1
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	window = max(window, 0)
+
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(0, i-window); j < min(len(b), i+window+1); j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,247 @@
+package dedup
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Helper function to create a koordinat
+func koordinat(lon, lat float64) *[2]float64 {
+	return &[2]float64{lon, lat}
+}
+
+// Helper function to parse a date
+func date(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Moh. Djoko Santosso", "muhamad joko santoso"},
+		{"Muhammad Joko Santoso", "muhamad joko santoso"},
+		{"MOCHAMAD  RIZKY", "muhamad rizky"},
+		{"M. Rizki", "muhamad rizki"},
+		{"St. Noer'aini", "siti nur aini"},
+		{"Tjahjono", "cahjono"},
+		{"Soekarno-Putri", "sukarno putri"},
+		{"Njoman Sjarif", "nyoman syarif"},
+		{"Achmad", "akhmad"},
+		{"Dewi 2", "dewi"},
+		{" ", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeName(tt.name); got != tt.want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	// Spellings of the same name score higher than other names
+	tests := []struct {
+		a, b    string
+		atLeast float64
+		below   float64
+	}{
+		{"Moh. Djoko Santosso", "Muhammad Joko Santoso", 1, 1.01},
+		{"Siti Noer'aini", "St. Nuraini", nameMatch, 1},
+		{"Muhammad Rizky", "M. Rizki", nameMatch, 1},
+		{"Rizky", "Rizka", nameMatch, 1},
+		{"Hasan", "Husein", 0, nameMatch},
+		{"Muhammad Rizky", "Hasan", 0, minBalitaName},
+		{"", "Hasan", 0, 0.01},
+		{"", "", 0, 0.01},
+	}
+	for _, tt := range tests {
+		got := NameSimilarity(tt.a, tt.b)
+		if got < tt.atLeast || got >= tt.below {
+			t.Errorf("NameSimilarity(%q, %q) = %.3f, want from %v to below %v", tt.a, tt.b, got, tt.atLeast, tt.below)
+		}
+		if reverse := NameSimilarity(tt.b, tt.a); math.Abs(reverse-got) > 1e-9 {
+			t.Errorf("NameSimilarity(%q, %q) = %.3f, but %.3f the other way round", tt.a, tt.b, got, reverse)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b      [2]float64
+		want      float64 // meters
+		closeness float64
+	}{
+		{[2]float64{108.55, -6.73}, [2]float64{108.55, -6.73}, 0, 1},
+		{[2]float64{108.55, -6.73}, [2]float64{108.5509, -6.73}, 99.4, 0.89},
+		{[2]float64{108.55, -6.73}, [2]float64{108.55, -6.7325}, 278, 0.49},
+		{[2]float64{108.55, -6.73}, [2]float64{108.56, -6.73}, 1104, 0},
+	}
+	for _, tt := range tests {
+		got := Distance(tt.a, tt.b)
+		if math.Abs(got-tt.want) > 1 {
+			t.Errorf("Distance(%v, %v) = %.1f, want %.1f", tt.a, tt.b, got, tt.want)
+		}
+		if c := closeness(&tt.a, &tt.b); math.Abs(c-tt.closeness) > 0.01 {
+			t.Errorf("closeness(%v, %v) = %.2f, want %.2f", tt.a, tt.b, c, tt.closeness)
+		}
+	}
+	if c := closeness(nil, koordinat(108.55, -6.73)); c != 0 {
+		t.Errorf("closeness without a koordinat = %v, want 0", c)
+	}
+}
+
+func TestDateSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"2023-03-05", "2023-03-05", 1},
+		{"2023-03-05", "2023-05-03", 0.6},
+		{"2023-03-05", "2023-03-15", 0.4},
+		{"2023-03-05", "2022-03-05", 0},
+		{"2023-03-05", "2023-04-05", 0},
+	}
+	for _, tt := range tests {
+		if got := dateSimilarity(date(t, tt.a), date(t, tt.b)); got != tt.want {
+			t.Errorf("dateSimilarity(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if got := dateSimilarity(time.Time{}, time.Time{}); got != 0 {
+		t.Errorf("dateSimilarity of missing dates = %v, want 0", got)
+	}
+}
+
+func TestScoreKeluarga(t *testing.T) {
+	recorded := Keluarga{
+		Id:           "1",
+		NomorKkIndex: "kk-1",
+		NikAyahIndex: "nik-ayah-1",
+		NikIbuIndex:  "nik-ibu-1",
+		NamaAyah:     "Moh. Djoko Santosso",
+		NamaIbu:      "Siti Noer'aini",
+		IdKelurahan:  "5",
+		Koordinat:    koordinat(108.5500, -6.7300),
+	}
+
+	// From the most to the least likely duplicate
+	tests := []struct {
+		name      string
+		other     Keluarga
+		candidate bool
+		reasons   []string
+	}{
+		{"same identifiers, names respelled", Keluarga{
+			NomorKkIndex: "kk-1", NikAyahIndex: "nik-ayah-1", NikIbuIndex: "nik-ibu-1",
+			NamaAyah: "Muhammad Joko Santoso", NamaIbu: "St. Nuraini", Koordinat: koordinat(108.5501, -6.7301),
+		}, true, []string{ReasonNomorKk, ReasonNikAyah, ReasonNikIbu, ReasonNamaAyah, ReasonNamaIbu, ReasonJarak}},
+		{"same nomor KK without NIK and koordinat", Keluarga{
+			NomorKkIndex: "kk-1", NamaAyah: "Djoko Santoso", NamaIbu: "Siti Nuraeni",
+		}, true, []string{ReasonNomorKk, ReasonNamaIbu}},
+		{"same NIK ibu, new nomor KK", Keluarga{
+			NomorKkIndex: "kk-2", NikIbuIndex: "nik-ibu-1",
+			NamaAyah: "Joko S", NamaIbu: "Siti Nur Aini", Koordinat: koordinat(108.5502, -6.7300),
+		}, false, []string{ReasonNikIbu, ReasonNamaIbu, ReasonJarak}},
+		{"neighbours with similar names", Keluarga{
+			NomorKkIndex: "kk-3", NamaAyah: "Joko Santoso", NamaIbu: "Siti Nuraini", Koordinat: koordinat(108.5503, -6.7300),
+		}, false, []string{ReasonNamaIbu, ReasonJarak}},
+		{"other keluarga", Keluarga{
+			NomorKkIndex: "kk-4", NamaAyah: "Ahmad Fauzi", NamaIbu: "Dewi Lestari", Koordinat: koordinat(108.5600, -6.7300),
+		}, false, nil},
+	}
+
+	previous := math.Inf(1)
+	for _, tt := range tests {
+		s := ScoreKeluarga(recorded, tt.other)
+		if s.Value >= previous {
+			t.Errorf("%s: score %.3f, want below the previous %.3f", tt.name, s.Value, previous)
+		}
+		previous = s.Value
+
+		if candidate := s.Value >= KeluargaThreshold; candidate != tt.candidate {
+			t.Errorf("%s: score %.3f, candidate %v, want %v", tt.name, s.Value, candidate, tt.candidate)
+		}
+		if !reflect.DeepEqual(s.Reasons, tt.reasons) {
+			t.Errorf("%s: reasons %v, want %v", tt.name, s.Reasons, tt.reasons)
+		}
+		if reverse := ScoreKeluarga(tt.other, recorded); math.Abs(reverse.Value-s.Value) > 1e-9 {
+			t.Errorf("%s: score %.3f, but %.3f the other way round", tt.name, s.Value, reverse.Value)
+		}
+	}
+
+	// Identical records score 1, empty identifiers never match
+	if s := ScoreKeluarga(recorded, recorded); math.Abs(s.Value-1) > 1e-9 {
+		t.Errorf("score of a record with itself = %v, want 1", s.Value)
+	}
+	if s := ScoreKeluarga(Keluarga{}, Keluarga{}); s.Value != 0 || len(s.Reasons) != 0 {
+		t.Errorf("score of empty records = %+v, want 0", s)
+	}
+}
+
+func TestScoreBalita(t *testing.T) {
+	recorded := Balita{
+		Id:           "1",
+		IdKeluarga:   "1",
+		Nama:         "Muhammad Rizky",
+		TanggalLahir: date(t, "2023-03-05"),
+		JenisKelamin: "L",
+		NomorKkIndex: "kk-1",
+		Koordinat:    koordinat(108.55, -6.73),
+	}
+
+	// From the most to the least likely duplicate
+	tests := []struct {
+		name      string
+		other     Balita
+		candidate bool
+		reasons   []string
+	}{
+		{"name respelled", Balita{
+			IdKeluarga: "1", Nama: "M. Rizki", TanggalLahir: date(t, "2023-03-05"), JenisKelamin: "L", NomorKkIndex: "kk-1",
+		}, true, []string{ReasonKeluarga, ReasonNama, ReasonTanggalLahir, ReasonJenisKelamin}},
+		{"keluarga recorded twice, day and month swapped", Balita{
+			IdKeluarga: "9", Nama: "Mochamad Rizky", TanggalLahir: date(t, "2023-05-03"), JenisKelamin: "L", NomorKkIndex: "kk-1",
+		}, true, []string{ReasonKeluarga, ReasonNama, ReasonJenisKelamin}},
+		{"twins", Balita{
+			IdKeluarga: "1", Nama: "Hasan", TanggalLahir: date(t, "2023-03-05"), JenisKelamin: "L", NomorKkIndex: "kk-1",
+		}, false, []string{ReasonKeluarga, ReasonTanggalLahir, ReasonJenisKelamin}},
+		{"same name in another keluarga", Balita{
+			IdKeluarga: "7", Nama: "Muhammad Rizky", TanggalLahir: date(t, "2021-08-17"), JenisKelamin: "L", NomorKkIndex: "kk-7",
+			Koordinat: koordinat(108.60, -6.73),
+		}, false, []string{ReasonNama, ReasonJenisKelamin}},
+	}
+
+	previous := math.Inf(1)
+	for _, tt := range tests {
+		s := ScoreBalita(recorded, tt.other)
+		if s.Value >= previous {
+			t.Errorf("%s: score %.3f, want below the previous %.3f", tt.name, s.Value, previous)
+		}
+		previous = s.Value
+
+		if candidate := s.Value >= BalitaThreshold; candidate != tt.candidate {
+			t.Errorf("%s: score %.3f, candidate %v, want %v", tt.name, s.Value, candidate, tt.candidate)
+		}
+		if !reflect.DeepEqual(s.Reasons, tt.reasons) {
+			t.Errorf("%s: reasons %v, want %v", tt.name, s.Reasons, tt.reasons)
+		}
+	}
+
+	// The koordinat only counts for balita of different keluarga
+	near := Balita{IdKeluarga: "1", Nama: "Rizky", Koordinat: koordinat(108.55, -6.73)}
+	far := near
+	far.Koordinat = koordinat(108.60, -6.73)
+	if a, b := ScoreBalita(recorded, near), ScoreBalita(recorded, far); a.Value != b.Value {
+		t.Errorf("same keluarga: score %.3f near, %.3f far, want them equal", a.Value, b.Value)
+	}
+	near.IdKeluarga, far.IdKeluarga = "8", "8"
+	if a, b := ScoreBalita(recorded, near), ScoreBalita(recorded, far); a.Value-b.Value < 0.1-1e-9 {
+		t.Errorf("other keluarga: score %.3f near, %.3f far, want the distance to count", a.Value, b.Value)
+	}
+}
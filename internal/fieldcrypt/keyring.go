@@ -0,0 +1,347 @@
+// Package fieldcrypt encrypts personal data stored in single columns, such
+// as NIK, nomor KK and phone numbers.
+//
+// Values use envelope encryption: every value is encrypted with its own
+// random data key using AES-256-GCM, and the data key is wrapped with a key
+// encryption key from the configured key ring. The stored value names the
+// key that wrapped its data key, so keys can be rotated without downtime:
+// add a new key, make it active, then run the rotate-keys command to
+// re-wrap the existing values.
+//
+// Every value is bound to its table, column and row id as additional
+// authenticated data, so a value copied to another column or row fails to
+// decrypt instead of being shown as the data of someone else.
+//
+// Encryption is randomized, so equal values do not produce equal
+// ciphertexts. Equality lookups use a blind index instead, an HMAC-SHA256 of
+// the value stored next to it in the <column>_index column. Every column has
+// its own index key derived from the configured one, so equal values in
+// different columns have unrelated indexes.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/config"
+)
+
+// prefix marks an encrypted value. Values without it are plaintext written
+// before encryption was enabled, and are returned unchanged by Decrypt.
+const prefix = "enc:v2:"
+
+// unboundPrefix marks a value encrypted before values were bound to their
+// row. Only Rotate reads them, to bind them.
+const unboundPrefix = "enc:v1:"
+
+// keySize is the size of every key, AES-256 and HMAC-SHA256 alike.
+const keySize = 32
+
+// ErrUnknownKey is returned when a value was encrypted with a key that is no
+// longer in the key ring.
+var ErrUnknownKey = errors.New("fieldcrypt: value encrypted with an unknown key")
+
+// ErrMalformed is returned when an encrypted value cannot be parsed, or was
+// not encrypted for the column and row it is read from.
+var ErrMalformed = errors.New("fieldcrypt: malformed encrypted value")
+
+// ErrUnbound is returned by Decrypt for a value encrypted before values were
+// bound to their row. The rotate-keys command binds them.
+var ErrUnbound = errors.New("fieldcrypt: value is not bound to its row, run rotate-keys")
+
+// Keyring holds the key encryption keys and the blind index key.
+type Keyring struct {
+	keys     map[string]cipher.AEAD
+	active   string
+	indexKey []byte
+}
+
+// NewKeyring creates a Keyring from the configured base64 encoded keys.
+func NewKeyring(cfg config.EncryptionConfig) (*Keyring, error) {
+	k := &Keyring{
+		keys:   make(map[string]cipher.AEAD, len(cfg.Keys)),
+		active: cfg.ActiveKey,
+	}
+
+	for id, encoded := range cfg.Keys {
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt: key %q: %w", id, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = aead
+	}
+	if _, ok := k.keys[k.active]; !ok {
+		return nil, fmt.Errorf("fieldcrypt: active key %q is not in the key ring", k.active)
+	}
+
+	indexKey, err := decodeKey(cfg.IndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("fieldcrypt: index key: %w", err)
+	}
+	k.indexKey = indexKey
+
+	return k, nil
+}
+
+// Development returns a Keyring with the public development keys of package
+// config, for tests and in-memory stores.
+func Development() *Keyring {
+	k, err := NewKeyring(config.EncryptionConfig{
+		Keys:      map[string]string{config.DefaultEncryptionKeyId: config.DefaultEncryptionKey},
+		ActiveKey: config.DefaultEncryptionKeyId,
+		IndexKey:  config.DefaultIndexKey,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// ActiveKey returns the id of the key that wraps new data keys.
+func (k *Keyring) ActiveKey() string {
+	return k.active
+}
+
+// Encrypt encrypts value of column in the row with the given id, with a new
+// data key wrapped by the active key. The empty string stays empty, so
+// optional columns keep telling "not filled" apart without a lookup.
+func (k *Keyring) Encrypt(column Column, id, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	wrapped, err := seal(k.keys[k.active], dataKey, []byte(k.active))
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(data, []byte(value), column.additionalData(id))
+	if err != nil {
+		return "", err
+	}
+
+	return k.format(k.active, wrapped, ciphertext), nil
+}
+
+// Decrypt returns the plaintext of a value written by Encrypt for the same
+// column and row. Values without the encryption prefix are returned
+// unchanged.
+func (k *Keyring) Decrypt(column Column, id, value string) (string, error) {
+	if strings.HasPrefix(value, unboundPrefix) {
+		return "", ErrUnbound
+	}
+	if !strings.HasPrefix(value, prefix) {
+		return value, nil
+	}
+
+	return k.decrypt(value, column.additionalData(id))
+}
+
+// DecryptAll decrypts in place the values of the encrypted columns of the
+// row with the given id, given in the order of Columns[table].
+func (k *Keyring) DecryptAll(table, id string, values ...*string) error {
+	columns := Columns[table]
+	if len(values) != len(columns) {
+		return fmt.Errorf("fieldcrypt: %s has %d encrypted columns, got %d values", table, len(columns), len(values))
+	}
+	for i, value := range values {
+		plaintext, err := k.Decrypt(columns[i], id, *value)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", columns[i], id, err)
+		}
+		*value = plaintext
+	}
+	return nil
+}
+
+// Rotate returns value with its data key wrapped by the active key, and
+// whether it changed. Plaintext values are encrypted, and values encrypted
+// before values were bound to their row are bound to column and id. The
+// data key itself is kept, so a bound value does not have to be decrypted.
+func (k *Keyring) Rotate(column Column, id, value string) (string, bool, error) {
+	if value == "" {
+		return value, false, nil
+	}
+	if strings.HasPrefix(value, unboundPrefix) {
+		plaintext, err := k.decrypt(value, nil)
+		if err != nil {
+			return "", false, err
+		}
+		encrypted, err := k.Encrypt(column, id, plaintext)
+		return encrypted, err == nil, err
+	}
+	if !strings.HasPrefix(value, prefix) {
+		encrypted, err := k.Encrypt(column, id, value)
+		return encrypted, err == nil, err
+	}
+
+	keyId, wrapped, ciphertext, err := parse(value)
+	if err != nil {
+		return "", false, err
+	}
+	if keyId == k.active {
+		return value, false, nil
+	}
+
+	dataKey, err := k.unwrap(keyId, wrapped)
+	if err != nil {
+		return "", false, err
+	}
+
+	rewrapped, err := seal(k.keys[k.active], dataKey, []byte(k.active))
+	if err != nil {
+		return "", false, err
+	}
+
+	return k.format(k.active, rewrapped, ciphertext), true, nil
+}
+
+// BlindIndex returns the blind index of value in column, the hex encoded
+// HMAC-SHA256 of the value with surrounding spaces removed, keyed with the
+// index key of the column. The empty string has an empty index.
+func (k *Keyring) BlindIndex(column Column, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, k.columnIndexKey(column))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Helper function to derive the index key of a column from the configured
+// index key
+func (k *Keyring) columnIndexKey(column Column) []byte {
+	mac := hmac.New(sha256.New, k.indexKey)
+	mac.Write([]byte(column.String()))
+	return mac.Sum(nil)
+}
+
+// Helper function to decrypt an encrypted value of either version with the
+// given additional data
+func (k *Keyring) decrypt(value string, additionalData []byte) (string, error) {
+	keyId, wrapped, ciphertext, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := k.unwrap(keyId, wrapped)
+	if err != nil {
+		return "", err
+	}
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(data, ciphertext, additionalData)
+	if err != nil {
+		return "", ErrMalformed
+	}
+	return string(plaintext), nil
+}
+
+// Helper function to unwrap the data key of a value
+func (k *Keyring) unwrap(keyId string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyId)
+	}
+	dataKey, err := open(aead, wrapped, []byte(keyId))
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	return dataKey, nil
+}
+
+// Helper function to build the stored form of an encrypted value
+func (k *Keyring) format(keyId string, wrapped, ciphertext []byte) string {
+	return prefix + keyId + ":" +
+		base64.RawStdEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext)
+}
+
+// Helper function to split an encrypted value of either version into its
+// key id, wrapped data key and ciphertext
+func parse(value string) (string, []byte, []byte, error) {
+	value, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		value, ok = strings.CutPrefix(value, unboundPrefix)
+	}
+	if !ok {
+		return "", nil, nil, ErrMalformed
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", nil, nil, ErrMalformed
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	return parts[0], wrapped, ciphertext, nil
+}
+
+// Helper function to decode a base64 encoded key
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("must be base64 encoded")
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("must be %d bytes", keySize)
+	}
+	return key, nil
+}
+
+// Helper function to create an AES-GCM cipher
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Helper function to encrypt plaintext with a random nonce, which is
+// prepended to the result
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Helper function to decrypt the result of seal
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,260 @@
+package fieldcrypt
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/rifqidaiva/stunting-web/internal/config"
+)
+
+// Helper function to create a new random base64 encoded key
+func newTestKey() string {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// Helper function to create a key ring with new random keys, the first id
+// being the active key
+func newTestKeyring(t *testing.T, ids ...string) (*Keyring, config.EncryptionConfig) {
+	t.Helper()
+	cfg := config.EncryptionConfig{
+		Keys:      make(map[string]string),
+		ActiveKey: ids[0],
+		IndexKey:  newTestKey(),
+	}
+	for _, id := range ids {
+		cfg.Keys[id] = newTestKey()
+	}
+	k, err := NewKeyring(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k, cfg
+}
+
+// Helper function to encrypt a value the way it was written before values
+// were bound to their row
+func encryptUnbound(t *testing.T, k *Keyring, value string) string {
+	t.Helper()
+	dataKey := make([]byte, keySize)
+	rand.Read(dataKey)
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := seal(k.keys[k.active], dataKey, []byte(k.active))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := seal(data, []byte(value), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return unboundPrefix + strings.TrimPrefix(k.format(k.active, wrapped, ciphertext), prefix)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+
+	encrypted, err := k.Encrypt(NikAyah, "7", "3209010101900001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encrypted, prefix+"k1:") {
+		t.Errorf("Encrypt = %q, want the prefix %q", encrypted, prefix+"k1:")
+	}
+	if again, _ := k.Encrypt(NikAyah, "7", "3209010101900001"); again == encrypted {
+		t.Error("encrypting twice gave the same value")
+	}
+
+	got, err := k.Decrypt(NikAyah, "7", encrypted)
+	if err != nil || got != "3209010101900001" {
+		t.Errorf("Decrypt = %q, %v, want the NIK", got, err)
+	}
+
+	// The empty string and plaintext written before encryption pass through
+	if got, err := k.Encrypt(NikIbu, "7", ""); got != "" || err != nil {
+		t.Errorf("Encrypt of the empty string = %q, %v", got, err)
+	}
+	if got, err := k.Decrypt(NikIbu, "7", "3209010101900002"); got != "3209010101900002" || err != nil {
+		t.Errorf("Decrypt of plaintext = %q, %v", got, err)
+	}
+}
+
+// A value copied to another column, row or table must not decrypt
+func TestDecryptBinding(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+	encrypted, err := k.Encrypt(NomorHpPelapor, "7", "081234567890")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		column Column
+		id     string
+	}{
+		{"other column", NomorHpKeluargaBalita, "7"},
+		{"other row", NomorHpPelapor, "8"},
+		{"other row with a shared prefix", NomorHpPelapor, "70"},
+		{"other table", Column{"keluarga", "nomor_hp_pelapor"}, "7"},
+	}
+	for _, tt := range tests {
+		if got, err := k.Decrypt(tt.column, tt.id, encrypted); !errors.Is(err, ErrMalformed) {
+			t.Errorf("%s: Decrypt = %q, %v, want ErrMalformed", tt.name, got, err)
+		}
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+	encrypted, err := k.Encrypt(NomorKk, "1", "3209010101230001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(encrypted, ":")
+	ciphertext, _ := base64.RawStdEncoding.DecodeString(parts[4])
+	ciphertext[len(ciphertext)-1] ^= 1
+	parts[4] = base64.RawStdEncoding.EncodeToString(ciphertext)
+
+	for _, value := range []string{
+		strings.Join(parts, ":"),
+		prefix + "k1:" + parts[3],
+		prefix + "k1:!:" + parts[4],
+	} {
+		if got, err := k.Decrypt(NomorKk, "1", value); !errors.Is(err, ErrMalformed) {
+			t.Errorf("Decrypt(%q) = %q, %v, want ErrMalformed", value, got, err)
+		}
+	}
+
+	unknown := strings.Replace(encrypted, prefix+"k1:", prefix+"k2:", 1)
+	if _, err := k.Decrypt(NomorKk, "1", unknown); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt with an unknown key = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	old, cfg := newTestKeyring(t, "k1")
+	encrypted, err := old.Encrypt(NomorKk, "1", "3209010101230001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add k2 and make it active, keeping k1 to read the existing values
+	keys := maps.Clone(cfg.Keys)
+	keys["k2"] = newTestKey()
+	k, err := NewKeyring(config.EncryptionConfig{Keys: keys, ActiveKey: "k2", IndexKey: cfg.IndexKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, changed, err := k.Rotate(NomorKk, "1", encrypted)
+	if err != nil || !changed {
+		t.Fatalf("Rotate = %v, %v, want a changed value", changed, err)
+	}
+	if !strings.HasPrefix(rotated, prefix+"k2:") {
+		t.Errorf("Rotate = %q, want it wrapped by k2", rotated)
+	}
+	if got, err := k.Decrypt(NomorKk, "1", rotated); err != nil || got != "3209010101230001" {
+		t.Errorf("Decrypt after Rotate = %q, %v", got, err)
+	}
+	if _, err := old.Decrypt(NomorKk, "1", rotated); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt with the old key ring = %v, want ErrUnknownKey", err)
+	}
+
+	// Rotating again is a no-op
+	if again, changed, err := k.Rotate(NomorKk, "1", rotated); err != nil || changed || again != rotated {
+		t.Errorf("second Rotate = %v, %v, want the value unchanged", changed, err)
+	}
+
+	// The index key is kept, so blind indexes do not change
+	if old.BlindIndex(NomorKk, "3209010101230001") != k.BlindIndex(NomorKk, "3209010101230001") {
+		t.Error("blind index changed with the active key")
+	}
+}
+
+func TestRotateBinds(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+
+	unbound := encryptUnbound(t, k, "081234567890")
+	if _, err := k.Decrypt(NomorHpPelapor, "3", unbound); !errors.Is(err, ErrUnbound) {
+		t.Errorf("Decrypt of an unbound value = %v, want ErrUnbound", err)
+	}
+
+	for _, value := range []string{unbound, "081234567890"} {
+		bound, changed, err := k.Rotate(NomorHpPelapor, "3", value)
+		if err != nil || !changed {
+			t.Fatalf("Rotate(%q) = %v, %v, want a changed value", value, changed, err)
+		}
+		if got, err := k.Decrypt(NomorHpPelapor, "3", bound); err != nil || got != "081234567890" {
+			t.Errorf("Decrypt after Rotate(%q) = %q, %v", value, got, err)
+		}
+		if _, err := k.Decrypt(NomorHpPelapor, "4", bound); !errors.Is(err, ErrMalformed) {
+			t.Errorf("Rotate(%q) did not bind the value to its row: %v", value, err)
+		}
+	}
+
+	if got, changed, err := k.Rotate(NomorHpPelapor, "3", ""); got != "" || changed || err != nil {
+		t.Errorf("Rotate of the empty string = %q, %v, %v", got, changed, err)
+	}
+}
+
+func TestBlindIndex(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+	const nik = "3209010101900001"
+
+	index := k.BlindIndex(NikAyah, nik)
+	if len(index) != 64 {
+		t.Errorf("BlindIndex = %q, want 64 hex digits", index)
+	}
+	if got := k.BlindIndex(NikAyah, " "+nik+"\n"); got != index {
+		t.Error("surrounding spaces changed the blind index")
+	}
+	if k.BlindIndex(NikIbu, nik) == index {
+		t.Error("NIK ayah and NIK ibu share a blind index")
+	}
+	if other, _ := newTestKeyring(t, "k1"); other.BlindIndex(NikAyah, nik) == index {
+		t.Error("blind index does not depend on the index key")
+	}
+	if got := k.BlindIndex(NikAyah, "  "); got != "" {
+		t.Errorf("BlindIndex of spaces = %q, want empty", got)
+	}
+}
+
+func TestDecryptAll(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1")
+
+	values := []string{"3209010101230001", "3209010101900001", ""}
+	encrypted := make([]string, len(values))
+	for i, column := range Columns["keluarga"] {
+		var err error
+		if encrypted[i], err = k.Encrypt(column, "5", values[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := append([]string(nil), encrypted...)
+	if err := k.DecryptAll("keluarga", "5", &got[0], &got[1], &got[2]); err != nil {
+		t.Fatal(err)
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("%s = %q, want %q", Columns["keluarga"][i], got[i], values[i])
+		}
+	}
+
+	// Swapped NIK ayah and NIK ibu
+	got = []string{encrypted[0], encrypted[1], encrypted[1]}
+	if err := k.DecryptAll("keluarga", "5", &got[0], &got[1], &got[2]); !errors.Is(err, ErrMalformed) {
+		t.Errorf("DecryptAll of a NIK ayah as NIK ibu = %v, want ErrMalformed", err)
+	}
+	if err := k.DecryptAll("keluarga", "5", &got[0]); err == nil {
+		t.Error("DecryptAll with too few values: want an error")
+	}
+}
@@ -0,0 +1,245 @@
+package fieldcrypt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MARK: Columns
+
+// Column is an encrypted column. Each column has a blind index in the
+// column named by Index.
+type Column struct {
+	Table string
+	Name  string
+}
+
+// The encrypted columns
+var (
+	NomorKk               = Column{"keluarga", "nomor_kk"}
+	NikAyah               = Column{"keluarga", "nik_ayah"}
+	NikIbu                = Column{"keluarga", "nik_ibu"}
+	NomorHpPelapor        = Column{"laporan_masyarakat", "nomor_hp_pelapor"}
+	NomorHpKeluargaBalita = Column{"laporan_masyarakat", "nomor_hp_keluarga_balita"}
+)
+
+// Columns lists the encrypted columns of every table.
+var Columns = map[string][]Column{
+	"keluarga":           {NomorKk, NikAyah, NikIbu},
+	"laporan_masyarakat": {NomorHpPelapor, NomorHpKeluargaBalita},
+}
+
+func (c Column) String() string {
+	return c.Table + "." + c.Name
+}
+
+// Index returns the name of the blind index column of the column.
+func (c Column) Index() string {
+	return IndexColumn(c.Name)
+}
+
+// IndexColumn returns the name of the blind index column of column.
+func IndexColumn(column string) string {
+	return column + "_index"
+}
+
+// Helper function to build the additional data binding a value to its
+// column and row
+func (c Column) additionalData(id string) []byte {
+	return []byte(c.String() + ":" + id)
+}
+
+// WriteRow encrypts the values of the encrypted columns of the row with the
+// given id, given in the order of Columns[table], and stores them with their
+// blind indexes. As values are bound to their row id, an insert writes the
+// other columns first and the encrypted ones with WriteRow once the id is
+// known, in the same transaction.
+func (k *Keyring) WriteRow(tx *sql.Tx, table, id string, values ...string) error {
+	columns := Columns[table]
+	if len(values) != len(columns) {
+		return fmt.Errorf("fieldcrypt: %s has %d encrypted columns, got %d values", table, len(columns), len(values))
+	}
+
+	var assignments []string
+	var args []any
+	for i, column := range columns {
+		encrypted, err := k.Encrypt(column, id, values[i])
+		if err != nil {
+			return err
+		}
+		assignments = append(assignments, column.Name+" = ?", column.Index()+" = ?")
+		args = append(args, encrypted, k.BlindIndex(column, values[i]))
+	}
+
+	update := fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, strings.Join(assignments, ", "))
+	_, err := tx.Exec(update, append(args, id)...)
+	return err
+}
+
+// MARK: Rotate
+
+// Unrotated counts per table the rows holding a value that RotateAll still
+// has to process before it can be read and looked up: plaintext, a value
+// not yet bound to its row, or a value without its blind index. Tables
+// without such rows are left out.
+func Unrotated(ctx context.Context, db *sql.DB) (map[string]int, error) {
+	counts := make(map[string]int)
+	for table, columns := range Columns {
+		var conditions []string
+		var args []any
+		for _, column := range columns {
+			conditions = append(conditions, fmt.Sprintf("(%s <> '' AND (%s NOT LIKE CONCAT(?, '%%') OR %s IS NULL))",
+				column.Name, column.Name, column.Index()))
+			args = append(args, prefix)
+		}
+
+		var n int
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, strings.Join(conditions, " OR "))
+		if err := db.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+			return nil, fmt.Errorf("fieldcrypt: %s: %w", table, err)
+		}
+		if n > 0 {
+			counts[table] = n
+		}
+	}
+	return counts, nil
+}
+
+// rotateBatchSize is the number of rows rewritten per transaction.
+const rotateBatchSize = 500
+
+// RotateResult counts the rows of a table checked and rewritten by RotateAll.
+type RotateResult struct {
+	Table   string
+	Checked int
+	Updated int
+}
+
+// RotateAll brings every row of the tables in Columns up to date with the
+// key ring: values are encrypted with the active key, plaintext values
+// written before encryption was enabled are encrypted, values not yet bound
+// to their row are bound, and the blind indexes are recomputed with the
+// current index key. Rows already up to date are left untouched, so it is
+// safe to run repeatedly.
+func RotateAll(ctx context.Context, db *sql.DB, keys *Keyring) ([]RotateResult, error) {
+	var results []RotateResult
+	for _, table := range slices.Sorted(maps.Keys(Columns)) {
+		result := RotateResult{Table: table}
+		lastId := 0
+		for {
+			n, last, updated, err := rotateBatch(ctx, db, keys, table, lastId)
+			if err != nil {
+				return results, fmt.Errorf("fieldcrypt: %s: %w", table, err)
+			}
+			result.Checked += n
+			result.Updated += updated
+			if n < rotateBatchSize {
+				break
+			}
+			lastId = last
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Helper function to rotate the batch of rows of table following lastId. It
+// returns the number of rows read, the id of the last one and the number of
+// rows updated.
+func rotateBatch(ctx context.Context, db *sql.DB, keys *Keyring, table string, lastId int) (int, int, int, error) {
+	columns := Columns[table]
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	defer tx.Rollback()
+
+	selected := []string{"id"}
+	var assignments []string
+	for _, column := range columns {
+		selected = append(selected, column.Name, column.Index())
+		assignments = append(assignments, column.Name+" = ?", column.Index()+" = ?")
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id > ? ORDER BY id LIMIT ? FOR UPDATE",
+		strings.Join(selected, ", "), table)
+	update := fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, strings.Join(assignments, ", "))
+
+	rows, err := tx.QueryContext(ctx, query, lastId, rotateBatchSize)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	type row struct {
+		id     int
+		values []sql.NullString // value and index of every column
+	}
+	var batch []row
+	for rows.Next() {
+		r := row{values: make([]sql.NullString, 2*len(columns))}
+		dest := []any{&r.id}
+		for i := range r.values {
+			dest = append(dest, &r.values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			rows.Close()
+			return 0, 0, 0, err
+		}
+		batch = append(batch, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	updated := 0
+	for _, r := range batch {
+		changed := false
+		args := make([]any, 0, len(r.values)+1)
+		id := strconv.Itoa(r.id)
+		for i, column := range columns {
+			value, index := r.values[2*i], r.values[2*i+1]
+			if !value.Valid {
+				changed = changed || index.Valid
+				args = append(args, nil, nil)
+				continue
+			}
+
+			rotated, rotatedChanged, err := keys.Rotate(column, id, value.String)
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("id %d: %s: %w", r.id, column.Name, err)
+			}
+			plaintext, err := keys.Decrypt(column, id, rotated)
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("id %d: %s: %w", r.id, column.Name, err)
+			}
+			blindIndex := keys.BlindIndex(column, plaintext)
+
+			changed = changed || rotatedChanged || !index.Valid || index.String != blindIndex
+			args = append(args, rotated, blindIndex)
+		}
+		if !changed {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, update, append(args, r.id)...); err != nil {
+			return 0, 0, 0, fmt.Errorf("id %d: %w", r.id, err)
+		}
+		updated++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	last := lastId
+	if len(batch) > 0 {
+		last = batch[len(batch)-1].id
+	}
+	return len(batch), last, updated, nil
+}
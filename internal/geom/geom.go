@@ -0,0 +1,79 @@
+// Package geom reads and writes 2D geometries in the Well-Known Text and
+// Well-Known Binary formats of the OpenGIS Simple Features specification,
+// and in the internal format MySQL stores and returns geometry columns in.
+//
+// Reading geometry columns directly, e.g. SELECT koordinat scanned into a
+// NullGeometry, avoids formatting them as text with ST_AsText in MySQL and
+// parsing the text again. Malformed input is reported as a *SyntaxError
+// with the byte offset of the problem.
+package geom
+
+import "fmt"
+
+// Geometry is one of Point, LineString, Polygon, MultiPoint,
+// MultiLineString, MultiPolygon and GeometryCollection.
+type Geometry interface {
+	// Type returns the name of the geometry type in WKT, e.g. POINT.
+	Type() string
+
+	wkbType() uint32
+}
+
+// Point is a position, X first: longitude and latitude for koordinat.
+type Point [2]float64
+
+// LineString is a line through its points.
+type LineString []Point
+
+// Polygon is an exterior ring followed by its holes. Rings are closed, the
+// last point repeats the first.
+type Polygon []LineString
+
+// MultiPoint is a collection of points.
+type MultiPoint []Point
+
+// MultiLineString is a collection of line strings.
+type MultiLineString []LineString
+
+// MultiPolygon is a collection of polygons.
+type MultiPolygon []Polygon
+
+// GeometryCollection is a collection of geometries of any type.
+type GeometryCollection []Geometry
+
+// WKB geometry type codes
+const (
+	wkbPoint              = 1
+	wkbLineString         = 2
+	wkbPolygon            = 3
+	wkbMultiPoint         = 4
+	wkbMultiLineString    = 5
+	wkbMultiPolygon       = 6
+	wkbGeometryCollection = 7
+)
+
+func (Point) Type() string              { return "POINT" }
+func (LineString) Type() string         { return "LINESTRING" }
+func (Polygon) Type() string            { return "POLYGON" }
+func (MultiPoint) Type() string         { return "MULTIPOINT" }
+func (MultiLineString) Type() string    { return "MULTILINESTRING" }
+func (MultiPolygon) Type() string       { return "MULTIPOLYGON" }
+func (GeometryCollection) Type() string { return "GEOMETRYCOLLECTION" }
+
+func (Point) wkbType() uint32              { return wkbPoint }
+func (LineString) wkbType() uint32         { return wkbLineString }
+func (Polygon) wkbType() uint32            { return wkbPolygon }
+func (MultiPoint) wkbType() uint32         { return wkbMultiPoint }
+func (MultiLineString) wkbType() uint32    { return wkbMultiLineString }
+func (MultiPolygon) wkbType() uint32       { return wkbMultiPolygon }
+func (GeometryCollection) wkbType() uint32 { return wkbGeometryCollection }
+
+// SyntaxError is a malformed WKT, WKB or MySQL geometry.
+type SyntaxError struct {
+	Offset int // byte offset in the input
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("geom: %s at offset %d", e.Msg, e.Offset)
+}
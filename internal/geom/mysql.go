@@ -0,0 +1,69 @@
+package geom
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// sridSize is the size of the SRID prefix of the MySQL format.
+const sridSize = 4
+
+// ParseMySQL parses a geometry in the internal format of MySQL, as
+// returned for a geometry column without ST_AsText: a little endian SRID
+// followed by the WKB of the geometry.
+func ParseMySQL(data []byte) (Geometry, uint32, error) {
+	if len(data) < sridSize {
+		return nil, 0, &SyntaxError{Offset: len(data), Msg: "unexpected end of input in SRID"}
+	}
+	srid := binary.LittleEndian.Uint32(data)
+	g, err := parseWKB(data[sridSize:], sridSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return g, srid, nil
+}
+
+// MySQL encodes a geometry in the internal format of MySQL.
+func MySQL(g Geometry, srid uint32) []byte {
+	b := binary.LittleEndian.AppendUint32(nil, srid)
+	return AppendWKB(b, g)
+}
+
+// NullGeometry is a geometry column that may be NULL. It implements
+// sql.Scanner for columns selected as is, e.g. SELECT koordinat.
+type NullGeometry struct {
+	Geometry Geometry
+	SRID     uint32
+	Valid    bool // Valid is true if the column is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullGeometry) Scan(value any) error {
+	if value == nil {
+		*n = NullGeometry{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("geom: cannot scan %T into a geometry", value)
+	}
+
+	g, srid, err := ParseMySQL(data)
+	if err != nil {
+		return err
+	}
+	*n = NullGeometry{Geometry: g, SRID: srid, Valid: true}
+	return nil
+}
+
+// Point returns the geometry if it is a point.
+func (n NullGeometry) Point() (Point, bool) {
+	p, ok := n.Geometry.(Point)
+	return p, n.Valid && ok
+}
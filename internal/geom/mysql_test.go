@@ -0,0 +1,90 @@
+package geom
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// POINT(108.55 -6.73) with SRID 4326, as returned by SELECT koordinat
+const mysqlPoint = "e6100000" + "0101000000" + "3333333333235b40" + "ec51b81e85eb1ac0"
+
+func TestParseMySQL(t *testing.T) {
+	data, _ := hex.DecodeString(mysqlPoint)
+	g, srid, err := ParseMySQL(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srid != 4326 {
+		t.Errorf("srid = %d, want 4326", srid)
+	}
+	if want := (Point{108.55, -6.73}); g != want {
+		t.Errorf("geometry = %v, want %v", g, want)
+	}
+	if got := hex.EncodeToString(MySQL(g, srid)); got != mysqlPoint {
+		t.Errorf("MySQL = %s, want %s", got, mysqlPoint)
+	}
+
+	for _, tt := range roundTripTests {
+		g, srid, err := ParseMySQL(MySQL(tt.geom, 0))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.wkt, err)
+		}
+		if srid != 0 || !reflect.DeepEqual(g, tt.geom) {
+			t.Errorf("%s: got %v with SRID %d", tt.wkt, g, srid)
+		}
+	}
+}
+
+// Offsets count the SRID prefix, so they point into the column value
+func TestParseMySQLErrors(t *testing.T) {
+	data, _ := hex.DecodeString(mysqlPoint)
+	zPoint := append([]byte(nil), data...)
+	zPoint[5], zPoint[6] = 0xe9, 0x03 // 1001, POINT Z
+
+	tests := []struct {
+		name   string
+		data   []byte
+		offset int
+	}{
+		{"no SRID", data[:2], 2},
+		{"SRID only", data[:4], 4},
+		{"truncated point", data[:len(data)-1], 9},
+		{"trailing byte", append(append([]byte(nil), data...), 0), len(data)},
+		{"Z point", zPoint, 5},
+	}
+	for _, tt := range tests {
+		_, _, err := ParseMySQL(tt.data)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("%s: err = %v, want a *SyntaxError", tt.name, err)
+			continue
+		}
+		if syntaxErr.Offset != tt.offset {
+			t.Errorf("%s: offset = %d, want %d (%v)", tt.name, syntaxErr.Offset, tt.offset, err)
+		}
+	}
+}
+
+func TestNullGeometryScan(t *testing.T) {
+	data, _ := hex.DecodeString(mysqlPoint)
+
+	var n NullGeometry
+	if err := n.Scan(data); err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := n.Point(); !ok || p != (Point{108.55, -6.73}) || n.SRID != 4326 {
+		t.Errorf("got %v, %v with SRID %d", p, ok, n.SRID)
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %v, valid %v", err, n.Valid)
+	}
+	if _, ok := n.Point(); ok {
+		t.Error("Point of NULL: want false")
+	}
+	if err := n.Scan(int64(1)); err == nil {
+		t.Error("Scan(int64): want an error")
+	}
+}
@@ -0,0 +1,309 @@
+package geom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Byte orders of WKB
+const (
+	wkbBigEndian    = 0
+	wkbLittleEndian = 1
+)
+
+// Sizes in bytes of the smallest encodings, used to reject counts that
+// cannot fit in the remaining input before allocating for them
+const (
+	pointSize  = 16 // two float64
+	headerSize = 5  // byte order and type
+	countSize  = 4
+)
+
+// ParseWKB parses a geometry in WKB, in either byte order. Geometries with
+// Z or M coordinates are not supported, as MySQL only stores 2D ones.
+func ParseWKB(wkb []byte) (Geometry, error) {
+	return parseWKB(wkb, 0)
+}
+
+// Helper function to parse WKB whose offsets in error messages start at
+// base, e.g. after the SRID of the MySQL format
+func parseWKB(wkb []byte, base int) (Geometry, error) {
+	r := &wkbReader{data: wkb, base: base}
+	g, err := r.geometry(0)
+	if err != nil {
+		return nil, err
+	}
+	if r.pos < len(r.data) {
+		return nil, r.errorf("%d unexpected bytes after the geometry", len(r.data)-r.pos)
+	}
+	return g, nil
+}
+
+// WKB encodes a geometry as little endian WKB.
+func WKB(g Geometry) []byte {
+	return AppendWKB(nil, g)
+}
+
+// AppendWKB appends the little endian WKB of a geometry to b.
+func AppendWKB(b []byte, g Geometry) []byte {
+	b = append(b, wkbLittleEndian)
+	b = binary.LittleEndian.AppendUint32(b, g.wkbType())
+	switch g := g.(type) {
+	case Point:
+		b = appendWKBPoint(b, g)
+	case LineString:
+		b = appendWKBPoints(b, g)
+	case Polygon:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g)))
+		for _, ring := range g {
+			b = appendWKBPoints(b, ring)
+		}
+	case MultiPoint:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g)))
+		for _, p := range g {
+			b = AppendWKB(b, p)
+		}
+	case MultiLineString:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g)))
+		for _, line := range g {
+			b = AppendWKB(b, line)
+		}
+	case MultiPolygon:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g)))
+		for _, polygon := range g {
+			b = AppendWKB(b, polygon)
+		}
+	case GeometryCollection:
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(g)))
+		for _, member := range g {
+			b = AppendWKB(b, member)
+		}
+	}
+	return b
+}
+
+// Helper function to append the coordinates of a point
+func appendWKBPoint(b []byte, p Point) []byte {
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(p[0]))
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(p[1]))
+}
+
+// Helper function to append a counted list of points
+func appendWKBPoints(b []byte, points []Point) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(points)))
+	for _, p := range points {
+		b = appendWKBPoint(b, p)
+	}
+	return b
+}
+
+// MARK: Reader
+
+// maxDepth limits the nesting of geometry collections.
+const maxDepth = 32
+
+type wkbReader struct {
+	data  []byte
+	pos   int
+	base  int
+	order binary.ByteOrder
+}
+
+// Helper function to report a syntax error at the current position
+func (r *wkbReader) errorf(format string, args ...any) error {
+	return r.errorAt(r.pos, format, args...)
+}
+
+// Helper function to report a syntax error at a position
+func (r *wkbReader) errorAt(pos int, format string, args ...any) error {
+	return &SyntaxError{Offset: r.base + pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Helper function to check that n more bytes are available
+func (r *wkbReader) need(n int, what string) error {
+	if len(r.data)-r.pos < n {
+		return r.errorf("unexpected end of input in %s", what)
+	}
+	return nil
+}
+
+func (r *wkbReader) uint32(what string) (uint32, error) {
+	if err := r.need(4, what); err != nil {
+		return 0, err
+	}
+	v := r.order.Uint32(r.data[r.pos:])
+	r.pos += 4
+	return v, nil
+}
+
+// Helper function to read a count of items of at least size bytes each
+func (r *wkbReader) count(size int, what string) (int, error) {
+	pos := r.pos
+	n, err := r.uint32(what)
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n)*uint64(size) > uint64(len(r.data)-r.pos) {
+		return 0, r.errorAt(pos, "%s count %d exceeds the input", what, n)
+	}
+	return int(n), nil
+}
+
+func (r *wkbReader) point() (Point, error) {
+	if err := r.need(pointSize, "point"); err != nil {
+		return Point{}, err
+	}
+	x := math.Float64frombits(r.order.Uint64(r.data[r.pos:]))
+	y := math.Float64frombits(r.order.Uint64(r.data[r.pos+8:]))
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return Point{}, r.errorf("point coordinates must be finite")
+	}
+	r.pos += pointSize
+	return Point{x, y}, nil
+}
+
+// Helper function to read a counted list of points
+func (r *wkbReader) points() ([]Point, error) {
+	n, err := r.count(pointSize, "point")
+	if err != nil {
+		return nil, err
+	}
+	points := make([]Point, n)
+	for i := range points {
+		if points[i], err = r.point(); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
+// Helper function to read the header of a geometry, setting the byte
+// order for its body
+func (r *wkbReader) header() (uint32, error) {
+	if err := r.need(headerSize, "geometry header"); err != nil {
+		return 0, err
+	}
+	switch r.data[r.pos] {
+	case wkbBigEndian:
+		r.order = binary.BigEndian
+	case wkbLittleEndian:
+		r.order = binary.LittleEndian
+	default:
+		return 0, r.errorf("invalid byte order %d", r.data[r.pos])
+	}
+	r.pos++
+
+	pos := r.pos
+	geomType, err := r.uint32("geometry type")
+	if err != nil {
+		return 0, err
+	}
+	if geomType < wkbPoint || geomType > wkbGeometryCollection {
+		if geomType%1000 >= wkbPoint && geomType%1000 <= wkbGeometryCollection || geomType&0xC0000000 != 0 {
+			return 0, r.errorAt(pos, "geometries with Z or M coordinates are not supported")
+		}
+		return 0, r.errorAt(pos, "unknown geometry type %d", geomType)
+	}
+	return geomType, nil
+}
+
+// Helper function to read a member of a collection, which must be of the
+// given type unless want is 0
+func (r *wkbReader) member(want uint32, depth int) (Geometry, error) {
+	pos, order := r.pos, r.order
+	g, err := r.geometry(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	r.order = order
+	if want != 0 && g.wkbType() != want {
+		return nil, r.errorAt(pos, "unexpected %s in a collection of another type", g.Type())
+	}
+	return g, nil
+}
+
+func (r *wkbReader) geometry(depth int) (Geometry, error) {
+	if depth > maxDepth {
+		return nil, r.errorf("geometry collections nested deeper than %d", maxDepth)
+	}
+
+	geomType, err := r.header()
+	if err != nil {
+		return nil, err
+	}
+
+	switch geomType {
+	case wkbPoint:
+		return r.point()
+	case wkbLineString:
+		points, err := r.points()
+		return LineString(points), err
+	case wkbPolygon:
+		n, err := r.count(countSize, "ring")
+		if err != nil {
+			return nil, err
+		}
+		polygon := make(Polygon, n)
+		for i := range polygon {
+			if polygon[i], err = r.points(); err != nil {
+				return nil, err
+			}
+		}
+		return polygon, nil
+	case wkbMultiPoint:
+		n, err := r.count(headerSize+pointSize, "point")
+		if err != nil {
+			return nil, err
+		}
+		multi := make(MultiPoint, n)
+		for i := range multi {
+			g, err := r.member(wkbPoint, depth)
+			if err != nil {
+				return nil, err
+			}
+			multi[i] = g.(Point)
+		}
+		return multi, nil
+	case wkbMultiLineString:
+		n, err := r.count(headerSize+countSize, "line string")
+		if err != nil {
+			return nil, err
+		}
+		multi := make(MultiLineString, n)
+		for i := range multi {
+			g, err := r.member(wkbLineString, depth)
+			if err != nil {
+				return nil, err
+			}
+			multi[i] = g.(LineString)
+		}
+		return multi, nil
+	case wkbMultiPolygon:
+		n, err := r.count(headerSize+countSize, "polygon")
+		if err != nil {
+			return nil, err
+		}
+		multi := make(MultiPolygon, n)
+		for i := range multi {
+			g, err := r.member(wkbPolygon, depth)
+			if err != nil {
+				return nil, err
+			}
+			multi[i] = g.(Polygon)
+		}
+		return multi, nil
+	default:
+		n, err := r.count(headerSize, "geometry")
+		if err != nil {
+			return nil, err
+		}
+		collection := make(GeometryCollection, n)
+		for i := range collection {
+			if collection[i], err = r.member(0, depth); err != nil {
+				return nil, err
+			}
+		}
+		return collection, nil
+	}
+}
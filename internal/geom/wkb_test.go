@@ -0,0 +1,202 @@
+package geom
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// One geometry of each type, as WKT written by ST_AsText
+var roundTripTests = []struct {
+	wkt  string
+	geom Geometry
+}{
+	{"POINT(108.55 -6.73)", Point{108.55, -6.73}},
+	{"LINESTRING(1 2,3 4)", LineString{{1, 2}, {3, 4}}},
+	{"LINESTRING EMPTY", LineString{}},
+	{"POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))", Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 0}},
+		{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+	}},
+	{"MULTIPOINT((1 2),(3 4))", MultiPoint{{1, 2}, {3, 4}}},
+	{"MULTILINESTRING((1 2,3 4),(5 6,7 8))", MultiLineString{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}}},
+	{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", MultiPolygon{
+		{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		{{{2, 2}, {3, 2}, {3, 3}, {2, 2}}},
+	}},
+	{"GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(3 4,5 6),GEOMETRYCOLLECTION(POINT(7 8)))", GeometryCollection{
+		Point{1, 2},
+		LineString{{3, 4}, {5, 6}},
+		GeometryCollection{Point{7, 8}},
+	}},
+	{"GEOMETRYCOLLECTION EMPTY", GeometryCollection{}},
+}
+
+func TestWKTWKBRoundTrip(t *testing.T) {
+	for _, tt := range roundTripTests {
+		g, err := ParseWKT(tt.wkt)
+		if err != nil {
+			t.Fatalf("ParseWKT(%q): %v", tt.wkt, err)
+		}
+		if !reflect.DeepEqual(g, tt.geom) {
+			t.Errorf("ParseWKT(%q) = %#v, want %#v", tt.wkt, g, tt.geom)
+		}
+
+		for _, order := range []byteOrder{binary.LittleEndian, binary.BigEndian} {
+			wkb := appendWKBOrder(nil, tt.geom, order)
+			if order == binary.LittleEndian && !reflect.DeepEqual(wkb, WKB(tt.geom)) {
+				t.Errorf("%s: WKB = %x, want %x", tt.wkt, WKB(tt.geom), wkb)
+			}
+			g, err := ParseWKB(wkb)
+			if err != nil {
+				t.Fatalf("%s: ParseWKB(%s %x): %v", tt.wkt, order, wkb, err)
+			}
+			if got := WKT(g); got != tt.wkt {
+				t.Errorf("%s: WKT(ParseWKB(%s)) = %q", tt.wkt, order, got)
+			}
+		}
+	}
+}
+
+func TestWKBMixedByteOrder(t *testing.T) {
+	// Members of a collection carry their own byte order
+	b := appendWKBOrder(nil, MultiPoint{}, binary.BigEndian)
+	b = binary.BigEndian.AppendUint32(b[:headerSize], 2)
+	b = appendWKBOrder(b, Point{1, 2}, binary.LittleEndian)
+	b = appendWKBOrder(b, Point{3, 4}, binary.BigEndian)
+
+	g, err := ParseWKB(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (MultiPoint{{1, 2}, {3, 4}}); !reflect.DeepEqual(g, want) {
+		t.Errorf("got %v, want %v", g, want)
+	}
+}
+
+func TestParseWKBErrors(t *testing.T) {
+	point := WKB(Point{1, 2})
+	line := WKB(LineString{{1, 2}, {3, 4}})
+
+	withType := func(b []byte, geomType uint32) []byte {
+		b = append([]byte(nil), b...)
+		binary.LittleEndian.PutUint32(b[1:], geomType)
+		return b
+	}
+	withCount := func(b []byte, pos int, n uint32) []byte {
+		b = append([]byte(nil), b...)
+		binary.LittleEndian.PutUint32(b[pos:], n)
+		return b
+	}
+
+	tests := []struct {
+		name   string
+		wkb    []byte
+		offset int
+	}{
+		{"empty", nil, 0},
+		{"truncated header", point[:3], 0},
+		{"truncated point", point[:12], 5},
+		{"truncated line string count", line[:7], 5},
+		{"truncated line string", line[:len(line)-1], 5},
+		{"truncated second point", line[:len(line)-16], 5},
+		{"trailing bytes", append(append([]byte(nil), point...), 0), 21},
+		{"invalid byte order", append([]byte{2}, point[1:]...), 0},
+		{"unknown type", withType(point, 8), 1},
+		{"ISO Z", withType(point, 1001), 1},
+		{"ISO M", withType(point, 2001), 1},
+		{"ISO ZM", withType(point, 3001), 1},
+		{"EWKB Z", withType(point, 0x80000001), 1},
+		{"EWKB M", withType(point, 0x40000001), 1},
+		{"oversized point count", withCount(line, 5, 3), 5},
+		{"huge point count", withCount(line, 5, math.MaxUint32), 5},
+		{"oversized ring count", withCount(WKB(Polygon{{{0, 0}, {1, 0}, {0, 0}}}), 5, 100), 5},
+		{"oversized member count", withCount(WKB(MultiPoint{{1, 2}}), 5, 2), 5},
+		{"oversized collection count", withCount(WKB(GeometryCollection{Point{1, 2}}), 5, 1<<30), 5},
+		{"wrong member type", append(withCount(WKB(MultiPoint{}), 5, 1), line...), 9},
+		{"NaN", WKB(Point{math.NaN(), 0}), 5},
+		{"infinity", WKB(LineString{{1, 2}, {math.Inf(1), 0}}), 25},
+	}
+	for _, tt := range tests {
+		g, err := ParseWKB(tt.wkb)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("%s: ParseWKB(%x) = %v, %v, want a *SyntaxError", tt.name, tt.wkb, g, err)
+			continue
+		}
+		if syntaxErr.Offset != tt.offset {
+			t.Errorf("%s: offset = %d, want %d (%v)", tt.name, syntaxErr.Offset, tt.offset, err)
+		}
+	}
+}
+
+func TestWKBNestingLimit(t *testing.T) {
+	var g Geometry = Point{1, 2}
+	for range maxDepth + 1 {
+		g = GeometryCollection{g}
+	}
+	if _, err := ParseWKB(WKB(g)); err == nil {
+		t.Errorf("collections nested %d deep: want an error", maxDepth+1)
+	}
+}
+
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// Helper function to encode WKB in either byte order, as written by other
+// databases than MySQL
+func appendWKBOrder(b []byte, g Geometry, order byteOrder) []byte {
+	if order == binary.BigEndian {
+		b = append(b, wkbBigEndian)
+	} else {
+		b = append(b, wkbLittleEndian)
+	}
+	b = order.AppendUint32(b, g.wkbType())
+
+	points := func(b []byte, points []Point) []byte {
+		b = order.AppendUint32(b, uint32(len(points)))
+		for _, p := range points {
+			b = order.AppendUint64(b, math.Float64bits(p[0]))
+			b = order.AppendUint64(b, math.Float64bits(p[1]))
+		}
+		return b
+	}
+
+	switch g := g.(type) {
+	case Point:
+		b = order.AppendUint64(b, math.Float64bits(g[0]))
+		b = order.AppendUint64(b, math.Float64bits(g[1]))
+	case LineString:
+		b = points(b, g)
+	case Polygon:
+		b = order.AppendUint32(b, uint32(len(g)))
+		for _, ring := range g {
+			b = points(b, ring)
+		}
+	case MultiPoint:
+		b = order.AppendUint32(b, uint32(len(g)))
+		for _, p := range g {
+			b = appendWKBOrder(b, p, order)
+		}
+	case MultiLineString:
+		b = order.AppendUint32(b, uint32(len(g)))
+		for _, line := range g {
+			b = appendWKBOrder(b, line, order)
+		}
+	case MultiPolygon:
+		b = order.AppendUint32(b, uint32(len(g)))
+		for _, polygon := range g {
+			b = appendWKBOrder(b, polygon, order)
+		}
+	case GeometryCollection:
+		b = order.AppendUint32(b, uint32(len(g)))
+		for _, member := range g {
+			b = appendWKBOrder(b, member, order)
+		}
+	}
+	return b
+}
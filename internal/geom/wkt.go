@@ -0,0 +1,402 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseWKT parses a geometry in WKT, e.g. "POINT(108.55 -6.73)". Keywords
+// are case insensitive, and members of a MULTIPOINT may be written with or
+// without parentheses. Empty geometries are accepted except POINT EMPTY,
+// which has no coordinates to hold, and as in ParseWKB geometries with Z or
+// M coordinates are not supported.
+func ParseWKT(wkt string) (Geometry, error) {
+	p := &wktParser{input: wkt}
+	g, err := p.geometry()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected %q after the geometry", p.input[p.pos:p.pos+1])
+	}
+	return g, nil
+}
+
+// WKT formats a geometry as WKT the way MySQL's ST_AsText does, e.g.
+// "MULTIPOINT((1 2),(3 4))", with the shortest decimal numbers that read
+// back to the same coordinates.
+func WKT(g Geometry) string {
+	return string(AppendWKT(nil, g))
+}
+
+// AppendWKT appends the WKT of a geometry to b.
+func AppendWKT(b []byte, g Geometry) []byte {
+	b = append(b, g.Type()...)
+	switch g := g.(type) {
+	case Point:
+		b = append(b, '(')
+		b = appendWKTPoint(b, g)
+		return append(b, ')')
+	case LineString:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		return appendWKTPoints(b, g)
+	case Polygon:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		return appendWKTRings(b, g)
+	case MultiPoint:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		b = append(b, '(')
+		for i, p := range g {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, '(')
+			b = appendWKTPoint(b, p)
+			b = append(b, ')')
+		}
+		return append(b, ')')
+	case MultiLineString:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		return appendWKTRings(b, g)
+	case MultiPolygon:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		b = append(b, '(')
+		for i, polygon := range g {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendWKTRings(b, polygon)
+		}
+		return append(b, ')')
+	case GeometryCollection:
+		if len(g) == 0 {
+			return append(b, " EMPTY"...)
+		}
+		b = append(b, '(')
+		for i, member := range g {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = AppendWKT(b, member)
+		}
+		return append(b, ')')
+	}
+	return b
+}
+
+// Helper function to append the coordinates of a point
+func appendWKTPoint(b []byte, p Point) []byte {
+	b = strconv.AppendFloat(b, p[0], 'f', -1, 64)
+	b = append(b, ' ')
+	return strconv.AppendFloat(b, p[1], 'f', -1, 64)
+}
+
+// Helper function to append a parenthesized list of points
+func appendWKTPoints(b []byte, points []Point) []byte {
+	b = append(b, '(')
+	for i, p := range points {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendWKTPoint(b, p)
+	}
+	return append(b, ')')
+}
+
+// Helper function to append a parenthesized list of point lists
+func appendWKTRings(b []byte, rings []LineString) []byte {
+	b = append(b, '(')
+	for i, ring := range rings {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendWKTPoints(b, ring)
+	}
+	return append(b, ')')
+}
+
+// MARK: Parser
+
+type wktParser struct {
+	input string
+	pos   int
+}
+
+// Helper function to report a syntax error at the current position
+func (p *wktParser) errorf(format string, args ...any) error {
+	return p.errorAt(p.pos, format, args...)
+}
+
+// Helper function to report a syntax error at a position
+func (p *wktParser) errorAt(pos int, format string, args ...any) error {
+	return &SyntaxError{Offset: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// Helper function to read a keyword, returned in upper case
+func (p *wktParser) keyword() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			break
+		}
+		p.pos++
+	}
+	return strings.ToUpper(p.input[start:p.pos])
+}
+
+// Helper function to consume c if it is the next character
+func (p *wktParser) accept(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// Helper function to consume c, which must be the next character
+func (p *wktParser) expect(c byte) error {
+	if p.accept(c) {
+		return nil
+	}
+	if p.pos >= len(p.input) {
+		return p.errorf("expected %q, found end of input", string(c))
+	}
+	return p.errorf("expected %q, found %q", string(c), p.input[p.pos:p.pos+1])
+}
+
+// Helper function to consume the EMPTY keyword if it is next
+func (p *wktParser) empty() bool {
+	pos := p.pos
+	if p.keyword() == "EMPTY" {
+		return true
+	}
+	p.pos = pos
+	return false
+}
+
+// Helper function to consume the Z, M or ZM keyword if it is next
+func (p *wktParser) dimension() bool {
+	pos := p.pos
+	switch p.keyword() {
+	case "Z", "M", "ZM":
+		return true
+	}
+	p.pos = pos
+	return false
+}
+
+func (p *wktParser) geometry() (Geometry, error) {
+	p.skipSpace()
+	start := p.pos
+	name := p.keyword()
+	if name != "" && p.dimension() {
+		return nil, p.errorAt(start, "geometries with Z or M coordinates are not supported")
+	}
+
+	switch name {
+	case "POINT":
+		if p.empty() {
+			return nil, p.errorAt(start, "POINT EMPTY is not supported")
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		point, err := p.point()
+		if err != nil {
+			return nil, err
+		}
+		return point, p.expect(')')
+	case "LINESTRING":
+		if p.empty() {
+			return LineString{}, nil
+		}
+		return p.points()
+	case "POLYGON":
+		if p.empty() {
+			return Polygon{}, nil
+		}
+		rings, err := p.rings()
+		return Polygon(rings), err
+	case "MULTIPOINT":
+		if p.empty() {
+			return MultiPoint{}, nil
+		}
+		return p.multiPoint()
+	case "MULTILINESTRING":
+		if p.empty() {
+			return MultiLineString{}, nil
+		}
+		rings, err := p.rings()
+		return MultiLineString(rings), err
+	case "MULTIPOLYGON":
+		if p.empty() {
+			return MultiPolygon{}, nil
+		}
+		return p.multiPolygon()
+	case "GEOMETRYCOLLECTION":
+		if p.empty() {
+			return GeometryCollection{}, nil
+		}
+		return p.geometryCollection()
+	case "":
+		if p.pos >= len(p.input) {
+			return nil, p.errorAt(start, "expected a geometry type, found end of input")
+		}
+		return nil, p.errorAt(start, "expected a geometry type, found %q", p.input[p.pos:p.pos+1])
+	default:
+		return nil, p.errorAt(start, "unknown geometry type %s", name)
+	}
+}
+
+// Helper function to read the two numbers of a point
+func (p *wktParser) point() (Point, error) {
+	x, err := p.number()
+	if err != nil {
+		return Point{}, err
+	}
+	y, err := p.number()
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{x, y}, nil
+}
+
+func (p *wktParser) number() (float64, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) && strings.IndexByte("0123456789+-.eE", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos >= len(p.input) {
+			return 0, p.errorf("expected a number, found end of input")
+		}
+		return 0, p.errorf("expected a number, found %q", p.input[p.pos:p.pos+1])
+	}
+
+	v, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+	if err != nil || math.IsInf(v, 0) {
+		return 0, p.errorAt(start, "invalid number %q", p.input[start:p.pos])
+	}
+	return v, nil
+}
+
+// Helper function to read a parenthesized list of points
+func (p *wktParser) points() (LineString, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var points LineString
+	for {
+		point, err := p.point()
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+		if !p.accept(',') {
+			break
+		}
+	}
+	return points, p.expect(')')
+}
+
+// Helper function to read a parenthesized list of point lists
+func (p *wktParser) rings() ([]LineString, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var rings []LineString
+	for {
+		ring, err := p.points()
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, ring)
+		if !p.accept(',') {
+			break
+		}
+	}
+	return rings, p.expect(')')
+}
+
+func (p *wktParser) multiPoint() (MultiPoint, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var points MultiPoint
+	for {
+		parenthesized := p.accept('(')
+		point, err := p.point()
+		if err != nil {
+			return nil, err
+		}
+		if parenthesized {
+			if err := p.expect(')'); err != nil {
+				return nil, err
+			}
+		}
+		points = append(points, point)
+		if !p.accept(',') {
+			break
+		}
+	}
+	return points, p.expect(')')
+}
+
+func (p *wktParser) multiPolygon() (MultiPolygon, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var polygons MultiPolygon
+	for {
+		rings, err := p.rings()
+		if err != nil {
+			return nil, err
+		}
+		polygons = append(polygons, Polygon(rings))
+		if !p.accept(',') {
+			break
+		}
+	}
+	return polygons, p.expect(')')
+}
+
+func (p *wktParser) geometryCollection() (GeometryCollection, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var geometries GeometryCollection
+	for {
+		g, err := p.geometry()
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, g)
+		if !p.accept(',') {
+			break
+		}
+	}
+	return geometries, p.expect(')')
+}
@@ -0,0 +1,82 @@
+package geom
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseWKTVariants(t *testing.T) {
+	tests := []struct {
+		wkt  string
+		want Geometry
+	}{
+		{"point(108.55 -6.73)", Point{108.55, -6.73}},
+		{"  POINT ( 1.5e2  -2 ) ", Point{150, -2}},
+		{"MULTIPOINT(1 2,3 4)", MultiPoint{{1, 2}, {3, 4}}},
+		{"MULTIPOINT((1 2),3 4)", MultiPoint{{1, 2}, {3, 4}}},
+		{"Polygon EMPTY", Polygon{}},
+		{"MULTIPOLYGON EMPTY", MultiPolygon{}},
+	}
+	for _, tt := range tests {
+		g, err := ParseWKT(tt.wkt)
+		if err != nil {
+			t.Errorf("ParseWKT(%q): %v", tt.wkt, err)
+			continue
+		}
+		if !reflect.DeepEqual(g, tt.want) {
+			t.Errorf("ParseWKT(%q) = %#v, want %#v", tt.wkt, g, tt.want)
+		}
+	}
+}
+
+func TestParseWKTErrors(t *testing.T) {
+	tests := []struct {
+		wkt    string
+		offset int
+	}{
+		{"", 0},
+		{"  ", 2},
+		{"(1 2)", 0},
+		{"CIRCLE(1 2)", 0},
+		{"POINT EMPTY", 0},
+		{"POINT(1)", 7},
+		{"POINT(1 2", 9},
+		{"POINT(1 2 3)", 10},
+		{"POINT(1 x)", 8},
+		{"POINT(1e999 2)", 6},
+		{"POINT(1 2) 3", 11},
+		{"POINT Z(1 2 3)", 0},
+		{"POINT M (1 2 3)", 0},
+		{"LINESTRING ZM(1 2 3 4,5 6 7 8)", 0},
+		{"GEOMETRYCOLLECTION(POINT(1 2),POINT Z(1 2 3))", 30},
+		{"LINESTRING(1 2,)", 15},
+		{"POLYGON((0 0,1 0,0 0)", 21},
+		{"MULTIPOINT((1 2)", 16},
+		{"MULTIPOLYGON((0 0,1 0,0 0))", 14},
+	}
+	for _, tt := range tests {
+		g, err := ParseWKT(tt.wkt)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("ParseWKT(%q) = %v, %v, want a *SyntaxError", tt.wkt, g, err)
+			continue
+		}
+		if syntaxErr.Offset != tt.offset {
+			t.Errorf("ParseWKT(%q): offset = %d, want %d (%v)", tt.wkt, syntaxErr.Offset, tt.offset, err)
+		}
+	}
+}
+
+// Coordinates are written with the shortest decimals that read back the same
+func TestWKTNumbers(t *testing.T) {
+	for _, p := range []Point{{0.1, 0.2}, {108.5512345678901, -6.7334}, {1e-7, 123456789}, {-0, 1}} {
+		g, err := ParseWKT(WKT(p))
+		if err != nil {
+			t.Fatalf("%v: %v", p, err)
+		}
+		if g != p {
+			t.Errorf("ParseWKT(WKT(%v)) = %v (%s)", p, g, WKT(p))
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Package growth computes anthropometric z-scores against the WHO 2006
+// Child Growth Standards and derives the nutritional status (status gizi)
+// of a balita following Permenkes No. 2 Tahun 2020.
+//
+// Three indicators are computed for every examination:
+//   - TB/U: length/height-for-age
+//   - BB/U: weight-for-age
+//   - BB/TB: weight-for-length (under 24 months) or weight-for-height
+//
+// Children under 24 months are assumed to be measured lying down (length)
+// and older children standing up (height), as prescribed by the WHO.
+package growth
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Status gizi values stored in riwayat_pemeriksaan.status_gizi.
+const (
+	StatusNormal    = "normal"
+	StatusStunting  = "stunting"
+	StatusGiziBuruk = "gizi buruk"
+)
+
+// AllowedStatus lists every valid status gizi value.
+var AllowedStatus = []string{StatusNormal, StatusStunting, StatusGiziBuruk}
+
+var (
+	// ErrOutOfRange is returned when the age or body length of the child
+	// falls outside the range covered by the WHO reference tables.
+	ErrOutOfRange = errors.New("measurement outside WHO reference range")
+
+	// ErrImplausible is returned when a z-score exceeds the WHO
+	// biologically implausible value cut-offs, which almost always
+	// indicates a measurement or data entry error.
+	ErrImplausible = errors.New("biologically implausible measurement")
+
+	// ErrInvalidSex is returned when jenis kelamin is not "L" or "P".
+	ErrInvalidSex = errors.New("jenis kelamin must be L or P")
+
+	// ErrStatusMismatch is returned by Verify when a submitted status gizi
+	// contradicts the computed one.
+	ErrStatusMismatch = errors.New("status gizi contradicts WHO growth standard")
+)
+
+// daysPerMonth is the average month length used by the WHO tables.
+const daysPerMonth = 30.4375
+
+type reference struct {
+	lengthForAge lmsTable
+	heightForAge lmsTable
+	weightForAge lmsTable
+	weightForLen lmsTable
+	weightForHgt lmsTable
+}
+
+var references = map[string]reference{
+	"L": {
+		lengthForAge: mustLoadTable("lhfa_boys_0_24.csv"),
+		heightForAge: mustLoadTable("lhfa_boys_24_60.csv"),
+		weightForAge: mustLoadTable("wfa_boys.csv"),
+		weightForLen: mustLoadTable("wfl_boys.csv"),
+		weightForHgt: mustLoadTable("wfh_boys.csv"),
+	},
+	"P": {
+		lengthForAge: mustLoadTable("lhfa_girls_0_24.csv"),
+		heightForAge: mustLoadTable("lhfa_girls_24_60.csv"),
+		weightForAge: mustLoadTable("wfa_girls.csv"),
+		weightForLen: mustLoadTable("wfl_girls.csv"),
+		weightForHgt: mustLoadTable("wfh_girls.csv"),
+	},
+}
+
+// Measurement is a single anthropometric examination of a balita.
+type Measurement struct {
+	JenisKelamin string    // "L" or "P"
+	TanggalLahir time.Time // date of birth
+	Tanggal      time.Time // examination date
+	BeratBadan   float64   // weight in kg
+	TinggiBadan  float64   // length/height in cm
+}
+
+// NewMeasurement builds a Measurement from the string values used by the
+// API and the database (dates as YYYY-MM-DD, decimals as text).
+func NewMeasurement(jenisKelamin, tanggalLahir, tanggal, beratBadan, tinggiBadan string) (Measurement, error) {
+	lahir, err := time.Parse("2006-01-02", tanggalLahir)
+	if err != nil {
+		return Measurement{}, fmt.Errorf("invalid tanggal lahir")
+	}
+	pemeriksaan, err := time.Parse("2006-01-02", tanggal)
+	if err != nil {
+		return Measurement{}, fmt.Errorf("invalid tanggal pemeriksaan")
+	}
+	berat, err := strconv.ParseFloat(beratBadan, 64)
+	if err != nil {
+		return Measurement{}, fmt.Errorf("invalid berat badan")
+	}
+	tinggi, err := strconv.ParseFloat(tinggiBadan, 64)
+	if err != nil {
+		return Measurement{}, fmt.Errorf("invalid tinggi badan")
+	}
+
+	return Measurement{
+		JenisKelamin: jenisKelamin,
+		TanggalLahir: lahir,
+		Tanggal:      pemeriksaan,
+		BeratBadan:   berat,
+		TinggiBadan:  tinggi,
+	}, nil
+}
+
+// Result holds the computed z-scores and their classification.
+type Result struct {
+	UmurBulan float64 `json:"umur_bulan"`
+
+	ZScoreTBU  float64 `json:"zscore_tb_u"`
+	ZScoreBBU  float64 `json:"zscore_bb_u"`
+	ZScoreBBTB float64 `json:"zscore_bb_tb"`
+
+	KategoriTBU  string `json:"kategori_tb_u"`
+	KategoriBBU  string `json:"kategori_bb_u"`
+	KategoriBBTB string `json:"kategori_bb_tb"`
+
+	StatusGizi string `json:"status_gizi"`
+}
+
+// Assess computes the WHO z-scores for m and derives its status gizi.
+func Assess(m Measurement) (Result, error) {
+	ref, ok := references[m.JenisKelamin]
+	if !ok {
+		return Result{}, ErrInvalidSex
+	}
+
+	if m.Tanggal.Before(m.TanggalLahir) {
+		return Result{}, fmt.Errorf("tanggal pemeriksaan is before tanggal lahir")
+	}
+	if m.BeratBadan <= 0 || m.TinggiBadan <= 0 {
+		return Result{}, fmt.Errorf("berat badan and tinggi badan must be positive")
+	}
+
+	days := m.Tanggal.Sub(m.TanggalLahir).Hours() / 24
+	months := days / daysPerMonth
+	recumbent := months < 24
+
+	// TB/U
+	heightTable := ref.heightForAge
+	if recumbent {
+		heightTable = ref.lengthForAge
+	}
+	heightRow, ok := heightTable.at(months)
+	if !ok {
+		return Result{}, fmt.Errorf("%w: umur %.1f bulan (0-60 bulan)", ErrOutOfRange, months)
+	}
+
+	// BB/U
+	weightRow, ok := ref.weightForAge.at(months)
+	if !ok {
+		return Result{}, fmt.Errorf("%w: umur %.1f bulan (0-60 bulan)", ErrOutOfRange, months)
+	}
+
+	// BB/TB
+	proportionTable, label := ref.weightForHgt, "tinggi badan"
+	if recumbent {
+		proportionTable, label = ref.weightForLen, "panjang badan"
+	}
+	proportionRow, ok := proportionTable.at(m.TinggiBadan)
+	if !ok {
+		first, last := proportionTable[0].X, proportionTable[len(proportionTable)-1].X
+		return Result{}, fmt.Errorf("%w: %s %.1f cm (%.0f-%.0f cm)", ErrOutOfRange, label, m.TinggiBadan, first, last)
+	}
+
+	result := Result{
+		UmurBulan:  round2(months),
+		ZScoreTBU:  round2(heightRow.zscore(m.TinggiBadan)),
+		ZScoreBBU:  round2(weightRow.restrictedZscore(m.BeratBadan)),
+		ZScoreBBTB: round2(proportionRow.restrictedZscore(m.BeratBadan)),
+	}
+
+	// WHO flags for biologically implausible values
+	if math.Abs(result.ZScoreTBU) > 6 {
+		return result, fmt.Errorf("%w: z-score TB/U %.2f", ErrImplausible, result.ZScoreTBU)
+	}
+	if result.ZScoreBBU < -6 || result.ZScoreBBU > 5 {
+		return result, fmt.Errorf("%w: z-score BB/U %.2f", ErrImplausible, result.ZScoreBBU)
+	}
+	if math.Abs(result.ZScoreBBTB) > 5 {
+		return result, fmt.Errorf("%w: z-score BB/TB %.2f", ErrImplausible, result.ZScoreBBTB)
+	}
+
+	result.KategoriTBU = classifyTBU(result.ZScoreTBU)
+	result.KategoriBBU = classifyBBU(result.ZScoreBBU)
+	result.KategoriBBTB = classifyBBTB(result.ZScoreBBTB)
+	result.StatusGizi = statusGizi(result.ZScoreTBU, result.ZScoreBBTB)
+
+	return result, nil
+}
+
+// AssessRecord is a convenience wrapper around NewMeasurement and Assess
+// for callers working with the string values stored in the database.
+// It returns a nil Result when the balita is outside the WHO reference
+// range, in which case the status gizi has to be assessed manually.
+func AssessRecord(jenisKelamin, tanggalLahir, tanggal, beratBadan, tinggiBadan string) (*Result, error) {
+	measurement, err := NewMeasurement(jenisKelamin, tanggalLahir, tanggal, beratBadan, tinggiBadan)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := Assess(measurement)
+	if errors.Is(err, ErrOutOfRange) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// IsAllowedStatus reports whether status is a valid status gizi value.
+func IsAllowedStatus(status string) bool {
+	for _, allowed := range AllowedStatus {
+		if status == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify checks a submitted status gizi against the computed status.
+// An empty submitted status is always accepted.
+func (r Result) Verify(submitted string) error {
+	if submitted == "" || submitted == r.StatusGizi {
+		return nil
+	}
+	return fmt.Errorf("%w: submitted '%s', computed '%s' (z-score TB/U %.2f, BB/U %.2f, BB/TB %.2f)",
+		ErrStatusMismatch, submitted, r.StatusGizi, r.ZScoreTBU, r.ZScoreBBU, r.ZScoreBBTB)
+}
+
+// statusGizi reduces the indicators to the three status gizi values.
+// Severe wasting takes precedence over stunting.
+func statusGizi(zTBU, zBBTB float64) string {
+	switch {
+	case zBBTB < -3:
+		return StatusGiziBuruk
+	case zTBU < -2:
+		return StatusStunting
+	default:
+		return StatusNormal
+	}
+}
+
+// classifyTBU classifies length/height-for-age (Permenkes 2/2020).
+func classifyTBU(z float64) string {
+	switch {
+	case z < -3:
+		return "sangat pendek"
+	case z < -2:
+		return "pendek"
+	case z <= 3:
+		return "normal"
+	default:
+		return "tinggi"
+	}
+}
+
+// classifyBBU classifies weight-for-age (Permenkes 2/2020).
+func classifyBBU(z float64) string {
+	switch {
+	case z < -3:
+		return "berat badan sangat kurang"
+	case z < -2:
+		return "berat badan kurang"
+	case z <= 1:
+		return "berat badan normal"
+	default:
+		return "risiko berat badan lebih"
+	}
+}
+
+// classifyBBTB classifies weight-for-length/height (Permenkes 2/2020).
+func classifyBBTB(z float64) string {
+	switch {
+	case z < -3:
+		return "gizi buruk"
+	case z < -2:
+		return "gizi kurang"
+	case z <= 1:
+		return "gizi baik"
+	case z <= 2:
+		return "berisiko gizi lebih"
+	case z <= 3:
+		return "gizi lebih"
+	default:
+		return "obesitas"
+	}
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,204 @@
+package growth
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// SD lines of the WHO Child Growth Standards simplified field tables, which
+// are rounded to one decimal
+var whoReferenceTests = []struct {
+	name  string
+	sex   string
+	table func(reference) lmsTable
+	x     float64 // age in months or length/height in cm
+	sd    map[float64]float64
+}{
+	{"length-for-age", "L", lengthForAge, 0, map[float64]float64{-3: 44.2, -2: 46.1, 0: 49.9, 2: 53.7, 3: 55.6}},
+	{"length-for-age", "L", lengthForAge, 12, map[float64]float64{-2: 71.0, 0: 75.7, 2: 80.5}},
+	{"length-for-age", "L", lengthForAge, 24, map[float64]float64{-2: 81.7, 0: 87.8, 2: 93.9}},
+	{"height-for-age", "L", heightForAge, 24, map[float64]float64{-2: 81.0, 0: 87.1, 2: 93.2}},
+	{"height-for-age", "L", heightForAge, 60, map[float64]float64{-2: 100.7, 0: 110.0, 2: 119.2}},
+	{"length-for-age", "P", lengthForAge, 0, map[float64]float64{-3: 43.6, -2: 45.4, 0: 49.1, 2: 52.9, 3: 54.7}},
+	{"length-for-age", "P", lengthForAge, 12, map[float64]float64{-2: 68.9, 0: 74.0, 2: 79.2}},
+	{"length-for-age", "P", lengthForAge, 24, map[float64]float64{-2: 80.0, 0: 86.4, 2: 92.9}},
+	{"height-for-age", "P", heightForAge, 24, map[float64]float64{-2: 79.3, 0: 85.7, 2: 92.2}},
+	{"height-for-age", "P", heightForAge, 60, map[float64]float64{-2: 99.9, 0: 109.4, 2: 118.9}},
+
+	{"weight-for-age", "L", weightForAge, 0, map[float64]float64{-3: 2.1, -2: 2.5, 0: 3.3, 2: 4.4, 3: 5.0}},
+	{"weight-for-age", "L", weightForAge, 12, map[float64]float64{-2: 7.7, 0: 9.6, 2: 12.0}},
+	{"weight-for-age", "L", weightForAge, 60, map[float64]float64{-2: 14.1, 0: 18.3, 2: 24.2}},
+	{"weight-for-age", "P", weightForAge, 0, map[float64]float64{-3: 2.0, -2: 2.4, 0: 3.2, 2: 4.2, 3: 4.8}},
+	{"weight-for-age", "P", weightForAge, 12, map[float64]float64{-2: 7.0, 0: 8.9, 2: 11.5}},
+	{"weight-for-age", "P", weightForAge, 60, map[float64]float64{-2: 13.7, 0: 18.2, 2: 24.9}},
+
+	{"weight-for-length", "L", weightForLength, 45, map[float64]float64{-3: 1.9, -2: 2.0, 0: 2.4, 2: 3.0, 3: 3.3}},
+	{"weight-for-length", "L", weightForLength, 65, map[float64]float64{-2: 6.3, 0: 7.4, 2: 8.8}},
+	{"weight-for-length", "P", weightForLength, 45, map[float64]float64{-3: 1.9, -2: 2.1, 0: 2.5, 2: 3.0, 3: 3.3}},
+	{"weight-for-length", "P", weightForLength, 65, map[float64]float64{0: 7.1, 2: 8.5}},
+	{"weight-for-height", "L", weightForHeight, 100, map[float64]float64{0: 15.4}},
+	{"weight-for-height", "P", weightForHeight, 100, map[float64]float64{0: 15.2}},
+}
+
+func lengthForAge(r reference) lmsTable    { return r.lengthForAge }
+func heightForAge(r reference) lmsTable    { return r.heightForAge }
+func weightForAge(r reference) lmsTable    { return r.weightForAge }
+func weightForLength(r reference) lmsTable { return r.weightForLen }
+func weightForHeight(r reference) lmsTable { return r.weightForHgt }
+
+func TestWHOReference(t *testing.T) {
+	for _, tt := range whoReferenceTests {
+		row, ok := tt.table(references[tt.sex]).at(tt.x)
+		if !ok {
+			t.Errorf("%s %s at %v: outside the table", tt.name, tt.sex, tt.x)
+			continue
+		}
+		for z, want := range tt.sd {
+			if got := math.Round(row.valueAt(z)*10) / 10; got != want {
+				t.Errorf("%s %s at %v: %+v SD = %.1f, want %.1f", tt.name, tt.sex, tt.x, z, got, want)
+			}
+		}
+	}
+}
+
+// The weight-based indicators measure beyond ±3 SD in units of the
+// distance between the 2 and 3 SD lines
+func TestRestrictedZscore(t *testing.T) {
+	row, _ := references["L"].weightForAge.at(12)
+	sd2, sd3 := row.valueAt(2), row.valueAt(3)
+
+	tests := []struct {
+		y, want float64
+	}{
+		{row.M, 0},
+		{sd3, 3},
+		{sd3 + (sd3 - sd2), 4},
+		{row.valueAt(-3) - (row.valueAt(-2) - row.valueAt(-3)), -4},
+	}
+	for _, tt := range tests {
+		if got := row.restrictedZscore(tt.y); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("restrictedZscore(%.3f) = %v, want %v", tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestAssess(t *testing.T) {
+	lahir := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		m          Measurement
+		statusGizi string
+		tbu        string
+	}{
+		{"median boy", Measurement{"L", lahir, lahir.AddDate(1, 0, 0), 9.6, 75.7}, StatusNormal, "normal"},
+		{"stunted girl", Measurement{"P", lahir, lahir.AddDate(2, 6, 0), 11.0, 83.0}, StatusStunting, "pendek"},
+		{"severely wasted boy", Measurement{"L", lahir, lahir.AddDate(0, 9, 0), 6.6, 72.0}, StatusGiziBuruk, "normal"},
+	}
+	for _, tt := range tests {
+		result, err := Assess(tt.m)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if result.StatusGizi != tt.statusGizi || result.KategoriTBU != tt.tbu {
+			t.Errorf("%s: status gizi %q, TB/U %q (z %.2f, BB/TB %.2f), want %q, %q",
+				tt.name, result.StatusGizi, result.KategoriTBU, result.ZScoreTBU, result.ZScoreBBTB, tt.statusGizi, tt.tbu)
+		}
+	}
+
+	// Around the -3 SD line of BB/TB, which separates gizi buruk from the
+	// other statuses. Below it the weight-based z-score is restricted.
+	row, _ := references["L"].weightForLen.at(72)
+	sd3 := row.valueAt(-3)
+	boundaryTests := []struct {
+		weight     float64
+		statusGizi string
+		bbtb       string
+	}{
+		{sd3 - 0.05, StatusGiziBuruk, "gizi buruk"},
+		{sd3, StatusNormal, "gizi kurang"},
+		{sd3 + 0.05, StatusNormal, "gizi kurang"},
+	}
+	for _, tt := range boundaryTests {
+		result, err := Assess(Measurement{"L", lahir, lahir.AddDate(0, 9, 0), tt.weight, 72.0})
+		if err != nil {
+			t.Errorf("%.3f kg at 72 cm: %v", tt.weight, err)
+			continue
+		}
+		if result.StatusGizi != tt.statusGizi || result.KategoriBBTB != tt.bbtb {
+			t.Errorf("%.3f kg at 72 cm (-3 SD %.3f kg): status gizi %q, BB/TB %q (z %.2f), want %q, %q",
+				tt.weight, sd3, result.StatusGizi, result.KategoriBBTB, result.ZScoreBBTB, tt.statusGizi, tt.bbtb)
+		}
+	}
+
+	_, err := Assess(Measurement{"L", lahir, lahir.AddDate(6, 0, 0), 20, 115})
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("six years old: err = %v, want ErrOutOfRange", err)
+	}
+	_, err = Assess(Measurement{"L", lahir, lahir.AddDate(1, 0, 0), 30, 75})
+	if !errors.Is(err, ErrImplausible) {
+		t.Errorf("30 kg at one year: err = %v, want ErrImplausible", err)
+	}
+}
+
+// Weight-for-length covers 45 to 110 cm and weight-for-height 65 to 120 cm.
+// The expanded WHO tables list them every 0.1 cm, the resolution children
+// are measured at; coarser tables are interpolated between their rows.
+func TestProportionTables(t *testing.T) {
+	var coarse []string
+	for _, sex := range []string{"L", "P"} {
+		for _, tt := range []struct {
+			name       string
+			table      lmsTable
+			first, end float64
+		}{
+			{"weight-for-length", references[sex].weightForLen, 45, 110},
+			{"weight-for-height", references[sex].weightForHgt, 65, 120},
+		} {
+			first, last := tt.table[0].X, tt.table[len(tt.table)-1].X
+			if math.Abs(first-tt.first) > 1e-9 || math.Abs(last-tt.end) > 1e-9 {
+				t.Errorf("%s %s: %v to %v cm, want %v to %v cm", tt.name, sex, first, last, tt.first, tt.end)
+			}
+			if step := tt.table[1].X - tt.table[0].X; step > 0.1+1e-9 {
+				coarse = append(coarse, fmt.Sprintf("%s %s every %v cm", tt.name, sex, step))
+			}
+		}
+	}
+	if len(coarse) > 0 {
+		t.Skipf("not the expanded WHO tables: %s", strings.Join(coarse, ", "))
+	}
+}
+
+// The expanded tables published by the WHO are tab separated, carry the
+// SD columns and index the age tables by day. Only the layout matters here,
+// the rows after the first are not WHO values.
+func TestParseTableWHOExpanded(t *testing.T) {
+	data := "Day\tL\tM\tS\tSD3neg\tSD2neg\tSD1neg\tSD0\tSD1\tSD2\tSD3\n" +
+		"0\t1\t49.8842\t0.03795\t44.2\t46.1\t48.0\t49.9\t51.8\t53.7\t55.6\n" +
+		"1\t1\t50.0601\t0.03785\t44.4\t46.3\t48.2\t50.1\t52.0\t53.9\t55.8\n" +
+		"366\t1\t75.7865\t0.03136\t68.7\t71.0\t73.4\t75.8\t78.2\t80.5\t82.9\n"
+
+	table, err := parseTable([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table) != 3 || table[1].X != 1/daysPerMonth || table[1].M != 50.0601 {
+		t.Errorf("got %+v", table)
+	}
+
+	for _, data := range []string{
+		"Week,L,M,S\n0,1,49.8842,0.03795\n1,1,50.0601,0.03785\n",
+		"Month,L,M\n0,1,49.8842\n1,1,54.7244\n",
+		"Month,L,M,S\n0,1,49.8842,0.03795\n",
+		"Month,L,M,S\n0,1,49.8842,x\n1,1,54.7244,0.03557\n",
+	} {
+		if _, err := parseTable([]byte(data)); err == nil {
+			t.Errorf("parseTable(%q): want an error", data)
+		}
+	}
+}
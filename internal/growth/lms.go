@@ -0,0 +1,178 @@
+package growth
+
+import (
+	"bytes"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/*.csv
+var dataFS embed.FS
+
+// lmsRow is a single row of a WHO LMS reference table.
+// X is the table index: age in months or length/height in cm.
+type lmsRow struct {
+	X float64
+	L float64
+	M float64
+	S float64
+}
+
+// lmsTable is an LMS reference table sorted by X.
+type lmsTable []lmsRow
+
+// loadTable reads an embedded LMS table. Both the CSV files and the
+// tab separated expanded tables published by the WHO are read: lines
+// starting with "#" are treated as comments, the first non-comment line is
+// the header, and only the first four columns (index, L, M, S) are used, so
+// the SD columns of the WHO files are ignored. Age tables indexed by day,
+// as the expanded ones are, are converted to months.
+func loadTable(name string) (lmsTable, error) {
+	data, err := dataFS.ReadFile("data/" + name)
+	if err != nil {
+		return nil, err
+	}
+	table, err := parseTable(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return table, nil
+}
+
+// parseTable parses an LMS table in one of the formats read by loadTable.
+func parseTable(data []byte) (lmsTable, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	if strings.Contains(firstLine(data), "\t") {
+		reader.Comma = '\t'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 4 {
+		return nil, fmt.Errorf("header has %d columns, want at least index, L, M and S", len(header))
+	}
+	scale := 1.0
+	switch strings.ToLower(strings.TrimSpace(header[0])) {
+	case "day":
+		scale = 1 / daysPerMonth
+	case "month", "length", "height":
+	default:
+		return nil, fmt.Errorf("unknown index column %q", header[0])
+	}
+
+	var table lmsTable
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var values [4]float64
+		for i, field := range record[:4] {
+			values[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", field)
+			}
+		}
+		table = append(table, lmsRow{X: values[0] * scale, L: values[1], M: values[2], S: values[3]})
+	}
+
+	if len(table) < 2 {
+		return nil, fmt.Errorf("table must have at least two rows")
+	}
+	sort.Slice(table, func(i, j int) bool { return table[i].X < table[j].X })
+
+	return table, nil
+}
+
+// Helper function to return the text from the first line that is not a
+// comment
+func firstLine(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, "#") {
+			return line
+		}
+	}
+	return ""
+}
+
+// mustLoadTable is like loadTable but panics on error.
+// The tables are embedded in the binary, so a failure is a build defect.
+func mustLoadTable(name string) lmsTable {
+	table, err := loadTable(name)
+	if err != nil {
+		panic("growth: " + err.Error())
+	}
+	return table
+}
+
+// at returns the LMS parameters for x, linearly interpolated between
+// the surrounding rows. ok is false when x is outside the table range.
+func (t lmsTable) at(x float64) (row lmsRow, ok bool) {
+	first, last := t[0], t[len(t)-1]
+	if x < first.X || x > last.X {
+		return lmsRow{}, false
+	}
+
+	i := sort.Search(len(t), func(i int) bool { return t[i].X >= x })
+	if t[i].X == x {
+		return t[i], true
+	}
+
+	lo, hi := t[i-1], t[i]
+	f := (x - lo.X) / (hi.X - lo.X)
+	return lmsRow{
+		X: x,
+		L: lo.L + f*(hi.L-lo.L),
+		M: lo.M + f*(hi.M-lo.M),
+		S: lo.S + f*(hi.S-lo.S),
+	}, true
+}
+
+// zscore computes the LMS z-score of measurement y.
+func (r lmsRow) zscore(y float64) float64 {
+	if r.L == 0 {
+		return math.Log(y/r.M) / r.S
+	}
+	return (math.Pow(y/r.M, r.L) - 1) / (r.L * r.S)
+}
+
+// valueAt returns the measurement that corresponds to z-score z.
+func (r lmsRow) valueAt(z float64) float64 {
+	if r.L == 0 {
+		return r.M * math.Exp(r.S*z)
+	}
+	return r.M * math.Pow(1+r.L*r.S*z, 1/r.L)
+}
+
+// restrictedZscore computes the z-score using the WHO restricted
+// application of the LMS method, which is used for the weight-based
+// indicators. Beyond ±3 SD the distance is measured in units of the
+// 2-3 SD interval to avoid the skew of the extreme tails.
+func (r lmsRow) restrictedZscore(y float64) float64 {
+	z := r.zscore(y)
+	switch {
+	case z > 3:
+		sd3 := r.valueAt(3)
+		sd23 := sd3 - r.valueAt(2)
+		return 3 + (y-sd3)/sd23
+	case z < -3:
+		sd3 := r.valueAt(-3)
+		sd23 := r.valueAt(-2) - sd3
+		return -3 + (y-sd3)/sd23
+	default:
+		return z
+	}
+}
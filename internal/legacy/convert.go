@@ -0,0 +1,664 @@
+package legacy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/growth"
+)
+
+// MARK: Pengguna
+
+// Helper function to convert legacy pengguna into pengguna with their
+// masyarakat or petugas_kesehatan row
+func (c *Converter) convertPengguna(ctx context.Context) error {
+	query := "SELECT id, email, nama, password_hash, role FROM pengguna ORDER BY id"
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type pengguna struct {
+		id, email, nama, passwordHash, role string
+	}
+	var penggunaList []pengguna
+	for rows.Next() {
+		var p pengguna
+		if err := rows.Scan(&p.id, &p.email, &p.nama, &p.passwordHash, &p.role); err != nil {
+			return err
+		}
+		penggunaList = append(penggunaList, p)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, p := range penggunaList {
+		role := p.role
+		if role == "masyarakat" && slices.Contains(c.opts.PetugasEmails, p.email) {
+			role = "petugas kesehatan"
+		}
+
+		c.convertRow(ctx, "pengguna", p.id, func(tx *sql.Tx) (string, error) {
+			// Link to an account that already exists in the new database
+			var existingId, existingRole string
+			err := tx.QueryRowContext(ctx, "SELECT id, role FROM pengguna WHERE email = ?", p.email).Scan(&existingId, &existingRole)
+			if err == nil {
+				if existingRole != role {
+					return "", fmt.Errorf("email %s already exists with role %s instead of %s", p.email, existingRole, role)
+				}
+				c.warn("pengguna", p.id, fmt.Sprintf("linked to existing pengguna %s with the same email", existingId))
+				return existingId, nil
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return "", err
+			}
+
+			if !isBcryptHash(p.passwordHash) {
+				c.warn("pengguna", p.id, "password is not a bcrypt hash, the user has to get a new password")
+			}
+
+			result, err := tx.ExecContext(ctx,
+				"INSERT INTO pengguna (email, password_hash, role) VALUES (?, ?, ?)",
+				p.email, p.passwordHash, role)
+			if err != nil {
+				return "", err
+			}
+			penggunaId, err := insertId(result)
+			if err != nil {
+				return "", err
+			}
+
+			// The legacy schema keeps the name in pengguna
+			switch role {
+			case "masyarakat":
+				_, err = tx.ExecContext(ctx,
+					"INSERT INTO masyarakat (id_pengguna, nama, alamat) VALUES (?, ?, ?)",
+					penggunaId, p.nama, "")
+				c.warn("pengguna", p.id, "masyarakat has no alamat in the legacy database")
+			case "petugas kesehatan":
+				_, err = tx.ExecContext(ctx,
+					"INSERT INTO petugas_kesehatan (id_pengguna, id_skpd, nama, created_date) VALUES (?, ?, ?, ?)",
+					penggunaId, c.opts.IdSkpd, p.nama, time.Now().Format("2006-01-02"))
+			}
+			if err != nil {
+				return "", err
+			}
+
+			return penggunaId, nil
+		})
+	}
+
+	return nil
+}
+
+// Helper function to check for the password hash format used by the login
+func isBcryptHash(hash string) bool {
+	return len(hash) == 60 && (strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$"))
+}
+
+// MARK: Keluarga
+
+// Helper function to convert legacy keluarga, matching kelurahan by name
+// and copying the koordinat geometry
+func (c *Converter) convertKeluarga(ctx context.Context) error {
+	legacyKelurahan, err := c.idsToNames(ctx, "SELECT id, kelurahan FROM kelurahan")
+	if err != nil {
+		return err
+	}
+	kelurahanIds, err := c.namesToIds(ctx, "SELECT id, kelurahan FROM kelurahan")
+	if err != nil {
+		return err
+	}
+
+	query := `
+        SELECT
+            id, nomor_kk, nama_ayah, nama_ibu, nik_ayah, nik_ibu, alamat, rt, rw,
+            id_kelurahan, ST_AsText(koordinat),
+            created_id, created_date, updated_id, updated_date, deleted_id, deleted_date
+        FROM keluarga
+        ORDER BY id
+    `
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type keluarga struct {
+		id, nomorKk                            string
+		namaAyah, namaIbu, nikAyah, nikIbu     sql.NullString
+		alamat, rt, rw, idKelurahan, koordinat sql.NullString
+		createdId, createdDate, updatedId      sql.NullString
+		updatedDate, deletedId, deletedDate    sql.NullString
+	}
+	var keluargaList []keluarga
+	for rows.Next() {
+		var k keluarga
+		err := rows.Scan(
+			&k.id, &k.nomorKk, &k.namaAyah, &k.namaIbu, &k.nikAyah, &k.nikIbu,
+			&k.alamat, &k.rt, &k.rw, &k.idKelurahan, &k.koordinat,
+			&k.createdId, &k.createdDate, &k.updatedId, &k.updatedDate, &k.deletedId, &k.deletedDate,
+		)
+		if err != nil {
+			return err
+		}
+		keluargaList = append(keluargaList, k)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, k := range keluargaList {
+		c.convertRow(ctx, "keluarga", k.id, func(tx *sql.Tx) (string, error) {
+			// Link to an active keluarga with the same nomor KK
+			var existingId string
+			err := tx.QueryRowContext(ctx,
+				"SELECT id FROM keluarga WHERE nomor_kk_index = ? AND deleted_date IS NULL", c.opts.Keys.BlindIndex(fieldcrypt.NomorKk, k.nomorKk)).Scan(&existingId)
+			if err == nil {
+				c.warn("keluarga", k.id, fmt.Sprintf("linked to existing keluarga %s with the same nomor KK", existingId))
+				return existingId, nil
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return "", err
+			}
+
+			var idKelurahan any
+			if k.idKelurahan.Valid {
+				name := legacyKelurahan[k.idKelurahan.String]
+				if id, ok := kelurahanIds[name]; ok {
+					idKelurahan = id
+				} else {
+					c.warn("keluarga", k.id, fmt.Sprintf("kelurahan %s (%q) not found, id_kelurahan left empty", k.idKelurahan.String, name))
+				}
+			}
+
+			var koordinat any
+			if k.koordinat.Valid && k.koordinat.String != "" {
+				koordinat = k.koordinat.String
+			}
+
+			result, err := tx.ExecContext(ctx, `
+                INSERT INTO keluarga
+                    (nomor_kk, nama_ayah, nama_ibu,
+                    alamat, rt, rw, id_kelurahan, koordinat,
+                    created_id, created_date, updated_id, updated_date, deleted_id, deleted_date)
+                VALUES ('', ?, ?, ?, ?, ?, ?, ST_GeomFromText(?), ?, ?, ?, ?, ?, ?)`,
+				k.namaAyah, k.namaIbu,
+				k.alamat, k.rt, k.rw,
+				idKelurahan, koordinat,
+				c.user(k.createdId), k.createdDate, c.user(k.updatedId), k.updatedDate, c.user(k.deletedId), k.deletedDate,
+			)
+			if err != nil {
+				return "", err
+			}
+			id, err := insertId(result)
+			if err != nil {
+				return "", err
+			}
+
+			// The NIK and nomor KK are bound to the row id, so they are
+			// encrypted once the row exists
+			var args []any
+			for i, value := range []sql.NullString{{String: k.nomorKk, Valid: true}, k.nikAyah, k.nikIbu} {
+				encrypted, index, err := c.encrypt(fieldcrypt.Columns["keluarga"][i], id, value)
+				if err != nil {
+					return "", err
+				}
+				args = append(args, encrypted, index)
+			}
+			_, err = tx.ExecContext(ctx, `
+                UPDATE keluarga
+                SET nomor_kk = ?, nomor_kk_index = ?, nik_ayah = ?, nik_ayah_index = ?, nik_ibu = ?, nik_ibu_index = ?
+                WHERE id = ?`, append(args, id)...)
+			if err != nil {
+				return "", err
+			}
+			return id, nil
+		})
+	}
+
+	return nil
+}
+
+// Helper function to encrypt a nullable column value of the row with the
+// given id, returning the values for the column and its blind index
+func (c *Converter) encrypt(column fieldcrypt.Column, id string, value sql.NullString) (any, any, error) {
+	if !value.Valid {
+		return nil, nil, nil
+	}
+	encrypted, err := c.opts.Keys.Encrypt(column, id, value.String)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encrypted, c.opts.Keys.BlindIndex(column, value.String), nil
+}
+
+// MARK: Balita
+
+// Helper function to convert legacy balita, turning the birth weight into
+// grams and the birth length into whole centimeters
+func (c *Converter) convertBalita(ctx context.Context) error {
+	query := `
+        SELECT
+            id, id_keluarga, nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir,
+            created_id, created_date, updated_id, updated_date, deleted_id, deleted_date
+        FROM balita
+        ORDER BY id
+    `
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type balita struct {
+		id, nama, tanggalLahir, jenisKelamin string
+		idKeluarga, beratLahir, tinggiLahir  sql.NullString
+		createdId, createdDate, updatedId    sql.NullString
+		updatedDate, deletedId, deletedDate  sql.NullString
+	}
+	var balitaList []balita
+	for rows.Next() {
+		var b balita
+		err := rows.Scan(
+			&b.id, &b.idKeluarga, &b.nama, &b.tanggalLahir, &b.jenisKelamin, &b.beratLahir, &b.tinggiLahir,
+			&b.createdId, &b.createdDate, &b.updatedId, &b.updatedDate, &b.deletedId, &b.deletedDate,
+		)
+		if err != nil {
+			return err
+		}
+		balitaList = append(balitaList, b)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, b := range balitaList {
+		c.convertRow(ctx, "balita", b.id, func(tx *sql.Tx) (string, error) {
+			var idKeluarga any
+			if b.idKeluarga.Valid {
+				id, ok := c.lookup("keluarga", b.idKeluarga.String)
+				if !ok {
+					return "", fmt.Errorf("keluarga %s was not converted", b.idKeluarga.String)
+				}
+				idKeluarga = id
+			}
+
+			beratLahir, converted, err := birthWeightGrams(b.beratLahir)
+			if err != nil {
+				return "", err
+			}
+			if converted {
+				c.warn("balita", b.id, fmt.Sprintf("berat_lahir %s kg converted to %v gram", b.beratLahir.String, beratLahir))
+			}
+			tinggiLahir, err := roundedNumber(b.tinggiLahir)
+			if err != nil {
+				return "", err
+			}
+
+			result, err := tx.ExecContext(ctx, `
+                INSERT INTO balita
+                    (id_keluarga, nama, tanggal_lahir, jenis_kelamin, berat_lahir, tinggi_lahir,
+                    created_id, created_date, updated_id, updated_date, deleted_id, deleted_date)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				idKeluarga, b.nama, b.tanggalLahir, b.jenisKelamin, beratLahir, tinggiLahir,
+				c.user(b.createdId), b.createdDate, c.user(b.updatedId), b.updatedDate, c.user(b.deletedId), b.deletedDate,
+			)
+			if err != nil {
+				return "", err
+			}
+			return insertId(result)
+		})
+	}
+
+	return nil
+}
+
+// Helper function to convert a legacy birth weight to grams. The legacy
+// decimal(5,2) column was filled in kilograms, values above 100 are taken
+// to be grams already.
+func birthWeightGrams(value sql.NullString) (any, bool, error) {
+	if !value.Valid {
+		return nil, false, nil
+	}
+	weight, err := strconv.ParseFloat(value.String, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid berat_lahir %q", value.String)
+	}
+	if weight > 100 {
+		return int(math.Round(weight)), false, nil
+	}
+	return int(math.Round(weight * 1000)), true, nil
+}
+
+// Helper function to round a nullable decimal to an integer
+func roundedNumber(value sql.NullString) (any, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(value.String, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", value.String)
+	}
+	return int(math.Round(number)), nil
+}
+
+// MARK: Laporan Masyarakat
+
+// Helper function to convert legacy laporan masyarakat, resolving the
+// reporting pengguna to its masyarakat and matching status by name
+func (c *Converter) convertLaporanMasyarakat(ctx context.Context) error {
+	legacyStatus, err := c.idsToNames(ctx, "SELECT id, status FROM status_laporan")
+	if err != nil {
+		return err
+	}
+	statusIds, err := c.namesToIds(ctx, "SELECT id, status FROM status_laporan")
+	if err != nil {
+		return err
+	}
+
+	query := `
+        SELECT
+            id, id_pengguna, id_balita, id_status_laporan, tanggal_laporan,
+            created_id, created_date, updated_id, updated_date, deleted_id, deleted_date
+        FROM laporan_masyarakat
+        ORDER BY id
+    `
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type laporan struct {
+		id, tanggalLaporan                  string
+		idPengguna, idBalita, idStatus      sql.NullString
+		createdId, createdDate, updatedId   sql.NullString
+		updatedDate, deletedId, deletedDate sql.NullString
+	}
+	var laporanList []laporan
+	for rows.Next() {
+		var l laporan
+		err := rows.Scan(
+			&l.id, &l.idPengguna, &l.idBalita, &l.idStatus, &l.tanggalLaporan,
+			&l.createdId, &l.createdDate, &l.updatedId, &l.updatedDate, &l.deletedId, &l.deletedDate,
+		)
+		if err != nil {
+			return err
+		}
+		laporanList = append(laporanList, l)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, l := range laporanList {
+		c.convertRow(ctx, "laporan_masyarakat", l.id, func(tx *sql.Tx) (string, error) {
+			// Laporan of masyarakat point to their masyarakat row, laporan
+			// entered by an admin have none
+			var idMasyarakat any
+			if l.idPengguna.Valid {
+				penggunaId, ok := c.lookup("pengguna", l.idPengguna.String)
+				if !ok {
+					return "", fmt.Errorf("pelapor pengguna %s was not converted", l.idPengguna.String)
+				}
+				var masyarakatId string
+				err := tx.QueryRowContext(ctx, "SELECT id FROM masyarakat WHERE id_pengguna = ?", penggunaId).Scan(&masyarakatId)
+				if err == nil {
+					idMasyarakat = masyarakatId
+				} else if !errors.Is(err, sql.ErrNoRows) {
+					return "", err
+				}
+			}
+
+			if !l.idBalita.Valid {
+				return "", errors.New("laporan has no balita")
+			}
+			idBalita, ok := c.lookup("balita", l.idBalita.String)
+			if !ok {
+				return "", fmt.Errorf("balita %s was not converted", l.idBalita.String)
+			}
+
+			if !l.idStatus.Valid {
+				return "", errors.New("laporan has no status")
+			}
+			statusName := legacyStatus[l.idStatus.String]
+			idStatus, ok := statusIds[statusName]
+			if !ok {
+				return "", fmt.Errorf("status laporan %s (%q) not found", l.idStatus.String, statusName)
+			}
+
+			c.warn("laporan_masyarakat", l.id, "nomor_hp_keluarga_balita is not in the legacy database")
+
+			createdId := c.user(l.createdId)
+			result, err := tx.ExecContext(ctx, `
+                INSERT INTO laporan_masyarakat
+                    (id_masyarakat, id_balita, id_status_laporan, tanggal_laporan,
+                    nomor_hp_keluarga_balita, nomor_hp_keluarga_balita_index,
+                    created_id, created_date, updated_id, updated_date, deleted_id, deleted_date)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				idMasyarakat, idBalita, idStatus, l.tanggalLaporan, "", "",
+				createdId, l.createdDate, c.user(l.updatedId), l.updatedDate, c.user(l.deletedId), l.deletedDate,
+			)
+			if err != nil {
+				return "", err
+			}
+			laporanId, err := insertId(result)
+			if err != nil {
+				return "", err
+			}
+
+			// Record the status the laporan had when it was converted
+			historyDate := l.tanggalLaporan
+			if l.updatedDate.Valid {
+				historyDate = l.updatedDate.String
+			} else if l.createdDate.Valid {
+				historyDate = l.createdDate.String
+			}
+			_, err = tx.ExecContext(ctx, `
+                INSERT INTO laporan_status_history
+                    (id_laporan_masyarakat, id_status_laporan_asal, id_status_laporan_tujuan, catatan, created_id, created_date)
+                VALUES (?, NULL, ?, ?, ?, ?)`,
+				laporanId, idStatus, "Status awal hasil konversi data lama", createdId, historyDate,
+			)
+			if err != nil {
+				return "", err
+			}
+
+			return laporanId, nil
+		})
+	}
+
+	return nil
+}
+
+// MARK: Intervensi
+
+// Helper function to convert legacy intervensi. The legacy schema has no
+// status, intervensi with a hasil are taken to be completed.
+func (c *Converter) convertIntervensi(ctx context.Context) error {
+	query := `
+        SELECT
+            id, id_balita, jenis, tanggal, deskripsi, hasil,
+            created_id, created_date, updated_id, updated_date, deleted_id, deleted_date
+        FROM intervensi
+        ORDER BY id
+    `
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type intervensi struct {
+		id, jenis, tanggal                  string
+		idBalita, deskripsi, hasil          sql.NullString
+		createdId, createdDate, updatedId   sql.NullString
+		updatedDate, deletedId, deletedDate sql.NullString
+	}
+	var intervensiList []intervensi
+	for rows.Next() {
+		var i intervensi
+		err := rows.Scan(
+			&i.id, &i.idBalita, &i.jenis, &i.tanggal, &i.deskripsi, &i.hasil,
+			&i.createdId, &i.createdDate, &i.updatedId, &i.updatedDate, &i.deletedId, &i.deletedDate,
+		)
+		if err != nil {
+			return err
+		}
+		intervensiList = append(intervensiList, i)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, i := range intervensiList {
+		c.convertRow(ctx, "intervensi", i.id, func(tx *sql.Tx) (string, error) {
+			var idBalita any
+			if i.idBalita.Valid {
+				id, ok := c.lookup("balita", i.idBalita.String)
+				if !ok {
+					return "", fmt.Errorf("balita %s was not converted", i.idBalita.String)
+				}
+				idBalita = id
+			}
+
+			status := "planned"
+			var completedId, completedDate any
+			if strings.TrimSpace(i.hasil.String) != "" {
+				status = "completed"
+				completedId, completedDate = c.user(i.createdId), i.createdDate
+				if i.updatedDate.Valid {
+					completedId, completedDate = c.user(i.updatedId), i.updatedDate
+				}
+				c.warn("intervensi", i.id, "has a hasil, converted as completed")
+			}
+
+			result, err := tx.ExecContext(ctx, `
+                INSERT INTO intervensi
+                    (id_balita, jenis, tanggal, deskripsi, hasil, status, completed_id, completed_date,
+                    created_id, created_date, updated_id, updated_date, deleted_id, deleted_date)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				idBalita, i.jenis, i.tanggal, i.deskripsi, i.hasil, status, completedId, completedDate,
+				c.user(i.createdId), i.createdDate, c.user(i.updatedId), i.updatedDate, c.user(i.deletedId), i.deletedDate,
+			)
+			if err != nil {
+				return "", err
+			}
+			return insertId(result)
+		})
+	}
+
+	return nil
+}
+
+// MARK: Riwayat Pemeriksaan
+
+// Helper function to convert legacy riwayat pemeriksaan and compute the
+// z-scores the legacy schema did not store
+func (c *Converter) convertRiwayatPemeriksaan(ctx context.Context) error {
+	query := `
+        SELECT
+            rp.id, rp.id_balita, rp.tanggal, rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
+            rp.created_id, rp.created_date, rp.updated_id, rp.updated_date, rp.deleted_id, rp.deleted_date,
+            COALESCE(b.jenis_kelamin, ''), COALESCE(b.tanggal_lahir, '')
+        FROM riwayat_pemeriksaan rp
+        LEFT JOIN balita b ON rp.id_balita = b.id
+        ORDER BY rp.id
+    `
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type riwayat struct {
+		id, tanggal                                   string
+		idBalita, beratBadan, tinggiBadan, statusGizi sql.NullString
+		keterangan                                    sql.NullString
+		createdId, createdDate, updatedId             sql.NullString
+		updatedDate, deletedId, deletedDate           sql.NullString
+		jenisKelamin, tanggalLahir                    string
+	}
+	var riwayatList []riwayat
+	for rows.Next() {
+		var r riwayat
+		err := rows.Scan(
+			&r.id, &r.idBalita, &r.tanggal, &r.beratBadan, &r.tinggiBadan, &r.statusGizi, &r.keterangan,
+			&r.createdId, &r.createdDate, &r.updatedId, &r.updatedDate, &r.deletedId, &r.deletedDate,
+			&r.jenisKelamin, &r.tanggalLahir,
+		)
+		if err != nil {
+			return err
+		}
+		riwayatList = append(riwayatList, r)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range riwayatList {
+		c.convertRow(ctx, "riwayat_pemeriksaan", r.id, func(tx *sql.Tx) (string, error) {
+			if !r.idBalita.Valid {
+				return "", errors.New("riwayat pemeriksaan has no balita")
+			}
+			idBalita, ok := c.lookup("balita", r.idBalita.String)
+			if !ok {
+				return "", fmt.Errorf("balita %s was not converted", r.idBalita.String)
+			}
+
+			// The legacy enum spelled gizi buruk with an underscore
+			var statusGizi any
+			status := strings.ReplaceAll(r.statusGizi.String, "_", " ")
+			if r.statusGizi.Valid {
+				if !growth.IsAllowedStatus(status) {
+					return "", fmt.Errorf("unknown status gizi %q", r.statusGizi.String)
+				}
+				statusGizi = status
+			}
+
+			var zscoreTBU, zscoreBBU, zscoreBBTB any
+			if r.beratBadan.Valid && r.tinggiBadan.Valid {
+				assessment, err := growth.AssessRecord(r.jenisKelamin, r.tanggalLahir, r.tanggal, r.beratBadan.String, r.tinggiBadan.String)
+				switch {
+				case err != nil:
+					c.warn("riwayat_pemeriksaan", r.id, fmt.Sprintf("z-scores not computed: %v", err))
+				case assessment == nil:
+					c.warn("riwayat_pemeriksaan", r.id, "z-scores not computed: balita outside WHO reference range")
+				default:
+					zscoreTBU, zscoreBBU, zscoreBBTB = assessment.ZScoreTBU, assessment.ZScoreBBU, assessment.ZScoreBBTB
+					if err := assessment.Verify(status); err != nil {
+						c.warn("riwayat_pemeriksaan", r.id, err.Error())
+					}
+				}
+			}
+
+			result, err := tx.ExecContext(ctx, `
+                INSERT INTO riwayat_pemeriksaan
+                    (id_balita, tanggal, berat_badan, tinggi_badan, status_gizi,
+                    zscore_tb_u, zscore_bb_u, zscore_bb_tb, keterangan,
+                    created_id, created_date, updated_id, updated_date, deleted_id, deleted_date)
+                VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				idBalita, r.tanggal, r.beratBadan, r.tinggiBadan, statusGizi,
+				zscoreTBU, zscoreBBU, zscoreBBTB, r.keterangan,
+				c.user(r.createdId), r.createdDate, c.user(r.updatedId), r.updatedDate, c.user(r.deletedId), r.deletedDate,
+			)
+			if err != nil {
+				return "", err
+			}
+			return insertId(result)
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,411 @@
+package legacy
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// MARK: Fake database
+
+// fakeDB answers the queries of the converter from memory. A legacy
+// database returns the rows of its tables, a target database keeps what is
+// inserted and rolls it back with the transaction.
+type fakeDB struct {
+	mu sync.Mutex
+
+	// Legacy database: table -> rows in the column order of the converter
+	// queries
+	legacy map[string][][]driver.Value
+
+	// Target database
+	lookups map[string][][]driver.Value // rows of the kelurahan and status_laporan lookups
+	state   fakeState
+	saved   *fakeState // state at the start of the open transaction
+}
+
+// fakeState is the data written to the target database.
+type fakeState struct {
+	nextId   map[string]int64
+	inserted map[string][][]driver.Value // table -> args of every insert
+	idMap    [][3]string                 // tabel, id_legacy, id_baru
+	pengguna map[string][2]string        // email -> id, role
+	kkIndex  map[string]string           // nomor_kk_index -> keluarga id
+}
+
+func newFakeState() fakeState {
+	return fakeState{
+		nextId:   map[string]int64{},
+		inserted: map[string][][]driver.Value{},
+		pengguna: map[string][2]string{},
+		kkIndex:  map[string]string{},
+	}
+}
+
+func (s fakeState) clone() fakeState {
+	c := fakeState{
+		nextId:   maps.Clone(s.nextId),
+		inserted: map[string][][]driver.Value{},
+		idMap:    slices.Clone(s.idMap),
+		pengguna: maps.Clone(s.pengguna),
+		kkIndex:  maps.Clone(s.kkIndex),
+	}
+	for table, rows := range s.inserted {
+		c.inserted[table] = slices.Clone(rows)
+	}
+	return c
+}
+
+var (
+	fromTable   = regexp.MustCompile(`FROM (\w+)`)
+	insertTable = regexp.MustCompile(`^INSERT INTO (\w+)`)
+)
+
+// Helper function to answer a query
+func (db *fakeDB) query(query string, args []driver.Value) ([][]driver.Value, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query = strings.Join(strings.Fields(query), " ")
+	if db.legacy != nil {
+		if rows, ok := db.legacy[fromTable.FindStringSubmatch(query)[1]]; ok {
+			return rows, nil
+		}
+		return nil, fmt.Errorf("fake legacy database: unexpected query %q", query)
+	}
+
+	switch query {
+	case "SELECT tabel, id_legacy, id_baru FROM legacy_id_map":
+		var rows [][]driver.Value
+		for _, m := range db.state.idMap {
+			rows = append(rows, []driver.Value{m[0], m[1], m[2]})
+		}
+		return rows, nil
+	case "SELECT id, kelurahan FROM kelurahan":
+		return db.lookups["kelurahan"], nil
+	case "SELECT id, status FROM status_laporan":
+		return db.lookups["status_laporan"], nil
+	case "SELECT id, role FROM pengguna WHERE email = ?":
+		if p, ok := db.state.pengguna[args[0].(string)]; ok {
+			return [][]driver.Value{{p[0], p[1]}}, nil
+		}
+		return nil, nil
+	case "SELECT id FROM keluarga WHERE nomor_kk_index = ? AND deleted_date IS NULL":
+		if id, ok := db.state.kkIndex[args[0].(string)]; ok {
+			return [][]driver.Value{{id}}, nil
+		}
+		return nil, nil
+	case "SELECT id FROM masyarakat WHERE id_pengguna = ?":
+		for i, row := range db.state.inserted["masyarakat"] {
+			if row[0] == args[0] {
+				return [][]driver.Value{{strconv.Itoa(i + 1)}}, nil
+			}
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("fake target database: unexpected query %q", query)
+}
+
+// Helper function to run a statement, returning the id of an insert
+func (db *fakeDB) exec(query string, args []driver.Value) (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	query = strings.Join(strings.Fields(query), " ")
+	if strings.HasPrefix(query, "UPDATE keluarga SET nomor_kk = ?, nomor_kk_index = ?") {
+		db.state.kkIndex[args[1].(string)] = args[len(args)-1].(string)
+		return 0, nil
+	}
+
+	match := insertTable.FindStringSubmatch(query)
+	if db.legacy != nil || match == nil {
+		return 0, fmt.Errorf("fake database: unexpected statement %q", query)
+	}
+	table := match[1]
+
+	switch table {
+	case "legacy_id_map":
+		db.state.idMap = append(db.state.idMap, [3]string{args[0].(string), args[1].(string), args[2].(string)})
+		return 0, nil
+	case "pengguna":
+		db.state.pengguna[args[0].(string)] = [2]string{strconv.FormatInt(db.state.nextId[table]+1, 10), args[2].(string)}
+	}
+	db.state.nextId[table]++
+	db.state.inserted[table] = append(db.state.inserted[table], args)
+	return db.state.nextId[table], nil
+}
+
+// Helper function to open a *sql.DB on the fake database
+func (db *fakeDB) open(t *testing.T) *sql.DB {
+	t.Helper()
+	sqlDB := sql.OpenDB(fakeConnector{db})
+	// A single connection, as the transactions share one saved state
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	return sqlDB
+}
+
+type fakeConnector struct{ db *fakeDB }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c fakeConn) Close() error                        { return nil }
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	saved := c.db.state.clone()
+	c.db.saved = &saved
+	return fakeTx(c), nil
+}
+
+func (c fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.db.query(query, values(args))
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+func (c fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	id, err := c.db.exec(query, values(args))
+	if err != nil {
+		return nil, err
+	}
+	return fakeResult(id), nil
+}
+
+// Helper function to get the values of query arguments
+func values(args []driver.NamedValue) []driver.Value {
+	v := make([]driver.Value, len(args))
+	for i, arg := range args {
+		v[i] = arg.Value
+	}
+	return v
+}
+
+type fakeTx struct{ db *fakeDB }
+
+func (tx fakeTx) Commit() error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+	tx.db.saved = nil
+	return nil
+}
+
+func (tx fakeTx) Rollback() error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+	tx.db.state, tx.db.saved = *tx.db.saved, nil
+	return nil
+}
+
+type fakeResult int64
+
+func (r fakeResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+// MARK: Tests
+
+// Helper function to create the legacy database of the tests. Every table
+// holds rows that convert and rows that cannot.
+func newLegacyDB() *fakeDB {
+	const bcrypt = "$2a$10$abcdefghijklmnopqrstuuABCDEFGHIJKLMNOPQRSTUVWXYZ01234"
+	// created_id, created_date, updated_id, updated_date, deleted_id,
+	// deleted_date
+	audit := []driver.Value{"1", "2023-01-02 08:00:00", nil, nil, nil, nil}
+	row := func(values ...driver.Value) []driver.Value {
+		return append(values, audit...)
+	}
+
+	return &fakeDB{legacy: map[string][][]driver.Value{
+		"pengguna": {
+			{"1", "admin@example.com", "Admin", bcrypt, "admin"},
+			{"2", "warga@example.com", "Warga", "rahasia", "masyarakat"},
+			{"3", "petugas@example.com", "Petugas", bcrypt, "masyarakat"},
+		},
+		"kelurahan": {{"1", "Kalijaga"}, {"2", "Tidak Ada"}},
+		"keluarga": {
+			row("1", "3209010101230001", "Budi", "Siti", "3209010101900001", "3209010101900002", "Jl. Merdeka", "1", "2", "1", "POINT(108.55 -6.73)"),
+			row("2", "3209010101230002", "Joko", "Ani", nil, nil, nil, nil, nil, "2", nil),
+			// The same nomor KK as the first, entered twice in the legacy database
+			row("3", "3209010101230001", "Budi", "Siti", nil, nil, nil, nil, nil, "1", nil),
+		},
+		"balita": {
+			row("1", "1", "Rizky", "2023-03-05", "L", "3.20", "49.5"),
+			row("2", "99", "Hasan", "2023-03-05", "L", nil, nil),
+			row("3", "3", "Putri", "2024-01-10", "P", "3100", "48"),
+		},
+		"status_laporan": {{"1", "Belum diproses"}, {"9", "Diarsipkan"}},
+		"laporan_masyarakat": {
+			row("1", "2", "1", "1", "2023-06-01"),
+			row("2", "2", "2", "1", "2023-06-01"),
+			row("3", nil, "3", "9", "2023-06-01"),
+		},
+		"intervensi": {
+			row("1", "1", "gizi", "2023-07-01", "PMT", "Selesai"),
+			row("2", "99", "gizi", "2023-07-01", nil, nil),
+		},
+		"riwayat_pemeriksaan": {
+			append(row("1", "1", "2024-03-05", "9.6", "75.7", "normal", nil), "L", "2023-03-05"),
+			append(row("2", "1", "2024-04-05", "9.8", "76.5", "obesitas", nil), "L", "2023-03-05"),
+			append(row("3", nil, "2024-04-05", "9.8", "76.5", nil, nil), "", ""),
+		},
+	}}
+}
+
+// Helper function to create an empty target database with the lookup
+// tables filled
+func newTargetDB() *fakeDB {
+	return &fakeDB{
+		lookups: map[string][][]driver.Value{
+			"kelurahan":      {{"10", "Kalijaga"}},
+			"status_laporan": {{"1", "Belum diproses"}},
+		},
+		state: newFakeState(),
+	}
+}
+
+func TestConvertTwice(t *testing.T) {
+	source, target := newLegacyDB(), newTargetDB()
+	opts := Options{
+		PetugasEmails: []string{"petugas@example.com"},
+		IdSkpd:        "1",
+		Keys:          fieldcrypt.Development(),
+	}
+
+	wantFailures := []string{
+		"balita #2: keluarga 99 was not converted",
+		"laporan_masyarakat #2: balita 2 was not converted",
+		`laporan_masyarakat #3: status laporan 9 ("Diarsipkan") not found`,
+		"intervensi #2: balita 99 was not converted",
+		`riwayat_pemeriksaan #2: unknown status gizi "obesitas"`,
+		"riwayat_pemeriksaan #3: riwayat pemeriksaan has no balita",
+	}
+	failures := func(r *Report) []string {
+		var list []string
+		for _, issue := range r.Failures {
+			list = append(list, fmt.Sprintf("%s #%s: %s", issue.Table, issue.LegacyId, issue.Reason))
+		}
+		return list
+	}
+
+	first, err := NewConverter(source.open(t), target.open(t), opts).Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTables := []TableReport{
+		{Table: "pengguna", Converted: 3},
+		{Table: "keluarga", Converted: 3},
+		{Table: "balita", Converted: 2, Failed: 1},
+		{Table: "laporan_masyarakat", Converted: 1, Failed: 2},
+		{Table: "intervensi", Converted: 1, Failed: 1},
+		{Table: "riwayat_pemeriksaan", Converted: 1, Failed: 2},
+	}
+	if !reflect.DeepEqual(first.Tables, wantTables) {
+		t.Errorf("first run: tables %+v, want %+v", first.Tables, wantTables)
+	}
+	if got := failures(first); !reflect.DeepEqual(got, wantFailures) {
+		t.Errorf("first run: failures\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(wantFailures, "\n"))
+	}
+
+	// The keluarga entered twice is linked to the first one instead of
+	// inserted again, and both balita point to it
+	rows := target.state.inserted
+	for table, want := range map[string]int{
+		"pengguna":               3,
+		"masyarakat":             1,
+		"petugas_kesehatan":      1,
+		"keluarga":               2,
+		"balita":                 2,
+		"laporan_masyarakat":     1,
+		"laporan_status_history": 1,
+		"intervensi":             1,
+		"riwayat_pemeriksaan":    1,
+	} {
+		if len(rows[table]) != want {
+			t.Errorf("first run: %d rows in %s, want %d", len(rows[table]), table, want)
+		}
+	}
+	if idKeluarga := rows["balita"][1][0]; idKeluarga != "1" {
+		t.Errorf("balita 3 belongs to keluarga %v, want the linked keluarga 1", idKeluarga)
+	}
+	if !slices.ContainsFunc(first.Warnings, func(issue Issue) bool {
+		return issue.Table == "keluarga" && issue.LegacyId == "3" && strings.Contains(issue.Reason, "linked to existing keluarga 1")
+	}) {
+		t.Errorf("first run: warnings %+v, want keluarga 3 linked to keluarga 1", first.Warnings)
+	}
+
+	// The second run skips the converted rows and reports the same failures
+	converted := target.state.clone()
+	second, err := NewConverter(source.open(t), target.open(t), opts).Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, table := range second.Tables {
+		want := TableReport{Table: wantTables[i].Table, Skipped: wantTables[i].Converted, Failed: wantTables[i].Failed}
+		if table != want {
+			t.Errorf("second run: %+v, want %+v", table, want)
+		}
+	}
+	if got := failures(second); !reflect.DeepEqual(got, wantFailures) {
+		t.Errorf("second run: failures\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(wantFailures, "\n"))
+	}
+	if !reflect.DeepEqual(target.state, converted) {
+		t.Error("second run changed the target database")
+	}
+
+	// The reconciliation report lists every failure
+	var csv strings.Builder
+	if err := second.WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"level,tabel,id_legacy,keterangan",
+		"error,balita,2,keluarga 99 was not converted",
+		`error,riwayat_pemeriksaan,2,"unknown status gizi ""obesitas"""`,
+	} {
+		if !strings.Contains(csv.String(), line+"\n") {
+			t.Errorf("report CSV has no line %q:\n%s", line, csv.String())
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Package legacy converts data from the legacy stuntingdb schema
+// (stuntingdb.sql) into the current schema.
+//
+// The legacy schema keeps the name of every user in pengguna, has no
+// petugas kesehatan role and links laporan masyarakat to a pengguna
+// instead of a masyarakat. The converter creates the masyarakat and
+// petugas_kesehatan rows for the converted users, matches kelurahan and
+// status laporan by name and copies the koordinat geometry.
+//
+// Every converted row is recorded in legacy_id_map together with the row
+// in the same transaction, so running the converter again skips the rows
+// that were already converted and only retries the ones that failed.
+package legacy
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// Options adjusts how legacy rows are converted.
+type Options struct {
+	// PetugasEmails lists the legacy pengguna (by email) that become
+	// petugas kesehatan instead of masyarakat, since the legacy schema
+	// has no such role.
+	PetugasEmails []string
+
+	// IdSkpd is the skpd assigned to the converted petugas kesehatan.
+	// It is required when PetugasEmails is not empty.
+	IdSkpd string
+
+	// Keys encrypts the NIK and nomor KK of the converted keluarga.
+	Keys *fieldcrypt.Keyring
+}
+
+// Issue is a legacy row that could not be converted, or was converted
+// with changes that need a manual check.
+type Issue struct {
+	Table    string
+	LegacyId string
+	Reason   string
+}
+
+// TableReport counts the outcome for the rows of one legacy table.
+type TableReport struct {
+	Table     string
+	Converted int
+	Skipped   int // converted by an earlier run
+	Failed    int
+}
+
+// Report is the reconciliation report of a conversion run.
+type Report struct {
+	Tables   []TableReport
+	Failures []Issue // rows that were not converted
+	Warnings []Issue // rows that were converted with changes
+}
+
+// WriteText writes a human readable summary of r.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TABLE\tCONVERTED\tSKIPPED\tFAILED")
+	for _, table := range r.Tables {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\n", table.Table, table.Converted, table.Skipped, table.Failed)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, section := range []struct {
+		title  string
+		issues []Issue
+	}{
+		{"Not converted", r.Failures},
+		{"Converted with warnings", r.Warnings},
+	} {
+		if len(section.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s:\n", section.title)
+		for _, issue := range section.issues {
+			fmt.Fprintf(w, "  %s #%s: %s\n", issue.Table, issue.LegacyId, issue.Reason)
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes every issue of r as CSV with the columns level, tabel,
+// id_legacy and keterangan.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"level", "tabel", "id_legacy", "keterangan"}); err != nil {
+		return err
+	}
+	for _, issue := range r.Failures {
+		if err := writer.Write([]string{"error", issue.Table, issue.LegacyId, issue.Reason}); err != nil {
+			return err
+		}
+	}
+	for _, issue := range r.Warnings {
+		if err := writer.Write([]string{"warning", issue.Table, issue.LegacyId, issue.Reason}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Converter copies the rows of a legacy database into the current schema.
+type Converter struct {
+	source *sql.DB
+	target *sql.DB
+	opts   Options
+
+	report *Report
+	table  *TableReport
+
+	// ids maps legacy table -> legacy id -> new id, loaded from
+	// legacy_id_map and extended while converting.
+	ids map[string]map[string]string
+}
+
+// NewConverter creates a Converter reading from the legacy database source
+// and writing to the migrated database target.
+func NewConverter(source, target *sql.DB, opts Options) *Converter {
+	return &Converter{source: source, target: target, opts: opts}
+}
+
+// Run converts every legacy table and returns the reconciliation report.
+// Row level problems are collected in the report; an error is only
+// returned when a table cannot be read at all.
+func (c *Converter) Run(ctx context.Context) (*Report, error) {
+	c.report = &Report{}
+
+	if err := c.loadIds(ctx); err != nil {
+		return nil, err
+	}
+	if c.opts.Keys == nil {
+		return nil, fmt.Errorf("legacy: a key ring is required to encrypt the converted keluarga")
+	}
+	if len(c.opts.PetugasEmails) > 0 && c.opts.IdSkpd == "" {
+		return nil, fmt.Errorf("legacy: an skpd is required to convert petugas kesehatan")
+	}
+
+	steps := []struct {
+		table   string
+		convert func(ctx context.Context) error
+	}{
+		// Parents before children, so the references can be mapped
+		{"pengguna", c.convertPengguna},
+		{"keluarga", c.convertKeluarga},
+		{"balita", c.convertBalita},
+		{"laporan_masyarakat", c.convertLaporanMasyarakat},
+		{"intervensi", c.convertIntervensi},
+		{"riwayat_pemeriksaan", c.convertRiwayatPemeriksaan},
+	}
+	for _, step := range steps {
+		c.report.Tables = append(c.report.Tables, TableReport{Table: step.table})
+		c.table = &c.report.Tables[len(c.report.Tables)-1]
+
+		if err := step.convert(ctx); err != nil {
+			return c.report, fmt.Errorf("legacy: convert %s: %w", step.table, err)
+		}
+	}
+
+	return c.report, nil
+}
+
+// Helper function to load the rows converted by earlier runs
+func (c *Converter) loadIds(ctx context.Context) error {
+	c.ids = make(map[string]map[string]string)
+
+	rows, err := c.target.QueryContext(ctx, "SELECT tabel, id_legacy, id_baru FROM legacy_id_map")
+	if err != nil {
+		return fmt.Errorf("legacy: read legacy_id_map, run \"migrate up\" first: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table, legacyId, newId string
+		if err := rows.Scan(&table, &legacyId, &newId); err != nil {
+			return err
+		}
+		c.remember(table, legacyId, newId)
+	}
+	return rows.Err()
+}
+
+// Helper function to store a mapping in memory
+func (c *Converter) remember(table, legacyId, newId string) {
+	if c.ids[table] == nil {
+		c.ids[table] = make(map[string]string)
+	}
+	c.ids[table][legacyId] = newId
+}
+
+// Helper function to look up the new id of a converted legacy row
+func (c *Converter) lookup(table, legacyId string) (string, bool) {
+	newId, ok := c.ids[table][legacyId]
+	return newId, ok
+}
+
+// Helper function to map a nullable legacy pengguna reference such as
+// created_id. References to unconverted users become NULL.
+func (c *Converter) user(legacyId sql.NullString) any {
+	if !legacyId.Valid {
+		return nil
+	}
+	if newId, ok := c.lookup("pengguna", legacyId.String); ok {
+		return newId
+	}
+	return nil
+}
+
+// Helper function to convert one row: convert runs the inserts in tx and
+// returns the new id, which is recorded in legacy_id_map before commit
+func (c *Converter) convertRow(ctx context.Context, table, legacyId string, convert func(tx *sql.Tx) (string, error)) {
+	if _, ok := c.lookup(table, legacyId); ok {
+		c.table.Skipped++
+		return
+	}
+
+	newId, err := c.inTx(ctx, func(tx *sql.Tx) (string, error) {
+		newId, err := convert(tx)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO legacy_id_map (tabel, id_legacy, id_baru, created_date) VALUES (?, ?, ?, ?)",
+			table, legacyId, newId, time.Now().Format("2006-01-02 15:04:05"))
+		return newId, err
+	})
+	if err != nil {
+		c.fail(table, legacyId, err.Error())
+		return
+	}
+
+	c.remember(table, legacyId, newId)
+	c.table.Converted++
+}
+
+// Helper function to run fn in a transaction
+func (c *Converter) inTx(ctx context.Context, fn func(tx *sql.Tx) (string, error)) (string, error) {
+	tx, err := c.target.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	id, err := fn(tx)
+	if err != nil {
+		return "", err
+	}
+	return id, tx.Commit()
+}
+
+// Helper function to record a row that was not converted
+func (c *Converter) fail(table, legacyId, reason string) {
+	c.table.Failed++
+	c.report.Failures = append(c.report.Failures, Issue{Table: table, LegacyId: legacyId, Reason: reason})
+}
+
+// Helper function to record a row that was converted with changes
+func (c *Converter) warn(table, legacyId, reason string) {
+	c.report.Warnings = append(c.report.Warnings, Issue{Table: table, LegacyId: legacyId, Reason: reason})
+}
+
+// Helper function to read a name -> id lookup table from the target database
+func (c *Converter) namesToIds(ctx context.Context, query string) (map[string]string, error) {
+	rows, err := c.target.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[string]string)
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		ids[name] = id
+	}
+	return ids, rows.Err()
+}
+
+// Helper function to read an id -> name lookup table from the legacy database
+func (c *Converter) idsToNames(ctx context.Context, query string) (map[string]string, error) {
+	rows, err := c.source.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make(map[string]string)
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
+
+// Helper function to return the id of an insert as a string
+func insertId(result sql.Result) (string, error) {
+	id, err := result.LastInsertId()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
@@ -0,0 +1,54 @@
+// Package middleware authenticates requests and enforces the method and
+// role requirements declared for every route.
+//
+// Routes are registered on a Router with the HTTP method and the roles
+// allowed to call them. The router validates the bearer token once, stores
+// the authenticated Principal in the request context and only then calls
+// the handler, so handlers no longer parse tokens or check roles themselves.
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+// Roles stored in pengguna.role.
+const (
+	RoleAdmin            = "admin"
+	RoleMasyarakat       = "masyarakat"
+	RolePetugasKesehatan = "petugas kesehatan"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserId    string // pengguna.id
+	Role      string
+	SessionId string // sesi.id of the access token
+
+	// MasyarakatId is the masyarakat.id of a pengguna with role masyarakat.
+	MasyarakatId string
+
+	// PetugasKesehatanId is the petugas_kesehatan.id of a pengguna with
+	// role petugas kesehatan.
+	PetugasKesehatanId string
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// GetPrincipal returns the authenticated caller of r. It returns the zero
+// Principal on public routes.
+func GetPrincipal(r *http.Request) Principal {
+	p, _ := PrincipalFromContext(r.Context())
+	return p
+}
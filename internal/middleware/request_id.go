@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// RequestIdHeader is the response header carrying the id of a request.
+const RequestIdHeader = "X-Request-Id"
+
+type requestIdKey struct{}
+
+// WithRequestId gives every request a random id, stores it in the request
+// context and returns it in the X-Request-Id response header, so an audit
+// log entry can be matched with the request that caused it.
+//
+// Ids sent by the client are ignored, they could be used to make unrelated
+// entries look like one request.
+func WithRequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := newRequestId()
+		w.Header().Set(RequestIdHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIdKey{}, id)))
+	})
+}
+
+// GetRequestId returns the id given to r by WithRequestId, or an empty
+// string outside of it.
+func GetRequestId(r *http.Request) string {
+	id, _ := r.Context().Value(requestIdKey{}).(string)
+	return id
+}
+
+// Helper function to generate a request id
+func newRequestId() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic("middleware: failed to generate request id: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
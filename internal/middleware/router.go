@@ -0,0 +1,219 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+// route is a handler registered for one method of a pattern.
+type route struct {
+	handler  http.HandlerFunc
+	public   bool
+	optional bool
+	roles    []string
+}
+
+// Router dispatches requests by pattern and method and authenticates them
+// before calling the handler.
+//
+// Every route must either be registered with HandlePublic or list the
+// roles allowed to call it. A route registered with Handle but without
+// roles rejects every request, so a forgotten role list fails closed.
+type Router struct {
+	mux      *http.ServeMux
+	secret   string
+	pengguna store.PenggunaRepository
+	sesi     store.SesiRepository
+	routes   map[string]map[string]route // pattern -> method -> route
+}
+
+// NewRouter creates a Router that validates tokens signed with secret,
+// rejects tokens of sessions revoked in sesi and resolves the masyarakat
+// and petugas kesehatan ids through pengguna.
+func NewRouter(secret string, pengguna store.PenggunaRepository, sesi store.SesiRepository) *Router {
+	return &Router{
+		mux:      http.NewServeMux(),
+		secret:   secret,
+		pengguna: pengguna,
+		sesi:     sesi,
+		routes:   make(map[string]map[string]route),
+	}
+}
+
+// Handle registers a handler for method and pattern that requires a valid
+// bearer token of one of roles.
+func (rt *Router) Handle(method, pattern string, handler http.HandlerFunc, roles ...string) {
+	rt.add(method, pattern, route{handler: handler, roles: roles})
+}
+
+// HandlePublic registers a handler for method and pattern that can be
+// called without a token.
+func (rt *Router) HandlePublic(method, pattern string, handler http.HandlerFunc) {
+	rt.add(method, pattern, route{handler: handler, public: true})
+}
+
+// HandleOptional registers a handler for method and pattern that accepts
+// both anonymous requests and requests with a bearer token of one of roles.
+// A token that is sent must be valid and of an allowed role, anonymous
+// requests reach the handler with the zero Principal and the handler
+// decides what they may do.
+func (rt *Router) HandleOptional(method, pattern string, handler http.HandlerFunc, roles ...string) {
+	rt.add(method, pattern, route{handler: handler, optional: true, roles: roles})
+}
+
+// Mount registers a plain handler for every method of pattern, without
+// authentication. It is meant for documentation and static files.
+func (rt *Router) Mount(pattern string, handler http.Handler) {
+	if _, ok := rt.routes[pattern]; ok {
+		panic("middleware: pattern " + pattern + " is already registered")
+	}
+	rt.routes[pattern] = nil
+	rt.mux.Handle(pattern, handler)
+}
+
+// ServeHTTP implements http.Handler.
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt.mux.ServeHTTP(w, r)
+}
+
+// Helper function to register a route, panicking on programming errors
+// like http.ServeMux does
+func (rt *Router) add(method, pattern string, rte route) {
+	if rte.handler == nil {
+		panic("middleware: nil handler for " + method + " " + pattern)
+	}
+
+	methods, ok := rt.routes[pattern]
+	if ok && methods == nil {
+		panic("middleware: pattern " + pattern + " is already mounted")
+	}
+	if !ok {
+		methods = make(map[string]route)
+		rt.routes[pattern] = methods
+		rt.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			rt.serveRoute(w, r, methods)
+		})
+	}
+	if _, ok := methods[method]; ok {
+		panic("middleware: " + method + " " + pattern + " is already registered")
+	}
+	methods[method] = rte
+}
+
+// Helper function to check method, token and role of a request before
+// calling the route handler
+func (rt *Router) serveRoute(w http.ResponseWriter, r *http.Request, methods map[string]route) {
+	rte, ok := methods[r.Method]
+	if !ok {
+		allowed := make([]string, 0, len(methods))
+		for method := range methods {
+			allowed = append(allowed, method)
+		}
+		slices.Sort(allowed)
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	if rte.public {
+		rte.handler(w, r)
+		return
+	}
+
+	if rte.optional && r.Header.Get("Authorization") == "" {
+		rte.handler(w, r)
+		return
+	}
+
+	// Extract and validate JWT token
+	token, err := object.GetJWTFromHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	claims, err := object.ParseJWT(token, rt.secret, rt.sesi)
+	if errors.Is(err, object.ErrTokenRevoked) {
+		writeError(w, http.StatusUnauthorized, "Session has been revoked")
+		return
+	}
+	if errors.Is(err, object.ErrInvalidToken) {
+		writeError(w, http.StatusUnauthorized, "Invalid or expired token")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Failed to verify token")
+		return
+	}
+
+	// Check role, routes without roles deny everyone
+	if !slices.Contains(rte.roles, claims.Role) {
+		writeError(w, http.StatusForbidden, accessDeniedMessage(rte.roles))
+		return
+	}
+
+	principal, status, message := rt.resolve(claims)
+	if status != 0 {
+		writeError(w, status, message)
+		return
+	}
+
+	rte.handler(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+}
+
+// Helper function to build the principal of an authenticated user,
+// returning an HTTP status and message when the user profile is missing
+func (rt *Router) resolve(claims object.Claims) (Principal, int, string) {
+	principal := Principal{UserId: claims.UserId, Role: claims.Role, SessionId: claims.SessionId}
+
+	var target *string
+	var missing string
+	switch claims.Role {
+	case RoleMasyarakat:
+		target, missing = &principal.MasyarakatId, "Masyarakat profile not found"
+	case RolePetugasKesehatan:
+		target, missing = &principal.PetugasKesehatanId, "Health worker profile not found"
+	default:
+		return principal, 0, ""
+	}
+
+	id, err := rt.pengguna.GetProfileId(claims.UserId, claims.Role)
+	if errors.Is(err, store.ErrNotFound) {
+		return Principal{}, http.StatusUnauthorized, missing
+	}
+	if err != nil {
+		return Principal{}, http.StatusInternalServerError, "Failed to verify user profile"
+	}
+	*target = id
+
+	return principal, 0, ""
+}
+
+// roleNames are the role names used in forbidden messages.
+var roleNames = map[string]string{
+	RoleAdmin:            "Admin",
+	RoleMasyarakat:       "Masyarakat",
+	RolePetugasKesehatan: "Health worker",
+}
+
+// Helper function to build the forbidden message for the roles of a route
+func accessDeniedMessage(roles []string) string {
+	if len(roles) != 1 {
+		return "Access denied"
+	}
+	return fmt.Sprintf("Access denied. %s role required", roleNames[roles[0]])
+}
+
+// Helper function to write an error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	response := object.NewResponse(status, message, nil)
+	if err := response.WriteJson(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+)
+
+const testSecret = "test-secret"
+
+// Helper function to create a router with one route of every kind, backed
+// by an in-memory store with an active and a revoked session
+func newTestRouter(t *testing.T) (*Router, *store.Memory) {
+	t.Helper()
+	m := store.NewMemory()
+	m.Profile = []store.Profile{
+		{IdPengguna: "2", Role: RoleMasyarakat, Id: "20"},
+		{IdPengguna: "3", Role: RolePetugasKesehatan, Id: "30"},
+	}
+	m.Sesi = []store.Sesi{
+		{Id: "1", IdPengguna: "1"},
+		{Id: "2", IdPengguna: "2"},
+		{Id: "3", IdPengguna: "3"},
+		{Id: "4", IdPengguna: "4"},
+		{Id: "5", IdPengguna: "1", RevokedDate: "2025-01-01 00:00:00"},
+	}
+	s := m.Store()
+
+	// The handlers write the principal they were called with
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		p := GetPrincipal(r)
+		w.Write([]byte(p.Role + "|" + p.UserId + "|" + p.MasyarakatId + "|" + p.PetugasKesehatanId))
+	}
+
+	rt := NewRouter(testSecret, s.Pengguna, s.Sesi)
+	rt.HandlePublic(http.MethodGet, "/api/wilayah", handler)
+	rt.HandleOptional(http.MethodPost, "/api/laporan", handler, RoleMasyarakat)
+	rt.Handle(http.MethodGet, "/api/admin/balita", handler, RoleAdmin)
+	rt.Handle(http.MethodPost, "/api/admin/balita", handler, RoleAdmin)
+	rt.Handle(http.MethodDelete, "/api/admin/balita", handler, RoleAdmin)
+	rt.Handle(http.MethodGet, "/api/intervensi", handler, RoleAdmin, RolePetugasKesehatan)
+	rt.Handle(http.MethodGet, "/api/lupa", handler)
+	return rt, m
+}
+
+// Helper function to create an access token
+func newTestToken(t *testing.T, userId, role, sessionId string, ttl time.Duration) string {
+	t.Helper()
+	token, err := object.GenerateJWT(userId, role, sessionId, testSecret, ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestRouter(t *testing.T) {
+	rt, _ := newTestRouter(t)
+
+	admin := newTestToken(t, "1", RoleAdmin, "1", time.Hour)
+	masyarakat := newTestToken(t, "2", RoleMasyarakat, "2", time.Hour)
+	petugas := newTestToken(t, "3", RolePetugasKesehatan, "3", time.Hour)
+	// A petugas kesehatan account without its petugas_kesehatan row
+	noProfile := newTestToken(t, "4", RolePetugasKesehatan, "4", time.Hour)
+	expired := newTestToken(t, "1", RoleAdmin, "1", -time.Minute)
+	revoked := newTestToken(t, "1", RoleAdmin, "5", time.Hour)
+	unknownSession := newTestToken(t, "1", RoleAdmin, "99", time.Hour)
+	otherSecret, err := object.GenerateJWT("1", RoleAdmin, "1", "other-secret", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		header string // Authorization header, instead of the token
+		status int
+		body   string // principal of successful requests, or part of the error
+	}{
+		{"public", http.MethodGet, "/api/wilayah", "", "", http.StatusOK, "|||"},
+		{"public with a token", http.MethodGet, "/api/wilayah", expired, "", http.StatusOK, "|||"},
+
+		{"optional anonymous", http.MethodPost, "/api/laporan", "", "", http.StatusOK, "|||"},
+		{"optional with a token", http.MethodPost, "/api/laporan", masyarakat, "", http.StatusOK, "masyarakat|2|20|"},
+		{"optional with another role", http.MethodPost, "/api/laporan", admin, "", http.StatusForbidden, ""},
+		{"optional with an expired token", http.MethodPost, "/api/laporan", expired, "", http.StatusUnauthorized, ""},
+
+		{"role", http.MethodGet, "/api/admin/balita", admin, "", http.StatusOK, "admin|1||"},
+		{"role without a token", http.MethodGet, "/api/admin/balita", "", "", http.StatusUnauthorized, ""},
+		{"role with a malformed header", http.MethodGet, "/api/admin/balita", "", "Token " + admin, http.StatusUnauthorized, ""},
+		{"role with another role", http.MethodGet, "/api/admin/balita", masyarakat, "", http.StatusForbidden, ""},
+		{"one of several roles", http.MethodGet, "/api/intervensi", petugas, "", http.StatusOK, "petugas kesehatan|3||30"},
+		{"none of several roles", http.MethodGet, "/api/intervensi", masyarakat, "", http.StatusForbidden, ""},
+		{"missing profile", http.MethodGet, "/api/intervensi", noProfile, "", http.StatusUnauthorized, ""},
+
+		{"expired token", http.MethodGet, "/api/admin/balita", expired, "", http.StatusUnauthorized, "Invalid or expired token"},
+		{"revoked session", http.MethodGet, "/api/admin/balita", revoked, "", http.StatusUnauthorized, "Session has been revoked"},
+		{"unknown session", http.MethodGet, "/api/admin/balita", unknownSession, "", http.StatusUnauthorized, ""},
+		{"other secret", http.MethodGet, "/api/admin/balita", otherSecret, "", http.StatusUnauthorized, ""},
+
+		{"no roles", http.MethodGet, "/api/lupa", admin, "", http.StatusForbidden, "Access denied"},
+		{"no roles anonymous", http.MethodGet, "/api/lupa", "", "", http.StatusUnauthorized, ""},
+
+		{"method not allowed", http.MethodPut, "/api/admin/balita", admin, "", http.StatusMethodNotAllowed, ""},
+		{"not found", http.MethodGet, "/api/tidak-ada", admin, "", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.token != "" {
+			r.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+		if tt.header != "" {
+			r.Header.Set("Authorization", tt.header)
+		}
+		w := httptest.NewRecorder()
+		rt.ServeHTTP(w, r)
+
+		if w.Code != tt.status {
+			t.Errorf("%s: status %d, want %d: %s", tt.name, w.Code, tt.status, w.Body)
+			continue
+		}
+		if tt.status == http.StatusOK && w.Body.String() != tt.body {
+			t.Errorf("%s: principal %q, want %q", tt.name, w.Body, tt.body)
+		}
+		if tt.status != http.StatusOK && !strings.Contains(w.Body.String(), tt.body) {
+			t.Errorf("%s: body %s, want %q", tt.name, w.Body, tt.body)
+		}
+	}
+}
+
+func TestRouterMethodNotAllowed(t *testing.T) {
+	rt, _ := newTestRouter(t)
+
+	// The method is checked before the token
+	for _, method := range []string{http.MethodPut, http.MethodPatch} {
+		r := httptest.NewRequest(method, "/api/admin/balita", nil)
+		w := httptest.NewRecorder()
+		rt.ServeHTTP(w, r)
+
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("%s: status %d, want %d", method, w.Code, http.StatusMethodNotAllowed)
+		}
+		if got, want := w.Header().Get("Allow"), "DELETE, GET, POST"; got != want {
+			t.Errorf("%s: Allow = %q, want %q", method, got, want)
+		}
+	}
+}
+
+func TestRouterRevokedAfterIssue(t *testing.T) {
+	rt, m := newTestRouter(t)
+	token := newTestToken(t, "1", RoleAdmin, "1", time.Hour)
+
+	serve := func() int {
+		r := httptest.NewRequest(http.MethodGet, "/api/admin/balita", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		rt.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if status := serve(); status != http.StatusOK {
+		t.Fatalf("status %d before the revocation, want %d", status, http.StatusOK)
+	}
+	if _, err := m.Store().Sesi.RevokeAllForPengguna("1"); err != nil {
+		t.Fatal(err)
+	}
+	if status := serve(); status != http.StatusUnauthorized {
+		t.Errorf("status %d after the revocation, want %d", status, http.StatusUnauthorized)
+	}
+}
+
+func TestRouterRegistration(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {}
+
+	tests := []struct {
+		name     string
+		register func(rt *Router)
+	}{
+		{"nil handler", func(rt *Router) {
+			rt.Handle(http.MethodGet, "/api/a", nil, RoleAdmin)
+		}},
+		{"duplicate route", func(rt *Router) {
+			rt.Handle(http.MethodGet, "/api/a", handler, RoleAdmin)
+			rt.HandlePublic(http.MethodGet, "/api/a", handler)
+		}},
+		{"route on a mount", func(rt *Router) {
+			rt.Mount("/docs/", http.NotFoundHandler())
+			rt.HandlePublic(http.MethodGet, "/docs/", handler)
+		}},
+		{"mount on a route", func(rt *Router) {
+			rt.HandlePublic(http.MethodGet, "/docs/", handler)
+			rt.Mount("/docs/", http.NotFoundHandler())
+		}},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: want a panic", tt.name)
+				}
+			}()
+			tt.register(NewRouter(testSecret, nil, nil))
+		}()
+	}
+}
@@ -0,0 +1,311 @@
+// Package migrate applies the versioned database schema migrations that are
+// embedded in the binary.
+//
+// Migrations live in the migrations directory as pairs of files named
+// NNNN_name.up.sql and NNNN_name.down.sql. The applied versions are
+// tracked in the schema_migrations table. MySQL commits DDL statements
+// implicitly, so a migration that fails halfway is not rolled back and
+// has to be repaired by hand; keep every migration small.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// Migration is one schema change with the SQL to apply and revert it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration with its state in the database.
+type Status struct {
+	Migration
+	Applied     bool
+	AppliedDate string
+}
+
+// migrationFile matches the file names in the migrations directory.
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load returns the embedded migrations ordered by version.
+func Load() ([]Migration, error) {
+	return load(migrationFS, "migrations")
+}
+
+// Helper function to read the migration pairs of a directory
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migrate: unexpected file %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migrate: version %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migrate: version %d needs both an up and a down file", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return a.Version - b.Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator applies migrations to a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New creates a Migrator for the embedded migrations.
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Status returns every migration with its state, ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		record, ok := applied[migration.Version]
+		if ok && record.name != migration.Name {
+			return nil, fmt.Errorf("migrate: version %d was applied as %s but is %s in this build, repair schema_migrations by hand",
+				migration.Version, record.name, migration.Name)
+		}
+		statuses = append(statuses, Status{
+			Migration:   migration,
+			Applied:     ok,
+			AppliedDate: record.date,
+		})
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations that are not applied yet.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, status := range statuses {
+		if !status.Applied {
+			pending = append(pending, status.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration in order and returns the applied
+// ones. It stops at the first failing migration.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range pending {
+		if err := m.run(ctx, migration, migration.Up, true); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Down reverts the most recently applied migration and returns it, or
+// returns nil when no migration is applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(statuses) - 1; i >= 0; i-- {
+		if !statuses[i].Applied {
+			continue
+		}
+		migration := statuses[i].Migration
+		if err := m.run(ctx, migration, migration.Down, false); err != nil {
+			return nil, err
+		}
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+// appliedMigration is a row of the schema_migrations table.
+type appliedMigration struct {
+	name string
+	date string
+}
+
+// Helper function to create the tracking table and read the applied versions
+func (m *Migrator) applied(ctx context.Context) (map[int]appliedMigration, error) {
+	createQuery := `
+        CREATE TABLE IF NOT EXISTS schema_migrations (
+            version int(11) NOT NULL,
+            name varchar(255) NOT NULL,
+            applied_date datetime NOT NULL,
+            PRIMARY KEY (version)
+        ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci
+    `
+	if _, err := m.db.ExecContext(ctx, createQuery); err != nil {
+		return nil, fmt.Errorf("migrate: create schema_migrations: %w", err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, applied_date FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("migrate: read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var version int
+		var record appliedMigration
+		if err := rows.Scan(&version, &record.name, &record.date); err != nil {
+			return nil, err
+		}
+		applied[version] = record
+	}
+	return applied, rows.Err()
+}
+
+// Helper function to execute the statements of a migration on a single
+// connection, so session settings like FOREIGN_KEY_CHECKS carry over
+// between statements, then record the new state
+func (m *Migrator) run(ctx context.Context, migration Migration, script string, up bool) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for i, statement := range SplitStatements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migrate: %04d_%s statement %d: %w", migration.Version, migration.Name, i+1, err)
+		}
+	}
+
+	if up {
+		_, err = conn.ExecContext(ctx,
+			"INSERT INTO schema_migrations (version, name, applied_date) VALUES (?, ?, ?)",
+			migration.Version, migration.Name, time.Now().Format("2006-01-02 15:04:05"))
+	} else {
+		_, err = conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", migration.Version)
+	}
+	if err != nil {
+		return fmt.Errorf("migrate: record %04d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// SplitStatements splits a SQL script into statements on the semicolons
+// outside of string literals and comments. Comments are dropped.
+func SplitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '-' && isLineComment(script[i:]), c == '#':
+			// Skip the comment up to the end of the line
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += end
+				current.WriteByte('\n')
+			}
+		case c == '\'' || c == '"' || c == '`':
+			// Copy the quoted literal including escaped quotes
+			end := i + 1
+			for end < len(script) && script[end] != c {
+				if script[end] == '\\' && c != '`' {
+					end++
+				}
+				end++
+			}
+			current.WriteString(script[i:min(end+1, len(script))])
+			i = end
+		case c == ';':
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+
+	return statements
+}
+
+// Helper function to check whether s starts with a "--" comment, which
+// MySQL only recognizes when followed by whitespace
+func isLineComment(s string) bool {
+	return strings.HasPrefix(s, "--") && (len(s) == 2 || strings.ContainsRune(" \t\r\n", rune(s[2])))
+}
@@ -0,0 +1,63 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadVersionsAreContiguous(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Fatalf("migration %d_%s has version %d, want %d", migration.Version, migration.Name, migration.Version, i+1)
+		}
+	}
+}
+
+// The baseline has to stay exactly the original dump, so a database
+// imported from it gets every later change from its own migration.
+func TestBaselineIsOriginalDump(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := migrations[0]
+	if baseline.Name != "baseline" {
+		t.Fatalf("first migration is %s, want baseline", baseline.Name)
+	}
+
+	for _, later := range []string{
+		"`zscore_tb_u`", "`started_id`", "`audit_log`", "`sesi`",
+		"`laporan_status_history`", "`status_laporan_transisi`", "'gizi buruk'",
+	} {
+		if strings.Contains(baseline.Up, later) {
+			t.Errorf("baseline contains %s, which belongs in a later migration", later)
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := "-- comment; not a statement\n" +
+		"INSERT INTO t VALUES ('a;b', \"c\\\";d\");\n" +
+		"# another comment\n" +
+		"UPDATE t SET `x;y` = 1;\n" +
+		"SELECT 1--2"
+
+	got := SplitStatements(script)
+	want := []string{
+		"INSERT INTO t VALUES ('a;b', \"c\\\";d\")",
+		"UPDATE t SET `x;y` = 1",
+		"SELECT 1--2",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d statements %q, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("statement %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
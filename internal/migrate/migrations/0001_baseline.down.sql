@@ -0,0 +1,17 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DROP TABLE IF EXISTS `status_laporan`;
+DROP TABLE IF EXISTS `skpd`;
+DROP TABLE IF EXISTS `riwayat_pemeriksaan`;
+DROP TABLE IF EXISTS `petugas_kesehatan`;
+DROP TABLE IF EXISTS `pengguna`;
+DROP TABLE IF EXISTS `masyarakat`;
+DROP TABLE IF EXISTS `laporan_masyarakat`;
+DROP TABLE IF EXISTS `kelurahan`;
+DROP TABLE IF EXISTS `keluarga`;
+DROP TABLE IF EXISTS `kecamatan`;
+DROP TABLE IF EXISTS `intervensi_petugas`;
+DROP TABLE IF EXISTS `intervensi`;
+DROP TABLE IF EXISTS `balita`;
+
+SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1,244 @@
+-- Baseline schema, exactly the structure of the original stuntingdb_new.sql
+-- dump. Every later change is a migration of its own.
+--
+-- Every table is created with IF NOT EXISTS, so a database that was set up
+-- by importing stuntingdb_new.sql is adopted as is and the later migrations
+-- bring it up to date.
+
+SET FOREIGN_KEY_CHECKS = 0;
+
+CREATE TABLE IF NOT EXISTS `balita` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_keluarga` int(11) DEFAULT NULL,
+  `nama` varchar(100) NOT NULL,
+  `tanggal_lahir` date NOT NULL,
+  `jenis_kelamin` enum('L','P') NOT NULL,
+  `berat_lahir` int(7) DEFAULT NULL,
+  `tinggi_lahir` int(7) DEFAULT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_keluarga` (`id_keluarga`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  CONSTRAINT `balita_ibfk_1` FOREIGN KEY (`id_keluarga`) REFERENCES `keluarga` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `balita_ibfk_2` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `balita_ibfk_3` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `balita_ibfk_4` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `intervensi` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_balita` int(11) DEFAULT NULL,
+  `jenis` enum('gizi','kesehatan','sosial') NOT NULL,
+  `tanggal` date NOT NULL,
+  `deskripsi` text DEFAULT NULL,
+  `hasil` text DEFAULT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  KEY `id_balita` (`id_balita`),
+  CONSTRAINT `intervensi_ibfk_2` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `intervensi_ibfk_3` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `intervensi_ibfk_4` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `intervensi_petugas` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_intervensi` int(11) NOT NULL,
+  `id_petugas_kesehatan` int(11) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_intervensi` (`id_intervensi`,`id_petugas_kesehatan`),
+  KEY `id_petugas_kesehatan` (`id_petugas_kesehatan`),
+  CONSTRAINT `intervensi_petugas_ibfk_1` FOREIGN KEY (`id_intervensi`) REFERENCES `intervensi` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `intervensi_petugas_ibfk_2` FOREIGN KEY (`id_petugas_kesehatan`) REFERENCES `petugas_kesehatan` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `kecamatan` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `kecamatan` varchar(100) NOT NULL,
+  `area` multipolygon NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `keluarga` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `nomor_kk` varchar(20) NOT NULL,
+  `nama_ayah` varchar(100) DEFAULT NULL,
+  `nama_ibu` varchar(100) DEFAULT NULL,
+  `nik_ayah` varchar(20) DEFAULT NULL,
+  `nik_ibu` varchar(20) DEFAULT NULL,
+  `alamat` text DEFAULT NULL,
+  `rt` varchar(5) DEFAULT NULL,
+  `rw` varchar(5) DEFAULT NULL,
+  `id_kelurahan` int(11) DEFAULT NULL,
+  `koordinat` point DEFAULT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_kelurahan` (`id_kelurahan`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  CONSTRAINT `keluarga_ibfk_1` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`),
+  CONSTRAINT `keluarga_ibfk_2` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `keluarga_ibfk_3` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `keluarga_ibfk_4` FOREIGN KEY (`id_kelurahan`) REFERENCES `kelurahan` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `kelurahan` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_kecamatan` int(11) DEFAULT NULL,
+  `kelurahan` varchar(100) NOT NULL,
+  `area` multipolygon NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_kecamatan` (`id_kecamatan`),
+  CONSTRAINT `kelurahan_ibfk_1` FOREIGN KEY (`id_kecamatan`) REFERENCES `kecamatan` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `laporan_masyarakat` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_masyarakat` int(11) DEFAULT NULL,
+  `id_balita` int(11) DEFAULT NULL,
+  `id_status_laporan` int(11) DEFAULT NULL,
+  `tanggal_laporan` date NOT NULL,
+  `hubungan_dengan_balita` text DEFAULT NULL,
+  `nomor_hp_pelapor` varchar(15) DEFAULT NULL,
+  `nomor_hp_keluarga_balita` varchar(15) NOT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_pengguna` (`id_masyarakat`,`id_balita`,`id_status_laporan`),
+  KEY `id_balita` (`id_balita`),
+  KEY `id_status_laporan` (`id_status_laporan`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  CONSTRAINT `laporan_masyarakat_ibfk_1` FOREIGN KEY (`id_status_laporan`) REFERENCES `status_laporan` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_masyarakat_ibfk_2` FOREIGN KEY (`id_masyarakat`) REFERENCES `masyarakat` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_masyarakat_ibfk_3` FOREIGN KEY (`id_balita`) REFERENCES `balita` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_masyarakat_ibfk_4` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_masyarakat_ibfk_5` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_masyarakat_ibfk_6` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `masyarakat` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_pengguna` int(11) DEFAULT NULL,
+  `nama` varchar(100) NOT NULL,
+  `alamat` text NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_pengguna` (`id_pengguna`),
+  CONSTRAINT `masyarakat_ibfk_1` FOREIGN KEY (`id_pengguna`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `pengguna` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `email` varchar(100) NOT NULL,
+  `password_hash` varchar(100) NOT NULL,
+  `role` enum('masyarakat','admin','petugas kesehatan') NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `email` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `petugas_kesehatan` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_pengguna` int(11) DEFAULT NULL,
+  `id_skpd` int(11) DEFAULT NULL,
+  `nama` varchar(100) NOT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_user` (`id_pengguna`,`id_skpd`,`created_id`,`updated_id`,`deleted_id`),
+  KEY `id_skpd` (`id_skpd`),
+  KEY `created_id` (`created_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  CONSTRAINT `petugas_kesehatan_ibfk_1` FOREIGN KEY (`id_skpd`) REFERENCES `skpd` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `petugas_kesehatan_ibfk_2` FOREIGN KEY (`id_pengguna`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `petugas_kesehatan_ibfk_3` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `petugas_kesehatan_ibfk_4` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `petugas_kesehatan_ibfk_5` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `riwayat_pemeriksaan` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_balita` int(11) DEFAULT NULL,
+  `id_intervensi` int(11) DEFAULT NULL,
+  `id_laporan_masyarakat` int(11) DEFAULT NULL,
+  `tanggal` date NOT NULL,
+  `berat_badan` decimal(5,2) DEFAULT NULL,
+  `tinggi_badan` decimal(5,2) DEFAULT NULL,
+  `status_gizi` enum('normal','stunting','gizi_buruk') DEFAULT NULL,
+  `keterangan` text DEFAULT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_balita` (`id_balita`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  KEY `id_intervensi` (`id_intervensi`,`id_laporan_masyarakat`),
+  KEY `id_laporan_masyarakat` (`id_laporan_masyarakat`),
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_1` FOREIGN KEY (`id_balita`) REFERENCES `balita` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_2` FOREIGN KEY (`id_intervensi`) REFERENCES `intervensi` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_3` FOREIGN KEY (`id_laporan_masyarakat`) REFERENCES `laporan_masyarakat` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_4` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_5` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `riwayat_pemeriksaan_ibfk_6` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `skpd` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `skpd` varchar(100) NOT NULL,
+  `jenis` enum('puskesmas','kelurahan','skpd') NOT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` date DEFAULT NULL,
+  `updated_id` int(11) DEFAULT NULL,
+  `updated_date` date DEFAULT NULL,
+  `deleted_id` int(11) DEFAULT NULL,
+  `deleted_date` date DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `created_id` (`created_id`,`updated_id`,`deleted_id`),
+  KEY `updated_id` (`updated_id`),
+  KEY `deleted_id` (`deleted_id`),
+  CONSTRAINT `skpd_ibfk_1` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `skpd_ibfk_2` FOREIGN KEY (`updated_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `skpd_ibfk_3` FOREIGN KEY (`deleted_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS `status_laporan` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `status` text NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1,10 @@
+ALTER TABLE `riwayat_pemeriksaan`
+  DROP COLUMN `zscore_bb_tb`,
+  DROP COLUMN `zscore_bb_u`,
+  DROP COLUMN `zscore_tb_u`,
+  MODIFY `status_gizi` enum('normal','stunting','gizi_buruk','gizi buruk') DEFAULT NULL;
+
+UPDATE `riwayat_pemeriksaan` SET `status_gizi` = 'gizi_buruk' WHERE `status_gizi` = 'gizi buruk';
+
+ALTER TABLE `riwayat_pemeriksaan`
+  MODIFY `status_gizi` enum('normal','stunting','gizi_buruk') DEFAULT NULL;
@@ -0,0 +1,13 @@
+-- WHO growth standard z-scores of every riwayat pemeriksaan, and the
+-- status_gizi value "gizi buruk" written by the growth package.
+
+ALTER TABLE `riwayat_pemeriksaan`
+  MODIFY `status_gizi` enum('normal','stunting','gizi_buruk','gizi buruk') DEFAULT NULL;
+
+UPDATE `riwayat_pemeriksaan` SET `status_gizi` = 'gizi buruk' WHERE `status_gizi` = 'gizi_buruk';
+
+ALTER TABLE `riwayat_pemeriksaan`
+  MODIFY `status_gizi` enum('normal','stunting','gizi buruk') DEFAULT NULL,
+  ADD COLUMN `zscore_tb_u` decimal(5,2) DEFAULT NULL AFTER `status_gizi`,
+  ADD COLUMN `zscore_bb_u` decimal(5,2) DEFAULT NULL AFTER `zscore_tb_u`,
+  ADD COLUMN `zscore_bb_tb` decimal(5,2) DEFAULT NULL AFTER `zscore_bb_u`;
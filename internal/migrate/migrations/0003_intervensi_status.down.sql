@@ -0,0 +1,17 @@
+ALTER TABLE `intervensi`
+  DROP FOREIGN KEY `intervensi_ibfk_7`,
+  DROP FOREIGN KEY `intervensi_ibfk_6`,
+  DROP FOREIGN KEY `intervensi_ibfk_5`;
+
+ALTER TABLE `intervensi`
+  DROP KEY `cancelled_id`,
+  DROP KEY `completed_id`,
+  DROP KEY `started_id`,
+  DROP KEY `status`,
+  DROP COLUMN `cancelled_date`,
+  DROP COLUMN `cancelled_id`,
+  DROP COLUMN `completed_date`,
+  DROP COLUMN `completed_id`,
+  DROP COLUMN `started_date`,
+  DROP COLUMN `started_id`,
+  DROP COLUMN `status`;
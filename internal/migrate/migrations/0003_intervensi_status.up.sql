@@ -0,0 +1,20 @@
+-- Lifecycle of an intervensi: planned, in progress, completed or cancelled,
+-- with who moved it into each state and when.
+
+ALTER TABLE `intervensi`
+  ADD COLUMN `status` enum('planned','in_progress','completed','cancelled') NOT NULL DEFAULT 'planned' AFTER `hasil`,
+  ADD COLUMN `started_id` int(11) DEFAULT NULL AFTER `status`,
+  ADD COLUMN `started_date` datetime DEFAULT NULL AFTER `started_id`,
+  ADD COLUMN `completed_id` int(11) DEFAULT NULL AFTER `started_date`,
+  ADD COLUMN `completed_date` datetime DEFAULT NULL AFTER `completed_id`,
+  ADD COLUMN `cancelled_id` int(11) DEFAULT NULL AFTER `completed_date`,
+  ADD COLUMN `cancelled_date` datetime DEFAULT NULL AFTER `cancelled_id`,
+  ADD KEY `status` (`status`),
+  ADD KEY `started_id` (`started_id`),
+  ADD KEY `completed_id` (`completed_id`),
+  ADD KEY `cancelled_id` (`cancelled_id`);
+
+ALTER TABLE `intervensi`
+  ADD CONSTRAINT `intervensi_ibfk_5` FOREIGN KEY (`started_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  ADD CONSTRAINT `intervensi_ibfk_6` FOREIGN KEY (`completed_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE,
+  ADD CONSTRAINT `intervensi_ibfk_7` FOREIGN KEY (`cancelled_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `laporan_status_history`;
+DROP TABLE IF EXISTS `status_laporan_transisi`;
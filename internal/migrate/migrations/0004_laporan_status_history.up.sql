@@ -0,0 +1,39 @@
+-- Status workflow of laporan masyarakat: the allowed transitions between
+-- status laporan and the history of every change. The transitions
+-- themselves are master data, loaded by 0007_master_data.
+
+CREATE TABLE `status_laporan_transisi` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_status_asal` int(11) DEFAULT NULL,
+  `id_status_tujuan` int(11) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `transisi` (`id_status_asal`,`id_status_tujuan`),
+  KEY `id_status_tujuan` (`id_status_tujuan`),
+  CONSTRAINT `status_laporan_transisi_ibfk_1` FOREIGN KEY (`id_status_asal`) REFERENCES `status_laporan` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `status_laporan_transisi_ibfk_2` FOREIGN KEY (`id_status_tujuan`) REFERENCES `status_laporan` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE `laporan_status_history` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_laporan_masyarakat` int(11) NOT NULL,
+  `id_status_laporan_asal` int(11) DEFAULT NULL,
+  `id_status_laporan_tujuan` int(11) NOT NULL,
+  `catatan` text NOT NULL,
+  `created_id` int(11) DEFAULT NULL,
+  `created_date` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_laporan_masyarakat` (`id_laporan_masyarakat`),
+  KEY `id_status_laporan_asal` (`id_status_laporan_asal`),
+  KEY `id_status_laporan_tujuan` (`id_status_laporan_tujuan`),
+  KEY `created_id` (`created_id`),
+  CONSTRAINT `laporan_status_history_ibfk_1` FOREIGN KEY (`id_laporan_masyarakat`) REFERENCES `laporan_masyarakat` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_status_history_ibfk_2` FOREIGN KEY (`id_status_laporan_asal`) REFERENCES `status_laporan` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_status_history_ibfk_3` FOREIGN KEY (`id_status_laporan_tujuan`) REFERENCES `status_laporan` (`id`) ON UPDATE CASCADE,
+  CONSTRAINT `laporan_status_history_ibfk_4` FOREIGN KEY (`created_id`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+-- Every existing laporan starts its history with the status it has now
+INSERT INTO `laporan_status_history` (`id_laporan_masyarakat`, `id_status_laporan_asal`, `id_status_laporan_tujuan`, `catatan`, `created_id`, `created_date`)
+SELECT `id`, NULL, `id_status_laporan`, 'Status awal sebelum riwayat status dicatat', `created_id`, COALESCE(`created_date`, `tanggal_laporan`)
+FROM `laporan_masyarakat`
+WHERE `id_status_laporan` IS NOT NULL;
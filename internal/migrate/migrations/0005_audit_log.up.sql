@@ -0,0 +1,17 @@
+-- Security relevant actions, such as registering an admin, with the
+-- pengguna who performed them.
+
+CREATE TABLE `audit_log` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_pengguna` int(11) DEFAULT NULL,
+  `aksi` varchar(50) NOT NULL,
+  `entitas` varchar(50) NOT NULL,
+  `id_entitas` int(11) NOT NULL,
+  `keterangan` text NOT NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `created_date` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_pengguna` (`id_pengguna`),
+  KEY `entitas` (`entitas`,`id_entitas`),
+  CONSTRAINT `audit_log_ibfk_1` FOREIGN KEY (`id_pengguna`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,20 @@
+-- Refresh token sessions. Only the SHA-256 hash of a refresh token is
+-- stored, previous_token_hash detects the reuse of a rotated token.
+
+CREATE TABLE `sesi` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_pengguna` int(11) NOT NULL,
+  `refresh_token_hash` char(64) NOT NULL,
+  `previous_token_hash` char(64) DEFAULT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `expires_date` datetime NOT NULL,
+  `revoked_date` datetime DEFAULT NULL,
+  `created_date` datetime NOT NULL,
+  `updated_date` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `refresh_token_hash` (`refresh_token_hash`),
+  KEY `previous_token_hash` (`previous_token_hash`),
+  KEY `id_pengguna` (`id_pengguna`),
+  CONSTRAINT `sesi_ibfk_1` FOREIGN KEY (`id_pengguna`) REFERENCES `pengguna` (`id`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
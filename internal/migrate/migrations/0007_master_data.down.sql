@@ -0,0 +1,8 @@
+SET FOREIGN_KEY_CHECKS = 0;
+
+DELETE FROM `status_laporan_transisi` WHERE `id` BETWEEN 1 AND 7;
+DELETE FROM `status_laporan` WHERE `id` BETWEEN 1 AND 6;
+DELETE FROM `kelurahan` WHERE `id` BETWEEN 1 AND 22;
+DELETE FROM `kecamatan` WHERE `id` BETWEEN 1 AND 5;
+
+SET FOREIGN_KEY_CHECKS = 1;
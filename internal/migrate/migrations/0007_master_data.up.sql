@@ -0,0 +1,58 @@
+-- Master data: wilayah of Kota Cirebon and the status laporan workflow.
+--
+-- INSERT IGNORE keeps rows that already exist, e.g. after importing
+-- stuntingdb_new.sql.
+
+INSERT IGNORE INTO `kecamatan` (`id`, `kecamatan`, `area`) VALUES
+(1, 'Harjamukti', 0x000000000106000000010000000103000000010000003d030000f38e96033d245b408f8269183ef21ac0665e18e945245b405042acfe08f31ac0995bf2785a245b4058f7a57edef41ac098577f8461245b4014266a696ef51ac0b429efe368245b409af5251b0ff61ac02c4f2a1a6b245b405de32d573ff61ac070f77ccd72245b401232fd12f1f61ac0fb7b884677245b405de82add5df71ac0177fe5417a245b40dc4533a7aff71ac01ab6705985245b40182329e961f81ac06be7cc7685245b40d44b309c6bf81ac03f20680586245b40956ac8d095f81ac006bac7d287245b401c3905f9d9f81ac0e11a709692245b40d26a6116daf91ac03144e6caa0245b4090a42c431cfb1ac0ed493140a2245b401029e44a3dfb1ac034105414af245b408e3c27bd6ffc1ac02fbac667b2245b4015846055bdfc1ac087c9b3cbb7245b408d370f2730fd1ac0273f4162bb245b4058866bb587fd1ac05af88a55c0245b40cfed5c8ff7fd1ac0de9d86a8c2245b401dafbe122cfe1ac079b65e5ad4245b40939861a3acff1ac050dc0812db245b4012ffffffffff1ac05cb85158da245b401e1f222109001bc08481999ed9245b40143f444212001bc0d854ee9ed9245b40d19bb97d12001bc0b9895278d9245b40d2cd308a25001bc0d56a9503d7245b40101a8c50edff1ac06dfbaceed5245b40158715c4f9ff1ac0bdf579f2d4245b40e04d3bed0a001bc0f518bdb3d3245b401a0b694d26001bc031d4832cd2245b4096b2043556001bc00d175b85d0245b405b820ca575001bc0c1190a2ecf245b405ad1241b89001bc031b93dcfcc245b4053cc1c1693001bc05254f06fcb245b405dcd5e9790001bc0e6368b40ca245b405d88b11c86001bc016fbe390c9245b4056820ca575001bc096935049c9245b4096f4b6b55f001bc0f15e0059c9245b4018b446b653001bc02e6e2119c9245b40d1e9e3bd44001bc0fa355aa9c8245b401a9705c438001bc05a09d4b9c7245b401621c34633001bc002e1cecac5245b401d5f89c82f001bc0b6e37d73c4245b404f9c0dc92e001bc012df6c9cc2245b40135f89c82f001bc0123af78cc1245b401a539aca2b001bc022b59555c0245b409192a2cd25001bc0b2440776bf245b401cd2aad01f001bc0ce3b759ebe245b40de06065713001bc04a78bd0ebe245b4099c158dc08001bc04e100f8cbc245b405b31b99ff3ff1ac007816bc9b8245b40d6c3dcde03001bc082940d8ab7245b40950accda0b001bc03797bc32b6245b40df06065713001bc003b94e1cb3245b40d011b3d319001bc06310f9ccb1245b405e9526d020001bc0fc43af65b0245b405b9c0dc92e001bc0700e979eae245b40955f263b4a001bc05f78b278aa245b40d7043e207f001bc0d4a323b4a3245b401f6a4d02ba001bc044f96b369f245b4059f36576d1001bc070b432af9d245b4051ff5474d5001bc051f709089c245b408eb35d73d7001bc0a54eb4b89a245b4051ff5474d5001bc0a05615f999245b40d77be177ce001bc005a65e6999245b401aec7e7dc3001bc03839b0c998245b40d720da03b7001bc0f900e95998245b405e27248aaa001bc0508139ba97245b404f1b358ca6001bc0f9ac8e1297245b409b19f30aa9001bc0b4a7dc7a96245b401758b98ca5001bc0c44b21f395245b40d79803119d001bc01dcc715395245b401652141595001bc034c3df7b94245b4013921c188f001bc01182863493245b40d9d2669c86001bc06a5d618591245b40154304a27b001bc0b10f96268f245b401c47ca2574001bc07dff43cf8d245b401d761da771001bc0d68903588c245b40df834e2673001bc0d635a9108b245b40950804a477001bc0b9701f298a245b4098ca3d227b001bc075bc885989245b401381ca2378001bc0b24fdab988245b40503adb2770001bc07ebb57c287245b40df3fe32c66001bc0719a125386245b4096743eb359001bc02e41067484245b409b6e993b49001bc0be2b028582245b40ded389c437001bc096b2a4dc7d245b409db0b04f1c001bc04b07b65c73245b409ee8d75217011bc013f5551d72245b408de0c0c539011bc0dbf1b16d71245b400d06b6b74c011bc0c865d82171245b409d0f21b355011bc0bcd9fed570245b4056479dae5e011bc05b8984ce6f245b40945a73a570011bc00b0803d76e245b40e09f20207b011bc07fa8670f6e245b404edb629f7c011bc0eb8d7c756d245b408d0a0bf879011bc0630b53406c245b40db9473a374011bc0afbeb8786b245b405ddf282375011bc0c8b526a16a245b4052da201e7f011bc0089a93c969245b4090e249988a011bc031b115ca68245b401728f71295011bc0e02f94d267245b40586ee60e9d011bc06c6cdc4267245b409f3c0f8ba4011bc06c6cdc4267245b4016c08287ab011bc08408c07a67245b4093f7fe82b4011bc0001c7cfc66245b40d20df9c2bf011bc0d0927f0366245b40994dfef1d5011bc0ec5e399464245b400fdbdce9e5011bc078c5040563245b408ea7c3e4ef011bc02cc8b3ad61245b40d95bcce3f1011bc088c3a2d65f245b4096a7c3e4ef011bc0881e2dc75e245b40dc220e67eb011bc088cad27f5d245b4053a0dcebe1011bc025c1691d5c245b4094fd8caadd011bc0354b1ce45b245b404d4ae410de011bc05d5548ab5a245b40de57703fe0011bc08187170a59245b400f2450e8e8011bc0b991f16558245b405609ff1af7011bc0b1af051157245b4010cdcd9714021bc0edd52fe356245b404e53c2c232021bc0d5394cab56245b40ca1c80ac5e021bc07de1706356245b408e34b99983021bc0e9fda4fb55245b405091028f98021bc0dd381b1455245b40d74d3408a6021bc0a928c9bc53245b401d9fd080b4021bc00557cdbd51245b40945b02fac1021bc05132a80e50245b404da2f1f5c9021bc016556b8f4e245b4057eb64f4cc021bc07aa4b4ff4d245b4058eb64f4cc021bc026d009584d245b405a339671d2021bc0d6772e104d245b40146b126ddb021bc0d6772e104d245b4051733be7e6021bc0ae8821284d245b4018c5d75ff5021bc00e3426204d245b409c8109d902031bc0cefb5eb04c245b4094939d4e17031bc01dafc4e84b245b405d9942c627031bc0be5e4ae14a245b409ba16b4033031bc092cac7e949245b4017e718bb3d031bc02aad62ba48245b40182cc63548031bc04e71bb0a48245b400a7fa42f54031bc09e7f9e9c47245b4011cee42660031bc0da362f8445245b40d8bad6a6fd031bc0ba482b2444245b404edc897df5031bc0fb5bdee53a245b4054aff12e51041bc0ec2e218c30245b40183a6b7db2041bc0b887f60029245b40987af07fec041bc0c1fef9791d245b40cb9c561bad041bc0e0f4d89919245b4093e340cb97041bc0d91df6c418245b401b128a3893041bc0669fe13816245b409947401a88041bc045985cfa14245b409b11e7e8be041bc0b18cfbb513245b40d17496b9f6041bc021eb7c1013245b4015778e3313051bc0be07ece911245b400a68a36d0e051bc071115ac310245b404c59b8a709051bc0f52317e80f245b401695311a06051bc046a1488d0f245b4055624bef18051bc04ec4e74c0f245b40551f264926051bc051e7860c0f245b40190a12a333051bc05b82607209245b40d18e7e7048051bc03270acec04245b400fec64d877051bc05a2dbbacfe235b4013f7368b84051bc04acfd3c0fc235b40594a8dc1a4051bc0ff7d2822fa235b408f2eada9d3051bc0e3b89e3af9235b409271d621e3051bc0438c184bf8235b401a3ebd1ced051bc0fb320c6cf6235b405df3079dec051bc073269a54f5235b405aba4920e6051bc04371d2edf2235b40cca4ef26d9051bc0cb8474aef1235b40922d6b28d6051bc0a76cc116f1235b401d69ada7d7051bc0bc9b1292ea235b40d3beac16f9051bc0dc3df5dbe6235b409a7dc51fe7051bc06858c745e3235b40dc753918f6051bc0d9f908cfe0235b404c377396f9051bc0f97d93bddd235b40d3eca40703061bc029b49eb0dd235b40134b498d0b061bc06514db38dd235b4008a5abf23f061bc095a72c99dc235b40db15685181061bc0a9712f8cdb235b409706b9c5db061bc0051feb21db235b40d316c18f02071bc0e18207eada235b40dc6ae10a0c071bc039b23c82da235b408d3302821d071bc0596f6529dc235b400f79affc27071bc019b24bf4dc235b408aab9e0333071bc019712356e0235b4010915ddb6e071bc0c894176ee0235b40196d544992071bc0b9740396e0235b401941223daa071bc005cddedde0235b40985719a9d1071bc0f95876c1e0235b408cd3264eee071bc019712356e0235b400b7b58517e081bc0b1da5859e0235b409b25cdd08c081bc024986167e0235b400ac97e43cc081bc0d010e7cde0235b404b0ccbd91a091bc0bcadb331e1235b409ab92644c3091bc0994b6cc5de235b4057577fac290a1bc0b9b8f4c8dc235b40597c1c00830a1bc08523f786d8235b4050272887470b1bc099a91370d2235b40cefbfc69360c1bc07103faddd1235b408ebe9f295a0c1bc056158dbdd0235b4093ba3fae720c1bc06a099756cf235b401795fec07d0c1bc09ae473efcd235b40ce7072108b0c1bc04a16dcaacc235b40d1b2990ba80c1bc01e1d9fd8c9235b404c98b9e1f30c1bc06d2634dfc7235b409b37852d270d1bc0895de0bdc6235b40520a9de14a0d1bc0222ada99c5235b40c98e2d24900d1bc0b6f487e0c4235b4095ee1762de0d1bc08edda64ac4235b408b0c4f1c310e1bc0d3f75255c1235b409560c9fc730e1bc0aead4525c4235b40cb29b7f9ee0e1bc0a31766dec0235b401a3f9320030f1bc066e9c8c2be235b401a483047220f1bc09e1a0ef8ba235b40975ac330530f1bc053aca39eb6235b400fd6ce587d0f1bc0f845ec83b4235b408cdae24f910f1bc094b2b096b1235b40c97415856f0f1bc00c85a680af235b400e471d52490f1bc068805820ae235b40cf131a9f010f1bc0ecf4cf71ad235b40d13655a3c90e1bc08cae359eac235b40d9a4a04da10e1bc0d4e7d83aab235b40d1f66ea27f0e1bc03914b592a8235b40185e082f7d0e1bc06da8b954a6235b40c7d12b6d8a0e1bc061b996d3a2235b4016aedfc0a20e1bc0914c2d759f235b40d6ef9183c80e1bc0d1bd3fe89d235b40508fb66bee0e1bc07d9136a29c235b40904e524c1d0f1bc041f782cb9a235b4011250b075e0f1bc04eddf44198235b40d64ea1769c0f1bc0a24ba20196235b409244c2c9c60f1bc086e1a20a94235b40595702e2db0f1bc08564a21392235b40169853faf00f1bc0b64516b590235b40d87126ddfe0f1bc0b6fcd6638f235b40171e680a3f101bc05238576a89235b4012b78b8444111bc00252aa8c85235b40d5219efe49121bc0670faebb84235b4095a4f86f93121bc03e39c68883235b4092ca31afdc121bc022a6397f81235b40891762c256131bc02e992d667f235b40d5a2060ddc131bc0a70b40857e235b40d3f86bc62a141bc097724fd17d235b40916f18ca5e141bc004bb5a4a7d235b404965f0e3a7141bc034c6ef527c235b4052a50cd5fa141bc0d043977a7a235b40174c960c60151bc047b6a99979235b409250d74798151bc0b8b9d2aa77235b40112c52260a161bc043c890c474235b40d4735122f9161bc020eabda872235b408f31fabd8c171bc05ccc79a36f235b400d82e7f760181bc0c07eb9266f235b40d99aeae299181bc02831f9a96e235b4051e1fecdd2181bc074e45ee26d235b400e0d876365191bc000849d656d235b40177827f51e1a1bc0b471c1cb6c235b40d08fa224dd1a1bc0a0860f856c235b40d6ee1c88341b1bc051e584976d235b40cd90ef36951b1bc0cc810a1c70235b40d4032ac0d01b1bc0946f145472235b408ac805550d1c1bc07b647e2773235b40158a80e7371c1bc0b322dc8d73235b40c9c38d93761c1bc0687f6ecb74235b40929192d7b01c1bc097d850bb76235b400ae1c71cf41c1bc093508dcf78235b40c9e9c0ae301d1bc042f510027c235b40550196946f1d1bc0a28e464881235b40d9f671c2f11d1bc05e078e9485235b400ff257e96a1e1bc0a3f4afef87235b409276ea73b01e1bc085dcefc088235b40c7b8addef51e1bc0552cba9588235b4051b23e94511f1bc05613e14888235b401541936e921f1bc066f1752487235b40540f492adc1f1bc037b3e57185235b400644586e18201bc04a2a15db84235b4054dce45776201bc07a4e1fbc81235b4016694ecb00211bc0f3ec15537f235b404b4b1a8267211bc0176724ef7a235b40c791e16913221bc0e721963a79235b404f46844968221bc08ffdad3f77235b4092aa7f7aad221bc047db11da74235b40900510b0e9221bc0cbe328bd72235b408d2e4a7f18231bc06f84173670235b40904bfa9c37231bc0271134d16d235b4097a2b6df6a231bc0fb8803d76b235b405231ef1da7231bc09db8c78e6a235b401143c2d6f0231bc0a14a708d69235b4059a31d8843241bc06cfed76369235b4050c2621b8b241bc02074f93a69235b40cf0be5bbc9241bc0ccf3df6068235b4091cef8eef1241bc0042b8af766235b40c7a081161a251bc01026e4fd64235b40ca2c9b9e4f251bc00ce6be5162235b400e3839627e251bc0fc55cd5d5f235b40099eb55caf251bc029a4101f5d235b400a2f578dc5251bc0c14fdd045b235b408ca618ced2251bc0b520191059235b40872d82efcb251bc0d9214c8757235b4019148663be251bc0d9451b2755235b405867477cb7251bc081f333a252235b40cba68cc1bb251bc09656c4f84f235b40902ec3f6c8251bc036074e9a4c235b40125c0eb9ee251bc05e0c783e48235b40d78c6bf535261bc0264662ba44235b40857db21372261bc0bf47d1c142235b40d4319f2f9a261bc083ecc1dc42235b40c8f258d007271bc0e34125d742235b400fcdcb294d271bc01f09768a42235b40c62c5ac78b271bc08360251a42235b4051cf5025c8271bc00bff0e1a41235b40d756ca2d0b281bc0a32f421641235b40c6834d283a281bc0ab20cb5a41235b408553d33560281bc0dbeb52c341235b40d0dd860984281bc0220cba5541235b40062b27d99e281bc0cff133ec3f235b4093d0533dc9281bc083306e3b3e235b40cdfb5022ef281bc053ac73d23c235b4050cc6fd012291bc0beba541c3c235b40cc49b1c938291bc02f0da9893b235b4017572e3f63291bc03769d9443a235b40c3e1c57682291bc00b85776b39235b4095ce16b7a1291bc0dfb0781039235b40937ee73fed291bc0a7efaaa739235b40d6bb880f1c2a1bc0837c6d1f3a235b40c84e0c7f3c2a1bc087bf8ce339235b4095de6a2b632a1bc0e34fe72f39235b40893f17178b2a1bc03f23614038235b400fd33b47aa2a1bc013ea683936235b400698963ac32a1bc073bde24935235b40ce63dc74ce2a1bc0dba0664634235b408f2cfdebdf2a1bc0543c067833235b40cc0d64af0a2b1bc004be658e32235b409286395a382b1bc03452eef530235b40068b0a32882b1bc02c72b93e2f235b400fe4c4c6d82b1bc0f098ca362b235b40924f8b9bfc2b1bc0e75ebf1429235b400a8742910f2c1bc011ecafef14235b4085d128d6092d1bc000188b86fc225b404507b9d9bf2d1bc054cc8583ea225b404961aa91442e1bc098f71410e5225b404a7500d3882e1bc07555f1f3dd225b40d16c6720152f1bc059065efbdc225b40485de97f302f1bc029c224cadb225b408d78571c522f1bc0cd78da6bd8225b400bc80c05802f1bc0da1bee4cd6225b4082cd140a762f1bc06eab5f6dd5225b4014394f19582f1bc076dd43aed3225b40512b81273c2f1bc0c213a9efd0225b40ca9da22f2c2f1bc0c2424eb1cd225b408583e53f0c2f1bc067320a82c7225b40c500da56b32e1bc0800cded9bc225b40901d03d5e62d1bc07c6d67dcb7225b40c3ccec116f2d1bc07c52217fb2225b409333085fd72c1bc071083660b0225b40cb235b79a32c1bc0698c6600b0225b40497cbf91732c1bc09cedd31fb1225b4007da2baf392c1bc078bc9f3cb4225b40863f4a0de92b1bc0490cc7cbb1225b40c48b8ec5e52b1bc075bd1daca2225b404e353472d12b1bc05e1a0acba4225b404e37fca8652b1bc0360388caa5225b40cdb388ac5e2b1bc0d52f0ebaa6225b40ce3c04ae5b2b1bc04d1c6cf9a7225b40126d99b0562b1bc0f148f2e8a8225b40083015b0572b1bc0a1e9e6f7aa225b40cdfcfbaa612b1bc034fda959b6225b400f70b7636b2b1bc010e37e88b9225b40476ec8716d2b1bc07c530d68ba225b400ad31b88412b1bc02304c4f7ba225b4004b2779f132b1bc0c407a437bb225b40c35f3eb4ea2a1bc0c407a437bb225b408708fdc3cb2a1bc0e7cbfc87ba225b40436d50da9f2a1bc0bce65ec8b9225b4009d2a3f0732a1bc080ae9758b9225b408903de01522a1bc0e4fde0c8b8225b40d0719c132f2a1bc09c494af9b7225b400b555b21142a1bc090d5dbd9b6225b40828d7c2b002a1bc059d146ae9b225b40caafa888d3291bc009ef60319b225b40ce0e82eec8291bc0e65b84049a225b40c29e54d5ad291bc03bc49bdf97225b40cbeece3f99291bc0a319b2ba95225b400f41cdac7f291bc0e39e138a93225b4089040ae059291bc05e36e6ea91225b40c9ecd0f234291bc0fa41276b91225b40849a97070c291bc09a4507ab91225b4085b8fb21d8281bc08ba8f2db8f225b40d09899baa7281bc06e3484bc8e225b404a8f320d83281bc0d383cd2c8e225b40cc32ed6552281bc01660d9148e225b40856c3198ef271bc06cce21a985225b4091efe8c88f271bc03874e43282225b40c492a3215f271bc03442329981225b400839214950271bc0ede825ba7f225b4051bb13f832271bc0a47f0fef7d225b4052db992022271bc0a5f1a1cd7c225b40922a73ca0e271bc068f3ed377c225b40107d7137f5261bc01418458e7b225b404307f0edc8261bc000c51bde7b225b40426ec3b8b3261bc0adaf47e17a225b408219c437af261bc0a5954a9477225b40534090cd9f261bc005f3f35672225b40c2fa45e07a261bc0c93e5d8771225b4045570def5d261bc0e9d90f2870225b4006dc25fa47261bc005d8adf96c225b408d4e470238261bc019521b2b6a225b403f3b710b26261bc02e795fac67225b4050ba81111a261bc076afc4ed64225b4091b0161611261bc0021ef19e62225b40d2a92f1d03261bc0c240b41f61225b404e9ee52de2251bc076104ef05f225b400284283ec2251bc0876377a05f225b408578de4ea1251bc0ee57362060225b404b28845790251bc04ad0254060225b4087913a6477251bc0b2a39f505f225b408c3c7d7653251bc09e825ae15d225b40c27722833a251bc05f2f4d1458225b40083b9e823b251bc04b4e4c3850225b40cf27c88b29251bc0207d0ddd46225b40c7907e9810251bc0003bc2cf43225b408d1a052810251bc0711afb323b225b404614f29417251bc019e0ed7731225b40c427c88b29251bc0daa7260831225b408db4a68339251bc03a7ba01830225b400d33127b4a251bc05ec329092f225b408c46e8715c251bc0ba6dfd692d225b408d9642696d251bc006cd085b2b225b40ca60a5617c251bc0823b350c29225b4090daad627a251bc01ea2007d27225b408e9642696d251bc07b757a8d26225b408343646f61251bc037c1e3bd25225b4044f68d7a4b251bc02f45145e25225b4090b4a68339251bc0cfcc243e25225b40442a4c8e24251bc02f45145e25225b408093029b0b251bc06a7ddbcd25225b40c7511ba4f9241bc0b6f6a55e27225b40085154f1d7241bc03f6bf77b27225b4085216a0ab3241bc037b9337e27225b40001ed29d97241bc05b61425b27225b40056d05b276241bc0dbc8bbe126225b408289dcdb46241bc0466b1c8c26225b408615be302c241bc0ff235c0326225b4043ea49421d241bc02fc1616025225b400dac696c1c241bc00782c0da23225b40c121747c26241bc0d7eac6b922225b408535aca92c241bc06bf1124121225b408d97300732241bc0ff77e01320225b40c5d3754834241bc07e629f9b1e225b4046a0ca2934241bc0fb86b5ad1d225b40c3dedd622f241bc0570cc9cc1c225b40d168d42025241bc06a5c3c821c225b40021f407c1c241bc0608743a91c225b40066153420b241bc0a7be23351d225b400f8ce1cbf3231bc0da5d69b41d225b408aa5041ddd231bc0970d20c31d225b4049f5047ac2231bc0838402751e225b4089a02153a6231bc0b32569761e225b40cac92f1695231bc0cf8bd46a1e225b40c5a75a5489231bc03a98d1bb1d225b4006afc33882231bc097472c761c225b408f0d12a27c231bc073a7cdd81a225b40c1b9ac3b76231bc0d8033b4819225b40c694eeb36c231bc09842e45a18225b40092ab6df60231bc0379a632317225b408b8150b347231bc0ec3e62f915225b40cc4a2ac722231bc024bc69af15225b40cc29391513231bc054672c3f15225b40503b25360b231bc0d3f22a4514225b40056018f200231bc0bc6089da12225b40425c7f82f3221bc0f152570c11225b403fdaa6a3dc221bc09890e4690e225b40035399f2b9221bc0d0abe0180b225b40876bd6a98a221bc0addc337109225b40c033c6d568221bc0cc64cfe007225b404b3bc39733221bc07189190d05225b4046dde1462f221bc026e76365fe215b404e09ba7116221bc0cda502a8fb215b40872ca8770a221bc0e2549ac0f9215b400c87f927f5211bc0ee228f94f8215b400c43964ee9211bc0dbe62443f7215b404d62d34fda211bc0ae619c88f6215b4048a6d0a9c6211bc04286f526f6215b40c349eab8a5211bc0c2395dd2f5215b40899750847e211bc0e2b7ceedf5215b400de59f8861211bc0a246a3ddf6215b4049c8e5cd4e211bc0a2f59f8ef7215b4080413f9f3d211bc08eb32901f8215b40468b244929211bc0fa484d0ff8215b404d8470b315211bc0467abcc6f7215b408cbb9ec4f4201bc0de7be089f7215b4003d27406de201bc0c628dd0df7215b408d763ebfcc201bc06a626e5ff6215b4085d45b96be201bc06e85c726f5215b4088ac9e84b3201bc0c67a8c97f3215b400c52858899201bc0060fd4e5f1215b4087df802459201bc01a00ebfaf0215b40815fdb892f201bc04e32d7b1f0215b40019fbedf14201bc08233bec0f0215b407f0bfbe2f71f1bc01e93011af1215b4002d3a0e4e51f1bc02e05b398f1215b40cd950f43d61f1bc03e6773c8f2215b40c93041aab41f1bc07e2d949bf4215b408156975d741f1bc0129f485bf5215b4085490d35561f1bc0129f485bf5215b400df189c3391f1bc0ee357afbf4215b40c1e17950201f1bc0321286e3f4215b40431bdddb091f1bc0ee357afbf4215b400fc3596aed1e1bc062c4d744f7215b40430db307c21e1bc03fce2f18f6215b400bdfffbf981e1bc0be445f2bf5215b40433aa9c7671e1bc036ecf131ee215b40cdb6b0222e1e1bc097bb070ce5215b408a814786e21d1bc0878337c0e6215b40be16a45da81d1bc053726c1fed215b4040a4408fe61c1bc06e752bb4ee215b4084182377b01c1bc0ea985225f7215b40cdbf63e7891b1bc0f683f319f8215b404688aef75a1b1bc0b2191d56f9215b408a6d55e4a01a1bc0826e5ac6f9215b4042574f4d4c1a1bc08e4c92e7fa215b40ccd58a36c7191bc04d6337edfb215b40cb56b64924191bc0be66463df9215b40c642695b0c191bc00672a69ff6215b404ba5a381f7181bc0b3dd787ff5215b401091e0ea59181bc072852cbff7215b40043ff7fc68181bc076848c8afc215b408171db2be4171bc0754e869c04225b404ce4595b0e181bc0b183c94b06225b40051554f1d2171bc0646793fe09225b40bfbb849b70171bc0cd4939190e225b4044f8cf7710171bc06bd1516a16225b404660c43e01161bc0ec7fbe3f1b225b407f796ca000151bc05841f28f1d225b400f5cda36ad141bc0a27c4ca434225b4043a8244372121bc0fe8afc6a36225b4043ccf35445121bc03698e90e37225b4080803a2235121bc0221e370939225b400868e017fc111bc07161435e39225b40cedb185ef0111bc042282d6e39225b404188762cee111bc05a0c208439225b408c4dd325eb111bc0a21e0e9439225b40088aa7f3e8111bc0a6a4cf9d39225b404c73409be7111bc086ab3af039225b404b056b3edc111bc0f51533eb3a225b40108c84a4b9111bc08935af9c3d225b400f598bd53a111bc0699b20fc41225b40429ff023c8101bc02cdcf7ba42225b40cf77e81e86101bc0b15d7b2544225b40ce09b1074e101bc049c3c44a45225b400bd4539212101bc0c1e53d8045225b400deac1d7e90f1bc05530a07046225b40d0c7990fd00f1bc0152da02a48225b4045ebec96aa0f1bc0e58a40cf48225b4007a16f0d970f1bc05199271c49225b4082762db4820f1bc0f8c4951149225b400d44ee686a0f1bc01d7d089149225b408e4c3c60510f1bc09806172b4a225b40c8a16e8b250f1bc05c2bb7104b225b40d101bad1f50e1bc00061cd8f4c225b408436e441a80e1bc03819d5bf57225b4044ce46a4290d1bc053d004c55a225b40c141083fa60c1bc00bb229bd5a225b408c2e8ae1940c1bc067057eab5a225b4042221ed26d0c1bc033a566335a225b40007893056a0c1bc0577d19c25a225b40494a317c4e0c1bc02f149d605d225b4005b077e4e70b1bc077ee6ebc60225b40914778a8910b1bc04fa5d51c62225b4091d4609b060b1bc0cb8114685c225b408d6201ffd30a1bc0a75b266858225b40ca584a91da0a1bc0793823e847225b40c23fdb533a0a1bc0fd9142f945225b4003ebda8d270a1bc04102edbb40225b400a517025f9091bc096dc96753d225b403e7da231e1091bc002b5de0037225b4044b16045ba091bc0678e572332225b40ce8e7adb8e091bc0866c4fbc2f225b40120f7f6673091bc09e7b02dd2e225b408dfe7a6e69091bc0fe56a3a82a225b408814d3ad20091bc0dea15d322c225b407f5dea4006091bc0bf9c831e2c225b40831a192d05091bc0cb1cf03b1e225b408cd8194644081bc05c1963231a225b40ffb22a7307081bc05057d70e17225b400ae5329beb071bc0948dec2c15225b4004e84097ef071bc07580fd4014225b40ce14e90efa071bc05c7600b512225b40871a8b82ed071bc0dc42a58e11225b404eb9f6e4e5071bc03851c5a910225b40892b8353e7071bc0c0c05f7b0f225b40c04f2752fa071bc0fd884ef502225b408dcd8e1c35091bc0bae5759700225b400e0f765a1c091bc0b247257502225b403ef7c8ae00091bc03def8a4602225b4045b5f3fdd4081bc056f83074ff215b40befa3a70ce081bc016c5ef39ff215b4001749586cb081bc0eabf67fefa215b407cdc267448081bc006c4cb13f5215b400e756bd1b6071bc06f0cfee4f3215b4046d2b0b292071bc0efb1fef0ef215b40063e20fb19071bc09736d654ef215b408f6b711108071bc0cb1c4b62ed215b404d0f4fe1ce061bc087476f23eb215b40cbf999f6a4061bc043ee6244e9215b408d9d500190061bc050ec0016e6215b4051d3ed0881061bc00c93f436e4215b408a4c599764061bc04877615fe3215b4046f4d52548061bc0b8427a2fe3215b4047a5bdaf34061bc0a04b0c07e4215b408c1a63ba1f061bc08c4c3d9ee5215b40c5cf10c804061bc064e49fd5e6215b408fb69559e2051bc048ed31ade7215b40ceea536dbb051bc0e49de83ce8215b408a0776068a051bc06c05e544e8215b404caa8f9e5a051bc034615861e7215b4002520c2d3e051bc0e88cadb9e6215b400c4c67b52d051bc0f4831be2e5215b404344dffb20051bc0c063bf9ee4215b400b0115c312051bc014bb694fe3215b4086fd528dfe041bc0983ad183e2215b40c9e97c96ec041bc0f40d4b94e1215b404695bfa8c8041bc0c869beb0e0215b403e46a732b5041bc0a8386f55df215b40cab786b9a7041bc054a7e371de215b40d04002bba4041bc0b8373ebedd215b408ac5b738a9041bc0b5fe8d22dd215b408d0a65b3b3041bc0353bd692dc215b407f8b54adbf041bc0e5a94aafdb215b40cbd2e469c6041bc00d12e877da215b408d597d29c7041bc06d28814cd9215b400852f56fba041bc055b4122dd8215b404dc79a7aa5041bc0308c55a9d7215b40caef060395041bc0f9d3a6c4d7215b405068006f81041bc04184f468d8215b40099966d374041bc09dd33dd9d7215b408d61ead76b041bc0ed92c367d8215b403e8e51065d041bc065acb1ecd8215b408b8d1ce453041bc061802550d7215b4002a36ecf42041bc065acb1ecd8215b4042e8633e20041bc03d3aa5fbda215b40ca3ee962d8031bc0e5a94aafdb215b408cf75cf4b5031bc00d8fe86edc215b40ca8fc90f80031bc08d0f813add215b4045aceba84e031bc0c436192dde215b40c067056f10031bc039813e48d1215b4002e36440c8021bc0fd543dbfc6215b40cf6cbfb45c031bc09271554ec5215b400bb2e6fa50031bc06fdca1bfc2215b4040513a0f29031bc0571c4bebbe215b4087c705cfd3021bc03bda4368b5215b40811e63ee5a021bc05ce05a86b5215b408662dca206021bc0ec1442b6b5215b40ff1227bad8011bc08b1822f6b5215b403efbedccb3011bc0789ee547b6215b40057ef63f9a011bc09394f155b6215b404a6728dc95011bc0a010c1b5b6215b4082935ae87d011bc0346e4e95b7215b40494b8cf85d011bc03fe2bcb4b8215b40c779420741011bc08f1223e4b9215b40891df9112c011bc097336853bb215b400f561a1c18011bc09e54adc2bc215b40bed42a220c011bc0b3f1c191be215b4041cbbf2603011bc0b29637a1bf215b400ece4329fe001bc0f64ace70c0215b40cfba6d32ec001bc0e3b3b8b3ba215b40c63dd88f38ff1ac0f67470ebb9215b408876c7f7fffe1ac0f7809cb4b9215b40c631a579f0fe1ac074a2ea55b9215b408b7a16b9e4fe1ac017a2f99cbb215b40cc871cb3d0fe1ac0a38b10a5bd215b40c1119f3bc1fe1ac0bfc45682c5215b407e06b13385fe1ac03d3f096cce215b40c65acb6438fe1ac000a5f2fdd4215b40053e4be812fe1ac0bc1cf33ae2215b408e533541d4fd1ac0648ab663ea215b408a8e34b8adfd1ac0e3a3d0b2ee215b40c39e53909ffd1ac063ba8d06f0215b40408d0244c1fc1ac053b2ef3cf1215b40c1b2cbb73efc1ac0b726e82ff4215b40907e30f0dcfb1ac05b59ad4cf8215b40885b001aa5fb1ac0d242d47d00225b4083ac68739cfb1ac0fe53b5dd04225b40d07a65deaafb1ac045bdb20b06225b4088f2bb4198fb1ac046bdb20b06225b404d7919c572fb1ac0be4b6cc5fe215b40c6b9490c02fb1ac0ca165454fd215b40425df3aacefa1ac05b8984effd215b4082d3fdf483fa1ac09a107ee200225b404f270cca34fa1ac0d69777d503225b40c8a9d1ab01fa1ac05903d88008225b400677280af4f91ac081389b4011225b40036fd23428fa1ac0a9de5ed218225b40ff99cb0d86fa1ac0282cc3b81b225b40d2c4c4e6e3fa1ac054bfdd921c225b40914fd25433fb1ac0b4bf78b81d225b408423b37a87fb1ac0c027e1421e225b404ddc5f3deefb1ac06f91799620225b40cd71dc291dfc1ac0f8890e8123225b400f90745e47fc1ac0fac099982e225b4082a2acdf4cfc1ac02e4cbe6037225b40c3ff9b6f28fc1ac0f0ef3ec747225b40c593f3c5defb1ac045bd318b50225b40c7009082c3fb1ac0406331ea5a225b4003351210affb1ac03c9e5b9a5b225b40ccda1324b6fb1ac0137eb43863225b400a5de6e3f6fb1ac0f61dcefc6a225b40c554f65d11fc1ac06e43dd8e70225b40c50832022afc1ac0ce4378b471225b40d56a5f402ffc1ac066e2287975225b400aff8f1326fc1ac019b4295778225b4092bda08504fc1ac0b1ba6e4a79225b408bf514a8e1fb1ac0c5d0907879225b40118c2ac3b8fb1ac0a569226c78225b40d01a446b45fb1ac00181d93d79225b401212d55b03fb1ac0d5852a357b225b4044d762b7cffa1ac00d9edb847b225b4090108942cbfa1ac0cdf1e9387e225b4088d118ada3fa1ac0f18be42b81225b400a081a3389fa1ac08d2d1e9084225b408dd570917bfa1ac03c7a5c548b225b4008b167cf65fa1ac02412acaa97225b40045d6743fef91ac0d35aa31ea2225b40d1e45df580f91ac022246b0da5225b40d18dab915df91ac08e0a3543aa225b4010a547533df91ac07e632f14b0225b404d2fd63730f91ac012dd1324b6225b409020904b1cf91ac05dff0188bb225b400cf96184f0f81ac075673e6ebf225b40c50b0055dcf81ac0b008ddacc1225b40c823a872bef81ac014a89718cb225b40d25a69aa0bf81ac048e1a128d0225b40123f439067f71ac097c865dcd4225b4008f63e5585f61ac04792c8f0d8225b404a2d73ba2cf61ac04b7c4e7adf225b40450e9bc8ccf51ac0ae719692e5225b4054197cf294f51ac01554c12ff5225b404a4ffeee1df51ac0354b29e8f6225b40c7193048faf41ac0f584bef4f6225b40c85cf8c1f9f41ac0e90a2344f9225b408cf6d8ee02f51ac019751af0f9225b401121533e04f51ac07c40390a10235b40481b7c6132f51ac0073c579412235b40087da99f37f51ac0df1bed9512235b409662aeac37f51ac037a64e4013235b400fbef2203df51ac05b2bb91116235b400c1ea16648f51ac0df954be317235b405285d8f143f51ac033ce87191a235b408f284fb14ef51ac08b0e62191b235b400d7e4fac53f51ac0071acc5f21235b408ed44e25e7f41ac07aedc03923235b40d99bf529c7f41ac0ee81441328235b404f1ebb0b94f41ac08e14fa9129235b4009fc74a191f41ac0b6bbad7b2b235b40ccdd718a8ef41ac0b20504a32d235b40ce8929059bf41ac05c0ba1832e235b40d419bd1aa0f41ac035cd290131235b40ce542fbfd3f41ac02db7db2e34235b4085688cd651f51ac0f13696b036235b404ef4cc2363f51ac094695bcd3a235b404af3c0c760f51ac0443b5cab3d235b401404e09f52f51ac0e8a16b5f40235b408e05b98b30f51ac05c33669945235b404b3ec6dcb5f41ac06783c8224d235b404e9061156ff41ac09312700855235b408e3365a71ff41ac0420d941458235b40d9241fbb0bf41ac0ad5e33f966235b40cfc67c40a0f31ac0d9eddade6e235b4092b7904770f31ac0fc535f9676235b40c9b6508a3af31ac0ac25607479235b405299c4b112f31ac05da614747b235b400f3d6e50dff21ac05412001c7b235b4051743c66a0f21ac03cc1b33d7a235b405aecb94c4df21ac0301071fa7a235b40d870fe2614f21ac0d0bcd1c77c235b404d72d712f2f11ac09fd0a0a17f235b400aa25bafe9f11ac04f6bce4884235b400ce9fa961df21ac0ffbac15087235b4049982cee3ff21ac0d77c4ace89235b408f44459c4ef21ac08a4e4bac8c235b4095e4965643f21ac0979b249694235b4089683524eef11ac0a9a535069d235b40599fc37c79f11ac0ed58af22a3235b404e510dfb3df11ac084208315a7235b40c820e3512af11ac030b33e97a9235b4056bfc16f27f11ac045161579ab235b40d2ddd5b73bf11ac0110653cdac235b40974c0f0a4af11ac0d49f86f6b1235b408b81aad1abf11ac0948eb5bfb3235b4051304278b4f11ac05737936fb6235b4050eff8f6aef11ac06bfedb65bf235b400eae09698df11ac08a73deffc7235b400a8c7f9f71f11ac0bf476100c9235b40d4353c556ff11ac00eabf413ce235b40d77ababa63f11ac0ae06dd5ed2235b40d9b6054b75f11ac0527075b2d4235b40dbc159a297f11ac0622d8194d8235b4018253acb2cf21ac041929c4cdc235b409ad8e89c9ff21ac011c01072de235b409bb52bf4c1f21ac0dded8497e0235b409ab3acfbc7f21ac09dbf8575e3235b40d3c3cbd3b9f21ac0097d2c7de8235b408c56b08d78f21ac0acc996e5eb235b40cd19bff04af21ac02435f790f0235b401c79c7293af21ac0979530d3f6235b405bf734272ff21ac0278627f4fa235b408f05d50627f21ac0975d7347ff235b4017cabc55d7f11ac09e215f4205245b400f5b499a5bf11ac08277fc1708245b4012a7734529f11ac00eabbbb20b245b40110ad462f0f01ac01d9b27d714245b4092eba17dacf01ac06277c4211b245b40cf1cff0582f01ac02120a2d11d245b4017ae0ad462f01ac0210374e61e245b4051d23a1938f01ac0ea07f1811d245b40584a1e4fcbef1ac0f5a3a3a31c245b40d56fc03f89ef1ac041e706431d245b400d5bcaf962ef1ac0f589fe571d245b408fd7b8fe5def1ac0099b0b5c1e245b409a97158a74ef1ac05670f4311f245b40d59098a086ef1ac09533d6851f245b40590889b48def1ac02d66c74620245b404cd7917b9eef1ac04404ed0e29245b405a518def6ff01ac0e4c3f69331245b404dfd7f9c30f11ac0bff4c7b436245b40538dcbb8a9f11ac0f38e96033d245b408f8269183ef21ac0),
+(2, 'Kejaksan', 0x00000000010600000001000000010300000001000000f8000000c98dc0b3f8235b401a511d1febc31ac00462b277fa235b40dad8ee7e12c41ac0a4f5d63afd235b4094df50110ac41ac0e46a84d7fe235b401c99754577c41ac09506d5c702245b404fcb37a533c51ac04be51f6c07245b4095d40699f7c51ac0a7582e770d245b40cc3b50c0d9c61ac03e0a414413245b40d2e7e673d2c71ac0b68e9a001a245b40197af008dec81ac026f20cbf20245b409210f749cfc91ac0f6e24f0627245b408d6b1576b1ca1ac00c2519492d245b400c52090dcccb1ac0bdb6724334245b404c9fc515c1cc1ac09195633138245b400f0d4bd8becd1ac0f076d3d539245b4097e32b9de9cd1ac084c723193b245b40900e010117ce1ac0606d94ca3b245b40904e17ea2fce1ac00719f75e3d245b400f4932155cce1ac0388040ee42245b40d520078af7ce1ac0d436488e42245b40519fc2c515cf1ac0f86b107441245b40122d54ad6ecf1ac0bccc673742245b4054d2016ce7cf1ac0a35cb49849245b40dcd6a7a785d01ac08b12c09850245b4055e72d3a33d11ac0da7780a153245b405fbc0c4d79d11ac00ee779dd53245b405e3c2db67ed11ac08661066d56245b40d96b80a9d1d11ac02a8fc84257245b4017f47742f1d11ac0caaa784759245b400d33c5a23dd21ac0e632b1f958245b4012eb97b538d21ac0821e004657245b404d58b3424fd21ac04271cbc651245b400dc5dc3e76d21ac00b4e1bfc47245b40526c9507b9d21ac068064faf41245b40de450873e1d21ac0305eb60339245b408ee659d41dd31ac0ad05f79e32245b40db43403c4dd31ac0a22af5d426245b409c33aa139dd31ac083d61dc317245b4017b45c0802d41ac0ffd9b59716245b40d082264508d41ac0626e407215245b404ddf1ff60fd41ac08eda075916245b4019ef299128d41ac08ec0620d17245b40d7b59db646d41ac0f589fe571d245b401a7b2aa73dd51ac0f5d603e621245b4012302592e8d51ac01041df162c245b40109d996038d71ac0e020645930245b4057efc000c2d71ac0d581289831245b4017b4c189e8d71ac041f2c91132245b409982bef4f6d71ac01157116e32245b40193bf88903d81ac041c1081a33245b40cf1018eb1bd81ac0099325732c245b405341e8a04bd81ac0050eab7823245b409c3e36c98fd81ac09596558a1d245b40505ae9b5d9d81ac023bc809719245b40930635d40cd91ac0166fa7ad11245b405f5f730e9ed91ac077df74cb0e245b40539c64abcbd91ac03b94633909245b404f1a9fc9fed91ac0038025de01245b404db9bda431da1ac0b8d7fecef6235b40cfb10ddc81da1ac04429d6a9f2235b4010b757e6adda1ac00a4d55dae2235b40180183a44fdb1ac0fe33c687d9235b400b0ba41cb0db1ac0cb966e99d3235b4009972427f7db1ac07b7b8cf2cc235b405ce3f56052dc1ac0af583f36c9235b40d2e39b6d6edc1ac08b68604cc9235b4051df929a78dc1ac067bbf770c9235b40da6ad26d89dc1ac0e670f085c9235b400e58349d9ddc1ac07bd1199fc9235b4015920035b5dc1ac0131eb0abc9235b405997a432c5dc1ac02b1a2d8eca235b40cfa211c45ddd1ac04f72495cc7235b400d457c2766dd1ac0bc765776c1235b404ea205685bdd1ac000f7b86fb5235b40dc43300e2edd1ac051bd2363b5235b40cd43300e2edd1ac06d97cc60b5235b4096aad80c2edd1ac07d06c24eb1235b400f7135b22bdd1ac05e7a7764ac235b4099b7efc01cdd1ac0716e8221ab235b40d8fd428f18dd1ac06d3b22dfa5235b40d59f134207dd1ac0c2dcdc989e235b404f6b51f7e5dc1ac0c6eb76f695235b40912bd505bcdc1ac0efab27f38f235b408e8147aca9dc1ac0ef13bc218d235b400ad2a3a99edc1ac0a7aabef38b235b400e2daef199dc1ac02f6bde718a235b40915011a793dc1ac00fc1a7eb89235b40ca7d164b91dc1ac04755807687235b408d76f35487dc1ac03c21628385235b40cc8493347fdc1ac0e009abb184235b400b93331477dc1ac0d08887307e235b404cde516342dc1ac051f1a65b76235b40867859130bdc1ac059d494eb6d235b40daebd808c4db1ac0c2f68ea163235b4050b0c05774db1ac09e9e9e7763235b400958350873db1ac05f4f621058235b404c09d9791bdb1ac04341deab56235b40d46562ba10db1ac02330fd4b52235b408bf6612cefda1ac00f5a367348235b40ce895242b0da1ac0cd8fa0aa42235b40536feb6e9eda1ac00da171e140235b40529b1727beda1ac03c22853941235b401034d42824d91ac0acd0f57d38235b4046a6203f1bd91ac0067dd7a02f235b40c52b0b26fed81ac0d2d7e1e82a235b4052849675ffd81ac02be8e44526235b4088dfac19fdd81ac03a0db1fa23235b40d4bf3bf9f4d81ac05329e56723235b40ca73ddecf3d81ac0bbf6f3a622235b40d6cb5ce0f2d81ac0ffa511d821235b40c903ee5af1d81ac0320610b321235b4056e50cffc8d81ac03e161a9f21235b400988269799d81ac09a7effd221235b405105395029d81ac0be2aedf621235b404f507037dcd71ac007c6e70222235b40564b6c80cad71ac05f2ecd3622235b408af2ec5c92d71ac08e568aba22235b4006b287cd32d71ac04727552223235b40133f4bbadad61ac036174b3623235b40171fe952aad61ac0d70a218a23235b40d7de248449d61ac0efb60eae23235b409accf39b1ad61ac07edbebf123235b40931c3388c3d51ac03affdf0924235b40139d66b668d51ac0db43d12524235b4011adfcde18d51ac063abcd2d24235b404fd42a34efd41ac046ccca3124235b404819bda391d41ac0020a7d3224235b4052cbd37786d41ac092c7b47b21235b408af73a7185d41ac03bf1b5cd18235b4046156e2982d41ac060bfb8ce0b235b40d7f9be7380d41ac04c95544807235b40d76ecb547cd41ac0193469f302235b40cf5469027ad41ac0e9884ef502235b40953bbfc05dd41ac0dd7fd22302235b4085469b105ed41ac0498dffc1fb225b404d2e86215bd41ac01766d223e9225b40cc736b9252d41ac00bd35c49e0225b40c4ecc7f04fd41ac0b0088baede225b40d34a2ba44dd41ac023640410db225b40901e9f7448d41ac0782ea4ddd7225b404d1789a840d41ac0a114b607d0225b400f97386e08d41ac029f11386cf225b400f2fbf4f2cd41ac08155d59ece225b4051ddfa506cd41ac0604d7881d3225b4005ef69d296d41ac014ef0655d3225b40d4e54fc01bd51ac060ff242ad3225b4049689e059cd51ac04fd9df98d9225b408c0d2e6ebad51ac0b47f1f8ad9225b405492c8d6e4d51ac008297f5ad9225b40c3a904c36dd61ac0e7b81121de225b40cfa2d41970d61ac037bacab0dd225b4016b6c1cd05d71ac05cbefb67d6225b40d0406938fdd61ac078052f1ed1225b4047c33bfdf6d61ac01d8a8a27d1225b4014fc00dee1d61ac0794a2afdcb225b404322fc11dad61ac03d4c7667cb225b4088ae0a76f1d61ac0e11ca51dc7225b40491fd74ee0d61ac01c1b59b3c7225b4053a05cf7afd61ac0e7904299b3225b401464523e62d61ac0c6b6de6fb4225b4091cf436d1bd61ac066940035b5225b4044e8b81ad9d51ac07e9306b7b5225b40c9742c47acd51ac0920d76c3b6225b404425c45c52d51ac087f3d077b7225b4012b6291e17d51ac032ed6dc6b8225b40cec8cbfacad41ac01a6887c0bd225b4088d674190bd41ac006629cd9bd225b4094f4293401d41ac09254e5ffbe225b40c75a72198dd31ac04f083a83b4225b40c71deacaa9d31ac0fa08db43b3225b4010f8e2eb68d31ac0060b85ddb7225b40554d655b4ad31ac0da618c18bb225b40ca1aae4a2cd31ac0324e3e9ebf225b408f5411d615d31ac08a0a7e19c1225b400a896c5c09d31ac01107e644c2225b400fbd2622fed21ac092c02eacc3225b40d0f689ade7d21ac0c13c4677c5225b400a96ddc1bfd21ac030e684f2c6225b400bcf9f8caad21ac07172a6a9c8225b40c8043d949bd21ac02dbf4071c9225b401536735795d21ac0a4ab9eb0ca225b404d38569991d21ac0c4471e9acf225b40c86acedd88d21ac078c09d90d2225b408a73fba578d21ac0e866b1ddd5225b400af735ae59d21ac07cd8795bd6225b40926b7c5634d21ac09c5f822dd8225b40d06be7fba9d11ac034fae356de225b40c56be7fba9d11ac06ff527eadf225b40c86be7fba9d11ac03ce3ecbadd225b404a4df73238d11ac030bdb731dc225b4088f29b31e8d01ac07872832dd8225b400f1724e53dd01ac0484a3c01d6225b4087326a93cacf1ac03a514fe5b5225b404adf405087d01ac093c1f7f2b3225b40919993aafccf1ac00a97eeaeb3225b400f0c54c6bfcf1ac0cba4d7edb3225b400cadd85f76cf1ac0925b65a6b4225b404714ab0661ce1ac01a884428b6225b4010c5353e93cd1ac0a21dfbe5b5225b40893865ef3ecd1ac04a4b80c5ba225b40ccff18603dcd1ac0f6c7f70cbb225b40d57f2a9e15cd1ac0f12db747be225b40cace60b611cd1ac096deaa60be225b4055d5aa3c05cd1ac02a708991c2225b40d5ca6fad03cd1ac05e1de5d5c3225b4014b698749bcb1ac0d6207daac2225b401381efc161cb1ac071670d7ec5225b404aa0d42952cb1ac0fa1f57f5c2225b40051de7e2e1ca1ac0921c910ec0225b40c7ccac3d9dc91ac04534d6dbbf225b40558b021287c91ac06e7db32bbf225b4047d2f2153ac91ac0ce480a16fb225b400cbe7baab7ca1ac09d4f52a0fc225b40ce169079c1ca1ac09d18fc7a01235b404c93456988cb1ac012c306f501235b408a0bd4f29bcb1ac0789ba4ed10235b40d501ab8001ce1ac07c4f91b811235b4051ffa4921bce1ac02571cf5612235b408abebd1218ce1ac090b2dea815235b4018dd904605ce1ac01fce6b9e23235b400ea1d28899cd1ac06755659126235b400deb17ec86cd1ac086fb28232e235b4050278aff57cd1ac001ec03c93b235b40ca15855d14cd1ac06c76251140235b4090395d4b05cd1ac009cc51b041235b40d004e7b9a9cc1ac08c8262bf42235b4007767c5d81cc1ac001cd824743235b40d327b2186dcc1ac0c98ebcc546235b4092bea69c69cb1ac04dd50f104a235b40516067f6b8ca1ac044d640a74b235b40c802e0cd8aca1ac0f8f335ad4f235b408e9227bd2dca1ac0b0e6761b53235b408e9a31deecc91ac00b0723a85a235b4054e00ebd8dc91ac0a7b98a655d235b409868b8a075c91ac0abf0c2c761235b400e662e0d4fc91ac0da09355a66235b40d7e7d9dc33c91ac07858c0b185235b400c2deb7a79c81ac04b6ebe8191235b40cf88358a1cc81ac0f0eb60f095235b405c9cb5abf5c71ac01ac95ce4a1235b40d0b348d88cc71ac0d127b031b6235b40948ff2afbfc61ac0b4af91bac3235b4010f5f3b54dc61ac07cd9b9d6cf235b40143ebbd4ecc51ac092bf2771de235b404e6764a958c51ac0029ec47be9235b404d7f66b2adc41ac00d4b6893ef235b40962de1d05bc41ac095f84b63f2235b4052e6970536c41ac0c98dc0b3f8235b401a511d1febc31ac0);
+
+INSERT IGNORE INTO `kecamatan` (`id`, `kecamatan`, `area`) VALUES
+(3, 'Kesambi', 0x00000000010600000001000000010300000001000000b90200002be8e44526235b4088dfac19fdd81ac0d2d7e1e82a235b4052849675ffd81ac0067dd7a02f235b40c52b0b26fed81ac0acd0f57d38235b4046a6203f1bd91ac03c22853941235b401034d42824d91ac00da171e140235b40529b1727beda1ac0cd8fa0aa42235b40536feb6e9eda1ac00f5a367348235b40088a5242b0da1ac02330fd4b52235b408bf6612cefda1ac04341deab56235b400c6662ba10db1ac05f4f621058235b404c09d9791bdb1ac09e9e9e7763235b400958350873db1ac0c2f68ea163235b4050b0c05774db1ac059d494eb6d235b40daebd808c4db1ac051f1a65b76235b40867859130bdc1ac0d08887307e235b404cde516342dc1ac0e009abb184235b400b93331477dc1ac03c21628385235b40cc8493347fdc1ac04755807687235b408d76f35487dc1ac00fc1a7eb89235b40ca7d164b91dc1ac02f6bde718a235b40915011a793dc1ac0a7aabef38b235b400e2daef199dc1ac0ef13bc218d235b400ad2a3a99edc1ac0efab27f38f235b408e8147aca9dc1ac0c6eb76f695235b40912bd505bcdc1ac0c2dcdc989e235b404f6b51f7e5dc1ac06d3b22dfa5235b40d59f134207dd1ac0716e8221ab235b40d8fd428f18dd1ac05e7a7764ac235b40d2b7efc01cdd1ac07d06c24eb1235b400f7135b22bdd1ac06d97cc60b5235b4096aad80c2edd1ac051bd2363b5235b40cd43300e2edd1ac0f94b886bb5235b40556d431d3add1ac0b58294d8b5235b40cb3f4b57b0dd1ac0fc1fdc9db5235b408e25fdbd14de1ac03095fab2b4235b4018404aecdade1ac0cc893824b5235b401a96c9703cdf1ac0f0d54334ba235b408d8900a777e11ac03f9f0b23bd235b40ca2f28d368e21ac07892f0bdbf235b40d0f3cef90de31ac0f8625cf8c1235b40892cf6067be31ac01b77c6f7c5235b40098069c03ce41ac09b7ddbf8c5235b4091d4fae33ce41ac06705745fce235b40d689709351e51ac0a78c6d52d1235b40503987c3b6e51ac06ad013cfd9235b4052769e78cee61ac0e2433ee8d9235b400a8c614ed0e61ac01e9039cfd8235b40922ada1ce7e61ac0224d7155d9235b40d047c0e8f2e61ac05995f947df235b40178b14cac2e71ac00ded5e75e4235b40d8b80b2577e81ac07d8b4b07eb235b40110429096de91ac0442d499eeb235b4091f07edc7ee91ac0f4e4a430ef235b4095e97420ebe91ac0ec4c1de4f5235b404b5df3aaceea1ac09861e63bf8235b4051d954dd23eb1ac057246937fa235b4015beba2a50eb1ac0d402857afa235b40d6298a5759eb1ac0cb3a268bfb235b405714c78157eb1ac05fc3887d02245b4057ea7ea642ec1ac0a7ffef880a245b40cc571af851ed1ac09a2b543717245b401ddd76a1b9ee1ac096ece2361a245b408a7abc900eef1ac036b05fb01b245b409d06978e39ef1ac0817341041c245b408d5ca3e540ef1ac032bfdd921c245b409d36c1374def1ac0f589fe571d245b408fd7b8fe5def1ac041e706431d245b400d5bcaf962ef1ac0f5a3a3a31c245b40d56fc03f89ef1ac0ea07f1811d245b40584a1e4fcbef1ac0210374e61e245b4051d23a1938f01ac02120a2d11d245b4017ae0ad462f01ac06277c4211b245b40cf1cff0582f01ac01d9b27d714245b4092eba17dacf01ac00eabbbb20b245b40110ad462f0f01ac08277fc1708245b4012a7734529f11ac09e215f4205245b400f5b499a5bf11ac0975d7347ff235b40cac9bc55d7f11ac0278627f4fa235b408f05d50627f21ac0979530d3f6235b400ff734272ff21ac02435f790f0235b401c79c7293af21ac0acc996e5eb235b40cd19bff04af21ac0097d2c7de8235b408c56b08d78f21ac09dbf8575e3235b40d3c3cbd3b9f21ac0dded8497e0235b409ab3acfbc7f21ac011c01072de235b409bb52bf4c1f21ac041929c4cdc235b409ad8e89c9ff21ac0622d8194d8235b4018253acb2cf21ac0527075b2d4235b40dbc159a297f11ac0ae06dd5ed2235b40d9b6054b75f11ac00eabf413ce235b40d77ababa63f11ac0bf476100c9235b40d4353c556ff11ac08a73deffc7235b400a8c7f9f71f11ac06bfedb65bf235b40d5ad09698df11ac05737936fb6235b4050eff8f6aef11ac0948eb5bfb3235b4051304278b4f11ac0d49f86f6b1235b408b81aad1abf11ac0110653cdac235b40974c0f0a4af11ac045161579ab235b40d2ddd5b73bf11ac030b33e97a9235b4056bfc16f27f11ac084208315a7235b409120e3512af11ac0ed58af22a3235b404e510dfb3df11ac0a9a535069d235b40599fc37c79f11ac0979b249694235b404e683524eef11ac08a4e4bac8c235b4049e4965643f21ac0d77c4ace89235b405644459c4ef21ac0ffbac15087235b4049982cee3ff21ac04f6bce4884235b400ce9fa961df21ac09fd0a0a17f235b400aa25bafe9f11ac0d0bcd1c77c235b404d72d712f2f11ac0301071fa7a235b40d870fe2614f21ac03cc1b33d7a235b405aecb94c4df21ac05412001c7b235b4051743c66a0f21ac05da614747b235b400f3d6e50dff21ac0ac25607479235b405299c4b112f31ac0fc535f9676235b40c9b6508a3af31ac0d9eddade6e235b4092b7904770f31ac0ad5e33f966235b4095c67c40a0f31ac0420d941458235b40d9241fbb0bf41ac09312700855235b408e3365a71ff41ac06783c8224d235b404e9061156ff41ac05c33669945235b404b3ec6dcb5f41ac0e8a16b5f40235b408e05b98b30f51ac0443b5cab3d235b401404e09f52f51ac094695bcd3a235b404af3c0c760f51ac0f13696b036235b404ef4cc2363f51ac02db7db2e34235b4085688cd651f51ac035cd290131235b40ce542fbfd3f41ac05c0ba1832e235b40d419bd1aa0f41ac0b20504a32d235b40ce8929059bf41ac0b6bbad7b2b235b40ccdd718a8ef41ac08e14fa9129235b4009fc74a191f41ac0ee81441328235b404f1ebb0b94f41ac07aedc03923235b408d9bf529c7f41ac0071acc5f21235b408ed44e25e7f41ac08b0e62191b235b400d7e4fac53f51ac033ce87191a235b408f284fb14ef51ac0df954be317235b400885d8f143f51ac05b2bb91116235b400c1ea16648f51ac037a64e4013235b400fbef2203df51ac0df1bed9512235b409662aeac37f51ac0073c579412235b40087da99f37f51ac07c40390a10235b40481b7c6132f51ac019751af0f9225b401121533e04f51ac0e90a2344f9225b408cf6d8ee02f51ac0f584bef4f6225b40c85cf8c1f9f41ac0354b29e8f6225b40c7193048faf41ac01554c12ff5225b404a4ffeee1df51ac0ae719692e5225b4054197cf294f51ac04b7c4e7adf225b40450e9bc8ccf51ac04792c8f0d8225b404a2d73ba2cf61ac097c865dcd4225b4008f63e5585f61ac048e1a128d0225b40123f439067f71ac014a89718cb225b40d25a69aa0bf81ac0b008ddacc1225b40c823a872bef81ac075673e6ebf225b40c50b0055dcf81ac05dff0188bb225b400cf96184f0f81ac012dd1324b6225b409020904b1cf91ac07e632f14b0225b404d2fd63730f91ac08e0a3543aa225b4010a547533df91ac022246b0da5225b40d18dab915df91ac0d35aa31ea2225b40d1e45df580f91ac02412acaa97225b40ca5c6743fef91ac03c7a5c548b225b4008b167cf65fa1ac08d2d1e9084225b408dd570917bfa1ac0f18be42b81225b400a081a3389fa1ac0cdf1e9387e225b4088d118ada3fa1ac00d9edb847b225b4044108942cbfa1ac0d5852a357b225b4044d762b7cffa1ac00181d93d79225b40c711d55b03fb1ac0a569226c78225b40d01a446b45fb1ac0c5d0907879225b40118c2ac3b8fb1ac0b1ba6e4a79225b408bf514a8e1fb1ac019b4295778225b4092bda08504fc1ac066e2287975225b400aff8f1326fc1ac0ce4378b471225b40d56a5f402ffc1ac06e43dd8e70225b40c50832022afc1ac0f61dcefc6a225b40c554f65d11fc1ac0137eb43863225b400a5de6e3f6fb1ac03c9e5b9a5b225b40ccda1324b6fb1ac0406331ea5a225b4003351210affb1ac045bd318b50225b40c7009082c3fb1ac0f0ef3ec747225b40c593f3c5defb1ac02e4cbe6037225b40c3ff9b6f28fc1ac0fac099982e225b4082a2acdf4cfc1ac0f8890e8123225b400f90745e47fc1ac06f91799620225b40cd71dc291dfc1ac0c027e1421e225b404ddc5f3deefb1ac0b4bf78b81d225b408423b37a87fb1ac054bfdd921c225b40914fd25433fb1ac0282cc3b81b225b4085c4c4e6e3fa1ac0a9de5ed218225b40ff99cb0d86fa1ac081389b4011225b40036fd23428fa1ac05903d88008225b400677280af4f91ac0d69777d503225b40c8a9d1ab01fa1ac09a107ee200225b404f270cca34fa1ac05b8984effd215b4082d3fdf483fa1ac0ca165454fd215b40425df3aacefa1ac0be4b6cc5fe215b40c6b9490c02fb1ac046bdb20b06225b404d7919c572fb1ac045bdb20b06225b4088f2bb4198fb1ac0fe53b5dd04225b40d07a65deaafb1ac0d242d47d00225b4049ac68739cfb1ac05b59ad4cf8215b40885b001aa5fb1ac0b726e82ff4215b40907e30f0dcfb1ac053b2ef3cf1215b4089b2cbb73efc1ac063ba8d06f0215b40088d0244c1fc1ac0e3a3d0b2ee215b40c39e53909ffd1ac0648ab663ea215b408a8e34b8adfd1ac0bc1cf33ae2215b408e533541d4fd1ac000a5f2fdd4215b40053e4be812fe1ac03d3f096cce215b40c65acb6438fe1ac0bfc45682c5215b407e06b13385fe1ac0a38b10a5bd215b40c1119f3bc1fe1ac017a2f99cbb215b4081871cb3d0fe1ac074a2ea55b9215b408b7a16b9e4fe1ac0fbe3ca71b8215b4006112169c8fe1ac09ce6552eb8215b40c246fe86a7fe1ac0cb992d96b8215b404548a30c99fe1ac0a7dab2e9b9215b407e08015985fe1ac00b43dccdba215b4008bf526078fe1ac0cb9f8081bb215b40ce2e4fa56efe1ac03e633811bc215b4087e9a12a64fe1ac01ff0fa88bc215b40c95c64f352fe1ac0276ccae8bc215b408f4b713e3dfe1ac0c21c8178bd215b408bcc64862dfe1ac043e03808be215b408134d91117fe1ac0ab683214be215b40c9798ada05fe1ac0ef443efcbd215b40c2e1fe65effd1ac0ca9850d8bd215b400757a470dafd1ac013b87b84bd215b4043d00fffbdfd1ac0e38fbe00bd215b40bf7d3105b2fd1ac047c83d30bc215b404aede7e4aafd1ac05f9a867eb9215b40c43967cca3fd1ac00c8cfaa3b6215b40ce32df1297fd1ac0c4efce00b5215b407feb4e5690fd1ac0ac241316b3215b408a13397c86fd1ac07be14226b2215b40071f091c85fd1ac05b34246bb0215b404a23cf9f7dfd1ac0dfb38b9faf215b4006ac465396fd1ac0ed15762bad215b4048d923347ffd1ac0653a237dac215b40c9e78c207cfd1ac074f953e3a5215b407c398a1362fd1ac07d04df2da3215b40c13ff1384cfd1ac092cfcb759b215b404ed49b1e02fd1ac021d85c6b98215b408de7d8d488fd1ac042841fe994215b40806d9bdc01fe1ac0e2112fdb90215b4009133977cdfd1ac0b22286418c215b403de96b1494fd1ac04a9550208a215b405071fdeb77fd1ac0de79e88689215b40cbc2c37454fd1ac0c79cf37289215b400366dd0c25fd1ac00ffd2ffb88215b40c1d5dd9ffffc1ac04ffb464e88215b40cb6706b9d7fc1ac05fd0a90b88215b40bdc14f2b6bfc1ac0b733b22c84215b407eaca2196dfc1ac0b733b22c84215b4045173c6850fc1ac07ba7907582215b404c1ca3ac47fc1ac0c75af6ad81215b40c5169ba751fc1ac03755b43f7e215b400c5423f634fc1ac0d4cb899c7c215b4086be1b8419fc1ac05cdf2b5d7b215b40caf63c8e05fc1ac03c17c74474215b40452e1c17f4fb1ac01c1a6b3671215b403dd633d0f1fb1ac0e9950d1071215b4082fce9e3fafb1ac0f9a3fbc56e215b407e912c1b2efc1ac06d9d89776d215b400509405f57fc1ac0c165e9b56c215b40829c884b7cfc1ac0dd1ff9116c215b400161f53da6fc1ac0895dd1466b215b403e95a9e4c4fc1ac079d508e968215b408804fab6f3fc1ac0cd5c1eea65215b403a8bd3012afd1ac02aa34e4663215b40063e8a4c56fd1ac07693750861215b40427956906efd1ac0fe38894160215b40fd08d7a370fd1ac05a1803e45e215b4089a1b05b69fd1ac0b2d174eb5f215b4002b784f026fd1ac0667269fa61215b40c4590116ddfc1ac0f12482b865215b408ad5d14d6ffc1ac0f5bb2dfe67215b40c5f6e83d30fc1ac0e126e4e668215b40fed1917f0dfc1ac0b4e6bb3669215b40839b38acb5fb1ac0e1cb59f669215b40858683c18bfb1ac071efb45b69215b404a85cbc288fb1ac009b7a0346a215b4081d849084cfb1ac0c81345e86a215b404841001533fb1ac04100a3276c215b4042e6fceefffa1ac0f5f0e5b567215b400355bb00ddfa1ac0519ba7a066215b40bee3657d65fa1ac0b54d8a6968215b40058760bce9f91ac0a0dcc4ad68215b408cc15926d0f91ac0759a243169215b40839472e29ef91ac02def62c569215b4045600e3e71f91ac0154336d069215b400b57c4e86df91ac009b7a0346a215b404ca4080b4ff91ac05981400267215b400aa1e34755f91ac026da162e63215b407d2fc6394af91ac096ff830b63215b40075e72342bf91ac0860c191663215b40ca898fc11df91ac0ea48fa0d63215b407f4a5db30ef91ac0061cd14063215b4042cbef138bf81ac081ac3a045e215b404d79e7435cf81ac05f8b92445a215b4083a89e5531f81ac0a3148d6257215b408c4be330fbf71ac05635ecd455215b40c28568eecdf71ac0ff1c757754215b4041a9ebd485f71ac08f9e6d1554215b40bf409e9769f71ac0b66d38a653215b40fe6f312f55f71ac0671570f852215b40c1836c303ff71ac0c325ddcc51215b4047340ddb2df71ac0929cae994f215b40c907a0921ff71ac0c8c57a8248215b407a545dd1f7f61ac07b23b9fa46215b40bffe57851cf71ac065c57fea3a215b40fe78b623a9f61ac0a43577f23e215b40c718edcd7cf61ac07190cdc83f215b40482ea6c46ef61ac0ad20c79f40215b4082278ee558f61ac0dd29400541215b404bc726d34af61ac0342e31ed40215b407c51b9473ef61ac02d91a5a240215b40bf0725a335f61ac02c3b173f40215b40bcb7f6332cf61ac03d2fbe1a40215b4047dd1b851cf61ac071a6875a40215b40c30546380ff61ac0c57262fe40215b404d75e91505f61ac08ca4ff8a41215b408bed1326ddf51ac06482740a42215b400b79d82fcbf51ac07c17b2ae42215b408922f459bcf51ac02b1dcc0d43215b40c5ad0660b7f51ac078ad6c5243215b40c5d4c4c8b3f51ac0a0db6e7344215b4089f1e4d2acf51ac07b0861ba45215b40bc5d3bf5a1f51ac0efe7d3c846215b407eab5a588bf51ac0858208de41215b40be3feea684f51ac0f511e02740215b403b032b1c7bf51ac03ce6277f3e215b4043d83d9a66f51ac05017f9a43c215b40c1c9bf7549f51ac0a9997d213b215b40c1ab70aa39f51ac0a817f81839215b4005f812092af51ac09890af5137215b40ca40255d1cf51ac0d92af21c38215b403e5a654dfcf41ac0d56be33436215b4001ce409ceff41ac0f1292a4636215b40863f22451bf41ac0c1127c7b33215b407c39d77919f41ac01d5fd26733215b40028be1ddd6f31ac00e225d4633215b400a6e2b2ad3f31ac0b91cacd232215b403d994f5dc6f31ac059d03c4932215b400387650dc0f31ac07527c19a31215b4001b42aadb2f31ac0a573c3a831215b403e1db5a8a0f31ac036b2329e31215b40bfea755d88f31ac0e50a0cc931215b407ecb5d2048f31ac0122295f031215b40c532cda130f31ac0dee506b331215b40c6622d8222f31ac0eac4887531215b407ec3e59913f31ac06127130631215b403d77d35302f31ac055831fd530215b40bfb89e6cf3f21ac0c116f02630215b408445bc77fff21ac00dda5f4b2f215b407945bc77fff21ac0aa5035a82d215b403e7a17fef2f21ac0deeab6b12a215b4048aed1c3e7f21ac096ddf71f2e215b4039b47a89dcf21ac0e98bfd7c33215b404cfa00bbd2f21ac0b619addb34215b4041d3a453d8f21ac009c352a435215b407988f363d8f21ac0855c413b36215b40cb0b1df4d2f21ac0b117be9336215b403e31c15ccaf21ac0a00e44303d215b4008828362d0f21ac02c74d4713f215b403d51f3f2c7f21ac0d4b2bec345215b408df9b71cc6f21ac064c3ea1148215b4047da92e5bcf21ac0532b94b548215b403dffe81cb5f21ac0ab4f1d1b49215b40c3fdea41a6f21ac0103b66cc49215b400452532891f21ac0f09fb2074b215b40c40e5a517bf21ac0f01a927c4d215b407b844acb64f21ac02b24f8da51215b403b0ed7f048f21ac0d799d0db5a215b40bd3866cc69f21ac082bcbd6c67215b4088a75d79b4f21ac0755ba5e269215b408964f4b5c4f21ac0150aa5d46a215b4047673dbeb5f21ac04186a3026c215b40bdcf404da9f21ac0913817086d215b400c7cd6dca1f21ac035e65c716e215b4083c52eb9a0f21ac001f476c66f215b40830ee6d4a0f21ac0998b4e9972215b40883bd8ca9af21ac04d9be20274215b40feef60e495f21ac0486f2be675215b4084417cbb80f21ac064c5d6a177215b400ce9a94a65f21ac034ea559f76215b408c8ae25f48f21ac0ccfb45a176215b40018cf88d30f21ac0f095c38276215b40c1cdfce11cf21ac028f24cfb75215b40c826e1cd10f21ac08438e1cb75215b408c1a29c307f21ac05c3ee52476215b4002ea94c3fef11ac04cf811ae76215b400328e5c7f5f11ac0049bb75777215b40459b694be8f11ac004ad53d177215b40c05ae649d9f11ac0cca8e32278215b40474a6844c9f11ac0e434e9a578215b4004f7bc36a9f11ac0809aaf4979215b400766af2581f11ac0e4b9f74d7a215b403adcd1bd56f11ac0b05da6127d215b408cf6a1fb07f11ac0cc471bbc79215b4002b76829a2f01ac024c04ad179215b403ed5b06094f01ac06ca413237a215b40055d259981f01ac0146ceb3d7b215b408a860cde69f01ac02427f4867d215b4041a6113043f01ac03807b2c280215b408f7ad6c304f01ac05773a60482215b40c9566095f9ef1ac09726970983215b40ffdc9969f8ef1ac00b229f6285215b40478ee3e203f01ac0b3e07c9c89215b40ce6ad64918f01ac056ef2e698f215b403c6a36031af01ac042faa45f97215b40c9b1d6855cef1ac0720d82c49f215b404a1f91a20def1ac0ea4f1089a3215b4009683613fcee1ac095858bc6ae215b408e28777ed5ee1ac0b7771db9b4215b4001cde510b5ee1ac0bbecb723ba215b408a194b2d9bee1ac0282315adb9215b40bf59488385ee1ac0ef534419b9215b400d325b8e64ee1ac0efa042edb8215b40cb29247056ee1ac0c86e54eeb8215b400108454649ee1ac09463d3a3b9215b404750660c3eee1ac0af35c53aba215b40c6155d7438ee1ac0d020cfefba215b401055bede32ee1ac0ec5996e0bb215b4088ac45f232ee1ac0cc1610a4bc215b40bf527be334ee1ac0ef9d94d0bd215b40c29417a03aee1ac0cc5a0e94be215b4002695e913cee1ac08b22e466bf215b40c2e50ac13aee1ac043144585c0215b4087fcf13335ee1ac06ae8c785c1215b40830746d22cee1ac03e561cf0c1215b40fdeea4a120ee1ac063424ff1c2215b407d9ea1c90fee1ac07bb73da7c4215b407c50e01efded1ac09bd2997cbf215b4044c8cfc72eed1ac0c0e33451bf215b40ca66e82319ed1ac0f828e815c0215b404ab8df090ced1ac0d0fd11dac0215b408203be8405ed1ac03f499a7fc1215b40c7fc299205ed1ac07f5cf8f7c2215b40464431cf03ed1ac01a0c6007c4215b408eed2a50fdec1ac0e61152eac4215b40fe842d57eeec1ac06f477c27c6215b4003ba2756e0ec1ac0a22e03bfc7215b40055aba6bd1ec1ac0afa6e764c9215b40c1bfeaf8caec1ac09bab0addca215b40d06b0908ccec1ac0fa692c09cc215b40c5732d78d6ec1ac032ca1e71cd215b40d162bfa0e5ec1ac08ec1dfe6cf215b404517110902ed1ac06daacca9d1215b400b188bc208ed1ac0da5626b8d5215b40c94a901609ed1ac08e370ea6d5215b40cb3e99b12eed1ac046a70310d9215b404b259bdd20ed1ac01d91c566e2215b408f3c70dafbec1ac0644048e9ee215b404fe888e1c2ec1ac0af57b775f5215b4050e1a433a7ec1ac0ef6e2602fc215b400a08d2858bec1ac03a792cbb02225b40429e033d67ec1ac0f1f050510b225b408988c3de33ec1ac056e071b50e225b4087bad01019ec1ac009d2ffc113225b4041dd6379efeb1ac045a2ffa415225b408e1ad8c2ddeb1ac0718f36e217225b408b235504cdeb1ac0c8b9bac41a225b40825c4425bfeb1ac09dc0469220225b40d1b10c71aceb1ac03c45251d21225b40c1f96327aaeb1ac05c3f95f42c225b40028f473678eb1ac0a33f2e6038225b40cd90106b45eb1ac011fd26f943225b40cd883fd10feb1ac082d394d749225b404b5faf27efea1ac0121194d144225b4008592a3f0eea1ac0514028ff45225b400b1dc5d106ea1ac03aba432d47225b408db967cff8e91ac0c24cb61f48225b400c3b3d33e4e91ac041e7cd0249225b408e42be58d3e91ac0350f64034a225b40caee4806cae91ac05146df5e4b225b400bdd7335b9e91ac07071db614d225b400d879aff8fe91ac06e58917648225b400a2c476acfe81ac09283d2ba47225b40c9d11cb4b2e81ac06e27331641225b40547b4abca6e71ac0c9faac2640225b40ced774586fe71ac0066219583d225b408dc864e555e71ac01edc86893a225b40ff6fe17339e71ac01635749d3a225b40ce106fa238e71ac0e6e1ac1444225b40cb3fd226d5e61ac0352d59a14a225b4009d83e429fe61ac0688796174e225b404a4de44c8ae61ac06188c7ae4f225b408b2657b8b2e61ac0f584027f50225b40c15779b8d3e61ac0b9d9724d52225b4054e94c021de71ac049072ad454225b4004eb4a4f81e71ac07d908a4158225b40c267ef89fee71ac0988de04c5a225b404e34021ff3e71ac0703e3b715d225b4087ce286fdde71ac038911fb55f225b408ce74901b8e71ac05c84444562225b40444e1c1086e71ac070a3283d64225b4084c342036ae71ac0ef077d9866225b4082f8d5f658e71ac007b82c3e69225b4050fe9ee852e71ac02b8d12106d225b4008dbd35956e71ac07f33801371225b40929ee0a461e71ac0f612ba5373225b400c8f44a969e71ac00ebbfcb274225b409206583468e71ac03a504b3c78225b404feca9b64ae71ac01e6edd8976225b40532b6db0f1e61ac0a236275579225b4013cdaacfd5e61ac0d29f59bc7f225b40432f8032bee61ac091ad948c86225b400319a08d8ce61ac024ce685391225b405076f5e35ce61ac02d55742497225b404265ef2c44e61ac0a0feb29f98225b400b50684f85e61ac04ca708ef99225b4007b06f2ccae61ac0c0f4ef3096225b4093706729d0e61ac03cafc6e996225b40cfb3d9f35de71ac0d39acd839e225b408c0eb52643e71ac04813eeb99f225b40942aefd83fe71ac08f6fb572a2225b40d385522acae71ac062f953e3a5225b4009604e08d1e71ac02f86ce15ac225b4046a2931ce5e71ac0160c61e4ae225b408c27499ae9e71ac02ed50150b2225b40157f44e3eee71ac015823132b3225b4045a83894f5e71ac00208c400b6225b4005cb1efe20e81ac0fd009457b8225b4048f7500a09e81ac0e5862626bb225b40c9addd0b06e81ac02de03205bd225b40898918aec2e71ac076b4ddacbd225b408eefad4594e71ac08a82873cbe225b40cf37d83335e71ac05914799fbe225b40d24bb63d1ee71ac0b9831e28bf225b4056ec453d0fe71ac0f9c51acebf225b4089924fa609e71ac025ffe1bec0225b40c58db4b909e71ac024d4c945c1225b4054d98b5910e71ac0bd1ea890c1225b4094cf1d1315e71ac0410d9136c2225b4043a6df6c10e71ac0148dc0ebc2225b400756bff508e71ac078dc67cdc3225b40931796f809e71ac0f1f2a19fc4225b400f62bfad0fe71ac034f86664c8225b40554b904bfbe61ac0306e31afcb225b40d2c59f9efae61ac03cac1b18ce225b40c5b4dec0fbe61ac0ac498035cf225b408e60ca6c02e71ac00cb8c882d1225b40ca661b8713e71ac000c15a5ad2225b40d368fa7a2be71ac0e8224909d4225b40c39ffe7b3ce71ac0ac628000d6225b40873cc86e43e71ac084f0730fd8225b4092853b6d46e71ac0f31043d1da225b404a34ef3845e71ac0b37f39dedd225b40478feb883ee71ac00b261c82dc225b40d5b55d7a91e71ac0a30e796edb225b400da747a1cce71ac07b7bc878da225b4009730cac09e81ac05f3f4cb1d9225b40caa6809039e81ac0170df012d9225b4004b642c070e81ac01f89bf72d9225b40484500ac98e81ac08ff94d52da225b404aa6ac97c0e81ac00f627bf1db225b4048c2487bf8e81ac0871cf5efde225b40c172aa4f4ee91ac067fd11afe0225b40c6875f3a78e91ac03e01d60be7225b408ad3563b76e91ac03609374ce6225b400e25d45a38e91ac0ce90472ce6225b408ece3779fce81ac03e01d60be7225b40143e19b38ae81ac03ea04c09ec225b40857d21b684e81ac0ae10dbe8ec225b408f5c6610d3e71ac0e64ea16ae9225b40c7157714cbe71ac046999827ea225b40111877b3a1e71ac0a2d144d8f0225b408759d52aabe71ac0b2af51e6f1225b408dc0fd5a40e71ac0628a2728f6225b40d37e12164ae71ac04583af13f7225b400656eac1f3e61ac0716050e1f3225b401356eac1f3e61ac0251397b6f4225b405362f4a498e61ac0999948e8f5225b408dac54db43e61ac011c7b703f7225b40881c3615d2e51ac0de809041fb225b40d5282513d6e51ac0365c6b92f9225b4093a74cd64de61ac0912fe5a2f8225b400b9c1df294e61ac0f19aa507ff225b408d97b6ad9de61ac0d55971d0fc225b40099c15814ce71ac06403647603235b400dbc08044ee71ac0d0277d1a0a235b4011043cc953e71ac0e33689f310235b4011bbc68250e71ac06733f11e12235b4087ef210944e71ac09716d84013235b4059e04c9034e71ac0f3dfe34c13235b404ed6bfeb33e71ac03389652915235b40535979d629e71ac06be2710817235b40105d3f5a22e71ac0b33b7ee718235b40155d3f5a22e71ac0c60139661b235b400e5b5c1826e71ac00b5b45451d235b40065cfdd824e71ac0ee23f7d71f235b4093fbf1adfbe61ac02ec752241f235b40c6387afcdee61ac03be766fc1e235b404b9fac06cbe61ac03be766fc1e235b4088d68b8fb9e61ac057077bd41e235b405010ef1aa3e61ac09357ad701e235b404e7863a68ce61ac09357ad701e235b4005b5ebf46fe61ac04af770e81e235b40c71d014158e61ac06e04098a1f235b40521aa61647e61ac0caa0af6320235b404ebfd8572be61ac0073ddb0622235b404bc39edb23e61ac0ba8975ce22235b4043fb1e2511e61ac003eab15622235b40cc6476f2f6e51ac0dbc0c33b20235b409307efc9c8e51ac0337d03b721235b4007f3bf9435e51ac01b641f881f235b40da2c23201fe51ac08eb106ca1b235b4045c9f231fce41ac03ef5c64e1a235b4008cbd573f8e41ac0dc6b9cab18235b4089ca34b3f9e41ac08010008716235b408b794f010ae51ac00b72e20416235b40d4d4073406e51ac08363f7fc14235b40d562b503f2e41ac08bffcf5213235b4007643208ebe41ac0eb37f2c714235b400daa75f455e41ac09355018f19235b4089495c5563e41ac00ccbfabe19235b40580091bd5fe41ac032cbfe791a235b40ce2f0dc347e41ac0d72eb6111b235b404a24a87c30e41ac0a768b9bd1b235b40471a038a0fe41ac00216ba271c235b404ced0acef7e31ac01f67d9aa1c235b40554c1e72dae31ac0f7d10d361d235b4009641347bbe31ac0db2edbb41d235b40967ac8e29ee31ac06ee4564b1e235b408fb636317de31ac01f6f5f8e1e235b4050c3b12e6ee31ac09204061e1f235b40d71ae16651e31ac0be83f7cf1f235b40879d57c02de31ac00ee22a4f20235b4047a25a4414e31ac0d7ad482921235b40c6fa8f00f9e21ac082f8e7a221235b408dd3b7cce9e21ac086b51f2922235b40cc88cc5ce0e21ac047b55cf41d235b40cf38930bd5e21ac093629b781a235b400c2e2aaccbe21ac0a394b63f17235b40942f9b00c3e21ac0e452f3bd15235b40942d8aeebee21ac0e7a6979211235b40497557abb3e21ac0570de9f010235b4085b087f6b1e21ac0ab0bf2f50d235b4053535248aee21ac0a4eba3980b235b4089c3cb5cabe21ac06873d7400a235b4053bb32b4a9e21ac034eac08608235b40c67e4492a7e21ac02051535408235b408893fd53a7e21ac0b1035de7fe225b404e5b6d658ce21ac03939173dff225b40913a063677e21ac0b57a3d51ff225b40cf548f3b72e21ac04ebc0fb2ff225b40112e8bb44de21ac04d27be4fff225b408696a4d70be21ac04dcebec5ff225b4092830be1e7e11ac0c9aa93bffd225b40d86ae275e1e11ac0cd9a3768fe225b40870721e8b4e11ac0813486e300235b404b8e5a33c2e11ac098f9997303235b40139976b04ce11ac0505632b405235b4053658a015ee11ac0e1b74d9307235b40110b4ace12e11ac0d5b1869806235b40c79a4d120ee11ac06d7275260b235b40cc5c59ca7be01ac09419ac910d235b40175044d59be01ac0f0e482b217235b4095ddd7d4bbe01ac0eb04bf991a235b40c74eea00c5e01ac02b4b18291b235b4018bbdb1d9be01ac04e78be9f1a235b4095cee1ea98e01ac0004762821a235b404681ab3c81e01ac0241c479d1a235b40cb5bd8c32ce01ac05a25ba921d235b409224ed132ee01ac037f825921d235b4007eab5433ddf1ac0b33dfe911d235b40d12cafa8fdde1ac05abdad311e235b404a8ea7e507de1ac0075422331e235b40d1ede9a3b9dd1ac0c2a6c10f1c235b400e2a0a2b7ddd1ac07644d6341e235b404b6bcaea5ddd1ac0afe1326f21235b4090c6b20529dd1ac09fe3949d24235b40905555e6e8dc1ac087fc306125235b40901793b2d0dc1ac0da3ca17c26235b40113e2047a8dc1ac0fed884b426235b40cc65eb0e99dc1ac04f416ae826235b40d5cb80a66adc1ac0178572d826235b400cbd11f44fdc1ac08be4c53426235b40085d0a170bdc1ac0cac8325d25235b400fa47fc8a9db1ac037bcc04524235b40095dd4003bdb1ac05b2ffecd23235b40568727190bdb1ac0d61a2b7623235b40ccd6c1f6d0da1ac0da9e5b1623235b4006929a315dda1ac01f7b67fe22235b408d4a6d023cda1ac03ebb8fae22235b40950bebb4d8d91ac036eea48622235b4053262a0cabd91ac02a62cb3a22235b40d83702b658d91ac007c6e70222235b40d494cd1220d91ac0ffa511d821235b40c903ee5af1d81ac0bbf6f3a622235b40d6cb5ce0f2d81ac05329e56723235b40ca73ddecf3d81ac03a0db1fa23235b40d4bf3bf9f4d81ac02be8e44526235b4088dfac19fdd81ac0),
+(4, 'Lemahwungkuk', 0x00000000010600000001000000010300000001000000cf02000008a8b527b0245b40d5c1fc96b2d21ac0fc378453b4245b4093419a0c22d31ac02c3b101db8245b4014d8a47077d31ac0f8ba14bfb8245b401107c8d4b3d31ac08b23104ac0245b40219fb16e3dd41ac03ed45989c0245b4013d2c2954ed41ac0f2c4fff9c0245b409981433156d41ac0638cc4ffc1245b401033cfa34cd41ac0f3eba783c4245b40122016c535d41ac0dfb5eb57c7245b4094ec906b1dd41ac0bfef04cac8245b4098c2020012d41ac0737a4a00cd245b40e19708baeed31ac0726c46f0d0245b405630a5dbb2d31ac03ef353e4d6245b405ac19b8e58d31ac009e58a71dd245b40968b1dc7f5d21ac025febf1ae2245b4019ae29f1b1d21ac06151d187e8245b40d14c73a74fd21ac051f0d1d7e7245b404f48664a46d21ac025ebeb1be8245b405f940e7441d21ac0c96d3ad2e8245b409a000a624bd21ac0b0cfed3af8245b40139c454561d11ac087f7ada6f8245b40e06ad7295cd11ac043c9690cf9245b405125a6b763d11ac047af25faf8245b40d3bf395866d11ac0839aab93f8245b409c7d923168d11ac0dc09b103f6245b40a034bb798cd11ac08467967ff3245b40daea68d4b2d11ac050b4b113f1245b4062adb27fd7d11ac018b7b31cee245b40529c556604d21ac0cc8ad3b8ea245b40d8a88c0938d21ac021aebe30e7245b405002c2ca6cd21ac065919b26e3245b4097e2df97a9d21ac078e6a135e0245b405c84f37ed6d21ac0b4696399dd245b40521c4098fdd21ac0124c85aeda245b40d04eee5e29d31ac06e750c7bd7245b40cf6277d359d31ac0be4965aed4245b4051345e1b84d31ac0eeb5914dd1245b405844512eb7d31ac0ddc665cbcd245b4019f1ec40edd31ac023a56543cd245b40ddcaa45bf4d31ac0aed4a6dccc245b40d2198d97f9d31ac0b3b81772ca245b40d0ede3550dd41ac0af31ef66c6245b4093f482ef30d41ac053ca9140c2245b4058f61e0856d41ac0fc594ec6be245b4018f5013974d41ac017bfcf1cbd245b40d613486282d41ac0d895e794bc245b404e95db4986d41ac0b5fbcadcac245b401ed2810560d41ac031f92aaba9245b40d244c6fb55d41ac001887ed39f245b409a2aa84f42d41ac0459440509c245b40183f708344d41ac0f233d3b19b245b4096931dcc3ad41ac09d1e179b97245b409d7d3a9afad31ac0be31e5ae8b245b40914857d90cd41ac0374145d887245b401736625104d51ac03520863599245b4052377b0742d51ac04a2aa8d199245b40d38d673244d51ac0254830879d245b40543429305dd51ac05a98ab8c97245b409bbe6b6043d71ac02115c91d97245b4056f2e79966d71ac00db205d694245b40d5dcda7d1fd81ac0f2fac83993245b409992712481d81ac022e9283092245b400f1dded68ad81ac02f5338e37d245b4057bbd79253d81ac01805e5f879245b40511221ea48d81ac0d8fbce976d245b401f181dfd20d81ac06087102a6d245b40cf762e9b1fd81ac051c4f80b6b245b405c5e88bbcbd81ac07967246669245b409d623a654fd91ac07057a4b56e245b4014eba90591d91ac02433659673245b4091a4a501a2d91ac0f3b5bbe478245b405aec247bb4d91ac047bf4b327d245b40a0c2b33cc3d91ac0a79e00a485245b4013749e41dbd91ac002e1d6a88a245b408d070c22ead91ac01244829d93245b409d8189140ada1ac025f96c3498245b40d1d905bd16da1ac0995afb669b245b401f1b4d6d2ada1ac01db9a58c9a245b401c74bd956eda1ac05ecc024e98245b40d4ce7175cdda1ac092e21b0d97245b4060fe392a09db1ac0a2a6da8695245b4013852cc651db1ac02310c64390245b400c973c4f50db1ac0426bfc808f245b40539e19694edb1ac0e69d179e8c245b409078628745db1ac09e9eb6168b245b409e03268e41db1ac0a2f8f0cf86245b40d24d2fa033db1ac0e3d0e53384245b40d402f6fe2adb1ac013aa9afd7a245b401f4ab5d500db1ac0970735747a245b4017eae560feda1ac0809ab72c77245b40d7744f0febda1ac084e1ae1076245b40ce988f4dedda1ac0104f15c675245b4018aab15eeeda1ac078d40e2474245b4098c22d59f4da1ac038a5a62d73245b4057b6744bf9da1ac060f2308a71245b401bab426323db1ac068ffdce971245b40d077718239db1ac0485a9c8072245b404ddaf95d5cdb1ac0a3d0bcaf79245b40db10f908dedb1ac0af074cc97a245b409b2031fdefdb1ac0bc22d51f7d245b401b211f2e18dc1ac09bd7003d7e245b404f68f2042adc1ac0af9cc4a981245b401f5a281564dc1ac0a088975984245b409b3dec2791dc1ac00fc8209185245b401a0a3cb8badc1ac00e6439028b245b4093142f9505dd1ac00fb5e52c91245b405d2330f48ddc1ac07a5de37595245b401ab49cbbc5dc1ac0a23ca5328e245b40194644244fdd1ac092522f6591245b409a1e20377add1ac066de4d8099245b405a7e1ccad9dc1ac0414d496c9d245b40d69166380ddd1ac0d262e67a9c245b409baba7565fdd1ac04da97dbc95245b40d7e6604ce6dd1ac02e7fa2278f245b405999a9ab63de1ac05b30f64f91245b40548835c77fde1ac01a7d14e399245b4093901972e3dd1ac01e8f86009e245b4099fe2b2895dd1ac0ed9426789f245b40dd7f795186dd1ac0148e92a6ad245b405323b0bd3fdd1ac0cb5f0a26b6245b40d505ea640edd1ac04028be42b5245b4011d2a3a4e5dc1ac0e4e00629b6245b40159a574ad6dc1ac0888c2a59b4245b409a95d123a1dc1ac0930b2beab3245b40d2b0d46b94dc1ac0c4ea3835b2245b409912c8f956dc1ac0ef392febb1245b40d0075a904cdc1ac0b824460cb1245b4010d2f98849dc1ac074785309b0245b40a1cb4e0446dc1ac0e0f7a40caf245b40d63a739542dc1ac0586eff9faa245b401a9d1de56adc1ac081f323e6a8245b4060c9e89e7adc1ac0880cb137a7245b401c9baf1d82dc1ac049cfa387a6245b40d5fe742e85dc1ac01c204b1aa1245b409967aa5f9ddc1ac0a16425e1a0245b40563198dd98dc1ac022664a359d245b401a8376b94edc1ac0551c929f9c245b4092b0f5e619dc1ac0065113a69d245b40556250367ddb1ac0bdacaed19f245b40cefb93b308db1ac02cf60982a2245b4022d69ed891da1ac03887ffb1a6245b4018246f3c7cda1ac0a93d180ca9245b409d3c0b216bda1ac0f406773aac245b401922d7b053da1ac00819f439b2245b40952de21a23da1ac03897e1afb1245b4017fce18e07da1ac0b45cf26bb0245b409d43faccddd91ac0e1f7a40caf245b40cfd987f48fd91ac0a5f965aaac245b4020510af720d91ac0519801faac245b405eb4f3c8e2d81ac0d0b5c252af245b401830c38674d81ac0d03bdccaaf245b405677b74a61d81ac0e0817d9ab1245b401f85210a17d81ac047110678b4245b40df09494aa0d71ac03f458970b5245b4092fc74f791d71ac03c5127fcb9245b404d5660b14ed71ac0d30cc28ebf245b405948e450f9d61ac0a703821dc5245b4013bc11baa4d61ac0f2538181ca245b40d11c8a4952d61ac0a99b330dd0245b40d83ec875fdd51ac0a2430f87d5245b401a4021c3a9d51ac0d684fd58da245b4019be6dea5fd51ac07981ffeddf245b40dd3ec2be0ad51ac0f9b9635ee5245b4092228148b7d41ac050ad4644eb245b401ea8aabe5dd41ac0284c56c9f0245b4055bd1e230bd41ac064f39e3df6245b40a11a4c3fb8d31ac047f186bdfb245b40961e452b64d31ac0b3d6d53b01255b401c598b1c10d31ac07af1b06506255b40548570edc0d21ac02a27297e06255b405434a188c6d21ac05f2c396101255b4059c04ee914d31ac0df9fd7f6fb245b40dd65164367d31ac098db046ef6245b408fae1fe6bbd31ac0e82c9501f1245b40dfe5fe570ed41ac0fc924a83eb245b401387321762d41ac0b11ffda6e5245b40148650c1bbd41ac06dbf1c22e0245b40d4ec9e5010d51ac02dfd8294da245b40d2015acc65d51ac0ea50cfcfd5245b401c4fb637aed51ac05a8d2444d0245b40dfbc98b902d61ac0fba41fcbca245b401ec0dbd256d61ac05e8e8a60c5245b40d3bfcda0a9d61ac08689cecdbf245b40dcb49e33ffd61ac094a36b3bba245b40dffea1aa53d71ac0ff4e80ceb5245b40903b75a598d71ac0303dba90b5245b4090adfc80a7d71ac0d7ce3ee5b1245b40583198b218d81ac097411ff5af245b40d942eb5069d81ac0652ce4aaaf245b405cc3dd6075d81ac04935707faf245b4054523fe17fd81ac0f010893bb1245b4010ada609e0d81ac0f8337af9b3245b409a1acde594d91ac0d89cb7f1b4245b4060068fdbd4d91ac087ba4280b5245b40960ea595f9d91ac0c4e328e3b9245b4095a914e71adb1ac0bb5619b4ba245b40dec69fbc50db1ac0579d0642bb245b4013e9b44d75db1ac0a403cc17bc245b409bdddb43aedb1ac06cadab28bc245b40d30dfac5b1db1ac04eee742cbe245b405cdc77021ddc1ac08e930428c0245b4013b2647290dc1ac0ca63f294c2245b4015532e52fddc1ac063e1f2e1c4245b405a4c526671dd1ac0baae1572c7245b40a1e23f6decdd1ac0efba88efc4245b40ce65192676de1ac03fcf0166c4245b4018f838a093de1ac07b13afa6c3245b40d453ddf81adf1ac08f3b0bb5c5245b408f6104c48cdf1ac0ba5f5afdc6245b40dcf826b0addf1ac056b640b8cb245b409e5bb71d27e01ac0fa323c38d0245b40100294e48be01ac065244128d7245b4052de91cf30e11ac04daad3f6d9245b40534804a87ee11ac08980e0ccdd245b409d5fb7df0ce21ac0c0d41e31e5245b405465926dede21ac04803074fe9245b40d3a0f3453be31ac0f4da081aec245b401e22a29d75e31ac098c8dd80ec245b40617926ef74e31ac0d029f455ed245b401bdc5dca6de31ac0ef937661f3245b401d7d0de939e31ac0d7d62116f5245b405354ea992be31ac0b3b1900afa245b40d6fd660902e31ac0aba68eb1fa245b40d994a390fce21ac0c36bd47bfe245b4053f202c6dce21ac00638f0cb02255b405ef8149db8e21ac06a98c4e202255b40969db073bce21ac047b423a5fe245b405356cde0e0e21ac0c7701426fa245b401ec9820306e31ac068ddd781f3245b4054c6dac83de31ac0a88a877eed245b40db868c1971e31ac06458d495ec245b4050376fdb78e31ac0a44c51adec245b40a03c3da281e31ac0805745b3f0245b40142f2b7ccce31ac0c3a921e9f4245b4058c5eeb94ee41ac070362ee9f6245b40526d077185e41ac06753a8cff7245b40a26aa3119ee41ac05b7ae94cf8245b409872c781a8e41ac003815f56fa245b40dbd236f6d3e41ac08b397726fa245b401b315fdf00e51ac05f54d966f9245b408f8bc14435e51ac0ab28840efa245b40219bd1b74ee51ac07bb7a8b4fd245b40e230769c84e51ac0cf8b535cfe245b4051c53b8da2e51ac09ea7e633ff245b4012e45b73d5e51ac0623d3b8300255b40d20642dd00e61ac0b21217c202255b409ed283c927e61ac0a68f17b904255b4019e659c039e61ac00f55317708255b4098b927b451e61ac03bb7cf2d0b255b40e149272177e61ac0cde5b74b0f255b40592598d903e71ac0053fc42a11255b40d7c4a7b942e71ac0459901a114255b405dd0f1a863e71ac0498cd2e51a255b40db778d9093e71ac0e5b9896c1d255b40dec6a506a7e71ac0d1baba031f255b40e2c6a506a7e71ac0280c66a221255b40dfc6a506a7e71ac0984a104124255b401042f088a2e71ac0a8be7e6025255b40d77d3208a4e71ac0406f35f025255b409a5100fcbbe71ac031f4962727255b409253dfefd3e71ac03ced667e29255b405dd8946dd8e71ac08446735d2b255b409196ad76c6e71ac0bf23b0dc2c255b40e111f8f8c1e71ac033e7676c2d255b40115d4aebdce71ac073cbd4942c255b40d82c525bfce71ac078c373542d255b4098b7ac5011e81ac02868e4ec30255b401b46cdc91ee81ac01feafa3132255b409fb7ac5011e81ac0ecfb1ee133255b406004e6d20ce81ac08f28a5d034255b40dcc6bcc32ae81ac0364dca7f36255b40dc5e483841e81ac08f9e751e39255b40142306b93fe81ac00f58be853a255b4019911fbc39e81ac0ca7bb29d3a255b401aef47a566e81ac01bd48de53a255b40e3c8ba108fe81ac0abfb56193d255b4093964547c3e81ac00222b8043f255b4016572bc9f0e81ac0b3f3e41942255b40e1ad776bd8e81ac04522cd3746255b40e1fc8fe1ebe81ac0ea4e532747255b405c514dcf0fe91ac0a672473f47255b40a12ac03a38e91ac07a833a5747255b40a125a6784de91ac0d9d3b45e48255b401cd43a1680e91ac0c5d4e5f549255b40df7bd6fdafe91ac05287feb34d255b40549cd5d7fae91ac04a882f4b4f255b4062309bc818ea1ac0eeb4b53a50255b40ddc460b936ea1ac074e39d5854255b401d575e73c0ea1ac079609e4f56255b4018367656f9ea1ac08d50dcce57255b40d4585cc024eb1ac0cd2d194e59255b405f686c333eeb1ac0c52e4ae55a255b401a6e11ab4eeb1ac0a5380d545d255b405bb784a951eb1ac06155d1c25f255b409e32cf2b4deb1ac02468260963255b4022e95b2d4aeb1ac0743d024865255b4099777ca657eb1ac00b3431026f255b405f82063558eb1ac0870d275c74255b40dd8cc9f99aeb1ac0fe91f7267d255b402468f767e3eb1ac0b662260380255b40d9da53f3ceeb1ac0d6c7a67e86255b409c4600378beb1ac0255f41308d255b4060a76e2945eb1ac0c508f4e093255b40e4e44c10feea1ac0e62521ce99255b4017d0c5b3bfea1ac0bd6d094fa1255b40de47617e71ea1ac0c8096670a7255b40a3840f1830ea1ac088ea9ecbad255b40d65ea05fece91ac0ebb3e817b1255b40ddce9180c4e91ac0ac2563e5b2255b409db87f7b8ae91ac050d7806eb3255b40dbc85e120ee91ac07b407ae1b3255b409541a47e95e81ac0a85eee70b4255b40194ca8ef1be81ac0b0f25a37b5255b406392658b1be81ac02c24f3b9b4255b4026454aa196e81ac06b4fe04ab4255b402480ae480fe91ac08c6625c2b3255b4026a682f48ee91ac03f8796aab1255b40d53e4a1fcce91ac03c46ef1fae255b405827eeb5f6e91ac080c97ed9a7255b405bf91af639ea1ac0a8bf4faba1255b405d18e1717aea1ac074713d479a255b40d81dfd64c9ea1ac0ad99d34c94255b40de5901cf07eb1ac045b26e9b8d255b40125022104feb1ac016848ad086255b401f677ffb95eb1ac00245766480255b4056a05dd4daeb1ac0e7e93edd79255b40e0bc99751fec1ac08fd7128d76255b409b0c807947ec1ac07f4be74273255b401c67f35662ec1ac020f9494b6b255b405520baa2bfec1ac018b1a42b64255b40546d26c518ed1ac049bb20f05a255b4096e59a6c7ded1ac0ed039de650255b4014e311d2d6ed1ac0ba334a4e48255b4092783f7831ee1ac07e762eab41255b40d4b124a377ee1ac0e68a5a9542255b40624ba3998cee1ac0fe73cc4c45255b402159ebdacaee1ac002d917f749255b4019b24a0237ef1ac005c9072e50255b40196458a9c6ef1ac0719fb20252255b405f9a99b7efef1ac0bd6360e854255b40d3449ec132f01ac03d9a574855255b40e19e07492ff01ac0311d4e705c255b40d85da705edef1ac0e482909463255b40d8bddac8aaef1ac000aa263a6b255b40124cb56a65ef1ac0dbb915a96d255b4024a45824dcee1ac0138e33a46f255b401e7c800769ee1ac0373251c46f255b4096920ad761ee1ac017265aa171255b405caa8f0bf7ed1ac0f7432c7773255b40d19350c298ed1ac0df07c36f79255b405d26a6ad3fed1ac04abeaa467e255b4061502f3f0aed1ac0767015d884255b40dab9399cc6ec1ac0d9aaa45d8b255b40a32d62727fec1ac0095e20c291255b402150aeb93bec1ac0e4b0484998255b40e2a44e54f7eb1ac0b85ed6b09e255b402182281bb3eb1ac0ad9efe5ba5255b40233c1c856deb1ac02448f225ac255b4065e9346d27eb1ac0ac3b4287b2255b4060cbfef7e2ea1ac02fc042b1b8255b40deb36d2fa1ea1ac037378f6dc0255b4016ef48314aea1ac0936d3282c4255b405ef6692be2e91ac062ad3c1ec6255b401c3391d46de91ac0a10e6445c6255b40decf1dd1f8e81ac0ea89df70c6255b40a1ae9b1f6de81ac03a7cfc34c7255b405a59073a6ce81ac0ad347f0dc7255b4057a658bef9e81ac051d511f0c6255b4066ce3d056fe91ac03a581341c5255b40d9957793e7e91ac0a30e44eec0255b4021fda53853ea1ac05e14ac02b9255b409aa67562a9ea1ac0a8d62af2b2255b405bf05a7eeaea1ac0e08d0b6aac255b4020e2d3a82feb1ac05190f0b6a5255b40618e447477eb1ac0fc5e6d1b9f255b40e0b2c5b4bdeb1ac0a15fafb398255b40d527ce0000ec1ac099c1825792255b4014ddc97c47ec1ac09d9a73ee8b255b409b33e9058bec1ac0a2089d4a85255b409a993c7cd1ec1ac07ed72ebc7e255b40543181ca14ed1ac0568b69377e255b401d9e2ffd18ed1ac01fe2819e79255b40230a348e47ed1ac03fee1b077a255b409e85012f59ed1ac05fc1df9f7a255b402485732988ed1ac0efa4bea17a255b40d9a417ad8aed1ac092dc083d7b255b40562e314a5bee1ac0b6c7055b7d255b4057889bf557ef1ac01219a46281255b4057952aacafef1ac00b1e511688255b40959b66302ff01ac0f119b4328f255b40a4d7dec500f11ac09d45098b8e255b4019dd833d11f11ac066ea9a7d89255b40a2ff69a73cf11ac09e68a5927f255b40a52f79f4a0f11ac01ee394df80255b40595d75b7c7f11ac02fdb339f81255b409b04119ff7f11ac037d3d25e82255b40d8dc418922f21ac036fc780e83255b40a2e5077648f21ac0076927ae83255b40203d496667f21ac0e2209ebd84255b405eddfd5489f21ac016820bdd85255b4093625045a8f21ac09e6608dc87255b4052fefc2ed4f21ac04edd79ea8a255b40dbf7d1012df31ac07a9372e88e255b4060af1acf90f31ac0ee24463791255b40975895aad8f31ac04676f1d593255b40a401108620f41ac0b8de1e7595255b40d89cbc6f4cf41ac0f8bb5bf496255b40a33f505286f41ac0a564b14398255b40919d783bb3f41ac060aa1b629b255b40981ac10a13f51ac0498b38219d255b405b2df2f241f51ac087ac61ada4255b4053d041a1e2f51ac0b3625aaba8255b406110067043f61ac0d79c8349ac255b409bb9804b8bf61ac04fd3cca7af255b405d152532bdf61ac073de5084b6255b40a03785e651f71ac0be58a2d2b9255b4061cd29cb87f71ac0e3ed5561bc255b402130b5aac7f71ac0c3f718d0be255b40a26992801af81ac0d6942d9fc0255b40583d607432f81ac0aaf97afec1255b405fca3e6c42f81ac0a92221aec2255b405709a26059f81ac01a93af8dc3255b40966cd24e7cf81ac0a277ac8cc5255b409c38143ba3f81ac07a05a09bc7255b401949662fbaf81ac0fa6dcd3ac9255b40e45c3c26ccf81ac0f49074d8cd255b4024479ef825f91ac0c4edac5fce255b401e70fcca2ff91ac0216873e1cf255b4097d551d04bf91ac0e6f7f19dc6255b4024c9c28690f91ac0e18877c0c2255b409a2d1f32adf91ac0366680bdc2255b40579bb242adf91ac00a29f38fbe255b40e5247497c4f91ac0a3db7357be255b40a3bbdb2cc6f91ac072d831aeb8255b402615fbcbeef91ac06f74f28ab6255b4061b53dcd0bfa1ac00bc9444ab3255b40956749ef37fa1ac05fb47bf2b0255b40e03aea5756fa1ac0ccb85d68ae255b40e5a2c7ef6dfa1ac0933e4fa6ab255b40e6b98927a9fa1ac058eb6130ab255b405e5e9f0bb3fa1ac0dc013d6daa255b4055140457c5fa1ac087f691a0a7255b4020bb158708fb1ac050636550a7255b4054e6b8131ffb1ac0f0562207a7255b40d4cb8bae33fb1ac0ebfb4c3fa6255b4097076be26bfb1ac05f98d833a6255b405ccd401b6ffb1ac05f98d833a6255b4093ed5e65a2fb1ac05f98d833a6255b409253a1dfc9fb1ac05f98d833a6255b40df673f15e1fb1ac0308da8c3a9255b40177a6aa947fc1ac01fb428a5ad255b40e41dd7308afc1ac034e031c0ae255b40e6d6e0249dfc1ac08be3fe3aaf255b405f1dd268a5fc1ac0770d8c61b3255b405a0676edecfc1ac00f8b0206b4255b40646e4f7ef5fc1ac0c73ab9a7b7255b40982564ea25fd1ac0c6aba06fb9255b409e18a18153fd1ac01b14ac6abc255b4020c13c57a0fd1ac0aec8749fbe255b40611d0936d9fd1ac0e65ce3d8bf255b4025a5acc5f8fd1ac00ebb9c45c1255b401b119abf6afe1ac052fe32b0bf255b406525361a1eff1ac08a8db73fbe255b405822dbdd29ff1ac0a780efd6bc255b401f688d6235ff1ac08ebb0deeb7255b409b50a87957ff1ac00f3aa269b3255b405b02700778ff1ac09f29bf17af255b40dc5c94e593ff1ac0d0ca3d6ca8255b40620e7ae0aaff1ac0985f23e4a4255b40542dbbb3b8ff1ac0c84448279c255b40de4eef6df0ff1ac08db5ce5398255b409cad64ccffff1ac0403453cc94255b405526a1c905001bc096f0ca5e91255b40a3d981d000001bc026cd01f28d255b40dea43070f2ff1ac0a9f9ec538a255b4015d79b13d9ff1ac03987823387255b4062c8789ebcff1ac0ae43601a83255b405560327185ff1ac0c65da5ac80255b40a33332dc41ff1ac06aeeb5707e255b40d369affe02ff1ac002f5511b7c255b40a05cd3d2c8fe1ac0ca507a6079255b409496b4b99cfe1ac09259fb5277255b40e196fee38cfe1ac0c6012e1c76255b40566c7d428afe1ac0920db0e075255b409de492c189fe1ac02f3a55dd74255b405e9f1d7f88fe1ac0c77318d671255b4018e214978ffe1ac0a756a7cb6e255b4062bfc7519afe1ac05fc1ca716c255b409b9ae39098fe1ac0d3b78aaf6a255b40169d26c188fe1ac0db41752069255b40decfd26b6cfe1ac0f34b0d1468255b4062b3b2662cfe1ac043a465da67255b40dacfb9a804fe1ac0974dc6ce67255b40151cda74c2fd1ac0eb57a4e567255b40daac974696fd1ac0972abc0668255b40ddc6e96a79fd1ac077f974f667255b40619131ca75fd1ac07c1ad97267255b406271eb7558fd1ac074866cac66255b409a7390303cfd1ac0b09d2b3665255b40d08f9aff22fd1ac03c18587962255b40d2d0a5f90ffd1ac070230a475f255b40157c8b5607fd1ac05c11a1985c255b40954fe85f0cfd1ac088433c7559255b401b866a8715fd1ac05dfd00cf56255b405c0d47db21fd1ac02dc1332d53255b40d6e2558237fd1ac04554f1264f255b4058c1a6b24efd1ac0a57df9074b255b401d746c2d61fd1ac0e257603547255b409e4c609365fd1ac00506056443255b401b346d4d5afd1ac059a3a8bf40255b409bf64c1f4ffd1ac0ae3ed44e3e255b4021511fb832fd1ac02e8840be3c255b40d5261e3129fd1ac02a1ba3453b255b4060e2c8c62dfd1ac06230678139255b40e4bd240a37fd1ac00ff536a337255b402430cb434bfd1ac03f6f1abf36255b409ef691f868fd1ac0aea51fda35255b40d7b9a6a591fd1ac00fb7451334255b4052b8fdd1bbfd1ac04a82b80631255b40de82e530e1fd1ac0547c28922e255b4012af6ccdf3fd1ac02c9c02ba2b255b4094b9d6fafcfd1ac08fd0056029255b405ba80ecbfcfd1ac04b8d55a126255b40e0f5d14601fe1ac083416f5f24255b4061b699a20dfe1ac04c67af0c24255b405592d94912fe1ac01caf1f3522255b40522395ce2cfe1ac09023d05420255b40a0b081ac5bfe1ac00df92af21e255b409d58027287fe1ac07cd67bc71c255b4097b99651abfe1ac0b03961201a255b40190fb09dc2fe1ac0d56a7c6616255b401fe51db9cbfe1ac0b1b0a3fd11255b4015ab91f0ccfe1ac0e2e0c60d0f255b401eefd2dec4fe1ac096edca1d0c255b406375415ebefe1ac035123b7e08255b405192bacfb7fe1ac0357ab60f05255b40d127f45fbffe1ac035230c1d0a255b40a32c78b049ff1ac0017bccbc0c255b405c94bdda8eff1ac012b9016a0d255b40deca64afacff1ac0b515ce990d255b409606fb79caff1ac01d841b330d255b40116cb038e8ff1ac00142dd4f0c255b4096da06f5faff1ac0724a8b720a255b40d5224e3604001bc021fe64e507255b40d584fa8b10001bc09a3f53bc05255b401f010f0c20001bc0ce7ace4b02255b40dd133faf40001bc09341f675fe245b409b5910d46d001bc05265bd9ffa245b409a6569ac9f001bc06eaac360f7245b404f8d8229c8001bc0978f9f3af4245b4092cc0acbf3001bc0bf46aec4f1245b4059fd94a417011bc0d70d3367ef245b4051dd045643011bc0931c1928ec245b405c755c646d011bc074e83303e9245b402096ec5989011bc0ab037cade7245b401d3c0bcb6a011bc0b9895278d9245b40d2cd308a25001bc0d854ee9ed9245b40d19bb97d12001bc08481999ed9245b40143f444212001bc05cb85158da245b401e1f222109001bc050dc0812db245b4012ffffffffff1ac079b65e5ad4245b40939861a3acff1ac0de9d86a8c2245b401dafbe122cfe1ac05af88a55c0245b40cfed5c8ff7fd1ac0273f4162bb245b4058866bb587fd1ac087c9b3cbb7245b408d370f2730fd1ac02fbac667b2245b4015846055bdfc1ac034105414af245b408e3c27bd6ffc1ac0ed493140a2245b401029e44a3dfb1ac03144e6caa0245b4090a42c431cfb1ac0e11a709692245b40d26a6116daf91ac006bac7d287245b401c3905f9d9f81ac03f20680586245b40956ac8d095f81ac06be7cc7685245b40d44b309c6bf81ac01ab6705985245b40182329e961f81ac0177fe5417a245b40dc4533a7aff71ac0fb7b884677245b405de82add5df71ac070f77ccd72245b401232fd12f1f61ac02c4f2a1a6b245b405de32d573ff61ac0b429efe368245b409af5251b0ff61ac098577f8461245b4014266a696ef51ac0995bf2785a245b4058f7a57edef41ac0665e18e945245b405042acfe08f31ac0f38e96033d245b408f8269183ef21ac0bff4c7b436245b40538dcbb8a9f11ac0e4c3f69331245b404dfd7f9c30f11ac04404ed0e29245b405a518def6ff01ac02d66c74620245b404cd7917b9eef1ac09533d6851f245b40590889b48def1ac05670f4311f245b40d59098a086ef1ac0099b0b5c1e245b409a97158a74ef1ac0f589fe571d245b408fd7b8fe5def1ac0f5858b6324245b40143fa4dfbeee1ac0d55151f52b245b40de26af2633ee1ac0286c494739245b404f879e9449ed1ac08f34fb3c46245b409c54698b6bec1ac06ec9ed974f245b40da4d0647c9eb1ac0ea0b2b1554245b40da729cdb84eb1ac08581b8ab57245b401804a8a965eb1ac001c4f5285c245b4092a17a6b60eb1ac0d03773486a245b4055ba624678eb1ac05f36d25279245b40d112ee9579eb1ac017011fbc76245b409ce8279cddea1ac087b0313674245b40d31e2922c3ea1ac014e34e9b71245b40ce1f5c0185ea1ac044348b506c245b4095f97abe66e91ac02c4f2a1a6b245b408c741daa29e91ac064aaa3e36a245b40e0b24d821be91ac0a0e720e868245b409801452c62e81ac00cd25d1267245b4098266893c3e71ac00d06a8a965245b409d6f07ea94e71ac039cd0c1b65245b401f8ec6a17ee71ac00d204df564245b405b90459a78e71ac0cc161a8865245b40519c668176e71ac0c959e20165245b401defc16b97e61ac06d0ee19865245b40d4ea436fd5e51ac0ada001f566245b401a3eac376ae51ac0c4b5bc2967245b401a2282fa61e51ac00044f83b67245b4093502b215fe51ac078578fad67245b4096a1b2614de51ac04d17a58460245b40530b03965ce51ac0f16f20ab5b245b401115a58460e51ac0814247ab5a245b40538f149161e51ac041990aa359245b4054957840d9e41ac0baf34d2157245b409288b14cbfe41ac04ab042ca4f245b40d9cb79c6bee41ac06dfd372f4e245b4016403979ade41ac0423e71874d245b40cc9de8baf0e31ac0d211ed0a4d245b409ba32b4d54e31ac0ce39bbb54c245b4010168046e9e21ac09ade41ec4c245b4095fc4b5299e21ac0c58678245e245b40cb6d4c4f58e21ac0d57a573d60245b40107e6b274ae21ac08d72f2a963245b4018f22ada38e21ac0896a54e064245b40d1439f8f32e21ac0d9810bb265245b408d7dc51a2ee21ac059005d3464245b40ccc5be64e3e11ac055abb9dc60245b409cc1da183be11ac01d1542075d245b40194baab69be01ac099f35d4a5d245b4053e159828ce01ac039eb245b5d245b400dcbf09f6ee01ac08f00b1a547245b4050f7c264aae01ac0444af60835245b405ee27e1805e11ac0d7d6cbef34245b40d65be2c803e11ac01009228b34245b4051a63388f3e01ac06ca212d731245b401d29c6f99be01ac0e8d290f128245b40d57b7bd7a0df1ac0e83a252026245b40cdc1f5285cdf1ac01245520b25245b4019f8f6ae41df1ac0ba4f0af31e245b4051aacdffabde1ac022bc809719245b40553443aa28de1ac0266029cb10245b404dbe45274bdd1ac064e4e13d07245b408e9731056bdc1ac094b66d1805245b409b5aa6b723dc1ac0738e446804245b400dfff5620cdc1ac0002e0b26fe235b40d14f6c054ddb1ac04cedc1a4f8235b40946e1edfc2da1ac000fe3383f8235b4051f3a276bfda1ac0d450745df8235b409a9f1518b2da1ac0484a2f6af7235b400ae2378595da1ac0b8d7fecef6235b40cfb10ddc81da1ac0038025de01245b40dbb8bda431da1ac03b94633909245b404f1a9fc9fed91ac077df74cb0e245b40539c64abcbd91ac0166fa7ad11245b405f5f730e9ed91ac023bc809719245b405a0635d40cd91ac09596558a1d245b409a5ae9b5d9d81ac0050eab7823245b409c3e36c98fd81ac0099325732c245b409d41e8a04bd81ac041c1081a33245b40cf1018eb1bd81ac01157116e32245b40193bf88903d81ac041f2c91132245b409982bef4f6d71ac0d581289831245b404eb4c189e8d71ac0e020645930245b4057efc000c2d71ac01041df162c245b408c9c996038d71ac0f5d603e621245b4012302592e8d51ac0f589fe571d245b401a7b2aa73dd51ac08ec0620d17245b40d7b59db646d41ac08eda075916245b4019ef299128d41ac0626e407215245b404ddf1ff60fd41ac0ffd9b59716245b404a82264508d41ac083d61dc317245b4017b45c0802d41ac0a22af5d426245b409c33aa139dd31ac0ad05f79e32245b40db43403c4dd31ac0305eb60339245b408ee659d41dd31ac068064faf41245b40de450873e1d21ac00b4e1bfc47245b40526c9507b9d21ac04271cbc651245b400dc5dc3e76d21ac0821e004657245b404d58b3424fd21ac0e632b1f958245b4012eb97b538d21ac0caaa784759245b400d33c5a23dd21ac02acc77e959245b4051e3079555d21ac08e3535225b245b40146e35bd54d21ac0568ecf0e5f245b40d48f0a0852d21ac03d3d1a0362245b40965ed8decad11ac04581c08463245b40dab9181c88d11ac031ccdd5e67245b40d088bf58a5d11ac0fd902fde65245b40625b854bc9d11ac0d517170a62245b409b409cf968d21ac0bd9f20ee60245b40de9ddefceed21ac0e5d0a6cf60245b409cf5ba5efdd21ac0a19b704a61245b4056dcbc01fed21ac0ed45e81462245b409a3c820effd21ac0c1b33eed69245b409c8a687809d31ac040cbccb16e245b4090afa8f0b6d21ac0ec44a97673245b40d6d9994e5ad21ac068ef7bd978245b409761f1feecd11ac01c16d5a479245b40953d0e34b3d11ac07466da9e83245b40d4e1ec8233d11ac05a28ce4a8d245b4053db4fb9ced01ac0567753f092245b40d66c2ae3c3d01ac09615a7cf98245b40d3539b28d7d01ac095dbb4709e245b4014714bbd04d11ac04a6cea6aa2245b4021ccf3fe44d11ac0bc380e31a8245b40d778f86089d11ac0e483fa1fab245b4057242495d3d11ac06ca82962ae245b40a100cc03f8d11ac084ebe4d7b2245b4052ea154e86d21ac008a8b527b0245b40d5c1fc96b2d21ac0),
+(5, 'Pekalipan', 0x00000000010600000001000000010300000001000000790000001a6029cb10245b404bbe45274bdd1ac022bc809719245b40553443aa28de1ac0ba4f0af31e245b4051aacdffabde1ac01245520b25245b4019f8f6ae41df1ac0e83a252026245b40cdc1f5285cdf1ac0e8d290f128245b40d57b7bd7a0df1ac06ca212d731245b409029c6f99be01ac01009228b34245b4051a63388f3e01ac0d7d6cbef34245b40d65be2c803e11ac0444af60835245b405ee27e1805e11ac08f00b1a547245b4050f7c264aae01ac039eb245b5d245b400dcbf09f6ee01ac099f35d4a5d245b4053e159828ce01ac01d1542075d245b40194baab69be01ac055abb9dc60245b409cc1da183be11ac059005d3464245b40ccc5be64e3e11ac0d9810bb265245b408d7dc51a2ee21ac0896a54e064245b40d1439f8f32e21ac08d72f2a963245b4018f22ada38e21ac0d57a573d60245b40107e6b274ae21ac0c58678245e245b40cb6d4c4f58e21ac09ade41ec4c245b4095fc4b5299e21ac0ce39bbb54c245b4010168046e9e21ac0d211ed0a4d245b409ba32b4d54e31ac0423e71874d245b40cc9de8baf0e31ac06dfd372f4e245b4016403979ade41ac04ab042ca4f245b40d9cb79c6bee41ac0baf34d2157245b409288b14cbfe41ac041990aa359245b4054957840d9e41ac0814247ab5a245b40538f149161e51ac0f16f20ab5b245b401115a58460e51ac04d17a58460245b40530b03965ce51ac078578fad67245b4096a1b2614de51ac00044f83b67245b4093502b215fe51ac0c4b5bc2967245b401a2282fa61e51ac0ada001f566245b401a3eac376ae51ac06d0ee19865245b40d4ea436fd5e51ac0c959e20165245b401defc16b97e61ac0cc161a8865245b40519c668176e71ac00d204df564245b405b90459a78e71ac039cd0c1b65245b401f8ec6a17ee71ac00d06a8a965245b409d6f07ea94e71ac00cd25d1267245b4098266893c3e71ac0a0e720e868245b409801452c62e81ac064aaa3e36a245b40e0b24d821be91ac02c4f2a1a6b245b408c741daa29e91ac044348b506c245b4095f97abe66e91ac014e34e9b71245b40ce1f5c0185ea1ac087b0313674245b40d31e2922c3ea1ac017011fbc76245b409ce8279cddea1ac05f36d25279245b40d112ee9579eb1ac0d03773486a245b4055ba624678eb1ac001c4f5285c245b4092a17a6b60eb1ac08581b8ab57245b401804a8a965eb1ac0ea0b2b1554245b40da729cdb84eb1ac06ec9ed974f245b40da4d0647c9eb1ac08f34fb3c46245b409c54698b6bec1ac0286c494739245b404f879e9449ed1ac0d55151f52b245b40de26af2633ee1ac0f5858b6324245b40143fa4dfbeee1ac0f589fe571d245b408fd7b8fe5def1ac032bfdd921c245b409d36c1374def1ac0817341041c245b408d5ca3e540ef1ac036b05fb01b245b409d06978e39ef1ac096ece2361a245b408a7abc900eef1ac09a2b543717245b401ddd76a1b9ee1ac0a7ffef880a245b40cc571af851ed1ac05fc3887d02245b408eea7ea642ec1ac0cb3a268bfb235b405714c78157eb1ac0d402857afa235b40d6298a5759eb1ac057246937fa235b4015beba2a50eb1ac09861e63bf8235b4051d954dd23eb1ac0ec4c1de4f5235b404b5df3aaceea1ac0f4e4a430ef235b4095e97420ebe91ac0442d499eeb235b4091f07edc7ee91ac07d8b4b07eb235b40110429096de91ac00ded5e75e4235b40d8b80b2577e81ac05995f947df235b40178b14cac2e71ac0224d7155d9235b40d047c0e8f2e61ac01e9039cfd8235b40922ada1ce7e61ac0e2433ee8d9235b400a8c614ed0e61ac06ad013cfd9235b4052769e78cee61ac0a78c6d52d1235b40503987c3b6e51ac06705745fce235b40d689709351e51ac09b7ddbf8c5235b4091d4fae33ce41ac01b77c6f7c5235b40098069c03ce41ac0f8625cf8c1235b40892cf6067be31ac07892f0bdbf235b40d0f3cef90de31ac03f9f0b23bd235b40ca2f28d368e21ac0f0d54334ba235b408d8900a777e11ac0cc893824b5235b401a96c9703cdf1ac03095fab2b4235b4018404aecdade1ac0fc1fdc9db5235b408e25fdbd14de1ac0b58294d8b5235b40cb3f4b57b0dd1ac0f94b886bb5235b40556d431d3add1ac051bd2363b5235b40cd43300e2edd1ac000f7b86fb5235b40dc43300e2edd1ac0bc765776c1235b404ea205685bdd1ac04f72495cc7235b400d457c2766dd1ac02b1a2d8eca235b40cfa211c45ddd1ac0131eb0abc9235b405997a432c5dc1ac07bd1199fc9235b4015920035b5dc1ac0e670f085c9235b400e58349d9ddc1ac067bbf770c9235b40da6ad26d89dc1ac08b68604cc9235b4051df929a78dc1ac0af583f36c9235b40d2e39b6d6edc1ac07b7b8cf2cc235b405ce3f56052dc1ac0cb966e99d3235b4055972427f7db1ac0fe33c687d9235b400b0ba41cb0db1ac00a4d55dae2235b40180183a44fdb1ac04429d6a9f2235b405bb757e6adda1ac0b8d7fecef6235b40cfb10ddc81da1ac0484a2f6af7235b400ae2378595da1ac0d450745df8235b409a9f1518b2da1ac000fe3383f8235b40d5f3a276bfda1ac04cedc1a4f8235b40946e1edfc2da1ac0002e0b26fe235b40d14f6c054ddb1ac0738e446804245b400dfff5620cdc1ac094b66d1805245b409b5aa6b723dc1ac064e4e13d07245b408e9731056bdc1ac01a6029cb10245b404bbe45274bdd1ac0);
+
+INSERT IGNORE INTO `kelurahan` (`id`, `id_kecamatan`, `kelurahan`, `area`) VALUES
+(1, 1, 'Argasunya', 0x00000000010600000001000000010300000001000000da01000086e1a20a94235b40595702e2db0f1bc08564a21392235b40169853faf00f1bc0b14516b590235b40d77126ddfe0f1bc0b6fcd6638f235b40511e680a3f101bc04a38576a89235b4011b78b8444111bc00252aa8c85235b40d5219efe49121bc06a0faebb84235b4096a4f86f93121bc03639c68883235b4091ca31afdc121bc01fa6397f81235b40d41762c256131bc026992d667f235b400da3060ddc131bc0a30b40857e235b40d1f86bc62a141bc08f724fd17d235b40906f18ca5e141bc004bb5a4a7d235b409565f0e3a7141bc02cc6ef527c235b408ba50cd5fa141bc0cb43977a7a235b40174c960c60151bc047b6a99979235b40c950d74798151bc0b3b9d2aa77235b40112c52260a161bc043c890c474235b400c745122f9161bc020eabda872235b408f31fabd8c171bc054cc79a36f235b400c82e7f760181bc0c07eb9266f235b40d99aeae299181bc02831f9a96e235b4089e1fecdd2181bc071e45ee26d235b40490d876365191bc0fc839d656d235b40177827f51e1a1bc0b871c1cb6c235b40d18fa224dd1a1bc098860f856c235b40d4ee1c88341b1bc051e584976d235b40cd90ef36951b1bc0c3810a1c70235b400e042ac0d01b1bc0946f145472235b40d5c805550d1c1bc07b647e2773235b404d8a80e7371c1bc0ab22dc8d73235b40c8c38d93761c1bc0687f6ecb74235b40cd9192d7b01c1bc093d850bb76235b4056e1c71cf41c1bc093508dcf78235b40c9e9c0ae301d1bc03ff510027c235b408f0196946f1d1bc0a68e464881235b40d9f671c2f11d1bc05b078e9485235b4047f257e96a1e1bc09bf4afef87235b409176ea73b01e1bc082dcefc088235b4014b9addef51e1bc04e2cba9588235b4088b23e94511f1bc04e13e14888235b401441936e921f1bc063f1752487235b40540f492adc1f1bc032b3e57185235b400644586e18201bc04f2a15db84235b408edce45776201bc0724e1fbc81235b404f694ecb00211bc0ebec15537f235b404b4b1a8267211bc0126724ef7a235b40d891e16913221bc0e421963a79235b408646844968221bc08ffdad3f77235b40ccaa7f7aad221bc04bdb11da74235b40910510b0e9221bc0c7e328bd72235b40d82e4a7f18231bc06f84173670235b40904bfa9c37231bc02b1134d16d235b4097a2b6df6a231bc0f88803d76b235b405131ef1da7231bc09db8c78e6a235b404b43c2d6f0231bc0a14a708d69235b4059a31d8843241bc06cfed76369235b4086c2621b8b241bc01974f93a69235b40060ce5bbc9241bc0d0f3df6068235b4092cef8eef1241bc0042b8af766235b4012a181161a251bc01026e4fd64235b40ca2c9b9e4f251bc008e6be5162235b400e3839627e251bc0fc55cd5d5f235b40099eb55caf251bc025a4101f5d235b40542f578dc5251bc0c54fdd045b235b408ca618ced2251bc0b120191059235b40d12d82efcb251bc0d5214c8757235b4051148663be251bc0d1451b2755235b405767477cb7251bc079f333a252235b4015a78cc1bb251bc09256c4f84f235b40902ec3f6c8251bc032074e9a4c235b40125c0eb9ee251bc05a0c783e48235b40108d6bf535261bc0264662ba44235b40857db21372261bc0b647d1c142235b40d3319f2f9a261bc083ecc1dc42235b40c8f258d007271bc0de4125d742235b400fcdcb294d271bc01709768a42235b40c52c5ac78b271bc07f60251a42235b4051cf5025c8271bc003ff0e1a41235b40d656ca2d0b281bc0a32f421641235b4010844d283a281bc0a320cb5a41235b408453d33560281bc0d3eb52c341235b4009de860984281bc01f0cba5541235b40062b27d99e281bc0cff133ec3f235b4093d0533dc9281bc07f306e3b3e235b40ccfb5022ef281bc04fac73d23c235b4087cc6fd012291bc0b7ba541c3c235b40164ab1c938291bc02f0da9893b235b404e572e3f63291bc02f69d9443a235b4010e2c57682291bc00785776b39235b40cdce16b7a1291bc0d7b0781039235b40927ee73fed291bc09fefaaa739235b400fbc880f1c2a1bc0837c6d1f3a235b40144f0c7f3c2a1bc083bf8ce339235b4095de6a2b632a1bc0df4fe72f39235b40883f17178b2a1bc03f23614038235b400fd33b47aa2a1bc00fea683936235b400698963ac32a1bc06fbde24935235b400464dc74ce2a1bc0d8a0664634235b40c82cfdebdf2a1bc0503c067833235b40060e64af0a2b1bc004be658e32235b40ca86395a382b1bc02c52eef530235b40068b0a32882b1bc02472b93e2f235b400fe4c4c6d82b1bc0f098ca362b235b40924f8b9bfc2b1bc0df5ebf1429235b40548742910f2c1bc00eecafef14235b4085d128d6092d1bc0f8178b86fc225b409007b9d9bf2d1bc054cc8583ea225b404961aa91442e1bc096f71410e5225b40837500d3882e1bc07955f1f3dd225b40d16c6720152f1bc051065efbdc225b40925de97f302f1bc025c224cadb225b40c878571c522f1bc0c578da6bd8225b4044c80c05802f1bc0de1bee4cd6225b4083cd140a762f1bc06eab5f6dd5225b404d394f19582f1bc06edd43aed3225b40502b81273c2f1bc0ba13a9efd0225b40169ea22f2c2f1bc0ba424eb1cd225b40d083e53f0c2f1bc06b320a82c7225b40c500da56b32e1bc07c0cded9bc225b40c71d03d5e62d1bc07c6d67dcb7225b4012cdec116f2d1bc07852217fb2225b40cc33085fd72c1bc071083660b0225b40cb235b79a32c1bc0698c6600b0225b40497cbf91732c1bc09cedd31fb1225b4007da2baf392c1bc078bc9f3cb4225b40d53f4a0de92b1bc0490cc7cbb1225b40c48b8ec5e52b1bc075bd1daca2225b4088353472d12b1bc05e1a0acba4225b408637fca8652b1bc0310388caa5225b4006b488ac5e2b1bc0d12f0ebaa6225b40cd3c04ae5b2b1bc0481c6cf9a7225b404b6d99b0562b1bc0ed48f2e8a8225b40533015b0572b1bc0a5e9e6f7aa225b4007fdfbaa612b1bc034fda959b6225b400f70b7636b2b1bc010e37e88b9225b40936ec8716d2b1bc080530d68ba225b4045d31b88412b1bc01c04c4f7ba225b4004b2779f132b1bc0c007a437bb225b40d65f3eb4ea2a1bc0c007a437bb225b408708fdc3cb2a1bc0dfcbfc87ba225b40436d50da9f2a1bc0b4e65ec8b9225b4008d2a3f0732a1bc07cae9758b9225b408803de01522a1bc0e0fde0c8b8225b4008729c132f2a1bc0a0494af9b7225b400b555b21142a1bc08cd5dbd9b6225b40828d7c2b002a1bc059d146ae9b225b40caafa888d3291bc009ef60319b225b40ce0e82eec8291bc0e65b84049a225b40c29e54d5ad291bc036c49bdf97225b40cbeece3f99291bc0a619b2ba95225b404841cdac7f291bc0db9e138a93225b4088040ae059291bc05e36e6ea91225b40c9ecd0f234291bc0f241276b91225b40d09a97070c291bc09a4507ab91225b40d0b8fb21d8281bc086a8f2db8f225b40d09899baa7281bc06e3484bc8e225b404a8f320d83281bc0d683cd2c8e225b40cd32ed6552281bc01a60d9148e225b40866c3198ef271bc06cce21a985225b4091efe8c88f271bc03374e43282225b401293a3215f271bc02f42329981225b400639214950271bc0ede825ba7f225b4089bb13f832271bc09c7f0fef7d225b4052db992022271bc0a5f1a1cd7c225b40922a73ca0e271bc065f3ed377c225b40107d7137f5261bc00c18458e7b225b404207f0edc8261bc005c51bde7b225b40906ec3b8b3261bc0a8af47e17a225b408019c437af261bc0a5954a9477225b408b4090cd9f261bc001f3f35672225b40c2fa45e07a261bc0c53e5d8771225b4091570def5d261bc0e9d90f2870225b4006dc25fa47261bc0fdd7adf96c225b40c54e470238261bc019521b2b6a225b408b3b710b26261bc02a795fac67225b4089ba81111a261bc076afc4ed64225b40cab0161611261bc0fa1df19e62225b40d2a92f1d03261bc0be40b41f61225b404e9ee52de2251bc076104ef05f225b400284283ec2251bc0826377a05f225b408578de4ea1251bc0ea57362060225b404b28845790251bc04ad0254060225b4087913a6477251bc0aaa39f505f225b40c53c7d7653251bc09e825ae15d225b40c27722833a251bc0632f4d1458225b400a3b9e823b251bc04b4e4c3850225b40cf27c88b29251bc01c7d0ddd46225b40c7907e9810251bc0fc3ac2cf43225b40c31a052810251bc06c1afb323b225b404614f29417251bc016e0ed7731225b40c427c88b29251bc0dea7260831225b40c5b4a68339251bc03a7ba01830225b400d33127b4a251bc056c329092f225b408c46e8715c251bc0ba6dfd692d225b40c79642696d251bc001cd085b2b225b40c960a5617c251bc0853b350c29225b40c8daad627a251bc017a2007d27225b40c79642696d251bc077757a8d26225b40cf43646f61251bc037c1e3bd25225b4044f68d7a4b251bc02f45145e25225b40cbb4a68339251bc0cfcc243e25225b40442a4c8e24251bc02f45145e25225b408093029b0b251bc0677ddbcd25225b40ff511ba4f9241bc0aef6a55e27225b40085154f1d7241bc0426bf77b27225b40d0216a0ab3241bc02fb9337e27225b40ff1dd29d97241bc05661425b27225b40046d05b276241bc0dbc8bbe126225b408289dcdb46241bc0436b1c8c26225b40d015be302c241bc0ff235c0326225b408eea49421d241bc027c1616025225b4047ac696c1c241bc0ff81c0da23225b40c121747c26241bc0d7eac6b922225b408535aca92c241bc063f1124121225b408d97300732241bc00278e01320225b40c5d3754834241bc07b629f9b1e225b407ea0ca2934241bc0f686b5ad1d225b40c3dedd622f241bc0570cc9cc1c225b40d168d42025241bc06a5c3c821c225b404f1f407c1c241bc0578743a91c225b40056153420b241bc0a7be23351d225b400f8ce1cbf3231bc0df5d69b41d225b408ca5041ddd231bc08e0d20c31d225b4082f5047ac2231bc07b8402751e225b4088a02153a6231bc0b32569761e225b4002ca2f1695231bc0ca8bd46a1e225b40fea75a5489231bc03f98d1bb1d225b4006afc33882231bc08f472c761c225b408e0d12a27c231bc070a7cdd81a225b400bbaac3b76231bc0d0033b4819225b400095eeb36c231bc09342e45a18225b40422ab6df60231bc02f9a632317225b40c48150b347231bc0e43e62f915225b40cc4a2ac722231bc01fbc69af15225b40062a391513231bc054672c3f15225b40883b25360b231bc0d0f22a4514225b40046018f200231bc0bc6089da12225b40425c7f82f3221bc0e852570c11225b408bdaa6a3dc221bc09890e4690e225b404f5399f2b9221bc0c8abe0180b225b40bf6bd6a98a221bc0a8dc337109225b40c033c6d568221bc0c864cfe007225b404b3bc39733221bc07189190d05225b407ddde1462f221bc029e76365fe215b408609ba7116221bc0cda502a8fb215b40872ca8770a221bc0dd549ac0f9215b404487f927f5211bc0ee228f94f8215b400c43964ee9211bc0d2e62443f7215b408862d34fda211bc0ab619c88f6215b4048a6d0a9c6211bc03e86f526f6215b40c249eab8a5211bc0ba395dd2f5215b40c09750847e211bc0e2b7ceedf5215b4045e59f8861211bc09a46a3ddf6215b4049c8e5cd4e211bc0a2f59f8ef7215b40cd413f9f3d211bc086b32901f8215b407e8b244929211bc0f2484d0ff8215b40858470b315211bc0467abcc6f7215b408cbb9ec4f4201bc0da7be089f7215b404fd27406de201bc0c628dd0df7215b40c5763ebfcc201bc062626e5ff6215b4085d45b96be201bc06e85c726f5215b4088ac9e84b3201bc0be7a8c97f3215b400c52858899201bc0060fd4e5f1215b4087df802459201bc01600ebfaf0215b40cd5fdb892f201bc04e32d7b1f0215b40019fbedf14201bc08233bec0f0215b40cc0bfbe2f71f1bc02293011af1215b404ed3a0e4e51f1bc03205b398f1215b4006960f43d61f1bc0366773c8f2215b40013141aab41f1bc0762d949bf4215b408056975d741f1bc0129f485bf5215b40bd490d35561f1bc0129f485bf5215b400df189c3391f1bc0ee357afbf4215b40c1e17950201f1bc0321286e3f4215b408f1bdddb091f1bc0ee357afbf4215b400fc3596aed1e1bc05ec4d744f7215b40430db307c21e1bc03fce2f18f6215b400bdfffbf981e1bc0be445f2bf5215b408e3aa9c7671e1bc033ecf131ee215b4006b7b0222e1e1bc097bb070ce5215b408a814786e21d1bc0878337c0e6215b40be16a45da81d1bc04f726c1fed215b408da4408fe61c1bc072752bb4ee215b40d0182377b01c1bc0e6985225f7215b4006c063e7891b1bc0f283f319f8215b404688aef75a1b1bc0b2191d56f9215b40c46d55e4a01a1bc07e6e5ac6f9215b4042574f4d4c1a1bc08e4c92e7fa215b40ccd58a36c7191bc0456337edfb215b40cb56b64924191bc0ba66463df9215b40c542695b0c191bc00272a69ff6215b404ba5a381f7181bc0abdd787ff5215b401091e0ea59181bc06a852cbff7215b40043ff7fc68181bc071848c8afc215b40cc71db2be4171bc06e4e869c04225b4086e4595b0e181bc0b183c94b06225b40051554f1d2171bc0606793fe09225b40bfbb849b70171bc0cd4939190e225b4044f8cf7710171bc06bd1516a16225b404660c43e01161bc0e77fbe3f1b225b4091796ca000151bc05841f28f1d225b400f5cda36ad141bc09d7c4ca434225b4042a8244372121bc0663705323b225b408953f1d748121bc018bb162d40225b400487670932121bc0bd9fec6646225b40817dc51a2e121bc0f44b969350225b408a5a087250121bc0a76ec7d45d225b4049374bc972121bc0561789096a225b400878944a78121bc0dae39dca69225b40d1625e471c121bc05ad4c08f6a225b40c2fad92077111bc04ef2bc546c225b404d839d62d5101bc08e9bf95c6d225b405258a48977101bc0726394196f225b4004db90ee03101bc03d62d3d171225b408de1d976be0f1bc0a169226c78225b408ef0ac35780f1bc05edeb5847c225b40c11c0071570f1bc09d4b0a2c80225b400b7949f4160f1bc0046ebcc282225b40c931aa0ce30e1bc049c4f4bd86225b40cc6e8ecb9c0e1bc01bcd708a8e225b4009ed0912db0d1bc08bea0df791225b40cca5d0798d0d1bc037429fc893225b409109d7a3700d1bc076954e2498225b408b6ab8c83d0d1bc0e2ea60fd9f225b4042dad2fbaa0c1bc0cfe3900da4225b4013aacfd5560c1bc0517c5b37a5225b408191e7fa3e0c1bc0829bec9fa7225b400b1122880f0c1bc0b1d199b4a9225b4046eaff1ce60b1bc08654b131af225b40078cb7955e0b1bc05d0e9ce5b2225b40c933b918030b1bc061cffe40b9225b400d4319aa620a1bc078c3dd59bb225b40c8754f1e160a1bc09d99c0adbb225b40c52f629f000a1bc035866055bd225b4004d55757050a1bc081b45f77ba225b4045c8f6b2d1091bc0f5e4c116bb225b400652df8aa8091bc0257b6590bb225b40c58a5f0988091bc09d657616bd225b40c6dc94f25a091bc045e9b3b5be225b4052196d5512091bc08c95795dbf225b4008dd21c500091bc064bc0d6abf225b40d100857afa081bc0a0d4beb9bf225b40cfa9d216d7081bc06745fb58c1225b4085d6cb5ed2081bc0007c17efc7225b40904d7cb5a3081bc0838d9374cd225b4055ba7ec16e081bc0cbd962b7cf225b4094a813d044081bc0a3f858fad0225b40c55cdc4603081bc0d70d81aad1225b40059c3ffeb6071bc0fb5561e5d0225b4053b94c4d82071bc0433e0feece225b404799417c60071bc0c8581392cc225b400ec37b0e2c071bc080d8f9b7cb225b405165b21001071bc0e0e032a7cb225b40934b18cdca061bc03307685bcd225b40537b293c68061bc08347425bce225b40156b97361c061bc0c321405bce225b4053b69c2f1c061bc08f802431d0225b40c7aa815511061bc05b30f294d0225b40c5d4a14c03061bc08f802431d0225b4043331dcae8051bc0a3d17c82cf225b40979ff899c9051bc083d2a237cf225b4086d778e3b6051bc02b839650cf225b408e121ef09d051bc083d2a237cf225b400a71996d83051bc053c91638cf225b40cf0a967d66051bc0c3d056cdcf225b40422355d94c051bc0af7ffe7bd0225b40ca73700437051bc07b2fccdfd0225b4012c1666c27051bc0bb1ba52ad1225b4052d2eb811c051bc05f17e7a0d2225b4015262c7000051bc08bc442e5d3225b409484a7ede5041bc09760e993d4225b4007b90274d9041bc0830f9142d5225b40d4dffda7d1041bc0175d51bfd5225b40cfed5dfacc041bc09b59b9ead6225b40c4ed5dfacc041bc01f562116d8225b4044f89889ce041bc0579f2309da225b4013b90274d9041bc0b69cb1e9da225b408f920740e1041bc01a9a3fcadb225b4016b90274d9041bc08ee825fcdb225b4007ffe20fc2041bc0e23732e3db225b4094333e96b5041bc0c2385898db225b40c98034fea5041bc0633bcab7da225b4048b19e7b8b041bc06ee94bb1db225b40111730c578041bc052e341bddd225b4010e286123f041bc056ca36c9df225b40967ba79c0b041bc0ca331ef1e0225b4051e5126fe6031bc0ae8dfeeee2225b4052d796c7bb031bc06290e5d4e3225b40d69866efc9031bc09e046a25e5225b40cf2637efd7031bc026e9b76be6225b404a6781b5eb031bc056445d6ce6225b40033481bfeb031bc0f2f1af6ce6225b40451ad7bfeb031bc0ade0de35e8225b40068c564607041bc015638731e9225b404aa6b1bd16041bc0bd057f46e9225b409013f48617041bc0f1585a46ea225b40c655ef703b041bc029bb8784ef225b4051b80e66f7041bc0b9ab7ea5f3225b408e7f9bc58b051bc068f49194f4225b40161518b2ba051bc0516c727ff5225b4005c561c1e1051bc0e4c7866ef6225b404593f8dc09061bc001455376fa225b40c64d3176de061bc08880b0dafc225b40d40eb3976d071bc0e8b49597fc225b40434d7d2079071bc0a108d0effb225b404eff39cc97071bc0348627f4fa225b401711a5bdc1071bc0acd02edffa225b40855d0f26c5071bc0f10dace3f8225b400f1397e315081bc0c1bd5983f7225b405114e3fc4d081bc04dc5b46fee225b4094eda581030a1bc0e23f83d4ed225b40067d7214200a1bc02d710b96ea225b409209cc9ba70a1bc0bd4bd05fe8225b40873a3602f10a1bc031278fa7e5225b40d846d6724b0b1bc0d138fb03e5225b408e8bab395c0b1bc04501c974e8225b40cc2f6e129f0b1bc09dca9063eb225b4053d93a38d80b1bc0b92db81ff0225b40917d703e750c1bc03d4449cff4225b40114fabe80f0d1bc0c8c880ecf5225b4096fee89b340d1bc0781194dbf6225b408ce9cbd24e0d1bc0514a2f6af7225b40c87400c45d0d1bc0ac646fdbf7225b40512d3a596a0d1bc0a444b850f9225b40cf6e29e78b0d1bc07c663733fa225b40d1f386fbc80d1bc0c1a3b437f8225b4004ad669df10d1bc0f4f68f37f9225b4056c0aa7af90d1bc0b7fcae08fe225b409427efe3680e1bc030680fb402235b4053f3df83d70e1bc0bfda3f4f03235b40cc0232cced0e1bc09fa7ef3504235b4089f42b9d0f0f1bc06c32d12005235b408ad16ef4310f1bc03c68e30f06235b40058dcd8e540f1bc07c6978b306235b40575bcaf9620f1bc088e3e7bf07235b400da4dc7d8e0f1bc088af9d2809235b40d421179cc10f1bc0d7bb2d910b235b40d19157e718101bc022f1e0270e235b40115181936d101bc0bec3db8310235b4005a25c1abf101bc0be75eca012235b40505a6f2c0c111bc0293393a817235b40570489edee111bc089b9921d1b235b408ced7893df121bc0992484471b235b409635e50aef121bc0d5d4a0681e235b400ebef38b12141bc061abf23d23235b404cd840bad8141bc0ed037e8d24235b40146740bd19151bc02466abcb29235b408c56941282151bc0305ee15d2e235b4054bfb167cf151bc0586fc2bd32235b40c8c9f962ef151bc033ea0fcd3c235b4013ee022505161bc0665bbbed42235b401234f0a31a161bc0cf27e0d748235b408db582a625161bc0ae8cfb8f4c235b4088e0085229161bc0b21d87484d235b409ab582a625161bc08600952a51235b4098e9043411161bc09e7ad89255235b40d0e09524cf151bc0f912df8959235b40d13556629e151bc03d71b54e5c235b4055c3961e4d151bc0f1857ea65e235b404ec1a4f8f8141bc07aed87d860235b401390d5ad9e131bc0b083ffad64235b4050675bcd3a131bc0fccab9c667235b40586e6589ce121bc0c8de88a06a235b40ce017d224f121bc035e795eb6d235b408b63f775c4111bc02014109370235b40ca1d317a6e111bc0940d9ffb72235b409121895e46111bc0331e210379235b404b355a0ef4101bc07b4f61a582235b40105625917d101bc02a60b7088c235b408c476c770f101bc0755a6ddd91235b4014c660b1c50f1bc099b4410092235b408cf2121ac30f1bc09963fa0092235b409182dd22c30f1bc086e1a20a94235b40595702e2db0f1bc0),
+(2, 1, 'Harjamukti', 0x00000000010600000001000000010300000001000000f0000000df1bed9512235b409662aeac37f51ac034a64e4013235b400fbef2203df51ac0532bb91116235b400c1ea16648f51ac0df954be317235b408c85d8f143f51ac02ece87191a235b408f284fb14ef51ac0830e62191b235b40f87d4fac53f51ac01a15a70c1c235b405551566458f51ac0baa4d9ee1e235b40b812268c66f51ac065ea633e20235b40511dd4d66cf51ac0c6d8f7e120235b40b1b59c4b71f51ac077bda1772a235b4005db4b1aa3f51ac0c08c170b43235b40c77a7651d8f51ac0efe31de049235b40170320eeeaf51ac026f85b3b51235b40b41166dafef51ac01690ab915d235b404881666820f61ac062599f245d235b40ca4dfd14abf61ac0d032dc2357235b40656dcae8acf61ac04fbc001a57235b40e69b8927b1f61ac02a5878ee52235b40bdd9475eb5f61ac070fb25df51235b4006e053e7b4f61ac08764aef34c235b4005802454bff61ac000e77adb4c235b4097eb6987bff61ac04c97b37746235b40d4f5b182dff61ac0706a554b3a235b409547996533f71ac0b1bbad7b2b235b40f719a19fa9f71ac0557f721420235b40cf421a1538f91ac0467c15191d235b40fe7bb3cd8df91ac0b0f709a018235b4082def94509fa1ac01ac8a17e17235b4005b29aae27fa1ac0c332242713235b40c75928999cfa1ac0cb8b3a730f235b404ed2973600fb1ac0c98486fe09235b40b2e9e6e26ffb1ac0c3632d3e05235b40fcbf4601befb1ac03c612f9b00235b40cddc054a0afc1ac0945a164cfc225b4014b97b1151fc1ac0fa80677bf4225b40031387dbbdfc1ac0df68ee21e1225b40d22d5393e0fd1ac0046c6798da225b400a13f99d42fe1ac077b66e83da225b408b9995ed43fe1ac0947a76f9d6225b40b6cf09a183fe1ac0f304e962d3225b40464d44bfb6fe1ac007a42424d2225b4006ab730cc8fe1ac0608450dec7225b4053effacc59ff1ac0e15bb8acc2225b407942554ca5ff1ac0fd74637ac2225b4045839ecdaaff1ac0350427dbc0225b4044658522ddff1ac0ad68d311c0225b4045ffffffffff1ac0e007abaaaa225b4054ffffffffff1ac026191800aa225b4044ffffffffff1ac02b8435cea6225b403effffffffff1ac080f518e599225b4050ffffffffff1ac07b98d7c397225b400c3d97a949001bc04779465b95225b40cdd9368c82001bc009be02d193225b400c3368e89f001bc015d450a390225b407ef9b48afe001bc088b7adb48c225b40c2f74ecc7a011bc0069a689082225b400bc1a6cea3021bc0ae3835d07c225b4033dbf3fc69031bc04aa70ef27a225b40011e95f3a9031bc0c6dbe38574225b40fde71fd594041bc0b235f44f70225b408811da722e051bc05a0721d26f225b40b04d250340051bc06a0b68226c225b40861ebaa0be051bc04f40ac376a225b4046d39b51f3051bc0aaa552ec68225b40d01d217216061bc0030bf9a067225b40cba2e4d539061bc0dbdf72f563225b40d1b9f3c473061bc053c658a65f225b40cbc1bcc799061bc00f1a93fe5e225b40827af65ca6061bc0f7f1694e5e225b40116ad784b4061bc057e89bbb5d225b401287bd50c0061bc08d4a3dbd52225b4084dfbbcd1b071bc070bb69334e225b40831e2c6343071bc0e5ca72124a225b400d11329067071bc0ef82b67c34225b402d3b260e7d081bc077ca328034225b40d0f75c1a7d081bc0630048e02e225b403a46bd38d5081bc0c09c831e2c225b40ce1a192d05091bc0cc1cf03b1e225b40b2d8194644081bc0541963231a225b40fdb22a7307081bc05057d70e17225b40f8e4329beb071bc0928dec2c15225b4004e84097ef071bc06d80fd4014225b400615e90efa071bc05a7600b512225b40c11a8b82ed071bc0da42a58e11225b4087b9f6e4e5071bc03551c5a910225b40892b8353e7071bc0c1c05f7b0f225b40c04f2752fa071bc0fe884ef502225b40b1cd8e1c35091bc0b7e5759700225b400e0f765a1c091bc0aa47257502225b408df7c8ae00091bc03eef8a4602225b406bb5f3fdd4081bc05af83074ff215b40befa3a70ce081bc01ac5ef39ff215b4001749586cb081bc0e2bf67fefa215b407bdc267448081bc007c4cb13f5215b400e756bd1b6071bc06b0cfee4f3215b4034d2b0b292071bc0e7b1fef0ef215b40f33d20fb19071bc08f36d654ef215b40c66b711108071bc0c71c4b62ed215b404d0f4fe1ce061bc080476f23eb215b4003fa99f6a4061bc03dee6244e9215b408c9d500190061bc050ec0016e6215b4075d3ed0881061bc00d93f436e4215b40c44c599764061bc04477615fe3215b4046f4d52548061bc0b1427a2fe3215b4046a5bdaf34061bc0994b0c07e4215b40c31a63ba1f061bc08b4c3d9ee5215b40c5cf10c804061bc05ce49fd5e6215b408fb69559e2051bc045ed31ade7215b40ceea536dbb051bc0e19de83ce8215b408a0776068a051bc06905e544e8215b4085aa8f9e5a051bc034615861e7215b40ef510c2d3e051bc0e08cadb9e6215b40434c67b52d051bc0f8831be2e5215b408e44dffb20051bc0bd63bf9ee4215b40430115c312051bc011bb694fe3215b4072fd528dfe041bc0993ad183e2215b40efe97c96ec041bc0f40d4b94e1215b406c95bfa8c8041bc0c069beb0e0215b408946a732b5041bc0a9386f55df215b4004b886b9a7041bc054a7e371de215b40bc4002bba4041bc0b5373ebedd215b408ac5b738a9041bc0adfe8d22dd215b40b00a65b3b3041bc0303bd692dc215b40b88b54adbf041bc0dda94aafdb215b4005d3e469c6041bc00d12e877da215b40c5597d29c7041bc06e28814cd9215b400852f56fba041bc056b4122dd8215b4086c79a7aa5041bc0298c55a9d7215b40c8ef060395041bc0f9d3a6c4d7215b405068006f81041bc03984f468d8215b40429966d374041bc09ed33dd9d7215b408d61ead76b041bc0e492c367d8215b403d8e51065d041bc066acb1ecd8215b40b28d1ce453041bc05a802550d7215b404da36ecf42041bc065acb1ecd8215b402ee8633e20041bc03e3aa5fbda215b40ca3ee962d8031bc0dda94aafdb215b40c6f75cf4b5031bc00d8fe86edc215b40ca8fc90f80031bc0840f813add215b408daceba84e031bc0c836192dde215b400d68056f10031bc031813e48d1215b4001e36440c8021bc0fb543dbfc6215b40bc6cbfb45c031bc08e71554ec5215b4042b2e6fa50031bc06fdca1bfc2215b4040513a0f29031bc05c1c4bebbe215b4089c705cfd3021bc038da4368b5215b40bb1e63ee5a021bc055e05a86b5215b40bf62dca206021bc0e71442b6b5215b40361327bad8011bc08b1822f6b5215b4078fbedccb3011bc0799ee547b6215b403f7ef63f9a011bc09494f155b6215b404b6728dc95011bc09c10c1b5b6215b4081935ae87d011bc02c6e4e95b7215b40494b8cf85d011bc03fe2bcb4b8215b40017a420741011bc0881223e4b9215b40751df9112c011bc08f336853bb215b40fb551a1c18011bc09c54adc2bc215b400cd52a220c011bc0b0f1c191be215b408ccbbf2603011bc0b29637a1bf215b400ece4329fe001bc0f34ace70c0215b40cfba6d32ec001bc0e4b3b8b3ba215b40c63dd88f38ff1ac0f77470ebb9215b407576c7f7fffe1ac0f7809cb4b9215b40c731a579f0fe1ac074a2ea55b9215b40af7a16b9e4fe1ac011a2f99cbb215b4006881cb3d0fe1ac0a48b10a5bd215b40ae119f3bc1fe1ac0bbc45682c5215b40cc06b13385fe1ac03b3f096cce215b40fe5acb6438fe1ac006a5f2fdd4215b40f33d4be812fe1ac0b61cf33ae2215b40b4533541d4fd1ac05d8ab663ea215b40778e34b8adfd1ac0e4a3d0b2ee215b400d9f53909ffd1ac05cba8d06f0215b408c8d0244c1fc1ac057b2ef3cf1215b40fbb2cbb73efc1ac0b826e82ff4215b40907e30f0dcfb1ac05b59ad4cf8215b40885b001aa5fb1ac0d242d47d00225b40ceac68739cfb1ac0fa53b5dd04225b40bc7a65deaafb1ac045bdb20b06225b4076f2bb4198fb1ac046bdb20b06225b40877919c572fb1ac0bb4b6cc5fe215b40c6b9490c02fb1ac0cb165454fd215b407b5df3aacefa1ac0568984effd215b40ccd3fdf483fa1ac096107ee200225b4085270cca34fa1ac0d69777d503225b40b4a9d1ab01fa1ac05103d88008225b405277280af4f91ac081389b4011225b40f16ed23428fa1ac0a1de5ed218225b40119acb0d86fa1ac0292cc3b81b225b400bc5c4e6e3fa1ac054bfdd921c225b40b74fd25433fb1ac0b1bf78b81d225b40bc23b37a87fb1ac0bd27e1421e225b404ddc5f3deefb1ac06991799620225b40cc71dc291dfc1ac0f3890e8123225b40fa8f745e47fc1ac0f3c099982e225b40baa2acdf4cfc1ac0334cbe6037225b40b0ff9b6f28fc1ac0eeef3ec747225b40fc93f3c5defb1ac041bd318b50225b40c6009082c3fb1ac03d6331ea5a225b4002351210affb1ac0349e5b9a5b225b40b9da1324b6fb1ac00b7eb43863225b40f55ce6e3f6fb1ac0ee1dcefc6a225b40b354f65d11fc1ac06b43dd8e70225b40c40832022afc1ac0c64378b471225b40fa6a5f402ffc1ac061e2287975225b4009ff8f1326fc1ac016b4295778225b4091bda08504fc1ac0b1ba6e4a79225b4076f514a8e1fb1ac0cad0907879225b40118c2ac3b8fb1ac0a169226c78225b40071b446b45fb1ac0fa80d93d79225b404a12d55b03fb1ac0cf852a357b225b4091d762b7cffa1ac00a9edb847b225b40b6108942cbfa1ac0cdf1e9387e225b40c0d118ada3fa1ac0ee8be42b81225b40f8071a3389fa1ac08d2d1e9084225b408dd570917bfa1ac03d7a5c548b225b4008b167cf65fa1ac02712acaa97225b403d5d6743fef91ac0cc5aa31ea2225b40bfe45df580f91ac023246b0da5225b40bf8dab915df91ac08b0a3543aa225b400fa547533df91ac07f632f14b0225b40732fd63730f91ac013dd1324b6225b409020904b1cf91ac055ff0188bb225b4044f96184f0f81ac06d673e6ebf225b40fb0b0055dcf81ac0ae08ddacc1225b40b523a872bef81ac00ca89718cb225b40f95a69aa0bf81ac048e1a128d0225b40393f439067f71ac095c865dcd4225b4008f63e5585f61ac04792c8f0d8225b40362d73ba2cf61ac0477c4e7adf225b40450e9bc8ccf51ac0a7719692e5225b4054197cf294f51ac01954c12ff5225b404a4ffeee1df51ac0324b29e8f6225b40c7193048faf41ac0ed84bef4f6225b40c75cf8c1f9f41ac0ed0a2344f9225b40d8f6d8ee02f51ac019751af0f9225b404a21533e04f51ac07440390a10235b40341b7c6132f51ac0033c579412235b40407da99f37f51ac0df1bed9512235b409662aeac37f51ac0),
+(3, 1, 'Kalijaga', 0x000000000106000000010000000103000000010000008f010000b072e481c8235b4036e26f7b82041bc076698570cc235b4008f3a78dea041bc04afea36fd2235b407adc240681051bc082941b45d6235b400643c362d4051bc089b2b807da235b400b1a2fdd24061bc05d14db38dd235b4056a5abf23f061bc091a72c99dc235b40db15685181061bc0a6712f8cdb235b409706b9c5db061bc0ff1eeb21db235b40c016c18f02071bc0e18207eada235b40136be10a0c071bc031b23c82da235b40c63302821d071bc0596f6529dc235b40fd78affc27071bc01ab24bf4dc235b408aab9e0333071bc011712356e0235b4048915ddb6e071bc0c894176ee0235b40516d544992071bc0b5740396e0235b401941223daa071bc001cddedde0235b40985719a9d1071bc0f05876c1e0235b40c3d3264eee071bc011712356e0235b40557b58517e081bc0aada5859e0235b408825cdd08c081bc025986167e0235b40f8c87e43cc081bc0d110e7cde0235b404b0ccbd91a091bc0bdadb331e1235b409ab92644c3091bc0984b6cc5de235b4057577fac290a1bc0b1b8f4c8dc235b40587c1c00830a1bc08123f786d8235b404f272887470b1bc095a91370d2235b40b9fbfc69360c1bc07203faddd1235b408ebe9f295a0c1bc04f158dbdd0235b4080ba3fae720c1bc067099756cf235b405295fec07d0c1bc09ee473efcd235b40177172108b0c1bc04616dcaacc235b40bdb2990ba80c1bc0171d9fd8c9235b404b98b9e1f30c1bc06a2634dfc7235b408637852d270d1bc0875de0bdc6235b403f0a9de14a0d1bc0232ada99c5235b40c98e2d24900d1bc0aef487e0c4235b40cfee1762de0d1bc08fdda64ac4235b40d60c4f1c310e1bc0d0f75255c1235b408160c9fc730e1bc0a6ad4525c4235b40b929b7f9ee0e1bc0a31766dec0235b40423f9320030f1bc066e9c8c2be235b401a483047220f1bc09c1a0ef8ba235b40975ac330530f1bc04faca39eb6235b400fd6ce587d0f1bc0f445ec83b4235b4078dae24f910f1bc08cb2b096b1235b40db7415856f0f1bc00b85a680af235b405a471d52490f1bc060805820ae235b40f6131a9f010f1bc0ecf4cf71ad235b40093755a3c90e1bc084ae359eac235b40d8a4a04da10e1bc0d9e7d83aab235b40f8f66ea27f0e1bc03514b592a8235b40055e082f7d0e1bc068a8b954a6235b40c7d12b6d8a0e1bc060b996d3a2235b4016aedfc0a20e1bc0894c2d759f235b40d6ef9183c80e1bc0d2bd3fe89d235b40508fb66bee0e1bc07a9136a29c235b407e4e524c1d0f1bc040f782cb9a235b404a250b075e0f1bc051ddf44198235b400e4fa1769c0f1bc0a24ba20196235b409244c2c9c60f1bc086e1a20a94235b40595702e2db0f1bc09963fa0092235b409182dd22c30f1bc09ab4410092235b408cf2121ac30f1bc0765a6ddd91235b4002c660b1c50f1bc02a60b7088c235b408c476c770f101bc07c4f61a582235b40105625917d101bc0341e210379235b404b355a0ef4101bc0940d9ffb72235b409121895e46111bc02014109370235b40ca1d317a6e111bc035e795eb6d235b408b63f775c4111bc0c9de88a06a235b40ce017d224f121bc0fdcab9c667235b40586e6589ce121bc0b183ffad64235b4050675bcd3a131bc07aed87d860235b401390d5ad9e131bc0f1857ea65e235b403cc1a4f8f8141bc03e71b54e5c235b4042c3961e4d151bc0fa12df8959235b40d13556629e151bc09e7ad89255235b40bde09524cf151bc08600952a51235b4086e9043411161bc0b21d87484d235b409ab582a625161bc0b08cfb8f4c235b4089e0085229161bc0cf27e0d748235b408db582a625161bc0675bbbed42235b401234f0a31a161bc035ea0fcd3c235b4013ee022505161bc0586fc2bd32235b40c8c9f962ef151bc0305ee15d2e235b4054bfb167cf151bc02466abcb29235b408c56941282151bc0ee037e8d24235b40146740bd19151bc061abf23d23235b404cd840bad8141bc0d5d4a0681e235b400ebef38b12141bc09a2484471b235b409635e50aef121bc08ab9921d1b235b4079ed7893df121bc0293393a817235b40570489edee111bc0be75eca012235b40505a6f2c0c111bc0bec3db8310235b4005a25c1abf101bc023f1e0270e235b40ff5081936d101bc0d7bb2d910b235b40d19157e718101bc088af9d2809235b40d421179cc10f1bc088e3e7bf07235b400da4dc7d8e0f1bc07b6978b306235b40575bcaf9620f1bc03c68e30f06235b40058dcd8e540f1bc06c32d12005235b4077d16ef4310f1bc09fa7ef3504235b4089f42b9d0f0f1bc0c0da3f4f03235b40cc0232cced0e1bc030680fb402235b4053f3df83d70e1bc0b8fcae08fe225b408227efe3680e1bc0f4f68f37f9225b4056c0aa7af90d1bc0c1a3b437f8225b4004ad669df10d1bc07c663733fa225b40d1f386fbc80d1bc0a544b850f9225b40cf6e29e78b0d1bc0ad646fdbf7225b403f2d3a596a0d1bc0514a2f6af7225b40c87400c45d0d1bc0791194dbf6225b408ce9cbd24e0d1bc0cac880ecf5225b4083fee89b340d1bc03d4449cff4225b40114fabe80f0d1bc0b82db81ff0225b40917d703e750c1bc09eca9063eb225b4053d93a38d80b1bc04501c974e8225b40cc2f6e129f0b1bc0d238fb03e5225b408e8bab395c0b1bc032278fa7e5225b40c646d6724b0b1bc0be4bd05fe8225b40753a3602f10a1bc02e710b96ea225b409209cc9ba70a1bc0e23f83d4ed225b40067d7214200a1bc04ec5b46fee225b4094eda581030a1bc0c2bd5983f7225b405114e3fc4d081bc0f10dace3f8225b400f1397e315081bc0acd02edffa225b40855d0f26c5071bc0358627f4fa225b400511a5bdc1071bc0a108d0effb225b404eff39cc97071bc0e9b49597fc225b40434d7d2079071bc08880b0dafc225b40d40eb3976d071bc001455376fa225b40b34d3176de061bc0e4c7866ef6225b403393f8dc09061bc0526c727ff5225b4005c561c1e1051bc069f49194f4225b40161518b2ba051bc0b8ab7ea5f3225b408e7f9bc58b051bc02abb8784ef225b403fb80e66f7041bc0f2585a46ea225b40c655ef703b041bc0bf057f46e9225b407d13f48617041bc016638731e9225b4037a6b1bd16041bc0ade0de35e8225b40068c564607041bc0f2f1af6ce6225b40451ad7bfeb031bc056445d6ce6225b40033481bfeb031bc026e9b76be6225b40356781b5eb031bc09e046a25e5225b40cf2637efd7031bc06390e5d4e3225b40c49866efc9031bc0ae8dfeeee2225b4052d796c7bb031bc0cb331ef1e0225b403de5126fe6031bc057ca36c9df225b40837ba79c0b041bc053e341bddd225b40fde186123f041bc070e94bb1db225b40ff1630c578041bc0633bcab7da225b4048b19e7b8b041bc0c3385898db225b40b78034fea5041bc0e23732e3db225b4094333e96b5041bc08fe825fcdb225b4007ffe20fc2041bc01b9a3fcadb225b4016b90274d9041bc0b89cb1e9da225b407c920740e1041bc0579f2309da225b4013b90274d9041bc01f562116d8225b4044f89889ce041bc09c59b9ead6225b40c4ed5dfacc041bc0185d51bfd5225b40d0ed5dfacc041bc0830f9142d5225b40c1dffda7d1041bc09760e993d4225b4007b90274d9041bc08bc442e5d3225b409484a7ede5041bc05f17e7a0d2225b4015262c7000051bc0bb1ba52ad1225b4052d2eb811c051bc07c2fccdfd0225b4013c1666c27051bc0b07ffe7bd0225b40ca73700437051bc0c4d056cdcf225b40422355d94c051bc053c91638cf225b40cf0a967d66051bc083d2a237cf225b40f570996d83051bc02c839650cf225b408e121ef09d051bc084d2a237cf225b4072d778e3b6051bc0a4d17c82cf225b40849ff899c9051bc08f802431d0225b4043331dcae8051bc05c30f294d0225b40c5d4a14c03061bc090802431d0225b40c7aa815511061bc0c421405bce225b4053b69c2f1c061bc08347425bce225b40156b97361c061bc03507685bcd225b40537b293c68061bc0e0e032a7cb225b40934b18cdca061bc080d8f9b7cb225b405165b21001071bc0c8581392cc225b400ec37b0e2c071bc0433e0feece225b404799417c60071bc0fc5561e5d0225b4040b94c4d82071bc0d70d81aad1225b40059c3ffeb6071bc0a4f858fad0225b40c55cdc4603081bc0cbd962b7cf225b4081a813d044081bc0848d9374cd225b4055ba7ec16e081bc0007c17efc7225b40904d7cb5a3081bc06845fb58c1225b4085d6cb5ed2081bc0a1d4beb9bf225b40cfa9d216d7081bc065bc0d6abf225b40bf00857afa081bc08c95795dbf225b40f5dc21c500091bc045e9b3b5be225b4052196d5512091bc09d657616bd225b40c7dc94f25a091bc0257b6590bb225b40c58a5f0988091bc0f5e4c116bb225b40f351df8aa8091bc081b45f77ba225b4033c8f6b2d1091bc036866055bd225b4006d55757050a1bc09d99c0adbb225b40b22f629f000a1bc078c3dd59bb225b40b4754f1e160a1bc060cffe40b9225b40fa4219aa620a1bc05d0e9ce5b2225b40b733b918030b1bc08754b131af225b40078cb7955e0b1bc0b1d199b4a9225b4046eaff1ce60b1bc0829bec9fa7225b400b1122880f0c1bc0517c5b37a5225b408191e7fa3e0c1bc0cee3900da4225b4013aacfd5560c1bc0e2ea60fd9f225b4042dad2fbaa0c1bc077954e2498225b40786ab8c83d0d1bc037429fc893225b409109d7a3700d1bc08bea0df791225b40cca5d0798d0d1bc01ccd708a8e225b4009ed0912db0d1bc04ac4f4bd86225b40cc6e8ecb9c0e1bc0036ebcc282225b40c931aa0ce30e1bc09d4b0a2c80225b400b7949f4160f1bc05edeb5847c225b40af1c0071570f1bc0a169226c78225b408ef0ac35780f1bc03f62d3d171225b408de1d976be0f1bc0726394196f225b4004db90ee03101bc08e9bf95c6d225b403f58a48977101bc04ff2bc546c225b4038839d62d5101bc05ad4c08f6a225b40b1fad92077111bc0dae39dca69225b40be625e471c121bc0571789096a225b400878944a78121bc0a86ec7d45d225b4049374bc972121bc0f44b969350225b408a5a087250121bc0be9fec6646225b40837dc51a2e121bc019bb162d40225b400487670932121bc0663705323b225b407653f1d748121bc09e7c4ca434225b4030a8244372121bc0fe8afc6a36225b4090ccf35445121bc03998e90e37225b4080803a2235121bc0211e370939225b404168e017fc111bc07161435e39225b40bbdb185ef0111bc03b282d6e39225b407988762cee111bc0530c208439225b40784dd325eb111bc09e1e0e9439225b40078aa7f3e8111bc09ea4cf9d39225b403973409be7111bc07fab3af039225b404a056b3edc111bc0ec1533eb3a225b400f8c84a4b9111bc08a35af9c3d225b400f598bd53a111bc0669b20fc41225b40429ff023c8101bc029dcf7ba42225b40ba77e81e86101bc0aa5d7b2544225b40ba09b1074e101bc04dc3c44a45225b4031d4539212101bc0bde53d8045225b400deac1d7e90f1bc05630a07046225b40bdc7990fd00f1bc00d2da02a48225b4045ebec96aa0f1bc0e68a40cf48225b4007a16f0d970f1bc05299271c49225b406e762db4820f1bc0fdc4951149225b404744ee686a0f1bc0127d089149225b407c4c3c60510f1bc09806172b4a225b40c8a16e8b250f1bc05c2bb7104b225b400802bad1f50e1bc00161cd8f4c225b408436e441a80e1bc03719d5bf57225b407dce46a4290d1bc057d004c55a225b40af41083fa60c1bc004b229bd5a225b408b2e8ae1940c1bc064057eab5a225b402e221ed26d0c1bc02ca566335a225b404c7893056a0c1bc04f7d19c25a225b406c4a317c4e0c1bc027149d605d225b4003b077e4e70b1bc06fee6ebc60225b40ca4778a8910b1bc04fa5d51c62225b4091d4609b060b1bc0c88114685c225b40c56201ffd30a1bc09f5b266858225b40b8584a91da0a1bc0793823e847225b400f40db533a0a1bc0fd9142f945225b4050ebda8d270a1bc03902edbb40225b4041517025f9091bc091dc96753d225b408b7da231e1091bc0fdb4de0037225b4090b16045ba091bc0628e572332225b40bb8e7adb8e091bc0846c4fbc2f225b404a0f7f6673091bc09e7b02dd2e225b4078fe7a6e69091bc0ff56a3a82a225b407614d3ad20091bc0dea15d322c225b40b95dea4006091bc0c09c831e2c225b40ce1a192d05091bc0630048e02e225b403a46bd38d5081bc077ca328034225b40d0f75c1a7d081bc0ef82b67c34225b402d3b260e7d081bc0e5ca72124a225b400d11329067071bc070bb69334e225b40831e2c6343071bc08d4a3dbd52225b4084dfbbcd1b071bc057e89bbb5d225b401287bd50c0061bc0f7f1694e5e225b40116ad784b4061bc00f1a93fe5e225b40827af65ca6061bc053c658a65f225b40cbc1bcc799061bc0dbdf72f563225b40d1b9f3c473061bc0030bf9a067225b40cba2e4d539061bc0aaa552ec68225b40d01d217216061bc04f40ac376a225b4046d39b51f3051bc06a0b68226c225b40861ebaa0be051bc05a0721d26f225b40b04d250340051bc0b235f44f70225b408811da722e051bc0c6dbe38574225b40fde71fd594041bc04aa70ef27a225b40011e95f3a9031bc0ae3835d07c225b4033dbf3fc69031bc0069a689082225b400bc1a6cea3021bc088b7adb48c225b40c2f74ecc7a011bc015d450a390225b407ef9b48afe001bc009be02d193225b400c3368e89f001bc04779465b95225b40cdd9368c82001bc07b98d7c397225b400c3d97a949001bc080f518e599225b4050ffffffffff1ac02b8435cea6225b403effffffffff1ac026191800aa225b4044ffffffffff1ac0e007abaaaa225b4054ffffffffff1ac0ad68d311c0225b4045ffffffffff1ac0350427dbc0225b4044658522ddff1ac0fd74637ac2225b4045839ecdaaff1ac0e15bb8acc2225b407942554ca5ff1ac0608450dec7225b4053effacc59ff1ac007a42424d2225b4006ab730cc8fe1ac0f304e962d3225b40464d44bfb6fe1ac0947a76f9d6225b40b6cf09a183fe1ac077b66e83da225b408b9995ed43fe1ac0046c6798da225b400a13f99d42fe1ac0df68ee21e1225b40d22d5393e0fd1ac0fa80677bf4225b40031387dbbdfc1ac0945a164cfc225b4014b97b1151fc1ac03c612f9b00235b40cddc054a0afc1ac0c3632d3e05235b40fcbf4601befb1ac0c98486fe09235b40b2e9e6e26ffb1ac0cb8b3a730f235b404ed2973600fb1ac0c332242713235b40c75928999cfa1ac01ac8a17e17235b4005b29aae27fa1ac0b0f709a018235b4082def94509fa1ac0467c15191d235b40fe7bb3cd8df91ac0557f721420235b40cf421a1538f91ac0b1bbad7b2b235b40f719a19fa9f71ac0706a554b3a235b409547996533f71ac04c97b37746235b40d4f5b182dff61ac000e77adb4c235b4097eb6987bff61ac08764aef34c235b4005802454bff61ac070fb25df51235b4006e053e7b4f61ac0dcda88ca52235b405888a242b5f61ac04fbc001a57235b40e69b8927b1f61ac0d032dc2357235b40656dcae8acf61ac062599f245d235b40ca4dfd14abf61ac02e06c4245c235b40186ad784b4f61ac0e68d48145a235b400e840b7904f71ac017462fa358235b40546d88f19af71ac0eaaf8b2958235b40928edfdbf4f71ac0de47239f57235b409837a0a52bf81ac0fe4ec7ea56235b40ccc778944af81ac0c76de9d154235b4089987381aff81ac07fd414014e235b40c1c39272f7f91ac0c4b424404d235b409784fb5910fa1ac04f5078094e235b401374763238fa1ac093e298654f235b407261d8614cfa1ac06e299cdd5a235b400adf844204fc1ac09abcb6b75b235b403b64e25641fc1ac0876b6ad95a235b40d4fb5069c4fc1ac0feb571c45a235b40cd5203cde7fc1ac02f06c4245c235b40f7bcf90d13fd1ac02eb8d4415e235b40fc7f7b9e3ffd1ac057538de55d235b40f5b04b546ffd1ac017c4f5285c235b40ca9cd497a5fd1ac0ff0361a758235b40f6528f34b8fd1ac00f1aaf7955235b4089c80050c5fd1ac0ce6609b952235b4040c010cadffd1ac04388191a4f235b40cf27166728fe1ac037f404c24e235b4045bdf8a23dfe1ac0265c7d7555235b4053273b8e03ff1ac02ebc473657235b4093237d5a45ff1ac066fd1bb457235b40cdd937f757ff1ac0befa2d3a59235b408aeec7ed97ff1ac0025904ff5b235b4056ffffffffff1ac0cae05c4a5d235b40c0cb237f30001bc08615dd2c5e235b4099fd99417c001bc09520d8f15f235b4000e716ba12011bc0aa7124d060235b40466ef3c649011bc0e44a387b67235b40cbdadb2dc9011bc0010e569c6a235b400f56975302021bc029a170766b235b404880506f2a021bc09e02940d6b235b40d036a5bc56021bc012c0823463235b40884a02d4d4021bc07dde09f65f235b405b5371e316031bc065cc129d65235b40d74a8e3ba5031bc0fc9e0df967235b40da153f1ede031bc025e438f06a235b407cfe158a58041bc0dc1dcefc6a235b40d737707687041bc02def5f5969235b4044240516c0041bc0c1baa64066235b40caff6e8104051bc0396d425a63235b403df274ae28051bc0f67600c45d235b4036b775374f051bc024217f6951235b400a5521768a051bc08197ce8767235b404ff0f44a59061bc0f88336397c235b40560fcd3cb9061bc07ddb8f1491235b401b0c1b65fd061bc0e9c80dbf9b235b403b7ce9edcf051bc089d60627a2235b40c1cdde196d051bc07dfbb660a9235b407a2047e201051bc0bfa30914b1235b403a592db0c7041bc06b8015e0bb235b409669b2d79f041bc08f0c3426c4235b4036ff55478e041bc0b072e481c8235b4036e26f7b82041bc0),
+(4, 1, 'Kecapi', 0x00000000010600000001000000010300000001000000c10000009a215f4205245b400f5b499a5bf11ac0def6471806245b40dbafe2546bf11ac0961638d906245b40d27ddfbf79f11ac0db740e9e09245b401c54a52daef11ac0aa5c31aa0c245b4090a97ea5f3f11ac0f21afed30d245b4052aa24b20ff21ac03e84fb010f245b40d36702452cf21ac06695dc6113245b409e3618eab0f21ac02d3a639813245b4056b3857808f31ac0fdcf6bec12245b4019bfb33d7af31ac0a6b8b41a12245b4052da334b02f41ac041f343a511245b4016f7f2934ef41ac006db925511245b40562538f581f41ac0e104b00111245b40918f2e36adf41ac09a58ea5910245b40cfff6e8104f51ac0cadc865110245b40d02af52c08f51ac0fa60234910245b401392399677f51ac0369379e40f245b40d55d2a36e6f51ac09b728fa50f245b4012b75b9203f61ac027422d060f245b400965c0594af61ac0877eb08c0d245b40d07e677bf4f61ac0fec8b7770d245b4093a76e2efef61ac04e4fe3900d245b409361f4dc42f71ac0ae4515fe0c245b4092c0d59272f71ac0ce5ec0cb0c245b40cd9f3193a8f71ac06e44805a0c245b408b8808ff22f81ac0323ed68c0c245b405d483e077af81ac05bd1f0660d245b4014f748dbdcf81ac0c66d3eae0d245b409ee311dc48f91ac01be262450d245b401d19e0826cf91ac07bea9b340d245b40d327266f80f91ac00a77711b0d245b40961cab949ef91ac09eae77060d245b405bdd137cb7f91ac027901ce90c245b404dabaa97dff91ac017252bbf0c245b40ce71d0b67efa1ac086c401a60c245b400fa4ec85e6fa1ac002ba39950c245b409845240a2dfb1ac0fb0e09910c245b4090701de38afb1ac0323ed68c0c245b401e2f3b33ddfb1ac04b54f8ba0c245b40db1a2ac6f9fb1ac06e5687dc0c245b400d82fb010ffc1ac07bea9b340d245b40d4f3143944fc1ac001ba39950c245b40db3e0c0550fc1ac02b82885b05245b400a1bb62dcafc1ac07b69942efd235b40d3da85e63afd1ac0f735d593f9235b40146262f371fd1ac0b730151bf3235b40d43e7ec7d4fd1ac074202e39ee235b400a8dcef929fe1ac0c4f5328ae5235b40d1a0c5c1c1fe1ac0ed67f4a3e1235b40957133dc80ff1ac0e1d3df4be1235b401cfbf43098ff1ac08d93054ce0235b405b658522ddff1ac069bd22f8df235b4058ffffffffff1ac04dbe1c76df235b401a2e51bd35001bc035ca3d5ddd235b400b9e0436e7001bc08198461adc235b408956b1f84d011bc091632ea9da235b4090cfed258d011bc045fa307bd9235b4057c79750c1011bc091b11d8cd8235b408b1af2cf0c021bc099bc44f5d6235b401152b29c84021bc0a69e4830d5235b40d614a75a0b031bc0c27cf5f1d0235b405606edd5c7031bc082f5fbfecd235b409a33cbf605041bc07a6c0e10cc235b40594ecc7a31041bc0fe981936ca235b4093811b295b041bc0ae72e481c8235b404ce26f7b82041bc08f0c3426c4235b404aff55478e041bc06b8015e0bb235b409669b2d79f041bc0bfa30914b1235b404f592db0c7041bc07dfbb660a9235b408e2047e201051bc088d60627a2235b40d3cdde196d051bc0e9c80dbf9b235b404d7ce9edcf051bc07ddb8f1491235b401b0c1b65fd061bc0f78336397c235b40560fcd3cb9061bc08197ce8767235b404ff0f44a59061bc022217f6951235b400a5521768a051bc0f57600c45d235b4049b775374f051bc0396d425a63235b4050f274ae28051bc0c1baa64066235b40caff6e8104051bc02def5f5969235b4056240516c0041bc0dc1dcefc6a235b40d737707687041bc024e438f06a235b408efe158a58041bc0fd9e0df967235b40da153f1ede031bc065cc129d65235b40d74a8e3ba5031bc07dde09f65f235b405b5371e316031bc011c0823463235b40884a02d4d4021bc09c02940d6b235b40d036a5bc56021bc029a170766b235b404880506f2a021bc0010e569c6a235b400f56975302021bc0e54a387b67235b40cbdadb2dc9011bc0aa7124d060235b40466ef3c649011bc09520d8f15f235b4013e716ba12011bc08615dd2c5e235b4099fd99417c001bc0cae05c4a5d235b40d3cb237f30001bc0025904ff5b235b4056ffffffffff1ac0befa2d3a59235b408aeec7ed97ff1ac066fd1bb457235b40cdd937f757ff1ac02ebc473657235b4093237d5a45ff1ac0265c7d7555235b4053273b8e03ff1ac037f404c24e235b4057bdf8a23dfe1ac04388191a4f235b40cf27166728fe1ac0ce6609b952235b4053c010cadffd1ac00e1aaf7955235b4089c80050c5fd1ac0fe0361a758235b4009538f34b8fd1ac016c4f5285c235b40ca9cd497a5fd1ac056538de55d235b4007b14b546ffd1ac02eb8d4415e235b400d807b9e3ffd1ac02e06c4245c235b400bbdf90d13fd1ac0feb571c45a235b40cd5203cde7fc1ac0866b6ad95a235b40d4fb5069c4fc1ac09abcb6b75b235b404e64e25641fc1ac06e299cdd5a235b400adf844204fc1ac093e298654f235b408661d8614cfa1ac04f5078094e235b401374763238fa1ac0c3b424404d235b409784fb5910fa1ac07fd414014e235b40d4c39272f7f91ac0c76de9d154235b4089987381aff81ac0fe4ec7ea56235b40ccc778944af81ac0de47239f57235b409837a0a52bf81ac0eaaf8b2958235b40928edfdbf4f71ac016462fa358235b40546d88f19af71ac0e68d48145a235b400e840b7904f71ac02e06c4245c235b40186ad784b4f61ac062599f245d235b40ca4dfd14abf61ac01690ab915d235b404881666820f61ac027f85b3b51235b40c61166dafef51ac0efe31de049235b40170320eeeaf51ac0c08c170b43235b40c77a7651d8f51ac076bda1772a235b4018db4b1aa3f51ac0c6d8f7e120235b40c4b59c4b71f51ac065ea633e20235b40511dd4d66cf51ac0baa4d9ee1e235b40ca12268c66f51ac01a15a70c1c235b405551566458f51ac0830e62191b235b400c7e4fac53f51ac0fe19cc5f21235b408ed44e25e7f41ac07aedc03923235b40139cf529c7f41ac0ee81441328235b40871ebb0b94f41ac08914fa9129235b4008fc74a191f41ac0b1bbad7b2b235b40cbdd718a8ef41ac0ad0504a32d235b40cd8929059bf41ac0590ba1832e235b400b1abd1aa0f41ac035cd290131235b40ce542fbfd3f41ac029b7db2e34235b4084688cd651f51ac0e93696b036235b404df4cc2363f51ac08c695bcd3a235b4098f3c0c760f51ac0443b5cab3d235b401404e09f52f51ac0eca16b5f40235b408e05b98b30f51ac05c33669945235b404b3ec6dcb5f41ac06b83c8224d235b404e9061156ff41ac09312700855235b408e3365a71ff41ac0420d941458235b40d9241fbb0bf41ac0aa5e33f966235b4008c77c40a0f31ac0d1eddade6e235b4092b7904770f31ac0fc535f9676235b40c9b6508a3af31ac0b125607479235b405399c4b112f31ac058a614747b235b400f3d6e50dff21ac04c12001c7b235b4050743c66a0f21ac038c1b33d7a235b405aecb94c4df21ac0281071fa7a235b40d870fe2614f21ac0ccbcd1c77c235b409872d712f2f11ac09bd0a0a17f235b4009a25bafe9f11ac04c6bce4884235b4056e9fa961df21ac0f7bac15087235b4048982cee3ff21ac0d37c4ace89235b40c644459c4ef21ac0874e4bac8c235b40cee4965643f21ac0979b249694235b40d3683524eef11ac0a9a535069d235b40599fc37c79f11ac0f258af22a3235b404e510dfb3df11ac084208315a7235b401621e3512af11ac028b33e97a9235b4055bfc16f27f11ac03d161579ab235b40d1ddd5b73bf11ac00d0653cdac235b40d04c0f0a4af11ac0d99f86f6b1235b408b81aad1abf11ac0948eb5bfb3235b4051304278b4f11ac05437936fb6235b409aeff8f6aef11ac06bfedb65bf235b4048ae09698df11ac08f73deffc7235b400a8c7f9f71f11ac0b7476100c9235b40d4353c556ff11ac00babf413ce235b40d77ababa63f11ac0a606dd5ed2235b4012b7054b75f11ac0527075b2d4235b4016c259a297f11ac0622d8194d8235b4018253acb2cf21ac041929c4cdc235b40d5d8e89c9ff21ac011c01072de235b40d5b52bf4c1f21ac0e1ed8497e0235b409ab3acfbc7f21ac099bf8575e3235b40d2c3cbd3b9f21ac0057d2c7de8235b408b56b08d78f21ac0acc996e5eb235b40cd19bff04af21ac02435f790f0235b401c79c7293af21ac0979530d3f6235b4094f734272ff21ac0248627f4fa235b40db05d50627f21ac0935d7347ff235b4050cabc55d7f11ac09a215f4205245b400f5b499a5bf11ac0);
+
+INSERT IGNORE INTO `kelurahan` (`id`, `id_kecamatan`, `kelurahan`, `area`) VALUES
+(5, 1, 'Larangan', 0x000000000106000000010000000103000000010000000d010000b5895278d9245b40d1cd308a25001bc0d56a9503d7245b40101a8c50edff1ac069fbaceed5245b40638715c4f9ff1ac0bdf579f2d4245b401a4e3bed0a001bc0f518bdb3d3245b401a0b694d26001bc031d4832cd2245b40ceb2043556001bc009175b85d0245b4094820ca575001bc0b9190a2ecf245b4092d1241b89001bc02db93dcfcc245b4053cc1c1693001bc05154f06fcb245b4096cd5e9790001bc0e9368b40ca245b405d88b11c86001bc00efbe390c9245b40a0820ca575001bc092935049c9245b40e0f4b6b55f001bc0f15e0059c9245b4018b446b653001bc02e6e2119c9245b40d1e9e3bd44001bc0f5355aa9c8245b40199705c438001bc05209d4b9c7245b401521c34633001bc0fee0cecac5245b40565f89c82f001bc0aee37d73c4245b404e9c0dc92e001bc012df6c9cc2245b405f5f89c82f001bc00e3af78cc1245b401a539aca2b001bc01db59555c0245b409192a2cd25001bc0ae440776bf245b401cd2aad01f001bc0c63b759ebe245b40dd06065713001bc04a78bd0ebe245b4099c158dc08001bc04e100f8cbc245b409331b99ff3ff1ac0ff806bc9b8245b400fc4dcde03001bc087940d8ab7245b40960accda0b001bc03797bc32b6245b40df06065713001bc003b94e1cb3245b40d011b3d319001bc05b10f9ccb1245b405e9526d020001bc0f643af65b0245b405b9c0dc92e001bc0700e979eae245b40cf5f263b4a001bc05778b278aa245b40d7043e207f001bc0d0a323b4a3245b401f6a4d02ba001bc03cf96b369f245b4058f36576d1001bc075b432af9d245b409bff5474d5001bc051f709089c245b40d8b35d73d7001bc0a54eb4b89a245b409dff5474d5001bc0985615f999245b40217ce177ce001bc0fda55e6999245b4053ec7e7dc3001bc03039b0c998245b401221da03b7001bc0f900e95998245b409627248aaa001bc04d8139ba97245b404f1b358ca6001bc0f9ac8e1297245b40d319f30aa9001bc0b4a7dc7a96245b401758b98ca5001bc0c44b21f395245b40119903119d001bc018cc715395245b405152141595001bc031c3df7b94245b4013921c188f001bc01182863493245b40d9d2669c86001bc0625d618591245b40144304a27b001bc0b10f96268f245b401c47ca2574001bc082ff43cf8d245b4056761da771001bc0cd8903588c245b40de834e2673001bc0cd35a9108b245b40cd0804a477001bc0b6701f298a245b4098ca3d227b001bc075bc885989245b405d81ca2378001bc0a94fdab988245b409d3adb2770001bc07ebb57c287245b40df3fe32c66001bc0719a125386245b4096743eb359001bc02e41067484245b40d36e993b49001bc0ba2b028582245b4017d489c437001bc096b2a4dc7d245b409db0b04f1c001bc04b07b65c73245b409ee8d75217011bc013f5551d72245b408de0c0c539011bc0d7f1b16d71245b400d06b6b74c011bc0c865d82171245b40d60f21b355011bc0b3d9fed570245b4056479dae5e011bc0578984ce6f245b40cc5a73a570011bc00f0803d76e245b4019a020207b011bc077a8670f6e245b4099db629f7c011bc0eb8d7c756d245b40d90a0bf879011bc0630b53406c245b40139573a374011bc0afbeb8786b245b4095df282375011bc0c8b526a16a245b4052da201e7f011bc0009a93c969245b408fe249988a011bc02cb115ca68245b401728f71295011bc0e02f94d267245b40916ee60e9d011bc0686cdc4267245b409e3c0f8ba4011bc0686cdc4267245b4016c08287ab011bc08408c07a67245b40dcf7fe82b4011bc0001c7cfc66245b40d20df9c2bf011bc0d0927f0366245b40d24dfef1d5011bc0e45e399464245b400edbdce9e5011bc074c5040563245b408da7c3e4ef011bc024c8b3ad61245b40d95bcce3f1011bc088c3a2d65f245b4096a7c3e4ef011bc0841e2dc75e245b40dc220e67eb011bc084cad27f5d245b409fa0dcebe1011bc025c1691d5c245b4094fd8caadd011bc02d4b1ce45b245b40994ae410de011bc05d5548ab5a245b40de57703fe0011bc07987170a59245b400e2450e8e8011bc0b591f16558245b405609ff1af7011bc0adaf051157245b4010cdcd9714021bc0e9d52fe356245b409b53c2c232021bc0cd394cab56245b40ca1c80ac5e021bc07de1706356245b408e34b99983021bc0edfda4fb55245b405091028f98021bc0d5381b1455245b40d74d3408a6021bc0a528c9bc53245b40549fd080b4021bc0fd56cdbd51245b40ce5b02fac1021bc04d32a80e50245b404da2f1f5c9021bc012556b8f4e245b408feb64f4cc021bc07aa4b4ff4d245b408feb64f4cc021bc022d009584d245b4092339671d2021bc0d6772e104d245b404c6b126ddb021bc0d6772e104d245b409e733be7e6021bc0ae8821284d245b4018c5d75ff5021bc0063426204d245b409b8109d902031bc0cefb5eb04c245b4094939d4e17031bc01aafc4e84b245b405d9942c627031bc0be5e4ae14a245b40d3a16b4033031bc092cac7e949245b4017e718bb3d031bc02aad62ba48245b40522cc63548031bc04e71bb0a48245b400a7fa42f54031bc09e7f9e9c47245b4011cee42660031bc0d6362f8445245b40d8bad6a6fd031bc0ba482b2444245b409adc897df5031bc0fb5bdee53a245b4054aff12e51041bc0e72e218c30245b40183a6b7db2041bc0b887f60029245b40987af07fec041bc0bcfef9791d245b40169d561bad041bc0ddf4d89919245b4091e340cb97041bc0d41df6c418245b401b128a3893041bc0669fe13816245b409947401a88041bc042985cfa14245b409911e7e8be041bc0b18cfbb513245b40d17496b9f6041bc01eeb7c1013245b4014778e3313051bc0b607ece911245b405568a36d0e051bc06e115ac310245b404c59b8a709051bc0fa2317e80f245b401695311a06051bc049a1488d0f245b4055624bef18051bc049c4e74c0f245b40551f264926051bc049e7860c0f245b40190a12a333051bc05682607209245b400b8f7e7048051bc03770acec04245b400fec64d877051bc0562dbbacfe235b4013f7368b84051bc04acfd3c0fc235b40594a8dc1a4051bc0fb7d2822fa235b40db2eada9d3051bc0e0b89e3af9235b409271d621e3051bc0408c184bf8235b401a3ebd1ced051bc0fb320c6cf6235b4096f3079dec051bc070269a54f5235b4090ba4920e6051bc03b71d2edf2235b40caa4ef26d9051bc0c38474aef1235b40922d6b28d6051bc0a06cc116f1235b401d69ada7d7051bc0bc9b1292ea235b400ebfac16f9051bc0dc3df5dbe6235b40d47dc51fe7051bc06058c745e3235b40dc753918f6051bc0d9f908cfe0235b4098377396f9051bc0f97d93bddd235b40d3eca40703061bc021b49eb0dd235b40134b498d0b061bc05d14db38dd235b4056a5abf23f061bc089b2b807da235b400b1a2fdd24061bc081941b45d6235b401743c362d4051bc04afea36fd2235b408ddc240681051bc076698570cc235b4008f3a78dea041bc0ae72e481c8235b404ce26f7b82041bc0fe981936ca235b4093811b295b041bc07a6c0e10cc235b40594ecc7a31041bc082f5fbfecd235b409a33cbf605041bc0c27cf5f1d0235b405606edd5c7031bc0a69e4830d5235b40d614a75a0b031bc099bc44f5d6235b401152b29c84021bc091b11d8cd8235b408b1af2cf0c021bc045fa307bd9235b4057c79750c1011bc091632ea9da235b4090cfed258d011bc08198461adc235b408956b1f84d011bc035ca3d5ddd235b400b9e0436e7001bc04dbe1c76df235b401a2e51bd35001bc069bd22f8df235b4058ffffffffff1ac08d93054ce0235b405b658522ddff1ac0e1d3df4be1235b401cfbf43098ff1ac0ed67f4a3e1235b40957133dc80ff1ac0c4f5328ae5235b40d1a0c5c1c1fe1ac074202e39ee235b400a8dcef929fe1ac0b730151bf3235b40d43e7ec7d4fd1ac0f735d593f9235b40146262f371fd1ac07b69942efd235b40d3da85e63afd1ac02b82885b05245b400a1bb62dcafc1ac001ba39950c245b40db3e0c0550fc1ac07bea9b340d245b40d4f3143944fc1ac06e5687dc0c245b400d82fb010ffc1ac04b54f8ba0c245b40db1a2ac6f9fb1ac0323ed68c0c245b401e2f3b33ddfb1ac0fb0e09910c245b4090701de38afb1ac002ba39950c245b409845240a2dfb1ac086c401a60c245b400fa4ec85e6fa1ac017252bbf0c245b40ce71d0b67efa1ac027901ce90c245b404dabaa97dff91ac09eae77060d245b405bdd137cb7f91ac00a77711b0d245b40961cab949ef91ac07bea9b340d245b40d327266f80f91ac01be262450d245b401d19e0826cf91ac0c66d3eae0d245b409ee311dc48f91ac05bd1f0660d245b4014f748dbdcf81ac0323ed68c0c245b405d483e077af81ac06e44805a0c245b408b8808ff22f81ac0ce5ec0cb0c245b40cd9f3193a8f71ac0ae4515fe0c245b4092c0d59272f71ac04e4fe3900d245b409361f4dc42f71ac0fec8b7770d245b4093a76e2efef61ac0877eb08c0d245b40d07e677bf4f61ac027422d060f245b400965c0594af61ac09b728fa50f245b4012b75b9203f61ac0369379e40f245b40d55d2a36e6f51ac0fa60234910245b401392399677f51ac0cadc865110245b40d02af52c08f51ac09a58ea5910245b40cfff6e8104f51ac0e104b00111245b40918f2e36adf41ac006db925511245b40562538f581f41ac041f343a511245b4016f7f2934ef41ac0a6b8b41a12245b4052da334b02f41ac0fdcf6bec12245b4019bfb33d7af31ac02d3a639813245b4056b3857808f31ac06695dc6113245b409e3618eab0f21ac03e84fb010f245b40d36702452cf21ac0f21afed30d245b4052aa24b20ff21ac0aa5c31aa0c245b4090a97ea5f3f11ac0db740e9e09245b401c54a52daef11ac0961638d906245b40d27ddfbf79f11ac0def6471806245b40dbafe2546bf11ac09a215f4205245b400f5b499a5bf11ac08277fc1708245b4012a7734529f11ac00babbbb20b245b40110ad462f0f01ac01d9b27d714245b4092eba17dacf01ac06277c4211b245b40cf1cff0582f01ac01d20a2d11d245b4015ae0ad462f01ac0190374e61e245b409dd23a1938f01ac0e207f1811d245b408f4a1e4fcbef1ac0f2a3a3a31c245b40d36fc03f89ef1ac046e706431d245b405b5bcaf962ef1ac0ee89fe571d245b408ed7b8fe5def1ac0099b0b5c1e245b409a97158a74ef1ac04e70f4311f245b40d59098a086ef1ac08d33d6851f245b40920889b48def1ac02866c74620245b404cd7917b9eef1ac04404ed0e29245b4092518def6ff01ac0e0c3f69331245b404dfd7f9c30f11ac0bff4c7b436245b40538dcbb8a9f11ac0eb8e96033d245b408d8269183ef21ac0665e18e945245b405042acfe08f31ac0995bf2785a245b4091f7a57edef41ac095577f8461245b404e266a696ef51ac0b429efe368245b409af5251b0ff61ac0244f2a1a6b245b4094e32d573ff61ac067f77ccd72245b401232fd12f1f61ac0ff7b884677245b405de82add5df71ac00f7fe5417a245b40db4533a7aff71ac012b6705985245b40502329e961f81ac06be7cc7685245b400d4c309c6bf81ac03f20680586245b40956ac8d095f81ac002bac7d287245b40553905f9d9f81ac0dd1a709692245b40d16a6116daf91ac03144e6caa0245b40dda42c431cfb1ac0e8493140a2245b405a29e44a3dfb1ac02c105414af245b40da3c27bd6ffc1ac027bac667b2245b4015846055bdfc1ac087c9b3cbb7245b40da370f2730fd1ac0273f4162bb245b4090866bb587fd1ac056f88a55c0245b40cfed5c8ff7fd1ac0d69d86a8c2245b401cafbe122cfe1ac075b65e5ad4245b40de9861a3acff1ac04cdc0812db245b4060ffffffffff1ac054b85158da245b401e1f222109001bc07d81999ed9245b40143f444212001bc0d854ee9ed9245b401e9cb97d12001bc0b5895278d9245b40d1cd308a25001bc0),
+(6, 2, 'Kebonbaru', 0x000000000106000000010000000103000000010000005e000000c6aa784759245b400d33c5a23dd21ac0de32b1f958245b4012eb97b538d21ac0861e004657245b404d58b3424fd21ac03a71cbc651245b400cc5dc3e76d21ac00b4e1bfc47245b40526c9507b9d21ac064064faf41245b40de450873e1d21ac0305eb60339245b40d9e659d41dd31ac0b005f79e32245b40db43403c4dd31ac0a22af5d426245b40d533aa139dd31ac07ed61dc317245b4016b45c0802d41ac0ffd9b59716245b40d082264508d41ac05a6e407215245b404cdf1ff60fd41ac08eda075916245b4019ef299128d41ac08ec0620d17245b40d7b59db646d41ac0ee89fe571d245b40197b2aa73dd51ac0f2d603e621245b404c302592e8d51ac01041df162c245b40109d996038d71ac0448a515e2b245b4010ec82c135d71ac06551e27327245b40ce86307e1ad71ac055d0bef220245b40d0a12424d2d61ac0a609e57d1c245b40922b0dfca8d61ac03ace77f013245b4011e5797077d61ac04aec638c0f245b40929a8e006ed61ac0deaf0caa0d245b4090e0e1ce69d61ac0afc838cd02245b400ed6338463d61ac0d42ba8a8fa235b4019076a8979d61ac054031077f5235b4011ea1d6e87d61ac0d14d5f81e8235b4050893c49bad61ac0be7882fdd7235b409a392009fbd61ac0a7ec3713d3235b404fb93f6f0ed71ac00bc5995fcd235b409bfca19927d71ac03b7d80eecb235b40dad83ee42dd71ac0ecd884b4c6235b40516b96cb46d71ac0d950adbeba235b400d0836ae7fd71ac068907d17b6235b4093a6ae7c96d71ac08a5b8121ab235b40d6f6e3f6cbd71ac07eded0949d235b4054aeec82c1d51ac0b71c649291235b4018f083f3a9d31ac04811cea78e235b401ca57f492ad31ac0571885fa89235b40954f7f285ed21ac09072b7b492235b408bda76dc1bd21ac07c06bcde93235b40915afc9115d21ac07e5416f098235b4089c7412ffad11ac0c3f140839e235b40534f3ce3d2d11ac04db5621da6235b404af07239a7d11ac0f5c187a8a8235b400fc0b1e396d11ac095dc19b0a8235b4050861bb396d11ac02ac7227eac235b408f7a1b2610d21ac0ba4f5ef2ac235b40d70105a41ed21ac035b312f3ac235b404d3559a31ed21ac05e60d218ad235b40d5dccd531dd21ac06924be13b3235b40da5c143df0d11ac011e0701fb9235b40cca00f96b1d11ac09ca4bb72bd235b4009c64b3789d11ac028db03adc0235b404bd1c6116bd11ac0b0f41dfcc4235b4091dc41ec4cd11ac0270bdb4fc6235b409a7072bf43d11ac0577fc7f0d8235b408b6b5b94d9d01ac0bdb6c189e8235b40d413697380d01ac0890d59ddea235b408a175b0872d01ac0885e8940f5235b409d67774831d01ac001754694f6235b40973e709527d01ac050cfc5dff6235b401bdd4eb324d01ac0d4b607cef7235b40d6247de537d01ac038f43d49f8235b40d96be9b046d01ac0ec57773ef9235b400968fb2264d01ac0e458a8d5fa235b40d8ae58be8cd01ac045e2d278fc235b401aca77a284d01ac0802bd56bfe235b4054ff73e976d01ac0a454c38600245b4090328dee6cd01ac0680cc08302245b40993836b461d01ac027b2538b0c245b401a5e42fb14d01ac003ba52320d245b409460c6fd0fd01ac0beffbc5010245b401bb3c46af6cf1ac0d220d01814245b40110847dad7cf1ac0364872c916245b408ca416ecb4cf1ac05ecfa5ae18245b404c10ae69a6cf1ac0bec495c51a245b401116906a96cf1ac0be3753cb1d245b40d74a55477fcf1ac0d6acb4fa27245b40d442f14e31cf1ac0b67ac08b2a245b40d6a5b8b917cf1ac0008b0be231245b401d066c97cece1ac00419f75e3d245b405a4932155cce1ac0348040ee42245b400f21078af7ce1ac0d036488e42245b40519fc2c515cf1ac0f86b107441245b405d2d54ad6ecf1ac0b7cc673742245b408dd2016ce7cf1ac0a35cb49849245b4016d7a7a785d01ac08712c09850245b4054e72d3a33d11ac0da7780a153245b405fbc0c4d79d11ac00ee779dd53245b405e3c2db67ed11ac08661066d56245b40d96b80a9d1d11ac02a8fc84257245b4017f47742f1d11ac0c6aa784759245b400d33c5a23dd21ac0),
+(7, 2, 'Kejaksan', 0x000000000106000000010000000103000000010000005b000000b8d7fecef6235b40cfb10ddc81da1ac04429d6a9f2235b405bb757e6adda1ac00a4d55dae2235b40180183a44fdb1ac0fe33c687d9235b400b0ba41cb0db1ac0cb966e99d3235b4055972427f7db1ac07b7b8cf2cc235b405ce3f56052dc1ac0af583f36c9235b40d2e39b6d6edc1ac08b68604cc9235b4051df929a78dc1ac067bbf770c9235b40da6ad26d89dc1ac0e670f085c9235b400e58349d9ddc1ac07bd1199fc9235b4015920035b5dc1ac0131eb0abc9235b405997a432c5dc1ac02b1a2d8eca235b40cfa211c45ddd1ac04f72495cc7235b400d457c2766dd1ac0bc765776c1235b404ea205685bdd1ac000f7b86fb5235b40dc43300e2edd1ac051bd2363b5235b40cd43300e2edd1ac06d97cc60b5235b4096aad80c2edd1ac07d06c24eb1235b400f7135b22bdd1ac05e7a7764ac235b40d2b7efc01cdd1ac0716e8221ab235b40d8fd428f18dd1ac06d3b22dfa5235b40d59f134207dd1ac0c2dcdc989e235b404f6b51f7e5dc1ac0c6eb76f695235b40912bd505bcdc1ac0efab27f38f235b408e8147aca9dc1ac0ef13bc218d235b400ad2a3a99edc1ac0a7aabef38b235b400e2daef199dc1ac02f6bde718a235b40915011a793dc1ac00fc1a7eb89235b40ca7d164b91dc1ac04755807687235b408d76f35487dc1ac03c21628385235b40cc8493347fdc1ac0e009abb184235b400b93331477dc1ac0d08887307e235b404cde516342dc1ac051f1a65b76235b40867859130bdc1ac059d494eb6d235b40daebd808c4db1ac0c2f68ea163235b4050b0c05774db1ac09e9e9e7763235b400958350873db1ac05f4f621058235b404c09d9791bdb1ac04341deab56235b400c6662ba10db1ac02330fd4b52235b408bf6612cefda1ac00f5a367348235b40088a5242b0da1ac0cd8fa0aa42235b40536feb6e9eda1ac00da171e140235b40529b1727beda1ac03c22853941235b401034d42824d91ac078873b1746235b408b7b9a9317d91ac02334282158235b40c7e079a9d8d81ac012a3544964235b40476f6ccea5d81ac04a1789096a235b40cda2e2ff8ed81ac081935b936e235b404bb68c2c7dd81ac03d77374f75235b409479354069d81ac0b494a8177c235b40173deaaf57d81ac0ac07483481235b404be95c514ad81ac028c4d84290235b408b172f8523d81ac06ad4bf2495235b4052aeeaac16d81ac0a3e8fd7f9c235b400e07dc4bfed71ac07f90e1b19f235b400f36548cf3d71ac00d970a2aaa235b4016caeaaed0d71ac08a5b8121ab235b40d6f6e3f6cbd71ac068907d17b6235b4093a6ae7c96d71ac0d950adbeba235b400d0836ae7fd71ac0e8d884b4c6235b401a6b96cb46d71ac03f7d80eecb235b40dcd83ee42dd71ac00fc5995fcd235b404dfca19927d71ac0afec3713d3235b4050b93f6f0ed71ac0c27882fdd7235b404e392009fbd61ac0d54d5f81e8235b4016893c49bad61ac054031077f5235b4011ea1d6e87d61ac0d02ba8a8fa235b40cc066a8979d61ac0afc838cd02245b40d5d5338463d61ac0e2af0caa0d245b4091e0e1ce69d61ac04eec638c0f245b40589a8e006ed61ac042ce77f013245b40dbe4797077d61ac0a609e57d1c245b40922b0dfca8d61ac05ed0bef220245b40d1a12424d2d61ac06a51e27327245b409486307e1ad71ac0498a515e2b245b4011ec82c135d71ac01041df162c245b40109d996038d71ac0e020645930245b4057efc000c2d71ac0d581289831245b404eb4c189e8d71ac041f2c91132245b409982bef4f6d71ac01157116e32245b40193bf88903d81ac041c1081a33245b40cf1018eb1bd81ac0099325732c245b409d41e8a04bd81ac0050eab7823245b409c3e36c98fd81ac09596558a1d245b409a5ae9b5d9d81ac023bc809719245b40930635d40cd91ac0166fa7ad11245b405f5f730e9ed91ac077df74cb0e245b40539c64abcbd91ac03b94633909245b404f1a9fc9fed91ac0038025de01245b404db9bda431da1ac0b8d7fecef6235b40cfb10ddc81da1ac0),
+(8, 2, 'Kesenden', 0x00000000010600000001000000010300000001000000640000000419f75e3d245b405a4932155cce1ac0008b0be231245b401d066c97cece1ac0b67ac08b2a245b40d6a5b8b917cf1ac0d6acb4fa27245b40d442f14e31cf1ac0be3753cb1d245b40d74a55477fcf1ac0bec495c51a245b401116906a96cf1ac05ecfa5ae18245b404c10ae69a6cf1ac0364872c916245b408ca416ecb4cf1ac0d220d01814245b40110847dad7cf1ac0beffbc5010245b401bb3c46af6cf1ac003ba52320d245b409460c6fd0fd01ac027b2538b0c245b401a5e42fb14d01ac0680cc08302245b40993836b461d01ac0a454c38600245b4090328dee6cd01ac0802bd56bfe235b4054ff73e976d01ac045e2d278fc235b401aca77a284d01ac0e458a8d5fa235b40d8ae58be8cd01ac0ec57773ef9235b400968fb2264d01ac038f43d49f8235b40d96be9b046d01ac0d4b607cef7235b40d6247de537d01ac050cfc5dff6235b401bdd4eb324d01ac001754694f6235b40973e709527d01ac0885e8940f5235b409d67774831d01ac0890d59ddea235b408a175b0872d01ac0bdb6c189e8235b40d413697380d01ac0577fc7f0d8235b408b6b5b94d9d01ac0270bdb4fc6235b409a7072bf43d11ac0b0f41dfcc4235b4091dc41ec4cd11ac028db03adc0235b404bd1c6116bd11ac09ca4bb72bd235b4009c64b3789d11ac011e0701fb9235b40cca00f96b1d11ac06924be13b3235b40da5c143df0d11ac05e60d218ad235b40d5dccd531dd21ac035b312f3ac235b404d3559a31ed21ac0ba4f5ef2ac235b40d70105a41ed21ac02ac7227eac235b408f7a1b2610d21ac095dc19b0a8235b4050861bb396d11ac0f5c187a8a8235b400fc0b1e396d11ac04db5621da6235b404af07239a7d11ac0c3f140839e235b40534f3ce3d2d11ac07e5416f098235b4089c7412ffad11ac07c06bcde93235b40915afc9115d21ac09072b7b492235b408bda76dc1bd21ac0571885fa89235b40954f7f285ed21ac000e08d0585235b404d79e1ce85d11ac02df1b6847c235b404e881b48fbcf1ac0853da24275235b400e6b6a48f8ce1ac0d5427e3672235b404d9fb874b0ce1ac01ef3b6d26b235b40d54462821ace1ac01615268c66235b4011cdfe40b9cd1ac0c301e2ae5e235b4055bcc62e51cd1ac0bf481d1d57235b408fcd18e604cd1ac0cf92b58652235b400561a3acdfcc1ac048f761bd51235b4046d4fb1ed9cc1ac0783182c64c235b40cf5b65feb5cc1ac001cebfe446235b408beee3688ecc1ac0546e904946235b404d40581e88cc1ac06150948444235b4096c5dcb584cc1ac075d29a3343235b405232b94182cc1ac0fdbf6bdf42235b408da9d2a481cc1ac0898262bf42235b4007767c5d81cc1ac001cd824743235b40d327b2186dcc1ac0c18ebcc546235b4091bea69c69cb1ac04dd50f104a235b40896067f6b8ca1ac044d640a74b235b40c802e0cd8aca1ac0f8f335ad4f235b408e9227bd2dca1ac0a7e6761b53235b40d89a31deecc91ac0020723a85a235b4054e00ebd8dc91ac09fb98a655d235b409868b8a075c91ac0a7f0c2c761235b400e662e0d4fc91ac0da09355a66235b400fe8d9dc33c91ac07858c0b185235b400c2deb7a79c81ac04b6ebe8191235b401889358a1cc81ac0f0eb60f095235b40969cb5abf5c71ac016c95ce4a1235b40d0b348d88cc71ac0d127b031b6235b40948ff2afbfc61ac0b4af91bac3235b405df5f3b54dc61ac074d9b9d6cf235b404b3ebbd4ecc51ac092bf2771de235b404e6764a958c51ac0fa9dc47be9235b40997f66b2adc41ac0094b6893ef235b40ce2de1d05bc41ac099f84b63f2235b4053e6970536c41ac0c98dc0b3f8235b401a511d1febc31ac00462b277fa235b4015d9ee7e12c41ac09cf5d63afd235b4093df50110ac41ac0e06a84d7fe235b401c99754577c41ac09506d5c702245b409acb37a533c51ac048e51f6c07245b4095d40699f7c51ac09f582e770d245b40163c50c0d9c61ac03e0a414413245b401ee8e673d2c71ac0b68e9a001a245b40197af008dec81ac021f20cbf20245b40dc10f749cfc91ac0f6e24f0627245b408d6b1576b1ca1ac00c2519492d245b405752090dcccb1ac0bdb6724334245b40999fc515c1cc1ac08c95633138245b400f0d4bd8becd1ac0f076d3d539245b4097e32b9de9cd1ac084c723193b245b40dd0e010117ce1ac0606d94ca3b245b40904e17ea2fce1ac00419f75e3d245b405a4932155cce1ac0),
+(9, 2, 'Sukapura', 0x00000000010600000001000000010300000001000000a0000000d2480a16fb225b4058be7baab7ca1ac0964f52a0fc225b40ce169079c1ca1ac09518fc7a01235b404a93456988cb1ac00dc306f501235b408a0bd4f29bcb1ac0709ba4ed10235b400b02ab8001ce1ac0744f91b811235b408affa4921bce1ac02071cf5612235b4089bebd1218ce1ac087b2dea815235b4051dd904605ce1ac01fce6b9e23235b4047a1d28899cd1ac05f55659126235b400ceb17ec86cd1ac07efb28232e235b4089278aff57cd1ac0f9eb03c93b235b40ca15855d14cd1ac06c76251140235b40c8395d4b05cd1ac009cc51b041235b400905e7b9a9cc1ac0898262bf42235b4007767c5d81cc1ac0fdbf6bdf42235b408da9d2a481cc1ac075d29a3343235b405232b94182cc1ac06150948444235b4096c5dcb584cc1ac0546e904946235b404d40581e88cc1ac001cebfe446235b408beee3688ecc1ac0783182c64c235b40cf5b65feb5cc1ac048f761bd51235b4046d4fb1ed9cc1ac0cf92b58652235b400561a3acdfcc1ac0bf481d1d57235b408fcd18e604cd1ac0c301e2ae5e235b4055bcc62e51cd1ac01615268c66235b4011cdfe40b9cd1ac01ef3b6d26b235b40d54462821ace1ac0d5427e3672235b404d9fb874b0ce1ac0853da24275235b400e6b6a48f8ce1ac02df1b6847c235b404e881b48fbcf1ac000e08d0585235b404d79e1ce85d11ac0571885fa89235b40954f7f285ed21ac04811cea78e235b401ca57f492ad31ac0b71c649291235b4018f083f3a9d31ac07eded0949d235b4054aeec82c1d51ac08a5b8121ab235b40d6f6e3f6cbd71ac005970a2aaa235b4016caeaaed0d71ac07f90e1b19f235b400f36548cf3d71ac0a3e8fd7f9c235b400e07dc4bfed71ac06bd4bf2495235b408aaeeaac16d81ac028c4d84290235b408b172f8523d81ac0ac07483481235b404be95c514ad81ac0b494a8177c235b404e3deaaf57d81ac03d77374f75235b409479354069d81ac07d935b936e235b4096b68c2c7dd81ac0461789096a235b4019a3e2ff8ed81ac00ea3544964235b40926f6ccea5d81ac01b34282158235b40c6e079a9d8d81ac070873b1746235b408b7b9a9317d91ac03422853941235b400f34d42824d91ac0acd0f57d38235b4093a6203f1bd91ac0067dd7a02f235b40c52b0b26fed81ac0d2d7e1e82a235b4052849675ffd81ac02be8e44526235b40d5dfac19fdd81ac0320db1fa23235b400cc03bf9f4d81ac05329e56723235b401574ddecf3d81ac0bbf6f3a622235b40d6cb5ce0f2d81ac0f7a511d821235b401604ee5af1d81ac0320610b321235b4056e50cffc8d81ac03e161a9f21235b405588269799d81ac0927effd221235b405105395029d81ac0bb2aedf621235b4089507037dcd71ac007c6e70222235b40564b6c80cad71ac05f2ecd3622235b40d6f2ec5c92d71ac08e568aba22235b4006b287cd32d71ac03f27552223235b404b3f4bbadad61ac033174b3623235b404f1fe952aad61ac0cf0a218a23235b4011df248449d61ac0f2b60eae23235b4087ccf39b1ad61ac07edbebf123235b40cb1c3388c3d51ac037ffdf0924235b40129d66b668d51ac0d643d12524235b4011adfcde18d51ac05eabcd2d24235b404fd42a34efd41ac043ccca3124235b409119bda391d41ac0ff097d3224235b4089cbd37786d41ac092c7b47b21235b408af73a7185d41ac038f1b5cd18235b4046156e2982d41ac060bfb8ce0b235b400ffabe7380d41ac04895544807235b40d76ecb547cd41ac0193469f302235b40095569027ad41ac0ed884ef502235b40953bbfc05dd41ac0dd7fd22302235b40d1469b105ed41ac0418dffc1fb225b404d2e86215bd41ac01766d223e9225b40cc736b9252d41ac007d35c49e0225b40d6ecc7f04fd41ac0b0088baede225b40d34a2ba44dd41ac028640410db225b40ca1e9f7448d41ac0782ea4ddd7225b404d1789a840d41ac09814b607d0225b400e97386e08d41ac021f11386cf225b40482fbf4f2cd41ac07d55d59ece225b4088ddfa506cd41ac0644d7881d3225b4007ef69d296d41ac014ef0655d3225b400ee64fc01bd51ac05cff242ad3225b4094689e059cd51ac048d9df98d9225b40c60d2e6ebad51ac0b07f1f8ad9225b405392c8d6e4d51ac00c297f5ad9225b40c4a904c36dd61ac0dfb81121de225b4006a3d41970d61ac030bacab0dd225b4015b6c1cd05d71ac060befb67d6225b400b416938fdd61ac078052f1ed1225b4091c33bfdf6d61ac0158a8a27d1225b4014fc00dee1d61ac0744a2afdcb225b408f22fc11dad61ac0354c7667cb225b40d2ae0a76f1d61ac0d91ca51dc7225b40491fd74ee0d61ac0191b59b3c7225b4053a05cf7afd61ac0e2904299b3225b404d64523e62d61ac0beb6de6fb4225b4091cf436d1bd61ac05e940035b5225b4044e8b81ad9d51ac07e9306b7b5225b40c9742c47acd51ac08a0d76c3b6225b409125c45c52d51ac087f3d077b7225b4012b6291e17d51ac033ed6dc6b8225b4008c9cbfacad41ac01a6887c0bd225b4088d674190bd41ac0fe619cd9bd225b40cbf4293401d41ac09254e5ffbe225b40135b72198dd31ac04a083a83b4225b40141eeacaa9d31ac0f208db43b3225b4010f8e2eb68d31ac0060b85ddb7225b40554d655b4ad31ac0d5618c18bb225b40ca1aae4a2cd31ac0324e3e9ebf225b408f5411d615d31ac08a0a7e19c1225b4056896c5c09d31ac00e07e644c2225b400fbd2622fed21ac08ec02eacc3225b4009f789ade7d21ac0bd3c4677c5225b400a96ddc1bfd21ac035e684f2c6225b4045cf9f8caad21ac06c72a6a9c8225b4013053d949bd21ac024bf4071c9225b404f36735795d21ac09dab9eb0ca225b404b38569991d21ac0c4471e9acf225b40146bcedd88d21ac075c09d90d2225b408a73fba578d21ac0e866b1ddd5225b400af735ae59d21ac074d8795bd6225b40916b7c5634d21ac0945f822dd8225b40d06be7fba9d11ac030fae356de225b40d76be7fba9d11ac06cf527eadf225b40c86be7fba9d11ac038e3ecbadd225b40944df73238d11ac030bdb731dc225b4088f29b31e8d01ac07472832dd8225b400f1724e53dd01ac0484a3c01d6225b4087326a93cacf1ac032514fe5b5225b4083df405087d01ac08ec1f7f2b3225b40c99993aafccf1ac00797eeaeb3225b400f0c54c6bfcf1ac0c3a4d7edb3225b400cadd85f76cf1ac08a5b65a6b4225b404714ab0661ce1ac01a884428b6225b4010c5353e93cd1ac0a71dfbe5b5225b40893865ef3ecd1ac0474b80c5ba225b40ccff18603dcd1ac0edc7f70cbb225b40d47f2a9e15cd1ac0ee2db747be225b4016cf60b611cd1ac096deaa60be225b408fd5aa3c05cd1ac02a708991c2225b40d5ca6fad03cd1ac0551de5d5c3225b4014b698749bcb1ac0d6207daac2225b401381efc161cb1ac071670d7ec5225b404aa0d42952cb1ac0f51f57f5c2225b404f1de7e2e1ca1ac08a1c910ec0225b4010cdac3d9dc91ac04234d6dbbf225b40558b021287c91ac06e7db32bbf225b4047d2f2153ac91ac0d2480a16fb225b4058be7baab7ca1ac0),
+(10, 3, 'Drajat', 0x0000000001060000000100000001030000000100000072000000d702857afa235b40d6298a5759eb1ac0cb3a268bfb235b405714c78157eb1ac057c3887d02245b40daea7ea642ec1ac0a7ffef880a245b40cc571af851ed1ac09d2b543717245b401ddd76a1b9ee1ac096ece2361a245b408a7abc900eef1ac036b05fb01b245b409d06978e39ef1ac0797341041c245b40d95ca3e540ef1ac032bfdd921c245b40d636c1374def1ac0ee89fe571d245b408ed7b8fe5def1ac046e706431d245b405b5bcaf962ef1ac0f2a3a3a31c245b40d36fc03f89ef1ac0e207f1811d245b408f4a1e4fcbef1ac0190374e61e245b409dd23a1938f01ac01d20a2d11d245b4015ae0ad462f01ac06277c4211b245b40cf1cff0582f01ac01d9b27d714245b4092eba17dacf01ac00babbbb20b245b40110ad462f0f01ac08277fc1708245b4012a7734529f11ac09a215f4205245b400f5b499a5bf11ac0935d7347ff235b4050cabc55d7f11ac0248627f4fa235b40db05d50627f21ac0979530d3f6235b4094f734272ff21ac02435f790f0235b401c79c7293af21ac0acc996e5eb235b40cd19bff04af21ac0057d2c7de8235b408b56b08d78f21ac099bf8575e3235b40d2c3cbd3b9f21ac0e1ed8497e0235b409ab3acfbc7f21ac011c01072de235b40d5b52bf4c1f21ac041929c4cdc235b40d5d8e89c9ff21ac0622d8194d8235b4018253acb2cf21ac0527075b2d4235b4016c259a297f11ac0a606dd5ed2235b4012b7054b75f11ac00babf413ce235b40d77ababa63f11ac0b7476100c9235b40d4353c556ff11ac08f73deffc7235b400a8c7f9f71f11ac06bfedb65bf235b4048ae09698df11ac05437936fb6235b409aeff8f6aef11ac0948eb5bfb3235b4051304278b4f11ac0d99f86f6b1235b408b81aad1abf11ac00d0653cdac235b40d04c0f0a4af11ac03d161579ab235b40d1ddd5b73bf11ac028b33e97a9235b4055bfc16f27f11ac084208315a7235b401621e3512af11ac0f258af22a3235b404e510dfb3df11ac0a9a535069d235b40599fc37c79f11ac0979b249694235b40d3683524eef11ac0874e4bac8c235b40cee4965643f21ac0d37c4ace89235b40c644459c4ef21ac0f7bac15087235b4048982cee3ff21ac04c6bce4884235b4056e9fa961df21ac09bd0a0a17f235b4009a25bafe9f11ac0ccbcd1c77c235b409872d712f2f11ac0281071fa7a235b40d870fe2614f21ac038c1b33d7a235b405aecb94c4df21ac04c12001c7b235b4050743c66a0f21ac058a614747b235b400f3d6e50dff21ac0b125607479235b405399c4b112f31ac0fc535f9676235b40c9b6508a3af31ac0d1eddade6e235b4092b7904770f31ac0aa5e33f966235b4008c77c40a0f31ac0420d941458235b40d9241fbb0bf41ac09312700855235b408e3365a71ff41ac06b83c8224d235b404e9061156ff41ac05c33669945235b404b3ec6dcb5f41ac0eca16b5f40235b408e05b98b30f51ac0443b5cab3d235b401404e09f52f51ac08c695bcd3a235b4098f3c0c760f51ac0e93696b036235b404df4cc2363f51ac029b7db2e34235b4084688cd651f51ac035cd290131235b40ce542fbfd3f41ac0590ba1832e235b400b1abd1aa0f41ac0ad0504a32d235b40cd8929059bf41ac0b1bbad7b2b235b40cbdd718a8ef41ac08914fa9129235b4008fc74a191f41ac0ee81441328235b40871ebb0b94f41ac07aedc03923235b40139cf529c7f41ac0fe19cc5f21235b408ed44e25e7f41ac0830e62191b235b400c7e4fac53f51ac02ece87191a235b408f284fb14ef51ac0df954be317235b408c85d8f143f51ac0532bb91116235b400c1ea16648f51ac034a64e4013235b400fbef2203df51ac0df1bed9512235b409662aeac37f51ac0969b965819235b40d0134c8db4f41ac07f257ec51a235b40ca80e84999f41ac0b2e418c91e235b4087b31ebe4cf41ac0f26a286728235b4004aa967494f31ac0da94196f2b235b409318726c3df31ac095bbbda431235b40d6ed056685f21ac0b50f677e35235b408ff734272ff21ac00c1f808637235b4094ba438a01f21ac01c40ada23f235b40512a5ebd6ef11ac0c4e1ba6246235b408ceb14ab06f11ac0b3cead1056235b400beb884336f01ac0da77fa415d235b40536b5cffaeef1ac0427869c361235b409588cf9d60ef1ac0ed0686ac6e235b40cef4b86fb5ee1ac01cbe82e673235b4096d6203b8bee1ac0cc064eb681235b40919c47c5ffed1ac07f4e775089235b408883e0608bed1ac0c73a502e8d235b405642572250ed1ac04d5a349d9d235b400b0bb1fa23ec1ac03e8e62b9a5235b40d3842e506eeb1ac09c802132ac235b408dc5d042e6ea1ac0253530f2b2235b40118beb7058ea1ac01b269694bb235b404ce38409a3e91ac0eb875451bc235b400d5b751daae91ac00ffd56ebc4235b40d1492366f6e91ac0ffc8f0d8cf235b4015e36a6457ea1ac0028969dfdc235b40ce8e5033a4ea1ac081975cc5e2235b405a4aafcdc6ea1ac060b1c91af5235b404d7d71a94aeb1ac0d702857afa235b40d6298a5759eb1ac0),
+(11, 3, 'Karyamulya', 0x000000000106000000010000000103000000010000008a010000ed84bef4f6225b40c75cf8c1f9f41ac0324b29e8f6225b40c7193048faf41ac01954c12ff5225b404a4ffeee1df51ac0a6719692e5225b4053197cf294f51ac0467c4e7adf225b40450e9bc8ccf51ac04792c8f0d8225b404a2d73ba2cf61ac093c865dcd4225b4008f63e5585f61ac048e1a128d0225b404b3f439067f71ac00ca89718cb225b400a5b69aa0bf81ac0ad08ddacc1225b40c823a872bef81ac06d673e6ebf225b400e0c0055dcf81ac055ff0188bb225b4044f96184f0f81ac012dd1324b6225b409020904b1cf91ac07e632f14b0225b40862fd63730f91ac08b0a3543aa225b400fa547533df91ac022246b0da5225b40d18dab915df91ac0cb5aa31ea2225b40d1e45df580f91ac02712acaa97225b40505d6743fef91ac03c7a5c548b225b4008b167cf65fa1ac08d2d1e9084225b408dd570917bfa1ac0ee8be42b81225b400a081a3389fa1ac0cdf1e9387e225b40d3d118ada3fa1ac0099edb847b225b40c9108942cbfa1ac0ce852a357b225b4091d762b7cffa1ac0f980d93d79225b404a12d55b03fb1ac0a169226c78225b40071b446b45fb1ac0c9d0907879225b40118c2ac3b8fb1ac0b1ba6e4a79225b408bf514a8e1fb1ac015b4295778225b4091bda08504fc1ac062e2287975225b4009ff8f1326fc1ac0c64378b471225b400d6b5f402ffc1ac06a43dd8e70225b40c40832022afc1ac0ee1dcefc6a225b40c554f65d11fc1ac00b7eb43863225b400a5de6e3f6fb1ac0349e5b9a5b225b40cbda1324b6fb1ac03c6331ea5a225b4002351210affb1ac041bd318b50225b40c6009082c3fb1ac0edef3ec747225b400f94f3c5defb1ac0334cbe6037225b40c3ff9b6f28fc1ac0f2c099982e225b40cda2acdf4cfc1ac0f3890e8123225b400f90745e47fc1ac06791799620225b40cc71dc291dfc1ac0bd27e1421e225b404ddc5f3deefb1ac0b1bf78b81d225b40cf23b37a87fb1ac054bfdd921c225b40ca4fd25433fb1ac0282cc3b81b225b4009c5c4e6e3fa1ac0a1de5ed218225b40119acb0d86fa1ac081389b4011225b40036fd23428fa1ac05103d88008225b405277280af4f91ac0d69777d503225b40c8a9d1ab01fa1ac096107ee200225b4085270cca34fa1ac0568984effd215b40ccd3fdf483fa1ac0ca165454fd215b408d5df3aacefa1ac0ba4b6cc5fe215b40c6b9490c02fb1ac046bdb20b06225b40877919c572fb1ac045bdb20b06225b4088f2bb4198fb1ac0fb53b5dd04225b40ce7a65deaafb1ac0d242d47d00225b40ceac68739cfb1ac05b59ad4cf8215b40885b001aa5fb1ac0b726e82ff4215b40907e30f0dcfb1ac057b2ef3cf1215b400eb3cbb73efc1ac05bba8d06f0215b408c8d0244c1fc1ac0e3a3d0b2ee215b400d9f53909ffd1ac05c8ab663ea215b408a8e34b8adfd1ac0b41cf33ae2215b40c6533541d4fd1ac005a5f2fdd4215b40053e4be812fe1ac03a3f096cce215b40fe5acb6438fe1ac0bbc45682c5215b40cc06b13385fe1ac0a38b10a5bd215b40c1119f3bc1fe1ac00fa2f99cbb215b4006881cb3d0fe1ac074a2ea55b9215b40c47a16b9e4fe1ac0f8e3ca71b8215b4040112169c8fe1ac097e6552eb8215b400c47fe86a7fe1ac0d0992d96b8215b404548a30c99fe1ac09fdab2e9b9215b407d08015985fe1ac00343dccdba215b4041bf526078fe1ac0c39f8081bb215b40cd2e4fa56efe1ac03e633811bc215b4087e9a12a64fe1ac01ff0fa88bc215b40025d64f352fe1ac0276ccae8bc215b408f4b713e3dfe1ac0bf1c8178bd215b408bcc64862dfe1ac03be03808be215b408034d91117fe1ac0a6683214be215b40017a8ada05fe1ac0ef443efcbd215b40c2e1fe65effd1ac0c79850d8bd215b403f57a470dafd1ac00eb87b84bd215b408dd00fffbdfd1ac0de8fbe00bd215b40bf7d3105b2fd1ac03ec83d30bc215b4048ede7e4aafd1ac05c9a867eb9215b404a3a67cca3fd1ac0078cfaa3b6215b40ce32df1297fd1ac0c4efce00b5215b407feb4e5690fd1ac0a7241316b3215b408a13397c86fd1ac073e14226b2215b403e1f091c85fd1ac05334246bb0215b404a23cf9f7dfd1ac0dcb38b9faf215b4005ac465396fd1ac0ed15762bad215b4080d923347ffd1ac05d3a237dac215b40c9e78c207cfd1ac074f953e3a5215b407c398a1362fd1ac07504df2da3215b400d40f1384cfd1ac089cfcb759b215b404ed49b1e02fd1ac026d85c6b98215b40c5e7d8d488fd1ac03e841fe994215b40806d9bdc01fe1ac0df112fdb90215b4040133977cdfd1ac0aa2286418c215b4087e96b1494fd1ac04e9550208a215b408771fdeb77fd1ac0db79e88689215b4006c3c37454fd1ac0c79cf37289215b400366dd0c25fd1ac007fd2ffb88215b40c0d5dd9ffffc1ac04ffb464e88215b40046806b9d7fc1ac063d0a90b88215b40bdc14f2b6bfc1ac0b733b22c84215b407eaca2196dfc1ac0b733b22c84215b407e173c6850fc1ac07ba7907582215b404c1ca3ac47fc1ac0c75af6ad81215b40fd169ba751fc1ac03355b43f7e215b400a5423f634fc1ac0d4cb899c7c215b4086be1b8419fc1ac05cdf2b5d7b215b4003f73c8e05fc1ac03817c74474215b40442e1c17f4fb1ac01c1a6b3671215b403dd633d0f1fb1ac0e5950d1071215b40ccfce9e3fafb1ac0f5a3fbc56e215b40c9912c1b2efc1ac06d9d89776d215b403f09405f57fc1ac0b965e9b56c215b40819c884b7cfc1ac0d91ff9116c215b400161f53da6fc1ac08d5dd1466b215b403e95a9e4c4fc1ac075d508e968215b408704fab6f3fc1ac0cd5c1eea65215b40878bd3012afd1ac02aa34e4663215b40063e8a4c56fd1ac07693750861215b40427956906efd1ac0f638894160215b408309d7a370fd1ac0561803e45e215b4089a1b05b69fd1ac0aed174eb5f215b403ab784f026fd1ac0667269fa61215b40c4590116ddfc1ac0e92482b865215b408ad5d14d6ffc1ac0edbb2dfe67215b40c4f6e83d30fc1ac0d926e4e668215b404ad2917f0dfc1ac0ade6bb3669215b40ba9b38acb5fb1ac0ddcb59f669215b40858683c18bfb1ac06defb45b69215b404a85cbc288fb1ac005b7a0346a215b4081d849084cfb1ac0c51345e86a215b408241001533fb1ac03d00a3276c215b407ae6fceefffa1ac0f5f0e5b567215b400355bb00ddfa1ac0519ba7a066215b4044e4657d65fa1ac0b54d8a6968215b40058760bce9f91ac0a0dcc4ad68215b408cc15926d0f91ac06d9a243169215b40829472e29ef91ac02def62c569215b407e600e3e71f91ac00d4336d069215b400a57c4e86df91ac005b7a0346a215b4086a4080b4ff91ac05581400267215b4043a1e34755f91ac022da162e63215b40c82fc6394af91ac08eff830b63215b40065e72342bf91ac0810c191663215b40ca898fc11df91ac0e248fa0d63215b407d4a5db30ef91ac0fe1bd14063215b4042cbef138bf81ac07eac3a045e215b408679e7435cf81ac0628b92445a215b4085a89e5531f81ac0a3148d6257215b40ff4be330fbf71ac04e35ecd455215b40c28568eecdf71ac0fb1c757754215b408ba9ebd485f71ac08b9e6d1554215b400b419e9769f71ac0b66d38a653215b404b70312f55f71ac0621570f852215b40c1836c303ff71ac0c325ddcc51215b4047340ddb2df71ac0929cae994f215b400008a0921ff71ac0c4c57a8248215b407a545dd1f7f61ac08023b9fa46215b40bffe57851cf71ac065c57fea3a215b40fe78b623a9f61ac09c3577f23e215b40c718edcd7cf61ac06c90cdc83f215b40802ea6c46ef61ac0a820c79f40215b40ba278ee558f61ac0dd29400541215b404bc726d34af61ac0312e31ed40215b407c51b9473ef61ac02591a5a240215b40070825a335f61ac0283b173f40215b4008b8f6332cf61ac0352fbe1a40215b4080dd1b851cf61ac06ca6875a40215b40c30546380ff61ac0bd7262fe40215b408475e91505f61ac091a4ff8a41215b40c3ed1326ddf51ac06482740a42215b400b79d82fcbf51ac07917b2ae42215b40c322f459bcf51ac0231dcc0d43215b40c5ad0660b7f51ac073ad6c5243215b40c5d4c4c8b3f51ac0a0db6e7344215b40c2f1e4d2acf51ac0780861ba45215b40bb5d3bf5a1f51ac0ece7d3c846215b40cbab5a588bf51ac07d8208de41215b404540eea684f51ac0f011e02740215b403a032b1c7bf51ac034e6277f3e215b407bd83d9a66f51ac05017f9a43c215b400ccabf7549f51ac0a1997d213b215b40c0ab70aa39f51ac0a017f81839215b4089f812092af51ac09590af5137215b40ca40255d1cf51ac0d42af21c38215b403e5a654dfcf41ac0d56be33436215b403bce409ceff41ac0ed292a4636215b40bf3f22451bf41ac0b9127c7b33215b407c39d77919f41ac01a5fd26733215b403a8be1ddd6f31ac009225d4633215b40426e2b2ad3f31ac0b91cacd232215b403d994f5dc6f31ac059d03c4932215b403e87650dc0f31ac06d27c19a31215b4000b42aadb2f31ac0a273c3a831215b403d1db5a8a0f31ac02eb2329e31215b4008eb755d88f31ac0e20a0cc931215b407ecb5d2048f31ac0092295f031215b40c332cda130f31ac0dee506b331215b4000632d8222f31ac0e2c4887531215b4004c4e59913f31ac06127130631215b403d77d35302f31ac051831fd530215b400cb99e6cf3f21ac0b916f02630215b40bd45bc77fff21ac00dda5f4b2f215b40c745bc77fff21ac0aa5035a82d215b40897a17fef2f21ac0dbeab6b12a215b4048aed1c3e7f21ac08eddf71f2e215b4038b47a89dcf21ac0e58bfd7c33215b404cfa00bbd2f21ac0b119addb34215b4041d3a453d8f21ac001c352a435215b407888f363d8f21ac07e5c413b36215b40030c1df4d2f21ac0b117be9336215b408b31c15ccaf21ac0980e44303d215b4040828362d0f21ac02474d4713f215b408751f3f2c7f21ac0d7b2bec345215b408df9b71cc6f21ac060c3ea1148215b4081da92e5bcf21ac04b2b94b548215b4088ffe81cb5f21ac0ab4f1d1b49215b40c3fdea41a6f21ac00b3b66cc49215b400452532891f21ac0f09fb2074b215b40fd0e5a517bf21ac0eb1a927c4d215b40c8844acb64f21ac02b24f8da51215b40c00ed7f048f21ac0d799d0db5a215b40bd3866cc69f21ac082bcbd6c67215b400da85d79b4f21ac0755ba5e269215b40c364f4b5c4f21ac00d0aa5d46a215b4047673dbeb5f21ac03986a3026c215b40bccf404da9f21ac08d3817086d215b400b7cd6dca1f21ac031e65c716e215b40bdc52eb9a0f21ac0f9f376c66f215b40070fe6d4a0f21ac0958b4e9972215b40873bd8ca9af21ac04d9be20274215b40feef60e495f21ac0406f2be675215b40d0417cbb80f21ac05cc5d6a177215b400be9a94a65f21ac034ea559f76215b408c8ae25f48f21ac0ccfb45a176215b404d8cf88d30f21ac0e895c38276215b40c1cdfce11cf21ac024f24cfb75215b40c826e1cd10f21ac08838e1cb75215b408d1a29c307f21ac0583ee52476215b4002ea94c3fef11ac04cf811ae76215b403b28e5c7f5f11ac0009bb75777215b40449b694be8f11ac008ad53d177215b400b5be649d9f11ac0d0a8e32278215b40484a6844c9f11ac0dc34e9a578215b403bf7bc36a9f11ac0809aaf4979215b404066af2581f11ac0e4b9f74d7a215b4087dcd1bd56f11ac0b05da6127d215b40c3f6a1fb07f11ac0cc471bbc79215b4002b76829a2f01ac01cc04ad179215b403ed5b06094f01ac068a413237a215b403e5d259981f01ac0106ceb3d7b215b40fb860cde69f01ac02827f4867d215b4041a6113043f01ac03807b2c280215b40c87ad6c304f01ac05c73a60482215b40cb566095f9ef1ac09326970983215b40fedc9969f8ef1ac003229f6285215b40ca8ee3e203f01ac0b3e07c9c89215b40ce6ad64918f01ac04fef2e698f215b40876a36031af01ac03afaa45f97215b404db2d6855cef1ac06a0d82c49f215b40821f91a20def1ac0ea4f1089a3215b4009683613fcee1ac095858bc6ae215b40c728777ed5ee1ac0b4771db9b4215b4001cde510b5ee1ac0b8ecb723ba215b40c1194b2d9bee1ac0202315adb9215b400c5a488385ee1ac0ec534419b9215b407f325b8e64ee1ac0eca042edb8215b40ca29247056ee1ac0cb6e54eeb8215b400108454649ee1ac09463d3a3b9215b404750660c3eee1ac0af35c53aba215b40c6155d7438ee1ac0cb20cfefba215b400f55bede32ee1ac0ec5996e0bb215b4088ac45f232ee1ac0c41610a4bc215b4007537be334ee1ac0ec9d94d0bd215b400e9517a03aee1ac0c75a0e94be215b4087695e913cee1ac08822e466bf215b40c2e50ac13aee1ac03f144585c0215b40c1fcf13335ee1ac067e8c785c1215b40ce0746d22cee1ac03b561cf0c1215b40fdeea4a120ee1ac05b424ff1c2215b407c9ea1c90fee1ac07fb73da7c4215b407d50e01efded1ac093d2997cbf215b4044c8cfc72eed1ac0c0e33451bf215b40ca66e82319ed1ac0f328e815c0215b4049b8df090ced1ac0c8fd11dac0215b408103be8405ed1ac03f499a7fc1215b40c7fc299205ed1ac07b5cf8f7c2215b40464431cf03ed1ac0120c6007c4215b408ded2a50fdec1ac0de1152eac4215b4010852d57eeec1ac06f477c27c6215b4089ba2756e0ec1ac09f2e03bfc7215b40035aba6bd1ec1ac0a7a6e764c9215b40c0bfeaf8caec1ac097ab0addca215b40d06b0908ccec1ac0fa692c09cc215b40c5732d78d6ec1ac032ca1e71cd215b404363bfa0e5ec1ac08ac1dfe6cf215b409017110902ed1ac06daacca9d1215b407e188bc208ed1ac0d55626b8d5215b40c94a901609ed1ac089370ea6d5215b40043f99b12eed1ac042a70310d9215b4082259bdd20ed1ac01491c566e2215b408e3c70dafbec1ac0644048e9ee215b404fe888e1c2ec1ac0a757b775f5215b404fe1a433a7ec1ac0b3b7f78df5215b400e98a6afb7ec1ac02ffeceeaf5215b40d0e76cc9f6ec1ac04bad7209f6215b408640c19c0bed1ac0c76deb19f5215b4002b7e3a636ed1ac0c76deb19f5215b404a2b236f65ed1ac007114766f4215b40c76f6ef597ed1ac0b7ded24ef3215b40054a98e5c2ed1ac0045b96d3f1215b403f925011dfed1ac0a4e02dc3f1215b40c016b68cf2ed1ac0a0e9a4b5f1215b408c0c399f02ee1ac0c3b73a87f2215b40c66c44ca2bee1ac023e60ce1f2215b404babe68a69ee1ac0e342b194f3215b40c4072c329aee1ac0e385d058f3215b40057c6bfac8ee1ac0ab983782f2215b4001867e8c0aef1ac0e3e7584bf2215b408e3c82501bef1ac0df8c0002f1215b400797e4b54fef1ac0e031a8b8ef215b407dbf6f978bef1ac07c655e51ee215b40cf3592a1b6ef1ac058dae743ed215b404078fae5ecef1ac0583c7036ec215b408c06171116f01ac068289e58ec215b40476fbce732f01ac0380c5272ec215b40bb4a629748f01ac03867aabbed215b4043a3e1ba80f01ac0e031a8b8ef215b40d0e72c41b3f01ac08b059902f1215b400772887bd6f01ac0c3b73a87f2215b4041f031fafff01ac0470ffbfbf4215b40d0329a3e36f11ac02bd8ac8ef7215b400393a5695ff11ac0131b2099fa215b404023a5d684f11ac07afa55b1ff215b404d17c12cd8f11ac016d80e4904225b400a599ab992f11ac082734c7408225b404c37f0c371f11ac03e59a6d908225b4048ea3c3470f11ac046551edd08225b400d55d80c70f11ac0f9709ba910225b400a750a6151f11ac04867b19b19225b4046a59a594bf11ac0c8a57b2425225b401186359545f11ac02c84a7902b225b4084257bf337f11ac05e299d4830225b40ce236ea626f11ac0f745149337225b40525a09dd25f11ac08e4c598638225b404491b06f27f11ac02e3c82cd39225b40d11e645930f11ac059765ab741225b40008f31772df11ac04dff2b2b4d225b40c27e129f3bf11ac0f001d28558225b40079e776341f11ac0e48678245e225b4090d0865435f11ac02f54978c63225b4005dbf5d214f11ac07f2cdd5d67225b40c836cdaaebf01ac0d676ac527a225b4091eae2361af01ac0be07483481225b4087506859f7ef1ac0ad82b1be81225b4010ecbb22f8ef1ac0b1aba84885225b40d02c05a4fdef1ac0346e4b9693225b40d02c05a4fdef1ac0c0fad85da0225b40c8a34fabe8ef1ac0a3b581c2a0225b40cd52742497ef1ac04bd12a33a5225b408a4c83a279f01ac01e20f818ac225b40cf168d0dc1f11ac0fd6a6e85b0225b40c859626534f21ac0a96209f2b3225b40c13e6ebf7cf21ac05d940035b5225b4093086d3997f21ac0cdd3e0b6b6225b4092a4660fb4f21ac0c99c0d80b8225b409881a966d6f21ac03ddf76a1b9225b4005a6b228ecf21ac048aa1611c5225b40848e6211a7f31ac0bd37e675c4225b400c9ee8baf0f31ac061068a58c4225b4013fe9600fcf31ac0112e4487c0225b400b1bef8e8cf51ac028566d37c1225b408aaa21718ff51ac00c4476dec6225b40051e1494a2f51ac01829bb99d1225b4016e37a6caff51ac0fbc120e9d3225b4087d3282499f51ac0c753b648da225b40c68240bfd3f41ac0fb948ac6da225b4047a77004a9f41ac0a706c1e3db225b40ce3afbca83f41ac0f2ed8497e0225b40887e69519ff41ac03d48bcc3ed225b4008198a3bdef41ac09557d5cbef225b40d6a8bc1de1f41ac03d0cd462f0225b408f43048bdff41ac005636bb6f2225b408ddde4b7e8f41ac0ed84bef4f6225b40c75cf8c1f9f41ac0),
+(12, 3, 'Kesambi', 0x00000000010600000001000000010300000001000000640000009b7ddbf8c5235b40dcd4fae33ce41ac05f05745fce235b400c8a709351e51ac09f8c6d52d1235b40503987c3b6e51ac06ad013cfd9235b408a769e78cee61ac0da433ee8d9235b40568c614ed0e61ac0169039cfd8235b40ca2ada1ce7e61ac01a4d7155d9235b40ce47c0e8f2e61ac05295f947df235b40168b14cac2e71ac009ed5e75e4235b40d8b80b2577e81ac0798b4b07eb235b40110429096de91ac0402d499eeb235b40caf07edc7ee91ac0f4e4a430ef235b40cee97420ebe91ac0e84c1de4f5235b40975df3aaceea1ac09861e63bf8235b408ad954dd23eb1ac054246937fa235b4015beba2a50eb1ac0d702857afa235b40d6298a5759eb1ac060b1c91af5235b404d7d71a94aeb1ac081975cc5e2235b405a4aafcdc6ea1ac0028969dfdc235b40ce8e5033a4ea1ac0ffc8f0d8cf235b4015e36a6457ea1ac00ffd56ebc4235b40d1492366f6e91ac0eb875451bc235b400d5b751daae91ac01b269694bb235b404ce38409a3e91ac0253530f2b2235b40118beb7058ea1ac09c802132ac235b408dc5d042e6ea1ac03e8e62b9a5235b40d3842e506eeb1ac04d5a349d9d235b400b0bb1fa23ec1ac0c73a502e8d235b405642572250ed1ac07f4e775089235b408883e0608bed1ac0cc064eb681235b40919c47c5ffed1ac01cbe82e673235b4096d6203b8bee1ac0ed0686ac6e235b40cef4b86fb5ee1ac0427869c361235b409588cf9d60ef1ac0da77fa415d235b40536b5cffaeef1ac0b3cead1056235b400beb884336f01ac0c4e1ba6246235b408ceb14ab06f11ac01c40ada23f235b40512a5ebd6ef11ac00c1f808637235b4094ba438a01f21ac0b50f677e35235b408ff734272ff21ac03c2a6b2233235b40c9f36915fdf11ac0052fe8bd31235b4046add689cbf11ac075bcb72231235b40cddb9bdf30f11ac0b9ab454431235b4056e7305f5ef01ac08927a94c31235b40cbcf7b6308f01ac0dc81289831235b40d6cc49807eef1ac0d9932f1a32235b4005aca6eb89ee1ac08036272f32235b4014bb135b5dee1ac0651d7c6132235b40524e6ac004ee1ac0bc4ed87e32235b400c8d5bcccfed1ac0a5f2643733235b4056162b6a30ed1ac0e10a168733235b40979f6d35ebec1ac09d01e31934235b40891dc2f869ec1ac005c7538f34235b40962970b20dec1ac009848b1535235b40ca3bf54883eb1ac0c0bd202235235b4057230d6e6beb1ac021acb4c535235b40d3803048dee91ac0096ae6c935235b4007cf0d4dd9e91ac0a58ad00836235b40d3059ca564e91ac084e8ec0736235b4052a098a364e91ac08d7ff50736235b408b13189464e91ac0fdf3873e36235b40d7232888fee81ac0c58df37e36235b408cac8d1186e81ac06ce3c08836235b40d7e16dbc73e81ac0d900b5ac36235b404ec6e07f30e81ac0e192250137235b404d923c0731e81ac02d19f45f39235b40cf983bdc09e81ac0ec83c8bf42235b40ccb868df14e81ac0f0a80c3a46235b40ca1dbe6d22e81ac0133f47f346235b4088ca42ba26e81ac03444210747235b400b94812468e71ac018651e0b47235b4091e295da41e71ac0fc851b0f47235b400e5fbb901be71ac08018828e49235b40cefdb02980e51ac0d475c3da4b235b40093e3b0682e51ac0814cf2ec6a235b408af0cd1d9ae51ac005c1a29f6c235b40596ce46e9be51ac08dfe4cbd6e235b40520bdc129de51ac0fdd5981073235b405125378aace51ac01062e3fa77235b408f61821abee51ac0a07333dc80235b40d2bab376dbe51ac0c79eb98784235b404cb940dae3e51ac007a04e2b85235b409015bebed6e51ac05f23c50089235b400b7b2aa73de51ac0ccbf124889235b404de653c72ae51ac02b9401a08a235b4055adba0ed5e41ac09e2885798f235b4092b7020af5e41ac072e2270ea0235b409862b5f97fe51ac03e87ae44a0235b4054fd62b664e51ac07e5c971aa1235b40ca64c1c41fe51ac0b1018dd2a5235b40d3f5402b30e41ac092e8e104a6235b40509a90d618e41ac081379fc1a6235b4096a830b610e41ac0c1383465a7235b404cfee9060ae41ac02127c808a8235b405082b45703e41ac03045ca89b5235b40d0ee3b4e1de41ac0d43418e1b5235b4054c912f61de41ac04404555fba235b401532949926e41ac0570913f8c3235b40981bf80c39e41ac01f14fbf7c5235b40da1130e53ce41ac09b7ddbf8c5235b40dcd4fae33ce41ac0),
+(13, 3, 'Pekiringan', 0x00000000010600000001000000010300000001000000cc0000003422853941235b400f34d42824d91ac008a171e140235b40529b1727beda1ac0c58fa0aa42235b408c6feb6e9eda1ac0075a367348235b40528a5242b0da1ac01b30fd4b52235b40d7f6612cefda1ac04341deab56235b40596662ba10db1ac05b4f621058235b404b09d9791bdb1ac0969e9e7763235b400958350873db1ac0c6f68ea163235b408ab0c05774db1ac055d494eb6d235b4012ecd808c4db1ac049f1a65b76235b40867859130bdc1ac0cc8887307e235b404cde516342dc1ac0dc09abb184235b400a93331477dc1ac03421628385235b40cc8493347fdc1ac03f55807687235b40d876f35487dc1ac00cc1a7eb89235b40167e164b91dc1ac02f6bde718a235b40c95011a793dc1ac09faabef38b235b400d2daef199dc1ac0ef13bc218d235b4056d2a3a99edc1ac0ebab27f38f235b40d98147aca9dc1ac0c6eb76f695235b40912bd505bcdc1ac0badcdc989e235b404e6b51f7e5dc1ac06a3b22dfa5235b400ea0134207dd1ac0696e8221ab235b40d7fd428f18dd1ac05e7a7764ac235b400bb8efc01cdd1ac07506c24eb1235b400e7135b22bdd1ac06997cc60b5235b40cfaad80c2edd1ac049bd2363b5235b40cc43300e2edd1ac0f94b886bb5235b40556d431d3add1ac0ac8294d8b5235b40cb3f4b57b0dd1ac0f91fdc9db5235b408e25fdbd14de1ac03095fab2b4235b4018404aecdade1ac0cc893824b5235b401a96c9703cdf1ac0ebd54334ba235b40d98900a777e11ac0449f0b23bd235b40163028d368e21ac07892f0bdbf235b401cf4cef90de31ac0f0625cf8c1235b40882cf6067be31ac01b77c6f7c5235b40558069c03ce41ac09b7ddbf8c5235b40dcd4fae33ce41ac01f14fbf7c5235b40da1130e53ce41ac0570913f8c3235b40981bf80c39e41ac04404555fba235b401532949926e41ac0d43418e1b5235b4054c912f61de41ac03045ca89b5235b40d0ee3b4e1de41ac02127c808a8235b405082b45703e41ac0c1383465a7235b404cfee9060ae41ac081379fc1a6235b4096a830b610e41ac092e8e104a6235b40509a90d618e41ac0b1018dd2a5235b40d3f5402b30e41ac07e5c971aa1235b40ca64c1c41fe51ac03e87ae44a0235b4054fd62b664e51ac072e2270ea0235b409862b5f97fe51ac09e2885798f235b4092b7020af5e41ac02b9401a08a235b4055adba0ed5e41ac0ccbf124889235b404de653c72ae51ac05f23c50089235b400b7b2aa73de51ac007a04e2b85235b409015bebed6e51ac0c79eb98784235b404cb940dae3e51ac0a07333dc80235b40d2bab376dbe51ac01062e3fa77235b408f61821abee51ac0fdd5981073235b405125378aace51ac08dfe4cbd6e235b40520bdc129de51ac005c1a29f6c235b40596ce46e9be51ac0814cf2ec6a235b408af0cd1d9ae51ac0d475c3da4b235b40093e3b0682e51ac08018828e49235b40cefdb02980e51ac0fc851b0f47235b400e5fbb901be71ac018651e0b47235b4091e295da41e71ac03444210747235b400b94812468e71ac0133f47f346235b4088ca42ba26e81ac0f0a80c3a46235b40ca1dbe6d22e81ac0ec83c8bf42235b40ccb868df14e81ac02d19f45f39235b40cf983bdc09e81ac0e192250137235b404d923c0731e81ac0d900b5ac36235b404ec6e07f30e81ac06ce3c08836235b40d7e16dbc73e81ac0c58df37e36235b408cac8d1186e81ac0fdf3873e36235b40d7232888fee81ac08d7ff50736235b408b13189464e91ac084e8ec0736235b4052a098a364e91ac0fd23c15433235b4010081b9e5ee91ac0464b0c022b235b405475c3b645e91ac043e0365321235b40587ebf982de91ac05bc2c8cb1a235b409206cf8426e91ac06fa45a4414235b40cb8ede701fe91ac0bf291e4014235b40d5f38d691fe91ac0d885404014235b40d180b7591fe91ac0fb23326014235b4055ffa0f8a8e81ac087fb35e013235b404e0bc478eee71ac06bd436a013235b40c660d677bde71ac09416d84013235b4091e04c9034e71ac0ebdfe34c13235b4088d6bfeb33e71ac02f89652915235b408d5979d629e71ac06fe2710817235b40495d3f5a22e71ac0b33b7ee718235b404e5d3f5a22e71ac0c20139661b235b40485b5c1826e71ac0065b45451d235b40055cfdd824e71ac0ea23f7d71f235b40cafbf1adfbe61ac02bc752241f235b40c5387afcdee61ac03ee766fc1e235b404b9fac06cbe61ac03ee766fc1e235b4088d68b8fb9e61ac052077bd41e235b405010ef1aa3e61ac08b57ad701e235b404d7863a68ce61ac08b57ad701e235b4051b5ebf46fe61ac04af770e81e235b40c71d014158e61ac06b04098a1f235b40521aa61647e61ac0c2a0af6320235b4085bfd8572be61ac0073ddb0622235b4098c39edb23e61ac0ba8975ce22235b4043fb1e2511e61ac0fbe9b15622235b40176576f2f6e51ac0d6c0c33b20235b409207efc9c8e51ac02e7d03b721235b4006f3bf9435e51ac016641f881f235b40da2c23201fe51ac08eb106ca1b235b4045c9f231fce41ac037f5c64e1a235b4051cbd573f8e41ac0d46b9cab18235b4088ca34b3f9e41ac07b10008716235b408b794f010ae51ac00b72e20416235b400cd5073406e51ac08363f7fc14235b401063b503f2e41ac087ffcf5213235b4006643208ebe41ac0e337f2c714235b400daa75f455e41ac09055018f19235b4089495c5563e41ac00ccbfabe19235b40580091bd5fe41ac02bcbfe791a235b40cd2f0dc347e41ac0cf2eb6111b235b404924a87c30e41ac0a768b9bd1b235b40471a038a0fe41ac0ff15ba271c235b404ced0acef7e31ac01f67d9aa1c235b40554c1e72dae31ac0fad10d361d235b4056641347bbe31ac0db2edbb41d235b40d07ac8e29ee31ac067e4564b1e235b40c6b636317de31ac01f6f5f8e1e235b408bc3b12e6ee31ac08f04061e1f235b400e1be16651e31ac0be83f7cf1f235b40d29d57c02de31ac00be22a4f20235b4047a25a4414e31ac0d7ad482921235b40c6fa8f00f9e21ac07ff8e7a221235b408dd3b7cce9e21ac086b51f2922235b400689cc5ce0e21ac03fb55cf41d235b400839930bd5e21ac093629b781a235b400c2e2aaccbe21ac0a094b63f17235b40cd2f9b00c3e21ac0e452f3bd15235b40942d8aeebee21ac0e3a6979211235b40487557abb3e21ac0540de9f010235b4098b087f6b1e21ac0ab0bf2f50d235b408b535248aee21ac0a4eba3980b235b4089c3cb5cabe21ac06073d7400a235b4053bb32b4a9e21ac039eac08608235b40c87e4492a7e21ac02551535408235b408893fd53a7e21ac0ad035de7fe225b404e5b6d658ce21ac03939173dff225b40913a063677e21ac0ad7a3d51ff225b4007558f3b72e21ac049bc0fb2ff225b404a2e8bb44de21ac04627be4fff225b408696a4d70be21ac04dcebec5ff225b4092830be1e7e11ac0cdaa93bffd225b40d86ae275e1e11ac0cd9a3768fe225b40d50721e8b4e11ac07d3486e300235b40958e5a33c2e11ac090f9997303235b40129976b04ce11ac0505632b405235b408d658a015ee11ac0e1b74d9307235b40110b4ace12e11ac0d0b1869806235b40139b4d120ee11ac0657275260b235b40165d59ca7be01ac08c19ac910d235b40155044d59be01ac0f0e482b217235b4095ddd7d4bbe01ac0eb04bf991a235b40c74eea00c5e01ac0274b18291b235b4018bbdb1d9be01ac05478be9f1a235b40cecee1ea98e01ac0fb4662821a235b404681ab3c81e01ac01f1c479d1a235b40cb5bd8c32ce01ac05725ba921d235b40cb24ed132ee01ac02ff825921d235b4051eab5433ddf1ac0b33dfe911d235b400b2dafa8fdde1ac05fbdad311e235b40968ea7e507de1ac0025422331e235b40d1ede9a3b9dd1ac0c3a6c10f1c235b40472a0a2b7ddd1ac06e44d6341e235b40966bcaea5ddd1ac0aae1326f21235b40c8c6b20529dd1ac097e3949d24235b40c95555e6e8dc1ac087fc306125235b40901793b2d0dc1ac0da3ca17c26235b40113e2047a8dc1ac0f7d884b426235b40cc65eb0e99dc1ac04f416ae826235b40d5cb80a66adc1ac01a8572d826235b400cbd11f44fdc1ac08be4c53426235b40085d0a170bdc1ac0c2c8325d25235b4044a47fc8a9db1ac037bcc04524235b40095dd4003bdb1ac0562ffecd23235b40908727190bdb1ac0d61a2b7623235b4018d7c1f6d0da1ac0d39e5b1623235b4050929a315dda1ac0177b67fe22235b408c4a6d023cda1ac03ebb8fae22235b40ce0bebb4d8d91ac036eea48622235b408b262a0cabd91ac02262cb3a22235b400f3802b658d91ac007c6e70222235b400d95cd1220d91ac0f7a511d821235b401604ee5af1d81ac0bbf6f3a622235b40d6cb5ce0f2d81ac05329e56723235b401574ddecf3d81ac0320db1fa23235b400cc03bf9f4d81ac02be8e44526235b40d5dfac19fdd81ac0d2d7e1e82a235b4052849675ffd81ac0067dd7a02f235b40c52b0b26fed81ac0acd0f57d38235b4093a6203f1bd91ac03422853941235b400f34d42824d91ac0);
+
+INSERT IGNORE INTO `kelurahan` (`id`, `id_kecamatan`, `kelurahan`, `area`) VALUES
+(14, 3, 'Sunyaragi', 0x00000000010600000001000000010300000001000000ff0000009416d84013235b4091e04c9034e71ac06bd436a013235b40c660d677bde71ac087fb35e013235b404e0bc478eee71ac0fb23326014235b4055ffa0f8a8e81ac0d885404014235b40d180b7591fe91ac0bf291e4014235b40d5f38d691fe91ac06fa45a4414235b40cb8ede701fe91ac05bc2c8cb1a235b409206cf8426e91ac043e0365321235b40587ebf982de91ac0464b0c022b235b405475c3b645e91ac0fd23c15433235b4010081b9e5ee91ac084e8ec0736235b4052a098a364e91ac0a58ad00836235b40d3059ca564e91ac0096ae6c935235b4007cf0d4dd9e91ac021acb4c535235b40d3803048dee91ac0c0bd202235235b4057230d6e6beb1ac009848b1535235b40ca3bf54883eb1ac005c7538f34235b40962970b20dec1ac09d01e31934235b40891dc2f869ec1ac0e10a168733235b40979f6d35ebec1ac0a5f2643733235b4056162b6a30ed1ac0bc4ed87e32235b400c8d5bcccfed1ac0651d7c6132235b40524e6ac004ee1ac08036272f32235b4014bb135b5dee1ac0d9932f1a32235b4005aca6eb89ee1ac0dc81289831235b40d6cc49807eef1ac08927a94c31235b40cbcf7b6308f01ac0b9ab454431235b4056e7305f5ef01ac075bcb72231235b40cddb9bdf30f11ac0052fe8bd31235b4046add689cbf11ac03c2a6b2233235b40c9f36915fdf11ac0b50f677e35235b408ff734272ff21ac095bbbda431235b40d6ed056685f21ac0da94196f2b235b409318726c3df31ac0f26a286728235b4004aa967494f31ac0b2e418c91e235b4087b31ebe4cf41ac07f257ec51a235b40ca80e84999f41ac0969b965819235b40d0134c8db4f41ac0df1bed9512235b409662aeac37f51ac0033c579412235b40547da99f37f51ac07440390a10235b40471b7c6132f51ac019751af0f9225b404a21533e04f51ac0ed0a2344f9225b40d8f6d8ee02f51ac0ed84bef4f6225b40c75cf8c1f9f41ac005636bb6f2225b408ddde4b7e8f41ac03d0cd462f0225b408f43048bdff41ac09557d5cbef225b40d6a8bc1de1f41ac03d48bcc3ed225b4008198a3bdef41ac0f2ed8497e0225b40887e69519ff41ac0a706c1e3db225b40ce3afbca83f41ac0fb948ac6da225b4047a77004a9f41ac0c753b648da225b40c68240bfd3f41ac0fbc120e9d3225b4087d3282499f51ac01829bb99d1225b4016e37a6caff51ac00c4476dec6225b40051e1494a2f51ac028566d37c1225b408aaa21718ff51ac0112e4487c0225b400b1bef8e8cf51ac061068a58c4225b4013fe9600fcf31ac0bd37e675c4225b400c9ee8baf0f31ac048aa1611c5225b40848e6211a7f31ac03ddf76a1b9225b4005a6b228ecf21ac0c99c0d80b8225b409881a966d6f21ac0cdd3e0b6b6225b4092a4660fb4f21ac05d940035b5225b4093086d3997f21ac0a96209f2b3225b40c13e6ebf7cf21ac0fd6a6e85b0225b40c859626534f21ac01e20f818ac225b40cf168d0dc1f11ac04bd12a33a5225b408a4c83a279f01ac0a3b581c2a0225b40cd52742497ef1ac0c0fad85da0225b40c8a34fabe8ef1ac0346e4b9693225b40d02c05a4fdef1ac0b1aba84885225b40d02c05a4fdef1ac0ad82b1be81225b4010ecbb22f8ef1ac0be07483481225b4087506859f7ef1ac0d676ac527a225b4091eae2361af01ac07f2cdd5d67225b40c836cdaaebf01ac02f54978c63225b4005dbf5d214f11ac0e48678245e225b4090d0865435f11ac0f001d28558225b40079e776341f11ac04dff2b2b4d225b40c27e129f3bf11ac059765ab741225b40008f31772df11ac02e3c82cd39225b40d11e645930f11ac08e4c598638225b404491b06f27f11ac0f745149337225b40525a09dd25f11ac05e299d4830225b40ce236ea626f11ac02c84a7902b225b4084257bf337f11ac0c8a57b2425225b401186359545f11ac04867b19b19225b4046a59a594bf11ac0f9709ba910225b400a750a6151f11ac046551edd08225b400d55d80c70f11ac03e59a6d908225b4048ea3c3470f11ac082734c7408225b404c37f0c371f11ac016d80e4904225b400a599ab992f11ac07afa55b1ff215b404d17c12cd8f11ac0131b2099fa215b404023a5d684f11ac02bd8ac8ef7215b400393a5695ff11ac0470ffbfbf4215b40d0329a3e36f11ac0c3b73a87f2215b4041f031fafff01ac08b059902f1215b400772887bd6f01ac0e031a8b8ef215b40d0e72c41b3f01ac03867aabbed215b4043a3e1ba80f01ac0380c5272ec215b40bb4a629748f01ac068289e58ec215b40476fbce732f01ac0583c7036ec215b408c06171116f01ac058dae743ed215b404078fae5ecef1ac07c655e51ee215b40cf3592a1b6ef1ac0e031a8b8ef215b407dbf6f978bef1ac0df8c0002f1215b400797e4b54fef1ac0e3e7584bf2215b408e3c82501bef1ac0ab983782f2215b4001867e8c0aef1ac0e385d058f3215b40057c6bfac8ee1ac0e342b194f3215b40c4072c329aee1ac023e60ce1f2215b404babe68a69ee1ac0c3b73a87f2215b40c66c44ca2bee1ac0a0e9a4b5f1215b408c0c399f02ee1ac0a4e02dc3f1215b40c016b68cf2ed1ac0045b96d3f1215b403f925011dfed1ac0b7ded24ef3215b40054a98e5c2ed1ac007114766f4215b40c76f6ef597ed1ac0c76deb19f5215b404a2b236f65ed1ac0c76deb19f5215b4002b7e3a636ed1ac04bad7209f6215b408640c19c0bed1ac02ffeceeaf5215b40d0e76cc9f6ec1ac0b3b7f78df5215b400e98a6afb7ec1ac0a757b775f5215b404fe1a433a7ec1ac0e76e2602fc215b404208d2858bec1ac032792cbb02225b408c9e033d67ec1ac0eaf050510b225b408888c3de33ec1ac056e071b50e225b4087bad01019ec1ac009d2ffc113225b4041dd6379efeb1ac04aa2ffa415225b408e1ad8c2ddeb1ac0718f36e217225b408b235504cdeb1ac0c8b9bac41a225b40825c4425bfeb1ac09dc0469220225b4009b20c71aceb1ac03c45251d21225b400cfa6327aaeb1ac05c3f95f42c225b40028f473678eb1ac09a3f2e6038225b400491106b45eb1ac00efd26f943225b40cb883fd10feb1ac07ad394d749225b40825faf27efea1ac00d1194d144225b4007592a3f0eea1ac0514028ff45225b40441dc5d106ea1ac036ba432d47225b40c4b967cff8e91ac0bd4cb61f48225b400a3b3d33e4e91ac041e7cd0249225b40c742be58d3e91ac0350f64034a225b40caee4806cae91ac04a46df5e4b225b400bdd7335b9e91ac07571db614d225b4047879aff8fe91ac06e58917648225b400a2c476acfe81ac09283d2ba47225b4001d21cb4b2e81ac06927331641225b408d7b4abca6e71ac0c9faac2640225b40ced774586fe71ac0036219583d225b408dc864e555e71ac01edc86893a225b40ff6fe17339e71ac00e35749d3a225b40cd106fa238e71ac0e1e1ac1444225b400340d226d5e61ac02d2d59a14a225b4008d83e429fe61ac0688796174e225b404a4de44c8ae61ac05d88c7ae4f225b40c52657b8b2e61ac0f084027f50225b400e5879b8d3e61ac0b1d9724d52225b4054e94c021de71ac049072ad454225b4051eb4a4f81e71ac07d908a4158225b400e68ef89fee71ac0948de04c5a225b404d34021ff3e71ac06c3e3b715d225b4087ce286fdde71ac034911fb55f225b408ce74901b8e71ac05484444562225b40904e1c1086e71ac070a3283d64225b40cfc342036ae71ac0f3077d9866225b40d0f8d5f658e71ac003b82c3e69225b4089fe9ee852e71ac0238d12106d225b4052dbd35956e71ac07733801371225b40cc9ee0a461e71ac0f612ba5373225b40438f44a969e71ac006bbfcb274225b40cb06583468e71ac03a504b3c78225b4088eca9b64ae71ac0226edd8976225b40532b6db0f1e61ac09e36275579225b4012cdaacfd5e61ac0d29f59bc7f225b40432f8032bee61ac091ad948c86225b400319a08d8ce61ac021ce685391225b408976f5e35ce61ac02455742497225b408e65ef2c44e61ac09bfeb29f98225b400a50684f85e61ac044a708ef99225b4006b06f2ccae61ac0bcf4ef3096225b4093706729d0e61ac039afc6e996225b4007b4d9f35de71ac0d39acd839e225b40c60eb52643e71ac04313eeb99f225b40942aefd83fe71ac08f6fb572a2225b40d385522acae71ac062f953e3a5225b4009604e08d1e71ac02a86ce15ac225b4091a2931ce5e71ac0120c61e4ae225b408c27499ae9e71ac02ed50150b2225b404e7f44e3eee71ac012823132b3225b4045a83894f5e71ac0fa07c400b6225b4005cb1efe20e81ac0fd009457b8225b4048f7500a09e81ac0e2862626bb225b4013aedd0b06e81ac025e03205bd225b40888918aec2e71ac079b4ddacbd225b408eefad4594e71ac08a82873cbe225b40cf37d83335e71ac05e14799fbe225b40d24bb63d1ee71ac0b1831e28bf225b4054ec453d0fe71ac0fcc51acebf225b4089924fa609e71ac01dffe1bec0225b40d78db4b909e71ac01dd4c945c1225b4054d98b5910e71ac0c11ea890c1225b4094cf1d1315e71ac03d0d9136c2225b408da6df6c10e71ac0118dc0ebc2225b400656bff508e71ac075dc67cdc3225b40931796f809e71ac0e9f2a19fc4225b400f62bfad0fe71ac031f86664c8225b408e4b904bfbe61ac02c6e31afcb225b40d2c59f9efae61ac03cac1b18ce225b40c5b4dec0fbe61ac0a8498035cf225b408d60ca6c02e71ac00fb8c882d1225b4014671b8713e71ac0f8c05a5ad2225b40d268fa7a2be71ac0e4224909d4225b40c29ffe7b3ce71ac0a8628000d6225b40873cc86e43e71ac080f0730fd8225b40ca853b6d46e71ac0eb1043d1da225b404a34ef3845e71ac0ab7f39dedd225b40938feb883ee71ac007261c82dc225b40d5b55d7a91e71ac09f0e796edb225b400ca747a1cce71ac0777bc878da225b4053730cac09e81ac0573f4cb1d9225b40c9a6809039e81ac0130df012d9225b404fb642c070e81ac01b89bf72d9225b40484500ac98e81ac08bf94d52da225b4049a6ac97c0e81ac00b627bf1db225b4048c2487bf8e81ac0831cf5efde225b40d572aa4f4ee91ac067fd11afe0225b40c6875f3a78e91ac03e01d60be7225b40d5d3563b76e91ac02e09374ce6225b400d25d45a38e91ac0ce90472ce6225b408ece3779fce81ac03e01d60be7225b404c3e19b38ae81ac03ea04c09ec225b40d17d21b684e81ac0ae10dbe8ec225b408f5c6610d3e71ac0ea4ea16ae9225b4012167714cbe71ac042999827ea225b40491877b3a1e71ac0a2d144d8f0225b40d259d52aabe71ac0aaaf51e6f1225b408cc0fd5a40e71ac0628a2728f6225b400d7f12164ae71ac03d83af13f7225b404e56eac1f3e61ac06d6050e1f3225b404956eac1f3e61ac01d1397b6f4225b405362f4a498e61ac09d9948e8f5225b40c7ac54db43e61ac00dc7b703f7225b40871c3615d2e51ac0de809041fb225b40d5282513d6e51ac02d5c6b92f9225b4092a74cd64de61ac08d2fe5a2f8225b400a9c1df294e61ac0ed9aa507ff225b408c97b6ad9de61ac0d15971d0fc225b40099c15814ce71ac06003647603235b4045bc08044ee71ac0cd277d1a0a235b4011043cc953e71ac0e33689f310235b4011bbc68250e71ac06733f11e12235b4087ef210944e71ac09416d84013235b4091e04c9034e71ac0),
+(15, 4, 'Kesepuhan', 0x0000000001060000000100000001030000000100000063000000affb56193d255b40e0964547c3e81ac0fa2cf52c08255b4094a69201a0ea1ac04a4c766c04255b401c450bd0b6ea1ac0f34b2366f6245b40db27983105eb1ac018f3186cea245b40df35ab3e57eb1ac01902df6dde245b40905e251fbbeb1ac0de1383c0ca245b4015457d923bec1ac02676cc79c6245b401288450c3bec1ac0c7808461c0245b401685200725ec1ac020f5f017b3245b4019aeb48cd4eb1ac0cd6b847ea6245b40cfb5645584eb1ac0515966c39a245b405bff0f0858eb1ac03a80164b91245b4018beba2a50eb1ac05662360186245b40519a577556eb1ac05f36d25279245b40d112ee9579eb1ac014011fbc76245b409ce8279cddea1ac087b0313674245b40201f2922c3ea1ac014e34e9b71245b40ce1f5c0185ea1ac044348b506c245b40cdf97abe66e91ac0244f2a1a6b245b40d8741daa29e91ac05caaa3e36a245b4017b34d821be91ac09ce720e868245b40d201452c62e81ac008d25d1267245b4097266893c3e71ac00d06a8a965245b409d6f07ea94e71ac035cd0c1b65245b401f8ec6a17ee71ac00d204df564245b405b90459a78e71ac0c9161a8865245b40519c668176e71ac0c559e20165245b4057efc16b97e61ac0690ee19865245b400ceb436fd5e51ac0b0a001f566245b401a3eac376ae51ac0bdb5bc2967245b40532282fa61e51ac00044f83b67245b40de502b215fe51ac078578fad67245b40cda1b2614de51ac074b827486c245b401a79abae43e51ac0c8d309ef72245b400c50a4fb39e51ac01f7b8ec877245b4014279d4830e51ac0fbbd666b7d245b400dc7ee0225e51ac0a633c85d84245b4095dfd6dd3ce51ac0ae8f1f2a8d245b4052e3073f55e51ac02d06a73e90245b400fc1172653e51ac02d8c0b8e92245b408e14ff7744e51ac0d9dbfe9595245b4059f658fad0e51ac0016f197096245b4093a6a209f8e51ac03662fe0a99245b400f5cc47762e61ac082b156ed9a245b40942b0dfca8e61ac0f9e1b88c9b245b4099085053cbe61ac05c73df6a9d245b40d075d1e8f2e61ac0cf8f1ebfb7245b4092c96c9049e61ac0472b560dc2245b40d3dabe47fde51ac0aaf30703cf245b40571472a59ee51ac066295b9ed3245b409f66d55e79e51ac0e9aad898d7245b40d8eca92d59e51ac025182d40db245b405ed4c15241e51ac0e5f83f41db245b4093f1b54b41e51ac02d3a5148db245b409b5f9b1d41e51ac0a01be7d9e6245b406038492301e51ac0a4334626eb245b406000e807e0e41ac067362ee9f6245b40526d077185e41ac06353a8cff7245b40da6aa3119ee41ac0537ae94cf8245b40e172c781a8e41ac0fb805f56fa245b40dad236f6d3e41ac087397726fa245b401b315fdf00e51ac05754d966f9245b408e8bc14435e51ac0ab28840efa245b40219bd1b74ee51ac07bb7a8b4fd245b401a31769c84e51ac0cf8b535cfe245b4051c53b8da2e51ac096a7e633ff245b4012e45b73d5e51ac05f3d3b8300255b40d10642dd00e61ac0aa1217c202255b409dd283c927e61ac0a68f17b904255b4019e659c039e61ac00f55317708255b40d1b927b451e61ac03bb7cf2d0b255b401b4a272177e61ac0c5e5b74b0f255b40922598d903e71ac0093fc42a11255b4022c5a7b942e71ac0459901a114255b4096d0f1a863e71ac0448cd2e51a255b4013788d9093e71ac0ddb9896c1d255b4017c7a506a7e71ac0d1baba031f255b401bc7a506a7e71ac0240c66a221255b4017c7a506a7e71ac0944a104124255b405c42f088a2e71ac0a8be7e6025255b40d77d3208a4e71ac0456f35f025255b409a5100fcbbe71ac034f4962727255b409353dfefd3e71ac037ed667e29255b405dd8946dd8e71ac07c46735d2b255b40de96ad76c6e71ac0b723b0dc2c255b401a12f8f8c1e71ac033e7676c2d255b40115d4aebdce71ac06bcbd4942c255b40232d525bfce71ac078c373542d255b4098b7ac5011e81ac02868e4ec30255b401b46cdc91ee81ac017eafa3132255b409fb7ac5011e81ac0e4fb1ee133255b409704e6d20ce81ac08728a5d034255b40dbc6bcc32ae81ac0364dca7f36255b40145f483841e81ac0879e751e39255b40612306b93fe81ac00b58be853a255b4019911fbc39e81ac0c37bb29d3a255b4052ef47a566e81ac013d48de53a255b40e2c8ba108fe81ac0affb56193d255b40e0964547c3e81ac0),
+(16, 4, 'Lemahwungkuk', 0x000000000106000000010000000103000000010000005b000000e7ba88efc4245b401a66192676de1ac037cf0166c4245b4018f838a093de1ac07b13afa6c3245b40d453ddf81adf1ac08b3b0bb5c5245b40da6104c48cdf1ac0b35f5afdc6245b4016f926b0addf1ac051b640b8cb245b409d5bb71d27e01ac0f6323c38d0245b40220294e48be01ac05d244128d7245b409dde91cf30e11ac045aad3f6d9245b40a04804a87ee11ac08580e0ccdd245b409d5fb7df0ce21ac0b8d41e31e5245b405365926dede21ac04803074fe9245b401ea1f3453be31ac0f4da081aec245b405722a29d75e31ac098c8dd80ec245b409a7926ef74e31ac0c829f455ed245b401adc5dca6de31ac0ef937661f3245b401d7d0de939e31ac0d3d62116f5245b405254ea992be31ac0afb1900afa245b40d6fd660902e31ac0aba68eb1fa245b40d994a390fce21ac0c36bd47bfe245b4053f202c6dce21ac0fe37f0cb02255b4097f8149db8e21ac06e98c4e202255b40969db073bce21ac047b423a5fe245b409f56cde0e0e21ac0c3701426fa245b4057c9820306e31ac05fddd781f3245b40a2c6dac83de31ac0a88a877eed245b4013878c1971e31ac06058d495ec245b409d376fdb78e31ac0a84c51adec245b40d83c3da281e31ac07c5745b3f0245b405e2f2b7ccce31ac0c3a921e9f4245b4058c5eeb94ee41ac067362ee9f6245b40526d077185e41ac0a4334626eb245b406000e807e0e41ac0a01be7d9e6245b406038492301e51ac02d3a5148db245b409b5f9b1d41e51ac0e5f83f41db245b4093f1b54b41e51ac025182d40db245b405ed4c15241e51ac0e9aad898d7245b40d8eca92d59e51ac066295b9ed3245b409f66d55e79e51ac0aaf30703cf245b40571472a59ee51ac0472b560dc2245b40d3dabe47fde51ac0cf8f1ebfb7245b4092c96c9049e61ac05c73df6a9d245b40d075d1e8f2e61ac0f9e1b88c9b245b4099085053cbe61ac082b156ed9a245b40942b0dfca8e61ac03662fe0a99245b400f5cc47762e61ac0016f197096245b4093a6a209f8e51ac0d9dbfe9595245b4059f658fad0e51ac02d8c0b8e92245b408e14ff7744e51ac02d06a73e90245b400fc1172653e51ac0ae8f1f2a8d245b4052e3073f55e51ac0a633c85d84245b4095dfd6dd3ce51ac0fbbd666b7d245b400dc7ee0225e51ac01f7b8ec877245b4014279d4830e51ac0c8d309ef72245b400c50a4fb39e51ac074b827486c245b401a79abae43e51ac078578fad67245b40cda1b2614de51ac04517a58460245b409d0b03965ce51ac0ed6f20ab5b245b401115a58460e51ac07d4247ab5a245b40538f149161e51ac039990aa359245b4053957840d9e41ac0b6f34d2157245b40df88b14cbfe41ac045b042ca4f245b40d8cb79c6bee41ac066fd372f4e245b4015403979ade41ac03e3e71874d245b40199ee8baf0e31ac0d211ed0a4d245b409ba32b4d54e31ac0c539bbb54c245b405b168046e9e21ac092de41ec4c245b4094fc4b5299e21ac0c18678245e245b40cb6d4c4f58e21ac0d97a573d60245b40117e6b274ae21ac08572f2a963245b4051f22ada38e21ac0856a54e064245b401e449f8f32e21ac0dd810bb265245b408e7dc51a2ee21ac055005d3464245b40ccc5be64e3e11ac051abb9dc60245b40d5c1da183be11ac0151542075d245b40194baab69be01ac099f35d4a5d245b4053e159828ce01ac039eb245b5d245b400dcbf09f6ee01ac0ed66988c63245b40ce1dcc955de01ac0dc3ad0436d245b4011c50ce528e01ac0389ffcf76f245b40180ae5b40fe01ac000c604357c245b40d00d2db29ddf1ac0fbc92f0a82245b401650b56e67df1ac0b636255987245b4090087cd619df1ac00a1709f489245b4011a2772ae0de1ac0d7a0008a91245b406201f39029df1ac07df41e679a245b401c5fafb0e0de1ac094871dc6a4245b401b2edffab0de1ac07406dec9a7245b405822be13b3de1ac0217814e7a8245b4098d878b0c5de1ac0e7ba88efc4245b40196394675ede1ac0e7ba88efc4245b401a66192676de1ac0),
+(17, 4, 'Panjunan', 0x000000000106000000010000000103000000010000002a01000005a8b527b0245b40d4c1fc96b2d21ac0f8378453b4245b4092419a0c22d31ac02d3b101db8245b4000d8a47077d31ac0f8ba14bfb8245b401107c8d4b3d31ac08323104ac0245b400d9fb16e3dd41ac03fd45989c0245b4037d2c2954ed41ac0ecc4fff9c0245b40ab81433156d41ac0608cc4ffc1245b402133cfa34cd41ac0edeba783c4245b40242016c535d41ac0dfb5eb57c7245b4080ec906b1dd41ac0b6ef04cac8245b4084c2020012d41ac06d7a4a00cd245b40199808baeed31ac06e6c46f0d0245b40a230a5dbb2d31ac03ef353e4d6245b40a7c19b8e58d31ac00ae58a71dd245b40d18b1dc7f5d21ac026febf1ae2245b4019ae29f1b1d21ac05751d187e8245b40d04c73a74fd21ac054f0d1d7e7245b409c48664a46d21ac01cebeb1be8245b4085940e7441d21ac0c26d3ad2e8245b4088000a624bd21ac0aacfed3af8245b404c9c454561d11ac081f7ada6f8245b40cd6ad7295cd11ac03ec9690cf9245b405025a6b763d11ac04aaf25faf8245b40d3bf395866d11ac0839aab93f8245b40c07d923168d11ac0d609b103f6245b40d934bb798cd11ac08867967ff3245b40edea68d4b2d11ac04eb4b113f1245b4062adb27fd7d11ac019b7b31cee245b409f9c556604d21ac0ce8ad3b8ea245b40eba88c0938d21ac018aebe30e7245b407602c2ca6cd21ac062919b26e3245b40e1e2df97a9d21ac070e6a135e0245b404884f37ed6d21ac0b4696399dd245b40b11c4098fdd21ac00f4c85aeda245b40f64eee5e29d31ac06a750c7bd7245b40e36277d359d31ac0b84965aed4245b4089345e1b84d31ac0ebb5914dd1245b405644512eb7d31ac0e0c665cbcd245b4040f1ec40edd31ac01ea56543cd245b4015cba45bf4d31ac0aad4a6dccc245b40d2198d97f9d31ac0afb81772ca245b40bdede3550dd41ac0b331ef66c6245b4093f482ef30d41ac055ca9140c2245b406af61e0856d41ac0f6594ec6be245b4017f5013974d41ac00fbfcf1cbd245b40e813486282d41ac0d095e794bc245b409b95db4986d41ac0b5fbcadcac245b401ed2810560d41ac029f92aaba9245b401e45c6fb55d41ac0f8877ed39f245b40992aa84f42d41ac03d9440509c245b40513f708344d41ac0f233d3b19b245b4096931dcc3ad41ac0991e179b97245b40d67d3a9afad31ac0bb31e5ae8b245b40ef4857d90cd41ac0334145d887245b407536625104d51ac02d20863599245b408a377b0742d51ac0462aa8d199245b40338e673244d51ac01e4830879d245b40543429305dd51ac05a98ab8c97245b40d3be6b6043d71ac02115c91d97245b406af2e79966d71ac00bb205d694245b40d5dcda7d1fd81ac0f7fac83993245b409a92712481d81ac01ee9283092245b400f1dded68ad81ac0285338e37d245b40a0bbd79253d81ac01805e5f879245b40511221ea48d81ac0d5fbce976d245b4030181dfd20d81ac06587102a6d245b40f4762e9b1fd81ac051c4f80b6b245b40a95e88bbcbd81ac07467246669245b40e9623a654fd91ac07157a4b56e245b4014eba90591d91ac02833659673245b4091a4a501a2d91ac0f3b5bbe478245b4048ec247bb4d91ac047bf4b327d245b40a0c2b33cc3d91ac09f9e00a485245b4000749e41dbd91ac0fae0d6a88a245b409f070c22ead91ac01244829d93245b40d58189140ada1ac01ff96c3498245b40d1d905bd16da1ac0925afb669b245b401f1b4d6d2ada1ac01db9a58c9a245b400974bd956eda1ac05acc024e98245b4021cf7175cdda1ac093e21b0d97245b4096fe392a09db1ac09aa6da8695245b404d852cc651db1ac01a10c64390245b4059973c4f50db1ac03a6bfc808f245b40519e19694edb1ac0de9d179e8c245b409078628745db1ac09e9eb6168b245b409e03268e41db1ac09ff8f0cf86245b401e4e2fa033db1ac0e0d0e53384245b401f03f6fe2adb1ac00baa9afd7a245b406b4ab5d500db1ac0900735747a245b4074eae560feda1ac07c9ab72c77245b4011754f0febda1ac084e1ae1076245b401a998f4dedda1ac0114f15c675245b403faab15eeeda1ac075d40e2474245b40f5c22d59f4da1ac030a5a62d73245b408eb6744bf9da1ac05af2308a71245b401aab426323db1ac068ffdce971245b400778718239db1ac0415a9c8072245b4098daf95d5cdb1ac0a4d0bcaf79245b400111f908dedb1ac0a7074cc97a245b409b2031fdefdb1ac0bc22d51f7d245b4068211f2e18dc1ac0a0d7003d7e245b409c68f2042adc1ac0ab9cc4a981245b40695a281564dc1ac0a088975984245b40d43dec2791dc1ac00bc8209185245b402c0a3cb8badc1ac00a6439028b245b40de142f9505dd1ac00fb5e52c91245b40952330f48ddc1ac0765de37595245b4052b49cbbc5dc1ac0a23ca5328e245b40194644244fdd1ac093522f6591245b409a1e20377add1ac062de4d8099245b40b77e1ccad9dc1ac0394d496c9d245b401e9266380ddd1ac0ca62e67a9c245b40d3aba7565fdd1ac046a97dbc95245b4037e7604ce6dd1ac02e7fa2278f245b409299a9ab63de1ac05a30f64f91245b409e8835c77fde1ac01a7d14e399245b40f1901972e3dd1ac0168f86009e245b4098fe2b2895dd1ac0e99426789f245b40dd7f795186dd1ac00b8e92a6ad245b409f23b0bd3fdd1ac0cc5f0a26b6245b403406ea640edd1ac04228be42b5245b40ffd1a3a4e5dc1ac0dbe00629b6245b40159a574ad6dc1ac0818c2a59b4245b40ad95d123a1dc1ac0930b2beab3245b401db1d46b94dc1ac0bcea3835b2245b40f712c8f956dc1ac0ed392febb1245b40be075a904cdc1ac0b024460cb1245b4049d2f98849dc1ac078785309b0245b40edcb4e0446dc1ac0d9f7a40caf245b40103b739542dc1ac0556eff9faa245b40549d1de56adc1ac07af323e6a8245b405fc9e89e7adc1ac0850cb137a7245b40309baf1d82dc1ac042cfa387a6245b4020ff742e85dc1ac019204b1aa1245b409867aa5f9ddc1ac09d6425e1a0245b407b3198dd98dc1ac01b664a359d245b40198376b94edc1ac0551c929f9c245b40f1b0f5e619dc1ac0065113a69d245b40436250367ddb1ac0baacaed19f245b402efc93b308db1ac02df60982a2245b4046d69ed891da1ac03e87ffb1a6245b4019246f3c7cda1ac0aa3d180ca9245b40b13c0b216bda1ac0f006773aac245b405422d7b053da1ac00319f439b2245b40cb2de21a23da1ac03397e1afb1245b4029fce18e07da1ac0b05cf26bb0245b409d43faccddd91ac0d9f7a40caf245b40ced987f48fd91ac0a5f965aaac245b4058510af720d91ac0519801faac245b40aab4f3c8e2d81ac0cfb5c252af245b401830c38674d81ac0cd3bdccaaf245b408f77b74a61d81ac0e1817d9ab1245b401f85210a17d81ac045110678b4245b40170a494aa0d71ac040458970b5245b4080fc74f791d71ac0375127fcb9245b40885660b14ed71ac0d50cc28ebf245b406e48e450f9d61ac0a303821dc5245b4013bc11baa4d61ac0f2538181ca245b40321d8a4952d61ac0a89b330dd0245b40d83ec875fdd51ac09b430f87d5245b40784021c3a9d51ac0ce84fd58da245b404fbe6dea5fd51ac07581ffeddf245b40dc3ec2be0ad51ac0f9b9635ee5245b4093228148b7d41ac04cad4644eb245b400aa8aabe5dd41ac0284c56c9f0245b4055bd1e230bd41ac060f39e3df6245b40a11a4c3fb8d31ac048f186bdfb245b40e01e452b64d31ac0b0d6d53b01255b401c598b1c10d31ac072f1b06506255b40a18570edc0d21ac02b27297e06255b405434a188c6d21ac05b2c396101255b4091c04ee914d31ac0df9fd7f6fb245b40cc65164367d31ac08ddb046ef6245b40a1ae1fe6bbd31ac0e42c9501f1245b402de6fe570ed41ac0f9924a83eb245b401387321762d41ac0a81ffda6e5245b40138650c1bbd41ac071bf1c22e0245b4020ed9e5010d51ac02dfd8294da245b40f8015acc65d51ac0e650cfcfd5245b401c4fb637aed51ac05b8d2444d0245b40b8bc98b902d61ac0f6a41fcbca245b400bc0dbd256d61ac05b8e8a60c5245b40d3bfcda0a9d61ac08689cecdbf245b40dcb49e33ffd61ac08fa36b3bba245b40f2fea1aa53d71ac0ff4e80ceb5245b40b73b75a598d71ac0303dba90b5245b40ddadfc80a7d71ac0d1ce3ee5b1245b40443198b218d81ac098411ff5af245b40ec42eb5069d81ac0662ce4aaaf245b405cc3dd6075d81ac04935707faf245b408e523fe17fd81ac0f110893bb1245b405bada609e0d81ac0f0337af9b3245b40991acde594d91ac0db9cb7f1b4245b40ab068fdbd4d91ac088ba4280b5245b40f50ea595f9d91ac0c3e328e3b9245b4081a914e71adb1ac0b25619b4ba245b4017c79fbc50db1ac04f9d0642bb245b4012e9b44d75db1ac09c03cc17bc245b409bdddb43aedb1ac06cadab28bc245b40d40dfac5b1db1ac048ee742cbe245b405cdc77021ddc1ac08c930428c0245b4013b2647290dc1ac0c263f294c2245b4014532e52fddc1ac05ce1f2e1c4245b405a4c526671dd1ac0baae1572c7245b407ae23f6decdd1ac0e7ba88efc4245b40f365192676de1ac0e7ba88efc4245b40f16294675ede1ac0217814e7a8245b4098d878b0c5de1ac07506dec9a7245b404422be13b3de1ac094871dc6a4245b401b2edffab0de1ac07ef41e679a245b401c5fafb0e0de1ac0d6a0008a91245b406201f39029df1ac00b1709f489245b40fba1772ae0de1ac0b636255987245b407c087cd619df1ac0fcc92f0a82245b401650b56e67df1ac000c604357c245b40be0d2db29ddf1ac0399ffcf76f245b40180ae5b40fe01ac0dd3ad0436d245b4011c50ce528e01ac0ee66988c63245b40d01dcc955de01ac03aeb245b5d245b400dcbf09f6ee01ac08700b1a547245b409af7c264aae01ac0404af60835245b4096e27e1805e11ac0cfd6cbef34245b40d55be2c803e11ac00c09228b34245b403da63388f3e01ac063a212d731245b406829c6f99be01ac0edd290f128245b40d57b7bd7a0df1ac0ee3a252026245b40b9c1f5285cdf1ac01245520b25245b4050f8f6ae41df1ac0b24f0af31e245b409caacdffabde1ac01dbc809719245b40543443aa28de1ac01c6029cb10245b4084be45274bdd1ac05ce4e13d07245b408e9731056bdc1ac08db66d1805245b40755aa6b723dc1ac0748e446804245b400dfff5620cdc1ac0002e0b26fe235b40d14f6c054ddb1ac044edc1a4f8235b40946e1edfc2da1ac005fe3383f8235b40b0f3a276bfda1ac0d850745df8235b40d39f1518b2da1ac0404a2f6af7235b40f6e1378595da1ac0b0d7fecef6235b40ceb10ddc81da1ac0ff7f25de01245b4026b9bda431da1ac03894633909245b403b1a9fc9fed91ac072df74cb0e245b40a19c64abcbd91ac0126fa7ad11245b40965f730e9ed91ac01ebc809719245b406d0635d40cd91ac09296558a1d245b40d25ae9b5d9d81ac0020eab7823245b40d33e36c98fd81ac0059325732c245b40d641e8a04bd81ac03cc1081a33245b40cf1018eb1bd81ac00d57116e32245b40513bf88903d81ac039f2c91132245b40d382bef4f6d71ac0cc81289831245b409ab4c189e8d71ac0dc20645930245b408eefc000c2d71ac01041df162c245b40ea9c996038d71ac0f2d603e621245b403a302592e8d51ac0ee89fe571d245b40057b2aa73dd51ac08fc0620d17245b40d8b59db646d41ac08eda075916245b4005ef299128d41ac05a6e407215245b404cdf1ff60fd41ac0ffd9b59716245b40aa82264508d41ac07ed61dc317245b4016b45c0802d41ac0a22af5d426245b40d533aa139dd31ac0b105f79e32245b40b643403c4dd31ac0315eb60339245b40d9e659d41dd31ac064064faf41245b40de450873e1d21ac00c4e1bfc47245b40526c9507b9d21ac03a71cbc651245b400cc5dc3e76d21ac0861e004657245b404d58b3424fd21ac0de32b1f958245b40ffea97b538d21ac0c7aa784759245b400d33c5a23dd21ac02bcc77e959245b40b1e3079555d21ac08a3535225b245b40276e35bd54d21ac04d8ecf0e5f245b40d38f0a0852d21ac0353d1a0362245b40ca5ed8decad11ac03d81c08463245b40d9b9181c88d11ac032ccdd5e67245b401b89bf58a5d11ac0f6902fde65245b404c5b854bc9d11ac0cd17170a62245b4088409cf968d21ac0c29f20ee60245b40179edefceed21ac0e2d0a6cf60245b40d5f5ba5efdd21ac0999b704a61245b40b5dcbc01fed21ac0ed45e81462245b40e73c820effd21ac0b9b33eed69245b409b8a687809d31ac045cbccb16e245b407cafa8f0b6d21ac0e844a97673245b40fcd9994e5ad21ac067ef7bd978245b408561f1feecd11ac01916d5a479245b40953d0e34b3d11ac07466da9e83245b40d4e1ec8233d11ac05228ce4a8d245b40a0db4fb9ced01ac04f7753f092245b40d66c2ae3c3d01ac09615a7cf98245b40fc539b28d7d01ac095dbb4709e245b4061714bbd04d11ac0456cea6aa2245b400fccf3fe44d11ac0ba380e31a8245b40d678f86089d11ac0e983fa1fab245b4090242495d3d11ac071a82962ae245b40a100cc03f8d11ac07debe4d7b2245b408aea154e86d21ac005a8b527b0245b40d4c1fc96b2d21ac0),
+(18, 4, 'Pegambiran', 0x0000000001060000000100000001030000000100000054010000216873e1cf255b4097d551d04bf91ac0e2f7f19dc6255b4024c9c28690f91ac0de8877c0c2255b40e62d1f32adf91ac02d6680bdc2255b40569bb242adf91ac00a29f38fbe255b40e5247497c4f91ac0a3db7357be255b40dcbbdb2cc6f91ac072d831aeb8255b402615fbcbeef91ac06b74f28ab6255b4060b53dcd0bfa1ac00fc9444ab3255b40966749ef37fa1ac05fb47bf2b0255b40e03aea5756fa1ac0ccb85d68ae255b401fa3c7ef6dfa1ac0933e4fa6ab255b40e6b98927a9fa1ac058eb6130ab255b40975e9f0bb3fa1ac0d7013d6daa255b40a1140457c5fa1ac083f691a0a7255b4059bb158708fb1ac053636550a7255b4055e6b8131ffb1ac0eb562207a7255b4020cc8bae33fb1ac0ebfb4c3fa6255b40e1076be26bfb1ac05b98d833a6255b4094cd401b6ffb1ac05b98d833a6255b4092ed5e65a2fb1ac05b98d833a6255b40dd53a1dfc9fb1ac05b98d833a6255b40df673f15e1fb1ac0308da8c3a9255b40177a6aa947fc1ac01fb428a5ad255b401f1ed7308afc1ac02ce031c0ae255b40e6d6e0249dfc1ac087e3fe3aaf255b405f1dd268a5fc1ac0720d8c61b3255b405a0676edecfc1ac00f8b0206b4255b40646e4f7ef5fc1ac0c73ab9a7b7255b40982564ea25fd1ac0c3aba06fb9255b409e18a18153fd1ac01b14ac6abc255b4020c13c57a0fd1ac0a6c8749fbe255b40601d0936d9fd1ac0e15ce3d8bf255b4025a5acc5f8fd1ac00abb9c45c1255b4055119abf6afe1ac04dfe32b0bf255b406525361a1eff1ac08e8db73fbe255b40a422dbdd29ff1ac0a280efd6bc255b4059688d6235ff1ac086bb0deeb7255b40e650a87957ff1ac00a3aa269b3255b40a502700778ff1ac09729bf17af255b40125d94e593ff1ac0cbca3d6ca8255b40620e7ae0aaff1ac08f5f23e4a4255b40532dbbb3b8ff1ac0c44448279c255b40de4eef6df0ff1ac08db5ce5398255b409cad64ccffff1ac0403453cc94255b405526a1c905001bc090f0ca5e91255b40dcd981d000001bc026cd01f28d255b401aa53070f2ff1ac0adf9ec538a255b4061d79b13d9ff1ac03187823387255b4062c8789ebcff1ac0b343601a83255b40a060327185ff1ac0cb5da5ac80255b40a33332dc41ff1ac066eeb5707e255b40d369affe02ff1ac0fef4511b7c255b40a05cd3d2c8fe1ac0c6507a6079255b409496b4b99cfe1ac09259fb5277255b401897fee38cfe1ac0ca012e1c76255b40566c7d428afe1ac08e0db0e075255b40d6e492c189fe1ac02f3a55dd74255b405e9f1d7f88fe1ac0c37318d671255b4018e214978ffe1ac09f56a7cb6e255b4099bfc7519afe1ac05bc1ca716c255b409b9ae39098fe1ac0cfb78aaf6a255b40169d26c188fe1ac0d741752069255b4015d0d26b6cfe1ac0ef4b0d1468255b4062b3b2662cfe1ac03ba465da67255b4013d0b9a804fe1ac08f4dc6ce67255b40151cda74c2fd1ac0e757a4e567255b4013ad974696fd1ac0972abc0668255b4016c7e96a79fd1ac06ff974f667255b40609131ca75fd1ac0781ad97267255b409a71eb7558fd1ac074866cac66255b409a7390303cfd1ac0b09d2b3665255b401b909aff22fd1ac03818587962255b40e5d0a5f90ffd1ac074230a475f255b40617c8b5607fd1ac05811a1985c255b40df4fe85f0cfd1ac088433c7559255b401b866a8715fd1ac05dfd00cf56255b405c0d47db21fd1ac031c1332d53255b4023e3558237fd1ac04554f1264f255b4058c1a6b24efd1ac09e7df9074b255b401d746c2d61fd1ac0dd57603547255b409d4c609365fd1ac0fd05056443255b4051346d4d5afd1ac059a3a8bf40255b409bf64c1f4ffd1ac0ae3ed44e3e255b405a511fb832fd1ac02e8840be3c255b40d5261e3129fd1ac02a1ba3453b255b4060e2c8c62dfd1ac05a30678139255b40e3bd240a37fd1ac00ff536a337255b405d30cb434bfd1ac0376f1abf36255b40d9f691f868fd1ac0a6a51fda35255b40d7b9a6a591fd1ac007b7451334255b409eb8fdd1bbfd1ac04a82b80631255b401583e530e1fd1ac04b7c28922e255b405faf6ccdf3fd1ac0239c02ba2b255b4093b9d6fafcfd1ac08cd0056029255b405aa80ecbfcfd1ac0488d55a126255b40e0f5d14601fe1ac080416f5f24255b409cb699a20dfe1ac04767af0c24255b405592d94912fe1ac014af1f3522255b40522395ce2cfe1ac09023d05420255b40a0b081ac5bfe1ac005f92af21e255b40d358027287fe1ac074d67bc71c255b40d0b99651abfe1ac0ad3961201a255b40520fb09dc2fe1ac0cc6a7c6616255b401ee51db9cbfe1ac0b1b0a3fd11255b4015ab91f0ccfe1ac0dae0c60d0f255b4056efd2dec4fe1ac08eedca1d0c255b409a75415ebefe1ac02e123b7e08255b405092bacfb7fe1ac02d7ab60f05255b401b28f45fbffe1ac02d230c1d0a255b40db2c78b049ff1ac0017bccbc0c255b405c94bdda8eff1ac00ab9016a0d255b4017cb64afacff1ac0ad15ce990d255b40e006fb79caff1ac01d841b330d255b405e6cb038e8ff1ac00142dd4f0c255b4096da06f5faff1ac06d4a8b720a255b40d5224e3604001bc021fe64e507255b400f85fa8b10001bc0923f53bc05255b4058010f0c20001bc0ce7ace4b02255b4016143faf40001bc09341f675fe245b40d25910d46d001bc05265bd9ffa245b409a6569ac9f001bc066aac360f7245b40628d8229c8001bc08f8f9f3af4245b40a5cc0acbf3001bc0b746aec4f1245b4059fd94a417011bc0d30d3367ef245b409cdd045643011bc0931c1928ec245b405c755c646d011bc077e83303e9245b402096ec5989011bc0a3037cade7245b40563c0bcb6a011bc0b5895278d9245b40d1cd308a25001bc0d854ee9ed9245b401e9cb97d12001bc07d81999ed9245b40143f444212001bc054b85158da245b401e1f222109001bc04cdc0812db245b4060ffffffffff1ac075b65e5ad4245b40de9861a3acff1ac0d69d86a8c2245b401cafbe122cfe1ac056f88a55c0245b40cfed5c8ff7fd1ac0273f4162bb245b4090866bb587fd1ac087c9b3cbb7245b40da370f2730fd1ac027bac667b2245b4015846055bdfc1ac02c105414af245b40da3c27bd6ffc1ac0e8493140a2245b405a29e44a3dfb1ac03144e6caa0245b40dda42c431cfb1ac0dd1a709692245b40d16a6116daf91ac002bac7d287245b40553905f9d9f81ac03f20680586245b40956ac8d095f81ac06be7cc7685245b400d4c309c6bf81ac012b6705985245b40502329e961f81ac00f7fe5417a245b40db4533a7aff71ac0ff7b884677245b405de82add5df71ac067f77ccd72245b401232fd12f1f61ac0244f2a1a6b245b4094e32d573ff61ac0b429efe368245b409af5251b0ff61ac095577f8461245b404e266a696ef51ac0995bf2785a245b4091f7a57edef41ac0665e18e945245b405042acfe08f31ac0eb8e96033d245b408d8269183ef21ac0bff4c7b436245b40538dcbb8a9f11ac0e0c3f69331245b404dfd7f9c30f11ac04404ed0e29245b4092518def6ff01ac02866c74620245b404cd7917b9eef1ac08d33d6851f245b40920889b48def1ac04e70f4311f245b40d59098a086ef1ac0099b0b5c1e245b409a97158a74ef1ac0ee89fe571d245b408ed7b8fe5def1ac0ed858b6324245b404c3fa4dfbeee1ac0cd5151f52b245b40de26af2633ee1ac0246c494739245b409a879e9449ed1ac08734fb3c46245b409c54698b6bec1ac066c9ed974f245b40144e0647c9eb1ac0e60b2b1554245b40da729cdb84eb1ac08581b8ab57245b401804a8a965eb1ac005c4f5285c245b40dda17a6b60eb1ac0cc3773486a245b4055ba624678eb1ac05f36d25279245b40d112ee9579eb1ac05662360186245b40519a577556eb1ac03a80164b91245b4018beba2a50eb1ac0515966c39a245b405bff0f0858eb1ac0cd6b847ea6245b40cfb5645584eb1ac020f5f017b3245b4019aeb48cd4eb1ac0c7808461c0245b401685200725ec1ac02676cc79c6245b401288450c3bec1ac0de1383c0ca245b4015457d923bec1ac01902df6dde245b40905e251fbbeb1ac018f3186cea245b40df35ab3e57eb1ac0f34b2366f6245b40db27983105eb1ac04a4c766c04255b401c450bd0b6ea1ac0fa2cf52c08255b4094a69201a0ea1ac0affb56193d255b40e0964547c3e81ac0ff21b8043f255b4016572bc9f0e81ac0b3f3e41942255b40e1ad776bd8e81ac04222cd3746255b40e1fc8fe1ebe81ac0e24e532747255b405c514dcf0fe91ac09e72473f47255b40d92ac03a38e91ac076833a5747255b40db25a6784de91ac0d1d3b45e48255b4055d43a1680e91ac0c5d4e5f549255b40df7bd6fdafe91ac04e87feb34d255b40549cd5d7fae91ac046882f4b4f255b409a309bc818ea1ac0e6b4b53a50255b40ddc460b936ea1ac074e39d5854255b401d575e73c0ea1ac071609e4f56255b4063367656f9ea1ac08d50dcce57255b4020595cc024eb1ac0c92d194e59255b405e686c333eeb1ac0c12e4ae55a255b40196e11ab4eeb1ac0a1380d545d255b405bb784a951eb1ac05d55d1c25f255b409e32cf2b4deb1ac02468260963255b405be95b2d4aeb1ac06c3d024865255b40e5777ca657eb1ac00b3431026f255b409982063558eb1ac0870d275c74255b40188dc9f99aeb1ac0fe91f7267d255b402468f767e3eb1ac0b262260380255b40d9da53f3ceeb1ac0d6c7a67e86255b409c4600378beb1ac01d5f41308d255b4096a76e2945eb1ac0c108f4e093255b40e4e44c10feea1ac0e02521ce99255b4062d0c5b3bfea1ac0b56d094fa1255b401648617e71ea1ac0c0096670a7255b40a2840f1830ea1ac088ea9ecbad255b40235fa05fece91ac0efb3e817b1255b40dece9180c4e91ac0a42563e5b2255b40d4b87f7b8ae91ac04bd7806eb3255b4015c95e120ee91ac07b407ae1b3255b409541a47e95e81ac0a85eee70b4255b40194ca8ef1be81ac0b0f25a37b5255b409c92658b1be81ac02424f3b9b4255b4026454aa196e81ac0684fe04ab4255b402480ae480fe91ac0846625c2b3255b4060a682f48ee91ac0378796aab1255b40d43e4a1fcce91ac03746ef1fae255b405827eeb5f6e91ac077c97ed9a7255b4059f91af639ea1ac0a8bf4faba1255b405d18e1717aea1ac074713d479a255b40d81dfd64c9ea1ac0a599d34c94255b40de5901cf07eb1ac045b26e9b8d255b40125022104feb1ac01a848ad086255b401f677ffb95eb1ac00245766480255b4056a05dd4daeb1ac0dfe93edd79255b4018bd99751fec1ac087d7128d76255b40d40c807947ec1ac0774be74273255b405267f35662ec1ac020f9494b6b255b40a120baa2bfec1ac018b1a42b64255b40546d26c518ed1ac045bb20f05a255b40e2e59a6c7ded1ac0e9039de650255b4013e311d2d6ed1ac0b2334a4e48255b4091783f7831ee1ac076762eab41255b4020b224a377ee1ac0e28a5a9542255b409a4ba3998cee1ac00274cc4c45255b402159ebdacaee1ac0fad817f749255b4063b24a0237ef1ac0fdc8072e50255b40196458a9c6ef1ac06d9fb20252255b405f9a99b7efef1ac0b56360e854255b40d3449ec132f01ac0399a574855255b401c9f07492ff01ac0291d4e705c255b40235ea705edef1ac0e482909463255b4022bedac8aaef1ac000aa263a6b255b405f4cb56a65ef1ac0dbb915a96d255b405ea45824dcee1ac0138e33a46f255b40577c800769ee1ac0373251c46f255b40e2920ad761ee1ac013265aa171255b4095aa8f0bf7ed1ac0f3432c7773255b40d19350c298ed1ac0df07c36f79255b409626a6ad3fed1ac042beaa467e255b4060502f3f0aed1ac07a7015d884255b40dab9399cc6ec1ac0d5aaa45d8b255b40dd2d62727fec1ac0095e20c291255b402150aeb93bec1ac0e4b0484998255b401aa54e54f7eb1ac0b45ed6b09e255b405982281bb3eb1ac0a59efe5ba5255b40223c1c856deb1ac02748f225ac255b409fe9346d27eb1ac0a73b4287b2255b4098cbfef7e2ea1ac033c042b1b8255b40dfb36d2fa1ea1ac02f378f6dc0255b4015ef48314aea1ac08f6d3282c4255b4096f6692be2e91ac062ad3c1ec6255b401c3391d46de91ac0a10e6445c6255b40decf1dd1f8e81ac0e689df70c6255b40a1ae9b1f6de81ac0327cfc34c7255b409259073a6ce81ac0b2347f0dc7255b4057a658bef9e81ac04ed511f0c6255b4065ce3d056fe91ac03a581341c5255b40d9957793e7e91ac0a30e44eec0255b4021fda53853ea1ac05e14ac02b9255b409aa67562a9ea1ac0a0d62af2b2255b40a7f05a7eeaea1ac0db8d0b6aac255b405ae2d3a82feb1ac04c90f0b6a5255b409c8e447477eb1ac0f45e6d1b9f255b4019b3c5b4bdeb1ac0a15fafb398255b40d527ce0000ec1ac092c1825792255b4014ddc97c47ec1ac0959a73ee8b255b409b33e9058bec1ac09a089d4a85255b4099993c7cd1ec1ac076d72ebc7e255b40533181ca14ed1ac04e8b69377e255b401c9e2ffd18ed1ac023e2819e79255b40230a348e47ed1ac043ee1b077a255b40d585012f59ed1ac062c1df9f7a255b402685732988ed1ac0efa4bea17a255b4024a517ad8aed1ac08edc083d7b255b40a12e314a5bee1ac0b2c7055b7d255b40a0889bf557ef1ac00f19a46281255b4057952aacafef1ac0021e511688255b40e19b66302ff01ac0e919b4328f255b40a3d7dec500f11ac09545098b8e255b4052dd833d11f11ac066ea9a7d89255b40d9ff69a73cf11ac09a68a5927f255b40df2f79f4a0f11ac016e394df80255b40575d75b7c7f11ac026db339f81255b40d304119ff7f11ac037d3d25e82255b4024dd418922f21ac032fc780e83255b40dae5077648f21ac0fe6827ae83255b40203d496667f21ac0e2209ebd84255b4097ddfd5489f21ac016820bdd85255b40e0625045a8f21ac09e6608dc87255b4052fefc2ed4f21ac046dd79ea8a255b40dbf7d1012df31ac0729372e88e255b405faf1acf90f31ac0ee24463791255b40975895aad8f31ac04176f1d593255b40de01108620f41ac0bdde1e7595255b40249dbc6f4cf41ac0f8bb5bf496255b40a33f505286f41ac0a564b14398255b40dd9d783bb3f41ac060aa1b629b255b40e41ac10a13f51ac0408b38219d255b405a2df2f241f51ac07fac61ada4255b4053d041a1e2f51ac0ab625aaba8255b409910067043f61ac0cf9c8349ac255b40d2b9804b8bf61ac04fd3cca7af255b4094152532bdf61ac073de5084b6255b40a03785e651f71ac0be58a2d2b9255b4099cd29cb87f71ac0e3ed5561bc255b405930b5aac7f71ac0bff718d0be255b40a16992801af81ac0d1942d9fc0255b40573d607432f81ac0aaf97afec1255b4097ca3e6c42f81ac0a62221aec2255b405609a26059f81ac01693af8dc3255b40e16cd24e7cf81ac09e77ac8cc5255b40d538143ba3f81ac07605a09bc7255b406549662fbaf81ac0f56dcd3ac9255b40e45c3c26ccf81ac0ed9074d8cd255b405d479ef825f91ac0c1edac5fce255b401e70fcca2ff91ac0216873e1cf255b4097d551d04bf91ac0),
+(19, 5, 'Jagasatru', 0x00000000010600000001000000010300000001000000370000005f36d25279245b40d112ee9579eb1ac0cc3773486a245b4055ba624678eb1ac005c4f5285c245b40dda17a6b60eb1ac08581b8ab57245b401804a8a965eb1ac0e60b2b1554245b40da729cdb84eb1ac066c9ed974f245b40144e0647c9eb1ac08734fb3c46245b409c54698b6bec1ac0246c494739245b409a879e9449ed1ac0cd5151f52b245b40de26af2633ee1ac0ed858b6324245b404c3fa4dfbeee1ac0ee89fe571d245b408ed7b8fe5def1ac032bfdd921c245b40d636c1374def1ac0797341041c245b40d95ca3e540ef1ac036b05fb01b245b409d06978e39ef1ac096ece2361a245b408a7abc900eef1ac09d2b543717245b401ddd76a1b9ee1ac0a7ffef880a245b40cc571af851ed1ac057c3887d02245b40daea7ea642ec1ac0cb3a268bfb235b405714c78157eb1ac08f8d4aea04245b4051f7460532eb1ac0ce4bcff412245b4093f6a0f815eb1ac0462e42b115245b400b2a172affea1ac0e5892afc19245b404d9e3c2cd4ea1ac0f9fbcf9a1f245b4056b5d8edb3ea1ac00dd6096822245b40d1686e85b0ea1ac0cd475c001a245b4055ceda6d17ea1ac0e63fae0d15245b40cbafa888d3e91ac0b2fb980f08245b40ca365f7d20e91ac037c482fb01245b40108854a9bde81ac090dc2322fc235b40cd41e8a04be81ac0d45ff23ff9235b405a7ec00303e81ac0e10dace3f8235b40549881caf8e71ac0e3624f3bfc235b405a8172dbbee71ac0ce5ec0cb0c245b4091e2df675ce81ac056fe3e6a13245b405a5fe7a6cde81ac0b6cd189815245b408ffbe07ceae81ac0721600e319245b40d55d0ebbefe81ac0c9ab7d3a1e245b4014b1f50ce1e81ac0fd1c295b24245b409d5e419ab1e81ac008d2967329245b401afeec478ae81ac0848be6012c245b40924732ab77e81ac03b2cdec83c245b408c6e47382de81ac0562e25cb49245b40955a5d4e09e81ac0f6e9037b4c245b408ffaae08fee71ac07dc7c1a563245b401afdbad39de71ac00d06a8a965245b409d6f07ea94e71ac008d25d1267245b4097266893c3e71ac09ce720e868245b40d201452c62e81ac05caaa3e36a245b4017b34d821be91ac0244f2a1a6b245b40d8741daa29e91ac044348b506c245b40cdf97abe66e91ac014e34e9b71245b40ce1f5c0185ea1ac087b0313674245b40201f2922c3ea1ac014011fbc76245b409ce8279cddea1ac05f36d25279245b40d112ee9579eb1ac0),
+(20, 5, 'Pekalangan', 0x00000000010600000001000000010300000001000000370000003f4af60835245b4096e27e1805e11ac0d9e64fa225245b400e6c74ce4fe11ac06113a3e716245b40d54e01309ee11ac0da44efdb10245b40dc37656ebee11ac0a312e15f04245b4091fd0b0401e21ac02017df50f8235b400c1c3ee944e21ac021c6aeeded235b4013f0845e7fe21ac0a9f62c08e5235b4094f34f70b1e21ac0f915ae47e1235b40175b21acc6e21ac0c2bb1ed3da235b4055c28c98f5e21ac0d26c6116da235b4049ab5626fce21ac0f0625cf8c1235b40882cf6067be31ac07892f0bdbf235b401cf4cef90de31ac0449f0b23bd235b40163028d368e21ac0ebd54334ba235b40d98900a777e11ac0cc893824b5235b401a96c9703cdf1ac03095fab2b4235b4018404aecdade1ac0f91fdc9db5235b408e25fdbd14de1ac0ac8294d8b5235b40cb3f4b57b0dd1ac0f94b886bb5235b40556d431d3add1ac049bd2363b5235b40cc43300e2edd1ac000f7b86fb5235b40dc43300e2edd1ac0b4765776c1235b404da205685bdd1ac04f72495cc7235b400d457c2766dd1ac02b1a2d8eca235b40cfa211c45ddd1ac0131eb0abc9235b405997a432c5dc1ac077d1199fc9235b4014920035b5dc1ac0e670f085c9235b400e58349d9ddc1ac05fbbf770c9235b40d96ad26d89dc1ac08368604cc9235b4087df929a78dc1ac0af583f36c9235b400ae49b6d6edc1ac0777b8cf2cc235b4095e3f56052dc1ac0ce966e99d3235b408f972427f7db1ac0fe33c687d9235b400b0ba41cb0db1ac00a4d55dae2235b40180183a44fdb1ac03c29d6a9f2235b4094b757e6adda1ac0b0d7fecef6235b40ceb10ddc81da1ac0404a2f6af7235b400ae2378595da1ac0d850745df8235b40d39f1518b2da1ac004fe3383f8235b40d6f3a276bfda1ac044edc1a4f8235b40946e1edfc2da1ac0002e0b26fe235b40d14f6c054ddb1ac0738e446804245b400dfff5620cdc1ac08cb66d1805245b409b5aa6b723dc1ac05ce4e13d07245b408e9731056bdc1ac01a6029cb10245b4098be45274bdd1ac01dbc809719245b40543443aa28de1ac0b24f0af31e245b409caacdffabde1ac01245520b25245b4050f8f6ae41df1ac0ed3a252026245b40cdc1f5285cdf1ac0edd290f128245b40d57b7bd7a0df1ac063a212d731245b408f29c6f99be01ac00c09228b34245b4051a63388f3e01ac0cfd6cbef34245b40d55be2c803e11ac03f4af60835245b4096e27e1805e11ac0),
+(21, 5, 'Pekalipan', 0x00000000010600000001000000010300000001000000370000007d4247ab5a245b40538f149161e51ac086e521054f245b40d4cdde196de51ac086cc660e49245b40daa7fc6b79e51ac00faff54542245b40cfb8c15087e51ac0a8ab454431245b40567420eba9e51ac0f9e12a4f20245b4053bfb167cfe51ac0f930048717245b400aa371a8dfe51ac02776779d0d245b404c5030630ae61ac04b54f8ba0c245b40d2468e7406e61ac0020829780a245b40588233f8fbe51ac0633df88903245b404d7f0ef3e5e51ac0283cab05f6235b400eba809719e61ac0908355f5f2235b400cebb69c2fe61ac029eb7aa2eb235b408d0f5a0f5fe61ac05500cff6e8235b408ddd567a6de61ac079f4c98ae1235b40d7e12de8a1e61ac0e203ff0dda235b408efc2e6ccde61ac0da433ee8d9235b40568c614ed0e61ac06ad013cfd9235b408a769e78cee61ac09f8c6d52d1235b40503987c3b6e51ac05f05745fce235b400c8a709351e51ac09b7ddbf8c5235b40dcd4fae33ce41ac01b77c6f7c5235b40558069c03ce41ac0f0625cf8c1235b40882cf6067be31ac0d26c6116da235b4049ab5626fce21ac0c2bb1ed3da235b4055c28c98f5e21ac0f915ae47e1235b40175b21acc6e21ac0a9f62c08e5235b4094f34f70b1e21ac021c6aeeded235b4013f0845e7fe21ac02017df50f8235b400c1c3ee944e21ac0a312e15f04245b4091fd0b0401e21ac0da44efdb10245b40dc37656ebee11ac06113a3e716245b40d54e01309ee11ac0d9e64fa225245b400e6c74ce4fe11ac03f4af60835245b4096e27e1805e11ac08700b1a547245b409af7c264aae01ac039eb245b5d245b400dcbf09f6ee01ac099f35d4a5d245b4053e159828ce01ac0151542075d245b40194baab69be01ac051abb9dc60245b40d5c1da183be11ac055005d3464245b40ccc5be64e3e11ac0dd810bb265245b408e7dc51a2ee21ac0856a54e064245b401e449f8f32e21ac08572f2a963245b4051f22ada38e21ac0d97a573d60245b40117e6b274ae21ac0c18678245e245b40cb6d4c4f58e21ac092de41ec4c245b4094fc4b5299e21ac0c539bbb54c245b405b168046e9e21ac0d211ed0a4d245b409ba32b4d54e31ac03e3e71874d245b40199ee8baf0e31ac066fd372f4e245b4015403979ade41ac045b042ca4f245b40d8cb79c6bee41ac0b6f34d2157245b40df88b14cbfe41ac039990aa359245b4053957840d9e41ac07d4247ab5a245b40538f149161e51ac0),
+(22, 5, 'Pulasaren', 0x00000000010600000001000000010300000001000000450000000d06a8a965245b409d6f07ea94e71ac07dc7c1a563245b401afdbad39de71ac0f6e9037b4c245b408ffaae08fee71ac0562e25cb49245b40955a5d4e09e81ac03b2cdec83c245b408c6e47382de81ac0848be6012c245b40924732ab77e81ac008d2967329245b401afeec478ae81ac0fd1c295b24245b409d5e419ab1e81ac0c9ab7d3a1e245b4014b1f50ce1e81ac0721600e319245b40d55d0ebbefe81ac0b6cd189815245b408ffbe07ceae81ac056fe3e6a13245b405a5fe7a6cde81ac0ce5ec0cb0c245b4091e2df675ce81ac0e3624f3bfc235b405a8172dbbee71ac0e10dace3f8235b40549881caf8e71ac0d45ff23ff9235b405a7ec00303e81ac090dc2322fc235b40cd41e8a04be81ac037c482fb01245b40108854a9bde81ac0b2fb980f08245b40ca365f7d20e91ac0e63fae0d15245b40cbafa888d3e91ac0cd475c001a245b4055ceda6d17ea1ac00dd6096822245b40d1686e85b0ea1ac0f9fbcf9a1f245b4056b5d8edb3ea1ac0e5892afc19245b404d9e3c2cd4ea1ac0462e42b115245b400b2a172affea1ac0ce4bcff412245b4093f6a0f815eb1ac08f8d4aea04245b4051f7460532eb1ac0cb3a268bfb235b405714c78157eb1ac0d702857afa235b40d6298a5759eb1ac054246937fa235b4015beba2a50eb1ac09861e63bf8235b408ad954dd23eb1ac0e84c1de4f5235b40975df3aaceea1ac0f4e4a430ef235b40cee97420ebe91ac0402d499eeb235b40caf07edc7ee91ac0798b4b07eb235b40110429096de91ac009ed5e75e4235b40d8b80b2577e81ac05295f947df235b40168b14cac2e71ac01a4d7155d9235b40ce47c0e8f2e61ac0169039cfd8235b40ca2ada1ce7e61ac0da433ee8d9235b40568c614ed0e61ac0e203ff0dda235b408efc2e6ccde61ac079f4c98ae1235b40d7e12de8a1e61ac05500cff6e8235b408ddd567a6de61ac029eb7aa2eb235b408d0f5a0f5fe61ac0908355f5f2235b400cebb69c2fe61ac0283cab05f6235b400eba809719e61ac0633df88903245b404d7f0ef3e5e51ac0020829780a245b40588233f8fbe51ac04b54f8ba0c245b40d2468e7406e61ac02776779d0d245b404c5030630ae61ac0f930048717245b400aa371a8dfe51ac0f9e12a4f20245b4053bfb167cfe51ac0a8ab454431245b40567420eba9e51ac00faff54542245b40cfb8c15087e51ac086cc660e49245b40daa7fc6b79e51ac086e521054f245b40d4cdde196de51ac07d4247ab5a245b40538f149161e51ac0ed6f20ab5b245b401115a58460e51ac04517a58460245b409d0b03965ce51ac078578fad67245b40cda1b2614de51ac00044f83b67245b40de502b215fe51ac0bdb5bc2967245b40532282fa61e51ac0b0a001f566245b401a3eac376ae51ac0690ee19865245b400ceb436fd5e51ac0c559e20165245b4057efc16b97e61ac0c9161a8865245b40519c668176e71ac00d204df564245b405b90459a78e71ac035cd0c1b65245b401f8ec6a17ee71ac00d06a8a965245b409d6f07ea94e71ac0);
+
+INSERT IGNORE INTO `status_laporan` (`id`, `status`) VALUES
+(1, 'Belum diproses'),
+(2, 'Diproses dan data tidak sesuai'),
+(3, 'Diproses dan data sesuai'),
+(4, 'Belum ditindaklanjuti'),
+(5, 'Sudah ditindaklanjuti'),
+(6, 'Sudah perbaikan gizi');
+
+INSERT IGNORE INTO `status_laporan_transisi` (`id`, `id_status_asal`, `id_status_tujuan`) VALUES
+(1, NULL, 1),
+(2, 1, 2),
+(3, 1, 3),
+(4, 2, 3),
+(5, 3, 4),
+(6, 4, 5),
+(7, 5, 6);
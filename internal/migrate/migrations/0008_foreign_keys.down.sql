@@ -0,0 +1,12 @@
+ALTER TABLE `intervensi_petugas`
+  DROP FOREIGN KEY `intervensi_petugas_ibfk_1`;
+
+ALTER TABLE `intervensi_petugas`
+  DROP INDEX `id_intervensi`,
+  ADD KEY `id_intervensi` (`id_intervensi`,`id_petugas_kesehatan`);
+
+ALTER TABLE `intervensi_petugas`
+  ADD CONSTRAINT `intervensi_petugas_ibfk_1` FOREIGN KEY (`id_intervensi`) REFERENCES `intervensi` (`id`) ON UPDATE CASCADE;
+
+ALTER TABLE `intervensi`
+  DROP FOREIGN KEY `intervensi_ibfk_1`;
@@ -0,0 +1,29 @@
+-- Foreign keys between balita, intervensi and intervensi_petugas.
+--
+-- intervensi.id_balita had no constraint, so an intervensi could point to a
+-- balita that never existed. intervensi_petugas allowed assigning the same
+-- petugas kesehatan twice and kept its rows when an intervensi was removed.
+--
+-- Adding intervensi_ibfk_1 fails while orphaned rows exist, list them with
+--   SELECT id, id_balita FROM intervensi
+--   WHERE id_balita IS NOT NULL AND id_balita NOT IN (SELECT id FROM balita);
+
+ALTER TABLE `intervensi`
+  ADD CONSTRAINT `intervensi_ibfk_1` FOREIGN KEY (`id_balita`) REFERENCES `balita` (`id`) ON UPDATE CASCADE;
+
+-- Remove duplicate assignments, keeping the oldest row
+DELETE ip1 FROM `intervensi_petugas` ip1
+JOIN `intervensi_petugas` ip2
+  ON ip1.`id_intervensi` = ip2.`id_intervensi`
+  AND ip1.`id_petugas_kesehatan` = ip2.`id_petugas_kesehatan`
+  AND ip1.`id` > ip2.`id`;
+
+ALTER TABLE `intervensi_petugas`
+  DROP FOREIGN KEY `intervensi_petugas_ibfk_1`;
+
+ALTER TABLE `intervensi_petugas`
+  DROP INDEX `id_intervensi`,
+  ADD UNIQUE KEY `id_intervensi` (`id_intervensi`,`id_petugas_kesehatan`);
+
+ALTER TABLE `intervensi_petugas`
+  ADD CONSTRAINT `intervensi_petugas_ibfk_1` FOREIGN KEY (`id_intervensi`) REFERENCES `intervensi` (`id`) ON DELETE CASCADE ON UPDATE CASCADE;
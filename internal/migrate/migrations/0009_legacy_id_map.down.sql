@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `legacy_id_map`;
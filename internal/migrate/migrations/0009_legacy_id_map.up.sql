@@ -0,0 +1,10 @@
+-- Links rows converted from the legacy stuntingdb schema to the rows they
+-- became, so the legacy converter can be re-run without duplicating data.
+
+CREATE TABLE `legacy_id_map` (
+  `tabel` varchar(50) NOT NULL,
+  `id_legacy` int(11) NOT NULL,
+  `id_baru` int(11) NOT NULL,
+  `created_date` datetime NOT NULL,
+  PRIMARY KEY (`tabel`,`id_legacy`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,5 @@
+ALTER TABLE `riwayat_pemeriksaan` MODIFY `deleted_date` date DEFAULT NULL;
+ALTER TABLE `intervensi` MODIFY `deleted_date` date DEFAULT NULL;
+ALTER TABLE `laporan_masyarakat` MODIFY `deleted_date` date DEFAULT NULL;
+ALTER TABLE `balita` MODIFY `deleted_date` date DEFAULT NULL;
+ALTER TABLE `keluarga` MODIFY `deleted_date` date DEFAULT NULL;
@@ -0,0 +1,8 @@
+-- Store the full soft delete timestamp so a cascading restore can find the
+-- dependent rows that were deleted together with their keluarga or balita.
+
+ALTER TABLE `keluarga` MODIFY `deleted_date` datetime DEFAULT NULL;
+ALTER TABLE `balita` MODIFY `deleted_date` datetime DEFAULT NULL;
+ALTER TABLE `laporan_masyarakat` MODIFY `deleted_date` datetime DEFAULT NULL;
+ALTER TABLE `intervensi` MODIFY `deleted_date` datetime DEFAULT NULL;
+ALTER TABLE `riwayat_pemeriksaan` MODIFY `deleted_date` datetime DEFAULT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE `audit_log`
+  DROP KEY `created_date`,
+  DROP KEY `id_request`,
+  DROP COLUMN `perubahan`,
+  DROP COLUMN `id_request`;
@@ -0,0 +1,8 @@
+-- Record the request and the column values before and after every change,
+-- so the audit log can answer what a row looked like and who changed it.
+
+ALTER TABLE `audit_log`
+  ADD COLUMN `id_request` varchar(32) DEFAULT NULL AFTER `id_entitas`,
+  ADD COLUMN `perubahan` longtext DEFAULT NULL AFTER `keterangan`,
+  ADD KEY `id_request` (`id_request`),
+  ADD KEY `created_date` (`created_date`);
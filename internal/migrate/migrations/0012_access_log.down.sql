@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `access_log_record`;
+DROP TABLE IF EXISTS `access_log`;
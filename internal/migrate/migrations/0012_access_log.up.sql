@@ -0,0 +1,27 @@
+-- Reads of personal data (UU PDP): who viewed which keluarga, balita and
+-- laporan records, when and for what purpose.
+
+CREATE TABLE `access_log` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `id_pengguna` int(11) DEFAULT NULL,
+  `id_request` varchar(32) DEFAULT NULL,
+  `endpoint` varchar(255) NOT NULL,
+  `tujuan` varchar(255) NOT NULL DEFAULT '',
+  `entitas` varchar(50) NOT NULL,
+  `jumlah` int(11) NOT NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `created_date` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `id_pengguna` (`id_pengguna`),
+  KEY `created_date` (`created_date`),
+  CONSTRAINT `access_log_ibfk_1` FOREIGN KEY (`id_pengguna`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+-- The ids returned by each access, one row per record.
+CREATE TABLE `access_log_record` (
+  `id_access_log` int(11) NOT NULL,
+  `id_entitas` int(11) NOT NULL,
+  PRIMARY KEY (`id_access_log`,`id_entitas`),
+  KEY `id_entitas` (`id_entitas`),
+  CONSTRAINT `access_log_record_ibfk_1` FOREIGN KEY (`id_access_log`) REFERENCES `access_log` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
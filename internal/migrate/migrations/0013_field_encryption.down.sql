@@ -0,0 +1,21 @@
+-- The columns are shrunk back to their plaintext size, which fails while
+-- they still hold encrypted values.
+
+ALTER TABLE `laporan_masyarakat`
+  DROP KEY `nomor_hp_keluarga_balita_index`,
+  DROP KEY `nomor_hp_pelapor_index`,
+  DROP COLUMN `nomor_hp_keluarga_balita_index`,
+  DROP COLUMN `nomor_hp_pelapor_index`,
+  MODIFY `nomor_hp_keluarga_balita` varchar(15) NOT NULL,
+  MODIFY `nomor_hp_pelapor` varchar(15) DEFAULT NULL;
+
+ALTER TABLE `keluarga`
+  DROP KEY `nik_ibu_index`,
+  DROP KEY `nik_ayah_index`,
+  DROP KEY `nomor_kk_index`,
+  DROP COLUMN `nik_ibu_index`,
+  DROP COLUMN `nik_ayah_index`,
+  DROP COLUMN `nomor_kk_index`,
+  MODIFY `nik_ibu` varchar(20) DEFAULT NULL,
+  MODIFY `nik_ayah` varchar(20) DEFAULT NULL,
+  MODIFY `nomor_kk` varchar(20) NOT NULL;
@@ -0,0 +1,22 @@
+-- Make room for the encrypted NIK, nomor KK and phone numbers, and add the
+-- blind index columns used for equality lookups on them. Existing values
+-- stay readable as plaintext until the rotate-keys command encrypts them.
+
+ALTER TABLE `keluarga`
+  MODIFY `nomor_kk` varchar(255) NOT NULL,
+  MODIFY `nik_ayah` varchar(255) DEFAULT NULL,
+  MODIFY `nik_ibu` varchar(255) DEFAULT NULL,
+  ADD COLUMN `nomor_kk_index` char(64) DEFAULT NULL AFTER `nomor_kk`,
+  ADD COLUMN `nik_ayah_index` char(64) DEFAULT NULL AFTER `nik_ayah`,
+  ADD COLUMN `nik_ibu_index` char(64) DEFAULT NULL AFTER `nik_ibu`,
+  ADD KEY `nomor_kk_index` (`nomor_kk_index`),
+  ADD KEY `nik_ayah_index` (`nik_ayah_index`),
+  ADD KEY `nik_ibu_index` (`nik_ibu_index`);
+
+ALTER TABLE `laporan_masyarakat`
+  MODIFY `nomor_hp_pelapor` varchar(255) DEFAULT NULL,
+  MODIFY `nomor_hp_keluarga_balita` varchar(255) NOT NULL,
+  ADD COLUMN `nomor_hp_pelapor_index` char(64) DEFAULT NULL AFTER `nomor_hp_pelapor`,
+  ADD COLUMN `nomor_hp_keluarga_balita_index` char(64) DEFAULT NULL AFTER `nomor_hp_keluarga_balita`,
+  ADD KEY `nomor_hp_pelapor_index` (`nomor_hp_pelapor_index`),
+  ADD KEY `nomor_hp_keluarga_balita_index` (`nomor_hp_keluarga_balita_index`);
@@ -0,0 +1,3 @@
+ALTER TABLE `access_log`
+  DROP KEY `privileged`,
+  DROP COLUMN `privileged`;
@@ -0,0 +1,6 @@
+-- Reads of the unmasked NIK, nomor KK and phone numbers, requested by an
+-- admin with the lengkap parameter.
+
+ALTER TABLE `access_log`
+  ADD COLUMN `privileged` tinyint(1) NOT NULL DEFAULT 0 AFTER `jumlah`,
+  ADD KEY `privileged` (`privileged`);
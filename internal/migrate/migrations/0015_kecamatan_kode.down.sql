@@ -0,0 +1,3 @@
+ALTER TABLE `kecamatan`
+  DROP KEY `kode`,
+  DROP COLUMN `kode`;
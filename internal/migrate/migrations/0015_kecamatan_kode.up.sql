@@ -0,0 +1,12 @@
+-- Kemendagri region code of every kecamatan, the first 6 digits of the
+-- nomor KK issued there.
+
+ALTER TABLE `kecamatan`
+  ADD COLUMN `kode` char(6) DEFAULT NULL AFTER `id`,
+  ADD UNIQUE KEY `kode` (`kode`);
+
+UPDATE `kecamatan` SET `kode` = '327401' WHERE `kecamatan` = 'Harjamukti';
+UPDATE `kecamatan` SET `kode` = '327402' WHERE `kecamatan` = 'Lemahwungkuk';
+UPDATE `kecamatan` SET `kode` = '327403' WHERE `kecamatan` = 'Pekalipan';
+UPDATE `kecamatan` SET `kode` = '327404' WHERE `kecamatan` = 'Kesambi';
+UPDATE `kecamatan` SET `kode` = '327405' WHERE `kecamatan` = 'Kejaksan';
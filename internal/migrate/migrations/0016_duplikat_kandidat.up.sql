@@ -0,0 +1,22 @@
+-- Candidate duplicate keluarga and balita found by the dedup command, and
+-- the review of an admin: merged into id_utama or rejected.
+
+CREATE TABLE `duplikat_kandidat` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `entitas` enum('keluarga','balita') NOT NULL,
+  `id_utama` int(11) NOT NULL,
+  `id_duplikat` int(11) NOT NULL,
+  `skor` decimal(4,3) NOT NULL,
+  `alasan` varchar(255) NOT NULL DEFAULT '',
+  `status` enum('menunggu','digabung','ditolak') NOT NULL DEFAULT 'menunggu',
+  `id_pengguna_review` int(11) DEFAULT NULL,
+  `tanggal_review` datetime DEFAULT NULL,
+  `created_date` datetime NOT NULL,
+  `updated_date` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `pasangan` (`entitas`,`id_utama`,`id_duplikat`),
+  KEY `status` (`status`,`entitas`),
+  KEY `id_duplikat` (`id_duplikat`),
+  KEY `id_pengguna_review` (`id_pengguna_review`),
+  CONSTRAINT `duplikat_kandidat_ibfk_1` FOREIGN KEY (`id_pengguna_review`) REFERENCES `pengguna` (`id`) ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
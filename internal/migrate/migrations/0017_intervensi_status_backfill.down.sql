@@ -0,0 +1,2 @@
+-- The derived statuses are kept, they are dropped together with the
+-- columns by 0003_intervensi_status.
@@ -0,0 +1,34 @@
+-- Derive the status of the intervensi that existed before the status
+-- lifecycle, which 0003_intervensi_status left at 'planned'. The hasil
+-- follows the meaning the health worker list gave it before:
+--   - a hasil mentioning "selesai" or "completed" is completed,
+--   - any other hasil is a progress note and the intervensi is in
+--     progress, as is one without a hasil whose tanggal has passed,
+--   - otherwise it is still planned.
+-- Completed is final, so only the explicit patterns count as completed
+-- and intervensi with a progress note can still be updated.
+-- The creator and the last editor stand in for the unknown actors.
+--
+-- Only rows created up to the day 0003_intervensi_status was applied and
+-- never moved through the lifecycle are touched, so intervensi planned
+-- later keep their status.
+
+UPDATE `intervensi`
+SET `status` = 'completed',
+    `started_id` = `created_id`,
+    `started_date` = `tanggal`,
+    `completed_id` = COALESCE(`updated_id`, `created_id`),
+    `completed_date` = GREATEST(`tanggal`, COALESCE(`updated_date`, `created_date`, `tanggal`))
+WHERE `status` = 'planned'
+  AND `started_date` IS NULL AND `completed_date` IS NULL AND `cancelled_date` IS NULL
+  AND (`hasil` LIKE '%selesai%' OR `hasil` LIKE '%completed%')
+  AND (`created_date` IS NULL OR `created_date` <= (SELECT DATE(`applied_date`) FROM `schema_migrations` WHERE `version` = 3));
+
+UPDATE `intervensi`
+SET `status` = 'in_progress',
+    `started_id` = `created_id`,
+    `started_date` = `tanggal`
+WHERE `status` = 'planned'
+  AND `started_date` IS NULL AND `completed_date` IS NULL AND `cancelled_date` IS NULL
+  AND (TRIM(COALESCE(`hasil`, '')) <> '' OR `tanggal` <= CURDATE())
+  AND (`created_date` IS NULL OR `created_date` <= (SELECT DATE(`applied_date`) FROM `schema_migrations` WHERE `version` = 3));
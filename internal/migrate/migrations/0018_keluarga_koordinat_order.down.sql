@@ -0,0 +1,2 @@
+-- The swapped koordinat are kept: after the migration they cannot be told
+-- apart from koordinat saved in the right order.
@@ -0,0 +1,12 @@
+-- The admin keluarga form saved koordinat in the [latitude, longitude]
+-- order of Leaflet, while the API and the kelurahan areas are [longitude,
+-- latitude]. A point whose Y is not a valid latitude but whose X is can
+-- only have been saved swapped, e.g. POINT(-6.72 108.55), and is swapped
+-- back. Other points are left as they are; check-kelurahan reports those
+-- outside every kelurahan.
+
+UPDATE `keluarga`
+SET `koordinat` = Point(ST_Y(`koordinat`), ST_X(`koordinat`))
+WHERE `koordinat` IS NOT NULL
+  AND ABS(ST_Y(`koordinat`)) > 90
+  AND ABS(ST_X(`koordinat`)) <= 90;
@@ -0,0 +1,2 @@
+-- The redacted values are not restored: they were never meant to be kept
+-- in plain text.
@@ -0,0 +1,65 @@
+-- Audit log entries recorded between 0011_audit_log_changes and
+-- 0013_field_encryption hold the nomor KK, NIK and phone numbers in plain
+-- text. Redact them the way the audit log records encrypted columns now:
+-- a value becomes "[redacted]", a null stays null so inserts and removals
+-- still read as such.
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_kk.old', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nomor_kk.old') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_kk.old') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_kk.new', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nomor_kk.new') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_kk.new') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nik_ayah.old', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nik_ayah.old') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nik_ayah.old') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nik_ayah.new', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nik_ayah.new') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nik_ayah.new') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nik_ibu.old', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nik_ibu.old') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nik_ibu.old') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nik_ibu.new', '[redacted]')
+WHERE `entitas` = 'keluarga'
+  AND JSON_VALUE(`perubahan`, '$.nik_ibu.new') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nik_ibu.new') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_hp_pelapor.old', '[redacted]')
+WHERE `entitas` = 'laporan_masyarakat'
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_pelapor.old') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_pelapor.old') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_hp_pelapor.new', '[redacted]')
+WHERE `entitas` = 'laporan_masyarakat'
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_pelapor.new') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_pelapor.new') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_hp_keluarga_balita.old', '[redacted]')
+WHERE `entitas` = 'laporan_masyarakat'
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_keluarga_balita.old') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_keluarga_balita.old') <> '[redacted]';
+
+UPDATE `audit_log`
+SET `perubahan` = JSON_REPLACE(`perubahan`, '$.nomor_hp_keluarga_balita.new', '[redacted]')
+WHERE `entitas` = 'laporan_masyarakat'
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_keluarga_balita.new') IS NOT NULL
+  AND JSON_VALUE(`perubahan`, '$.nomor_hp_keluarga_balita.new') <> '[redacted]';
@@ -0,0 +1,78 @@
+package mvt
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is an in-memory least recently used cache with an expiry time per
+// entry. It is safe for concurrent use.
+type Cache[V any] struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // of *cacheEntry, most recently used first
+	entries map[string]*list.Element
+}
+
+type cacheEntry[V any] struct {
+	key     string
+	value   V
+	expires time.Time
+}
+
+// NewCache returns a cache holding at most size entries.
+func NewCache[V any](size int) *Cache[V] {
+	return &Cache[V]{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// Get returns the value of key, if it is cached and not expired.
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*cacheEntry[V])
+	if time.Now().After(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return zero, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set caches the value of key for ttl, evicting the least recently used
+// entry when the cache is full.
+func (c *Cache[V]) Set(key string, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry[V]{key: key, value: value, expires: time.Now().Add(ttl)}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry[V]).key)
+	}
+}
+
+// Clear removes every entry.
+func (c *Cache[V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = map[string]*list.Element{}
+}
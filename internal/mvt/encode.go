@@ -0,0 +1,101 @@
+package mvt
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Protobuf wire types
+const (
+	wireVarint = 0
+	wire64     = 1
+	wireBytes  = 2
+)
+
+// Encode encodes the layers as a tile. Layers without features are left
+// out, a tile without any feature is empty.
+func Encode(layers ...*Layer) []byte {
+	var tile []byte
+	for _, l := range layers {
+		if l.Len() == 0 {
+			continue
+		}
+		tile = appendBytes(tile, 3, l.encode())
+	}
+	return tile
+}
+
+// Helper function to encode a Layer message
+func (l *Layer) encode() []byte {
+	var data []byte
+	data = appendVarint(data, 15, 2) // version
+	data = appendBytes(data, 1, []byte(l.Name))
+	for _, f := range l.features {
+		data = appendBytes(data, 2, f.encode())
+	}
+	for _, key := range l.keys {
+		data = appendBytes(data, 3, []byte(key))
+	}
+	for _, v := range l.values {
+		data = appendBytes(data, 4, v.encode())
+	}
+	data = appendVarint(data, 5, Extent)
+	return data
+}
+
+// Helper function to encode a Feature message
+func (f feature) encode() []byte {
+	var data []byte
+	if len(f.tags) > 0 {
+		data = appendPacked(data, 2, f.tags)
+	}
+	data = appendVarint(data, 3, uint64(f.geomType))
+	data = appendPacked(data, 4, f.geometry)
+	return data
+}
+
+// Helper function to encode a Value message
+func (v value) encode() []byte {
+	switch v.kind {
+	case valueString:
+		return appendBytes(nil, valueString, []byte(v.str))
+	case valueDouble:
+		data := appendTag(nil, valueDouble, wire64)
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(v.double))
+	case valueSint:
+		return appendVarint(nil, valueSint, uint64((v.sint<<1)^(v.sint>>63)))
+	default:
+		var b uint64
+		if v.boolean {
+			b = 1
+		}
+		return appendVarint(nil, valueBool, b)
+	}
+}
+
+// Helper function to append a field tag
+func appendTag(data []byte, field int, wireType int) []byte {
+	return binary.AppendUvarint(data, uint64(field)<<3|uint64(wireType))
+}
+
+// Helper function to append a varint field
+func appendVarint(data []byte, field int, v uint64) []byte {
+	data = appendTag(data, field, wireVarint)
+	return binary.AppendUvarint(data, v)
+}
+
+// Helper function to append a length delimited field
+func appendBytes(data []byte, field int, b []byte) []byte {
+	data = appendTag(data, field, wireBytes)
+	data = binary.AppendUvarint(data, uint64(len(b)))
+	return append(data, b...)
+}
+
+// Helper function to append a packed repeated uint32 field
+func appendPacked(data []byte, field int, values []uint32) []byte {
+	var packed []byte
+	for _, v := range values {
+		packed = binary.AppendUvarint(packed, uint64(v))
+	}
+	return appendBytes(data, field, packed)
+}
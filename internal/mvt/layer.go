@@ -0,0 +1,371 @@
+package mvt
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+)
+
+// Geometry types of a feature
+const (
+	geomPoint   = 1
+	geomPolygon = 3
+)
+
+// Geometry commands
+const (
+	cmdMoveTo    = 1
+	cmdLineTo    = 2
+	cmdClosePath = 7
+)
+
+// Layer is a named layer of a tile. Features are added in degrees and
+// stored encoded in tile units.
+type Layer struct {
+	Name string
+	tile Tile
+
+	features   []feature
+	keys       []string
+	keyIndex   map[string]uint32
+	values     []value
+	valueIndex map[value]uint32
+}
+
+type feature struct {
+	geomType uint32
+	tags     []uint32
+	geometry []uint32
+}
+
+// value is a property value. Only the field of its kind is set, so values
+// can be compared and used as map keys.
+type value struct {
+	kind    uint8
+	str     string
+	double  float64
+	sint    int64
+	boolean bool
+}
+
+// Kinds of a value, the protobuf field numbers of the Value message
+const (
+	valueString = 1
+	valueDouble = 3
+	valueSint   = 6
+	valueBool   = 7
+)
+
+type point struct{ x, y float64 }
+
+// NewLayer returns an empty layer of the tile.
+func (t Tile) NewLayer(name string) *Layer {
+	return &Layer{
+		Name:       name,
+		tile:       t,
+		keyIndex:   map[string]uint32{},
+		valueIndex: map[value]uint32{},
+	}
+}
+
+// Len returns the number of features of the layer.
+func (l *Layer) Len() int {
+	return len(l.features)
+}
+
+// AddPoint adds a point feature and reports whether it was added. Points
+// outside the tile and its Buffer are skipped.
+func (l *Layer) AddPoint(lon, lat float64, properties map[string]any) bool {
+	x, y := l.tile.project(lon, lat)
+	if x < -Buffer || x > Extent+Buffer || y < -Buffer || y > Extent+Buffer {
+		return false
+	}
+
+	px, py := int32(math.Round(x)), int32(math.Round(y))
+	l.features = append(l.features, feature{
+		geomType: geomPoint,
+		tags:     l.tags(properties),
+		geometry: []uint32{command(cmdMoveTo, 1), zigzag(px), zigzag(py)},
+	})
+	return true
+}
+
+// AddMultiPolygon adds a polygon feature made of the polygons, each an
+// exterior ring followed by its holes, as in GeoJSON. The rings are clipped
+// to the tile and its Buffer and simplified; polygons whose exterior ring
+// vanishes are dropped with their holes, the feature when none is left.
+func (l *Layer) AddMultiPolygon(polygons geom.MultiPolygon, properties map[string]any) {
+	var geometry []uint32
+	var cursorX, cursorY int32
+	for _, polygon := range polygons {
+		for i, ring := range polygon {
+			projected := make([]point, 0, len(ring))
+			for _, coordinate := range ring {
+				x, y := l.tile.project(coordinate[0], coordinate[1])
+				projected = append(projected, point{x, y})
+			}
+
+			rounded := roundRing(simplify(clip(projected, -Buffer, Extent+Buffer), SimplifyTolerance))
+			if len(rounded) < 3 {
+				if i == 0 {
+					break // exterior ring vanished, skip its holes
+				}
+				continue
+			}
+
+			// Exterior rings have a positive area in tile coordinates, holes a
+			// negative one
+			area := ringArea(rounded)
+			if area == 0 {
+				if i == 0 {
+					break
+				}
+				continue
+			}
+			if (i == 0) != (area > 0) {
+				for a, b := 0, len(rounded)-1; a < b; a, b = a+1, b-1 {
+					rounded[a], rounded[b] = rounded[b], rounded[a]
+				}
+			}
+
+			geometry = append(geometry, command(cmdMoveTo, 1))
+			for j, p := range rounded {
+				if j == 1 {
+					geometry = append(geometry, command(cmdLineTo, len(rounded)-1))
+				}
+				geometry = append(geometry, zigzag(p[0]-cursorX), zigzag(p[1]-cursorY))
+				cursorX, cursorY = p[0], p[1]
+			}
+			geometry = append(geometry, command(cmdClosePath, 1))
+		}
+	}
+	if len(geometry) == 0 {
+		return
+	}
+
+	l.features = append(l.features, feature{
+		geomType: geomPolygon,
+		tags:     l.tags(properties),
+		geometry: geometry,
+	})
+}
+
+// Helper function to turn the properties into key and value indexes.
+// Strings, numbers and booleans are stored as is, nil values are skipped
+// and other values, e.g. maps, are stored as their JSON.
+func (l *Layer) tags(properties map[string]any) []uint32 {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tags := make([]uint32, 0, 2*len(names))
+	for _, name := range names {
+		v, ok := toValue(properties[name])
+		if !ok {
+			continue
+		}
+
+		key, ok := l.keyIndex[name]
+		if !ok {
+			key = uint32(len(l.keys))
+			l.keys = append(l.keys, name)
+			l.keyIndex[name] = key
+		}
+		index, ok := l.valueIndex[v]
+		if !ok {
+			index = uint32(len(l.values))
+			l.values = append(l.values, v)
+			l.valueIndex[v] = index
+		}
+		tags = append(tags, key, index)
+	}
+	return tags
+}
+
+// Helper function to convert a property to a value
+func toValue(property any) (value, bool) {
+	switch p := property.(type) {
+	case nil:
+		return value{}, false
+	case string:
+		return value{kind: valueString, str: p}, true
+	case bool:
+		return value{kind: valueBool, boolean: p}, true
+	case int:
+		return value{kind: valueSint, sint: int64(p)}, true
+	case int64:
+		return value{kind: valueSint, sint: p}, true
+	case float64:
+		if p == math.Trunc(p) && math.Abs(p) < 1<<53 {
+			return value{kind: valueSint, sint: int64(p)}, true
+		}
+		return value{kind: valueDouble, double: p}, true
+	default:
+		data, err := json.Marshal(p)
+		if err != nil {
+			return value{}, false
+		}
+		return value{kind: valueString, str: string(data)}, true
+	}
+}
+
+// Helper function to clip a ring to the square from min to max on both
+// axes with the Sutherland-Hodgman algorithm. The result is not closed.
+func clip(ring []point, min, max float64) []point {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+
+	edges := []struct {
+		inside    func(p point) bool
+		intersect func(a, b point) point
+	}{
+		{func(p point) bool { return p.x >= min }, func(a, b point) point { return intersectX(a, b, min) }},
+		{func(p point) bool { return p.x <= max }, func(a, b point) point { return intersectX(a, b, max) }},
+		{func(p point) bool { return p.y >= min }, func(a, b point) point { return intersectY(a, b, min) }},
+		{func(p point) bool { return p.y <= max }, func(a, b point) point { return intersectY(a, b, max) }},
+	}
+
+	for _, edge := range edges {
+		if len(ring) == 0 {
+			return nil
+		}
+		input := ring
+		ring = make([]point, 0, len(input))
+		previous := input[len(input)-1]
+		for _, current := range input {
+			if edge.inside(current) {
+				if !edge.inside(previous) {
+					ring = append(ring, edge.intersect(previous, current))
+				}
+				ring = append(ring, current)
+			} else if edge.inside(previous) {
+				ring = append(ring, edge.intersect(previous, current))
+			}
+			previous = current
+		}
+	}
+	return ring
+}
+
+// Helper function to find where the segment from a to b crosses x
+func intersectX(a, b point, x float64) point {
+	return point{x, a.y + (b.y-a.y)*(x-a.x)/(b.x-a.x)}
+}
+
+// Helper function to find where the segment from a to b crosses y
+func intersectY(a, b point, y float64) point {
+	return point{a.x + (b.x-a.x)*(y-a.y)/(b.y-a.y), y}
+}
+
+// Helper function to simplify an unclosed ring with the Douglas-Peucker
+// algorithm. The ring is split at its first point and the point farthest
+// from it, so both halves have distinct end points.
+func simplify(ring []point, tolerance float64) []point {
+	if len(ring) < 4 {
+		return ring
+	}
+
+	far, farDistance := 0, -1.0
+	for i, p := range ring {
+		d := (p.x-ring[0].x)*(p.x-ring[0].x) + (p.y-ring[0].y)*(p.y-ring[0].y)
+		if d > farDistance {
+			far, farDistance = i, d
+		}
+	}
+
+	keep := make([]bool, len(ring)+1)
+	keep[0], keep[far], keep[len(ring)] = true, true, true
+	closed := append(ring[:len(ring):len(ring)], ring[0])
+	douglasPeucker(closed, 0, far, tolerance*tolerance, keep)
+	douglasPeucker(closed, far, len(ring), tolerance*tolerance, keep)
+
+	simplified := make([]point, 0, len(ring))
+	for i, p := range ring {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// Helper function to mark the points between first and last to keep
+func douglasPeucker(points []point, first, last int, sqTolerance float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+
+	index, maxDistance := 0, sqTolerance
+	for i := first + 1; i < last; i++ {
+		d := sqSegmentDistance(points[i], points[first], points[last])
+		if d > maxDistance {
+			index, maxDistance = i, d
+		}
+	}
+	if index == 0 {
+		return
+	}
+
+	keep[index] = true
+	douglasPeucker(points, first, index, sqTolerance, keep)
+	douglasPeucker(points, index, last, sqTolerance, keep)
+}
+
+// Helper function to compute the squared distance from p to the segment
+// from a to b
+func sqSegmentDistance(p, a, b point) float64 {
+	x, y := a.x, a.y
+	dx, dy := b.x-x, b.y-y
+	if dx != 0 || dy != 0 {
+		t := ((p.x-x)*dx + (p.y-y)*dy) / (dx*dx + dy*dy)
+		if t > 1 {
+			x, y = b.x, b.y
+		} else if t > 0 {
+			x, y = x+dx*t, y+dy*t
+		}
+	}
+	dx, dy = p.x-x, p.y-y
+	return dx*dx + dy*dy
+}
+
+// Helper function to round a ring to whole tile units, dropping repeated
+// points
+func roundRing(ring []point) [][2]int32 {
+	rounded := make([][2]int32, 0, len(ring))
+	for _, p := range ring {
+		q := [2]int32{int32(math.Round(p.x)), int32(math.Round(p.y))}
+		if len(rounded) > 0 && rounded[len(rounded)-1] == q {
+			continue
+		}
+		rounded = append(rounded, q)
+	}
+	for len(rounded) > 1 && rounded[0] == rounded[len(rounded)-1] {
+		rounded = rounded[:len(rounded)-1]
+	}
+	return rounded
+}
+
+// Helper function to compute twice the signed area of a ring with the
+// surveyor's formula
+func ringArea(ring [][2]int32) int64 {
+	var area int64
+	for i, p := range ring {
+		q := ring[(i+1)%len(ring)]
+		area += int64(p[0])*int64(q[1]) - int64(q[0])*int64(p[1])
+	}
+	return area
+}
+
+// Helper function to encode a command with its repeat count
+func command(id, count int) uint32 {
+	return uint32(id&0x7) | uint32(count)<<3
+}
+
+// Helper function to zigzag encode a parameter
+func zigzag(n int32) uint32 {
+	return uint32((n << 1) ^ (n >> 31))
+}
@@ -0,0 +1,360 @@
+package mvt
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+)
+
+// MARK: Decoding
+
+// Decoded messages of a tile, holding what the tests look at
+type decodedLayer struct {
+	version  uint64
+	name     string
+	extent   uint64
+	features []decodedFeature
+	keys     []string
+	values   []any
+}
+
+type decodedFeature struct {
+	geomType uint64
+	tags     []uint64
+	geometry []uint64
+}
+
+type decodedField struct {
+	field int
+	num   uint64
+	bytes []byte
+}
+
+// Helper function to split a protobuf message into its fields. Varint and
+// fixed64 fields are returned as numbers, length delimited ones as bytes.
+func decodeFields(t *testing.T, data []byte) []decodedField {
+	t.Helper()
+	var fields []decodedField
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			t.Fatalf("malformed tag in %x", data)
+		}
+		data = data[n:]
+
+		f := decodedField{field: int(tag >> 3)}
+		switch tag & 7 {
+		case wireVarint:
+			f.num, n = binary.Uvarint(data)
+			if n <= 0 {
+				t.Fatalf("field %d: malformed varint", f.field)
+			}
+			data = data[n:]
+		case wire64:
+			if len(data) < 8 {
+				t.Fatalf("field %d: short fixed64", f.field)
+			}
+			f.num = binary.LittleEndian.Uint64(data)
+			data = data[8:]
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				t.Fatalf("field %d: malformed length", f.field)
+			}
+			f.bytes = data[n : n+int(length)]
+			data = data[n+int(length):]
+		default:
+			t.Fatalf("field %d: unexpected wire type %d", f.field, tag&7)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// Helper function to decode a packed repeated varint field
+func decodePacked(t *testing.T, data []byte) []uint64 {
+	t.Helper()
+	var values []uint64
+	for len(data) > 0 {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			t.Fatalf("malformed packed field %x", data)
+		}
+		values = append(values, v)
+		data = data[n:]
+	}
+	return values
+}
+
+// Helper function to decode the layers of a tile
+func decodeTile(t *testing.T, data []byte) []decodedLayer {
+	t.Helper()
+	var layers []decodedLayer
+	for _, f := range decodeFields(t, data) {
+		if f.field != 3 {
+			t.Fatalf("tile: unexpected field %d", f.field)
+		}
+
+		var l decodedLayer
+		for _, f := range decodeFields(t, f.bytes) {
+			switch f.field {
+			case 15:
+				l.version = f.num
+			case 1:
+				l.name = string(f.bytes)
+			case 2:
+				var feature decodedFeature
+				for _, f := range decodeFields(t, f.bytes) {
+					switch f.field {
+					case 2:
+						feature.tags = decodePacked(t, f.bytes)
+					case 3:
+						feature.geomType = f.num
+					case 4:
+						feature.geometry = decodePacked(t, f.bytes)
+					}
+				}
+				l.features = append(l.features, feature)
+			case 3:
+				l.keys = append(l.keys, string(f.bytes))
+			case 4:
+				v := decodeFields(t, f.bytes)
+				if len(v) != 1 {
+					t.Fatalf("value with %d fields", len(v))
+				}
+				switch v[0].field {
+				case valueString:
+					l.values = append(l.values, string(v[0].bytes))
+				case valueDouble:
+					l.values = append(l.values, math.Float64frombits(v[0].num))
+				case valueSint:
+					l.values = append(l.values, int64(v[0].num>>1)^-int64(v[0].num&1))
+				case valueBool:
+					l.values = append(l.values, v[0].num == 1)
+				}
+			case 5:
+				l.extent = f.num
+			}
+		}
+		layers = append(layers, l)
+	}
+	return layers
+}
+
+// decodedGeometry is a geometry decoded to absolute tile coordinates, with
+// the number of each command found
+type decodedGeometry struct {
+	rings    [][][2]int32
+	commands map[int]int
+}
+
+// Helper function to decode the commands of a geometry
+func decodeGeometry(t *testing.T, geometry []uint64) decodedGeometry {
+	t.Helper()
+	g := decodedGeometry{commands: map[int]int{}}
+	var x, y int32
+	for i := 0; i < len(geometry); {
+		id, count := int(geometry[i]&7), int(geometry[i]>>3)
+		i++
+		g.commands[id]++
+		switch id {
+		case cmdMoveTo, cmdLineTo:
+			if i+2*count > len(geometry) {
+				t.Fatalf("command %d: %d parameters missing", id, i+2*count-len(geometry))
+			}
+			if id == cmdMoveTo {
+				g.rings = append(g.rings, nil)
+			}
+			for range count {
+				x += unzigzag(geometry[i])
+				y += unzigzag(geometry[i+1])
+				i += 2
+				g.rings[len(g.rings)-1] = append(g.rings[len(g.rings)-1], [2]int32{x, y})
+			}
+		case cmdClosePath:
+		default:
+			t.Fatalf("unknown command %d", id)
+		}
+	}
+	return g
+}
+
+func unzigzag(v uint64) int32 {
+	return int32(v>>1) ^ -int32(v&1)
+}
+
+// MARK: Tests
+
+func TestEncodePoints(t *testing.T) {
+	// Tile 1/0/0 spans the longitudes from -180 to 0, its Buffer reaches
+	// about 11 degrees east of 0 at latitude 10
+	tile := Tile{1, 0, 0}
+	layer := tile.NewLayer("balita")
+
+	points := []struct {
+		lon, lat   float64
+		properties map[string]any
+		added      bool
+	}{
+		{-90, 10, map[string]any{"status": "stunting", "jumlah": 3, "catatan": nil}, true},
+		{-45, 30, map[string]any{"status": "normal", "jumlah": 3.0}, true},
+		{1, 10, map[string]any{"status": "stunting", "aktif": true}, true},
+		{20, 10, map[string]any{"status": "normal"}, false},
+		{-90, -30, map[string]any{"status": "normal"}, false},
+	}
+	for _, p := range points {
+		if added := layer.AddPoint(p.lon, p.lat, p.properties); added != p.added {
+			t.Errorf("AddPoint(%v, %v) = %v, want %v", p.lon, p.lat, added, p.added)
+		}
+	}
+
+	layers := decodeTile(t, Encode(layer))
+	if len(layers) != 1 {
+		t.Fatalf("decoded %d layers, want 1", len(layers))
+	}
+	l := layers[0]
+	if l.version != 2 || l.name != "balita" || l.extent != Extent {
+		t.Errorf("layer version %d, name %q, extent %d", l.version, l.name, l.extent)
+	}
+	if len(l.features) != 3 {
+		t.Fatalf("decoded %d features, want 3", len(l.features))
+	}
+
+	// Keys and values are shared by the features, whole floats are stored as
+	// integers and nil properties are left out
+	if want := []string{"jumlah", "status", "aktif"}; !reflect.DeepEqual(l.keys, want) {
+		t.Errorf("keys = %q, want %q", l.keys, want)
+	}
+	if want := []any{int64(3), "stunting", "normal", true}; !reflect.DeepEqual(l.values, want) {
+		t.Errorf("values = %v, want %v", l.values, want)
+	}
+	wantTags := [][]uint64{{0, 0, 1, 1}, {0, 0, 1, 2}, {2, 3, 1, 1}}
+	for i, f := range l.features {
+		if !reflect.DeepEqual(f.tags, wantTags[i]) {
+			t.Errorf("feature %d: tags = %v, want %v", i, f.tags, wantTags[i])
+		}
+		if f.geomType != geomPoint {
+			t.Errorf("feature %d: type %d, want a point", i, f.geomType)
+		}
+	}
+
+	// Every point is a single MoveTo relative to the origin of the tile
+	g := decodeGeometry(t, l.features[0].geometry)
+	if len(l.features[0].geometry) != 3 || g.commands[cmdMoveTo] != 1 {
+		t.Fatalf("geometry %v, want a MoveTo with one point", l.features[0].geometry)
+	}
+	x, y := tile.project(-90, 10)
+	if want := [2]int32{int32(math.Round(x)), int32(math.Round(y))}; g.rings[0][0] != want {
+		t.Errorf("point at %v, want %v", g.rings[0][0], want)
+	}
+	if g := decodeGeometry(t, l.features[2].geometry); g.rings[0][0][0] <= Extent {
+		t.Errorf("point in the buffer at %v, want x beyond %d", g.rings[0][0], Extent)
+	}
+}
+
+func TestEncodePolygons(t *testing.T) {
+	tile := Tile{0, 0, 0}
+	layer := tile.NewLayer("wilayah")
+
+	// Rings of both orientations, the y axis of tile units points down so
+	// the encoding reverses the hole and the triangle
+	square := geom.Polygon{
+		{{-90, -45}, {-90, 45}, {90, 45}, {90, -45}, {-90, -45}},
+		{{-10, -10}, {10, -10}, {10, 10}, {-10, 10}, {-10, -10}},
+	}
+	triangle := geom.Polygon{{{100, 0}, {140, 0}, {120, 30}, {100, 0}}}
+	layer.AddMultiPolygon(geom.MultiPolygon{square, triangle}, map[string]any{"nama": "Cirebon"})
+
+	// Polygons that shrink to less than a tile unit are dropped, and the
+	// feature with them when none is left
+	layer.AddMultiPolygon(geom.MultiPolygon{{{{0, 0}, {0.001, 0}, {0.001, 0.001}, {0, 0}}}}, nil)
+
+	layers := decodeTile(t, Encode(layer))
+	if len(layers) != 1 || len(layers[0].features) != 1 {
+		t.Fatalf("decoded %+v, want one layer with one feature", layers)
+	}
+	f := layers[0].features[0]
+	if f.geomType != geomPolygon {
+		t.Errorf("type %d, want a polygon", f.geomType)
+	}
+
+	g := decodeGeometry(t, f.geometry)
+	if len(g.rings) != 3 {
+		t.Fatalf("decoded %d rings, want 3", len(g.rings))
+	}
+	if g.commands[cmdMoveTo] != 3 || g.commands[cmdLineTo] != 3 || g.commands[cmdClosePath] != 3 {
+		t.Errorf("commands %v, want a MoveTo, LineTo and ClosePath per ring", g.commands)
+	}
+	// The closing point is left to ClosePath
+	for i, want := range []int{4, 4, 3} {
+		if len(g.rings[i]) != want {
+			t.Errorf("ring %d has %d points, want %d", i, len(g.rings[i]), want)
+		}
+	}
+
+	// Exterior rings have a positive area, holes a negative one
+	for i, exterior := range []bool{true, false, true} {
+		if area := ringArea(g.rings[i]); (area > 0) != exterior || area == 0 {
+			t.Errorf("ring %d: area %d, exterior %v", i, area, exterior)
+		}
+	}
+}
+
+func TestEncodeClipsPolygons(t *testing.T) {
+	// Tile 2/1/1 is the quarter west of 0 and north of the equator, a polygon
+	// spanning it is clipped to the tile and its Buffer
+	tile := Tile{2, 1, 1}
+	layer := tile.NewLayer("wilayah")
+	layer.AddMultiPolygon(geom.MultiPolygon{{{{-180, -60}, {180, -60}, {180, 80}, {-180, 80}, {-180, -60}}}}, nil)
+	// and one far off is dropped
+	layer.AddMultiPolygon(geom.MultiPolygon{{{{100, -40}, {120, -40}, {120, -20}, {100, -40}}}}, nil)
+
+	if layer.Len() != 1 {
+		t.Fatalf("layer has %d features, want 1", layer.Len())
+	}
+	g := decodeGeometry(t, decodeTile(t, Encode(layer))[0].features[0].geometry)
+	for _, p := range g.rings[0] {
+		if p[0] < -Buffer || p[0] > Extent+Buffer || p[1] < -Buffer || p[1] > Extent+Buffer {
+			t.Errorf("point %v outside the buffer", p)
+		}
+	}
+	if len(g.rings[0]) != 4 {
+		t.Errorf("clipped ring %v, want the 4 corners of the buffer", g.rings[0])
+	}
+}
+
+func TestEncodeEmpty(t *testing.T) {
+	tile := Tile{0, 0, 0}
+	if data := Encode(tile.NewLayer("balita"), tile.NewLayer("wilayah")); len(data) != 0 {
+		t.Errorf("Encode of empty layers = %x, want an empty tile", data)
+	}
+}
+
+func TestParseTile(t *testing.T) {
+	if tile, err := ParseTile("3", "5", "2"); err != nil || tile != (Tile{3, 5, 2}) {
+		t.Errorf("ParseTile(3, 5, 2) = %v, %v", tile, err)
+	}
+	if tile, err := ParseTile("0", "0", "0"); err != nil || tile != (Tile{}) {
+		t.Errorf("ParseTile(0, 0, 0) = %v, %v", tile, err)
+	}
+
+	for _, zxy := range [][3]string{
+		{"", "0", "0"},
+		{"a", "0", "0"},
+		{"-1", "0", "0"},
+		{"21", "0", "0"},
+		{"1", "2", "0"},
+		{"1", "-1", "0"},
+		{"1", "0", "2"},
+		{"1", "0", "x"},
+		{"0", "0", "1"},
+		{"3", "1.5", "0"},
+	} {
+		if tile, err := ParseTile(zxy[0], zxy[1], zxy[2]); err == nil {
+			t.Errorf("ParseTile(%q, %q, %q) = %v, want an error", zxy[0], zxy[1], zxy[2], tile)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Package mvt encodes map layers as Mapbox Vector Tiles.
+//
+// A tile is addressed by zoom level and x and y in the XYZ scheme used by
+// Leaflet and OpenStreetMap. Geometries are projected to Web Mercator tile
+// coordinates of Extent units, clipped to the tile with a Buffer around it
+// and simplified by SimplifyTolerance units. As the tolerance is in tile
+// units, boundaries keep about the same detail on screen at every zoom
+// level and get coarser in degrees when zooming out.
+//
+// The protobuf encoding follows version 2.1 of the specification,
+// https://github.com/mapbox/vector-tile-spec/tree/master/2.1.
+package mvt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/rifqidaiva/stunting-web/internal/cluster"
+)
+
+// Extent is the size of a tile in tile units.
+const Extent = 4096
+
+// Buffer is the size in tile units of the margin around a tile in which
+// geometries are kept, so that lines and symbols crossing the tile edge are
+// drawn without seams. 256 units are 16 pixels of a 256 pixel tile.
+const Buffer = 256
+
+// SimplifyTolerance is the tolerance in tile units of the polygon
+// simplification, half a pixel of a 256 pixel tile.
+const SimplifyTolerance = 8
+
+// MaxZoom is the highest zoom level served.
+const MaxZoom = cluster.MaxZoom
+
+// ContentType is the media type of an encoded tile.
+const ContentType = "application/vnd.mapbox-vector-tile"
+
+// Tile is the address of a tile.
+type Tile struct {
+	Z, X, Y int
+}
+
+// ParseTile parses and checks the zoom level, x and y of a tile.
+func ParseTile(z, x, y string) (Tile, error) {
+	var t Tile
+	var err error
+	if t.Z, err = strconv.Atoi(z); err != nil || t.Z < 0 || t.Z > MaxZoom {
+		return Tile{}, fmt.Errorf("zoom must be a number from 0 to %d", MaxZoom)
+	}
+
+	n := 1 << t.Z
+	if t.X, err = strconv.Atoi(x); err != nil || t.X < 0 || t.X >= n {
+		return Tile{}, fmt.Errorf("x must be a number from 0 to %d at zoom %d", n-1, t.Z)
+	}
+	if t.Y, err = strconv.Atoi(y); err != nil || t.Y < 0 || t.Y >= n {
+		return Tile{}, fmt.Errorf("y must be a number from 0 to %d at zoom %d", n-1, t.Z)
+	}
+	return t, nil
+}
+
+// String returns the tile as z/x/y.
+func (t Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", t.Z, t.X, t.Y)
+}
+
+// Bounds returns the bounding box in degrees of the tile grown by margin
+// tile units on every side, e.g. Buffer.
+func (t Tile) Bounds(margin float64) cluster.BBox {
+	n := math.Exp2(float64(t.Z))
+	m := margin / Extent
+
+	lon := func(x float64) float64 {
+		return x/n*360 - 180
+	}
+	lat := func(y float64) float64 {
+		return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+	}
+
+	return cluster.BBox{
+		MinLon: math.Max(-180, lon(float64(t.X)-m)),
+		MaxLon: math.Min(180, lon(float64(t.X+1)+m)),
+		MinLat: lat(math.Min(n, float64(t.Y+1)+m)),
+		MaxLat: lat(math.Max(0, float64(t.Y)-m)),
+	}
+}
+
+// Helper function to project a point in degrees to tile units, relative
+// to the top left corner of the tile. Latitudes beyond the Mercator limit
+// are clamped.
+func (t Tile) project(lon, lat float64) (float64, float64) {
+	const maxLat = 85.05112878
+	lat = math.Max(-maxLat, math.Min(maxLat, lat))
+
+	n := math.Exp2(float64(t.Z))
+	sin := math.Sin(lat * math.Pi / 180)
+	x := (lon + 180) / 360 * n
+	y := (0.5 - math.Log((1+sin)/(1-sin))/(4*math.Pi)) * n
+	return (x - float64(t.X)) * Extent, (y - float64(t.Y)) * Extent
+}
@@ -0,0 +1,141 @@
+// Package nik decodes the structure of the 16 digit Nomor Induk
+// Kependudukan (NIK) and nomor Kartu Keluarga (KK).
+//
+// Both numbers start with the 6 digit Kemendagri region code of the
+// kecamatan that issued them: 2 digits province, 2 digits kabupaten/kota
+// and 2 digits kecamatan. A NIK continues with the birth date of its holder
+// as DDMMYY, where 40 is added to the day for women, and ends with a 4 digit
+// serial. A nomor KK continues with its issue date and ends with a serial.
+package nik
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Length is the number of digits of a NIK and a nomor KK.
+const Length = 16
+
+// femaleDayOffset is added to the birth day of women.
+const femaleDayOffset = 40
+
+// Errors returned by Parse and ParseKK. Their text completes a sentence
+// starting with the name of the field, e.g. "NIK ibu must be exactly 16
+// digits".
+var (
+	ErrLength    = errors.New("must be exactly 16 digits")
+	ErrRegion    = errors.New("has an unknown region code")
+	ErrBirthDate = errors.New("has an invalid birth date")
+	ErrSerial    = errors.New("has an invalid serial number")
+)
+
+// provinces lists the Kemendagri province codes.
+var provinces = []string{
+	"11", "12", "13", "14", "15", "16", "17", "18", "19",
+	"21",
+	"31", "32", "33", "34", "35", "36",
+	"51", "52", "53",
+	"61", "62", "63", "64", "65",
+	"71", "72", "73", "74", "75", "76",
+	"81", "82",
+	"91", "92", "93", "94", "95", "96",
+}
+
+// NIK is a decoded Nomor Induk Kependudukan.
+type NIK struct {
+	Region    string    // kode wilayah of the issuing kecamatan, e.g. 327401
+	BirthDate time.Time // date only, in UTC
+	Female    bool
+	Serial    string
+}
+
+// KK is a decoded nomor Kartu Keluarga.
+type KK struct {
+	Region string // kode wilayah of the issuing kecamatan, e.g. 327401
+	Serial string
+}
+
+// Parse decodes a NIK. Two digit birth years are placed in the most recent
+// century that does not put the birth date in the future.
+func Parse(value string) (NIK, error) {
+	if err := checkDigits(value); err != nil {
+		return NIK{}, err
+	}
+
+	n := NIK{Region: value[:6], Serial: value[12:]}
+	if !validRegion(n.Region) {
+		return NIK{}, ErrRegion
+	}
+	if n.Serial == "0000" {
+		return NIK{}, ErrSerial
+	}
+
+	day, _ := strconv.Atoi(value[6:8])
+	month, _ := strconv.Atoi(value[8:10])
+	year, _ := strconv.Atoi(value[10:12])
+	if day > femaleDayOffset {
+		n.Female = true
+		day -= femaleDayOffset
+	}
+
+	now := time.Now().UTC()
+	year += now.Year() / 100 * 100
+	birthDate, err := date(year, month, day)
+	if err == nil && birthDate.After(now) {
+		birthDate, err = date(year-100, month, day)
+	}
+	if err != nil {
+		return NIK{}, err
+	}
+	n.BirthDate = birthDate
+
+	return n, nil
+}
+
+// ParseKK decodes a nomor KK. The issue date is not checked, older cards
+// do not always follow the DDMMYY layout.
+func ParseKK(value string) (KK, error) {
+	if err := checkDigits(value); err != nil {
+		return KK{}, err
+	}
+
+	kk := KK{Region: value[:6], Serial: value[12:]}
+	if !validRegion(kk.Region) {
+		return KK{}, ErrRegion
+	}
+	if kk.Serial == "0000" {
+		return KK{}, ErrSerial
+	}
+	return kk, nil
+}
+
+// Helper function to check that value has exactly Length digits
+func checkDigits(value string) error {
+	if len(value) != Length {
+		return ErrLength
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return ErrLength
+		}
+	}
+	return nil
+}
+
+// Helper function to check a 6 digit region code: a known province and a
+// non-zero kabupaten/kota and kecamatan
+func validRegion(region string) bool {
+	return slices.Contains(provinces, region[:2]) && region[2:4] != "00" && region[4:6] != "00"
+}
+
+// Helper function to build a date, rejecting days that do not exist in the
+// month
+func date(year, month, day int) (time.Time, error) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || day < 1 || t.Day() != day {
+		return time.Time{}, ErrBirthDate
+	}
+	return t, nil
+}
@@ -0,0 +1,102 @@
+package nik
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	year := time.Now().UTC().Year()
+	tests := []struct {
+		nik       string
+		birthDate string
+		female    bool
+	}{
+		{"3274011505900001", "1990-05-15", false},
+		{"3274015505900002", "1990-05-15", true},
+		{"3274014101850003", "1985-01-01", true},
+		{"3274017112990004", "1999-12-31", true},
+		{"3274012902000005", "2000-02-29", false},
+		{"3274016902000006", "2000-02-29", true},
+		// Two digit years are placed in the latest century not in the future
+		{fmt.Sprintf("32740101%02d%02d0007", 1, year%100), fmt.Sprintf("%d-01-01", year), false},
+		{fmt.Sprintf("32740131%02d%02d0008", 12, (year+1)%100), fmt.Sprintf("%d-12-31", year+1-100), false},
+	}
+	for _, tt := range tests {
+		n, err := Parse(tt.nik)
+		if err != nil {
+			t.Errorf("Parse(%s): %v", tt.nik, err)
+			continue
+		}
+		if got := n.BirthDate.Format("2006-01-02"); got != tt.birthDate || n.Female != tt.female {
+			t.Errorf("Parse(%s) = %s female %v, want %s female %v", tt.nik, got, n.Female, tt.birthDate, tt.female)
+		}
+		if n.Region != "327401" || n.Serial != tt.nik[12:] {
+			t.Errorf("Parse(%s) = region %s serial %s", tt.nik, n.Region, n.Serial)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		nik  string
+		want error
+	}{
+		{"", ErrLength},
+		{"327401150590001", ErrLength},
+		{"32740115059000011", ErrLength},
+		{"32740115059000a1", ErrLength},
+		{"3274011505900000", ErrSerial},
+		// Region: unknown province, kabupaten/kota or kecamatan 00
+		{"9974011505900001", ErrRegion},
+		{"0074011505900001", ErrRegion},
+		{"3200011505900001", ErrRegion},
+		{"3274001505900001", ErrRegion},
+		// Birth date: 31 February, day 00, 40 and 72, month 00 and 13
+		{"3274013102990001", ErrBirthDate},
+		{"3274017102990001", ErrBirthDate},
+		{"3274010005900001", ErrBirthDate},
+		{"3274014005900001", ErrBirthDate},
+		{"3274017205900001", ErrBirthDate},
+		{"3274011500900001", ErrBirthDate},
+		{"3274011513900001", ErrBirthDate},
+		{"3274012902990001", ErrBirthDate},
+		{"3274013104900001", ErrBirthDate},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.nik); err != tt.want {
+			t.Errorf("Parse(%q) = %v, want %v", tt.nik, err, tt.want)
+		}
+	}
+}
+
+// The issue date of a nomor KK is not checked
+func TestParseKK(t *testing.T) {
+	for _, value := range []string{"3274012201150001", "3274019999990001"} {
+		kk, err := ParseKK(value)
+		if err != nil {
+			t.Errorf("ParseKK(%s): %v", value, err)
+			continue
+		}
+		if kk.Region != "327401" || kk.Serial != "0001" {
+			t.Errorf("ParseKK(%s) = %+v", value, kk)
+		}
+	}
+
+	tests := []struct {
+		kk   string
+		want error
+	}{
+		{"327401220115000", ErrLength},
+		{"3274-12201150001", ErrLength},
+		{"3274012201150000", ErrSerial},
+		{"1074012201150001", ErrRegion},
+		{"3274002201150001", ErrRegion},
+	}
+	for _, tt := range tests {
+		if _, err := ParseKK(tt.kk); err != tt.want {
+			t.Errorf("ParseKK(%q) = %v, want %v", tt.kk, err, tt.want)
+		}
+	}
+}
@@ -1,26 +1,36 @@
 package object
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/rifqidaiva/stunting-web/internal/config"
 )
 
-func ConnectDb() (*sql.DB, error) {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s",
-		"root",
-		"",
-		"127.0.0.1",
-		"3306",
-		"stuntingdb_new",
-	)
-
-	db, err := sql.Open("mysql", dsn)
+// ConnectDb opens the MySQL connection pool shared by all handlers and
+// checks that the database is reachable. It is called once at startup;
+// the caller is responsible for closing the pool on shutdown.
+func ConnectDb(cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open("mysql", cfg.DSN())
 	if err != nil {
 		return nil, err
 	}
 
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime))
+	db.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTime))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("database: %w", err)
+	}
+
 	return db, nil
 }
 
@@ -137,7 +147,10 @@ type RiwayatPemeriksaan struct {
 	Tanggal             string `json:"tanggal"`
 	BeratBadan          string `json:"berat_badan"`
 	TinggiBadan         string `json:"tinggi_badan"`
-	StatusGizi          string `json:"status_gizi"` // "normal", "stunting", "gizi buruk"
+	StatusGizi          string `json:"status_gizi"`  // "normal", "stunting", "gizi buruk"
+	ZScoreTBU           string `json:"zscore_tb_u"`  // length/height-for-age
+	ZScoreBBU           string `json:"zscore_bb_u"`  // weight-for-age
+	ZScoreBBTB          string `json:"zscore_bb_tb"` // weight-for-length/height
 	Keterangan          string `json:"keterangan"`
 
 	CreatedId   string `json:"created_id"`
@@ -156,8 +169,16 @@ type Intervensi struct {
 	Tanggal   string `json:"tanggal"`
 	Deskripsi string `json:"deskripsi"`
 	Hasil     string `json:"hasil"`
+	Status    string `json:"status"` // "planned", "in_progress", "completed", "cancelled"
 	// IdPetugasKesehatan string `json:"id_petugas_kesehatan"`
 
+	StartedId     string `json:"started_id"`
+	StartedDate   string `json:"started_date"`
+	CompletedId   string `json:"completed_id"`
+	CompletedDate string `json:"completed_date"`
+	CancelledId   string `json:"cancelled_id"`
+	CancelledDate string `json:"cancelled_date"`
+
 	CreatedId   string `json:"created_id"`
 	CreatedDate string `json:"created_date"`
 	UpdatedId   string `json:"updated_id"`
@@ -1,9 +1,7 @@
 package object
 
 import (
-	"fmt"
-	"strconv"
-	"strings"
+	"github.com/rifqidaiva/stunting-web/internal/geom"
 )
 
 // GeoJSONFeature represents a single GeoJSON feature
@@ -13,10 +11,15 @@ type GeoJSONFeature struct {
 	Properties map[string]interface{} `json:"properties"`
 }
 
-// GeoJSONGeometry represents the geometry part of a GeoJSON feature
+// GeoJSONGeometry represents the geometry part of a GeoJSON feature.
+// Coordinates holds []float64 for a Point, [][2]float64 for a LineString
+// or MultiPoint, [][][2]float64 for a Polygon or MultiLineString and
+// [][][][2]float64 for a MultiPolygon. A GeometryCollection has Geometries
+// instead.
 type GeoJSONGeometry struct {
-	Type        string      `json:"type"`
-	Coordinates interface{} `json:"coordinates"`
+	Type        string            `json:"type"`
+	Coordinates interface{}       `json:"coordinates,omitempty"`
+	Geometries  []GeoJSONGeometry `json:"geometries,omitempty"`
 }
 
 // GeoJSONFeatureCollection represents a collection of GeoJSON features
@@ -28,163 +31,62 @@ type GeoJSONFeatureCollection struct {
 // ToWKT converts a [2]float64 array into a Well-Known Text (WKT) representation of coordinates.
 // The output format is "POINT(long lat)".
 func ToWKT(coordinates [2]float64) string {
-	return "POINT(" +
-		strconv.FormatFloat(coordinates[0], 'f', -1, 64) + " " +
-		strconv.FormatFloat(coordinates[1], 'f', -1, 64) + ")"
-}
-
-// ParseWKT converts a WKT representation of coordinates into a [2]float64 array.
-// It supports the "POINT(long lat)" format.
-func ParseWKT(wkt string) [2]float64 {
-	if len(wkt) < 7 || wkt[:6] != "POINT(" || wkt[len(wkt)-1] != ')' {
-		return [2]float64{0, 0}
-	}
-
-	// Remove "POINT(" prefix and ")" suffix
-	coords := wkt[6 : len(wkt)-1]
-	parts := strings.Split(coords, " ")
-	if len(parts) != 2 {
-		return [2]float64{0, 0}
-	}
-
-	lon, err1 := strconv.ParseFloat(parts[0], 64)
-	lat, err2 := strconv.ParseFloat(parts[1], 64)
-	if err1 != nil || err2 != nil {
-		return [2]float64{0, 0}
-	}
-
-	return [2]float64{lon, lat}
+	return geom.WKT(geom.Point(coordinates))
 }
 
 // WKTToGeoJSON converts WKT geometry to GeoJSON geometry
 func WKTToGeoJSON(wkt string) (GeoJSONGeometry, error) {
-	var geometry GeoJSONGeometry
-
-	wkt = strings.TrimSpace(wkt)
-
-	if strings.HasPrefix(wkt, "POINT") {
-		return parseWKTPoint(wkt)
-	} else if strings.HasPrefix(wkt, "MULTIPOLYGON") {
-		return parseWKTMultiPolygon(wkt)
-	} else if strings.HasPrefix(wkt, "POLYGON") {
-		return parseWKTPolygon(wkt)
-	}
-
-	return geometry, fmt.Errorf("unsupported WKT geometry type")
-}
-
-// parseWKTPoint converts WKT POINT to GeoJSON Point
-func parseWKTPoint(wkt string) (GeoJSONGeometry, error) {
-	coords := ParseWKT(wkt)
-
-	return GeoJSONGeometry{
-		Type:        "Point",
-		Coordinates: []float64{coords[0], coords[1]},
-	}, nil
-}
-
-// parseWKTPolygon converts WKT POLYGON to GeoJSON Polygon
-func parseWKTPolygon(wkt string) (GeoJSONGeometry, error) {
-	// Remove "POLYGON(" prefix and ")" suffix
-	if !strings.HasPrefix(wkt, "POLYGON((") || !strings.HasSuffix(wkt, "))") {
-		return GeoJSONGeometry{}, fmt.Errorf("invalid POLYGON format")
-	}
-
-	coordStr := wkt[9 : len(wkt)-2] // Remove "POLYGON((" and "))"
-	rings := parsePolygonRings(coordStr)
-
-	return GeoJSONGeometry{
-		Type:        "Polygon",
-		Coordinates: rings,
-	}, nil
-}
-
-// parseWKTMultiPolygon converts WKT MULTIPOLYGON to GeoJSON MultiPolygon
-func parseWKTMultiPolygon(wkt string) (GeoJSONGeometry, error) {
-	// Remove "MULTIPOLYGON(" prefix and ")" suffix
-	if !strings.HasPrefix(wkt, "MULTIPOLYGON(") || !strings.HasSuffix(wkt, ")") {
-		return GeoJSONGeometry{}, fmt.Errorf("invalid MULTIPOLYGON format")
+	g, err := geom.ParseWKT(wkt)
+	if err != nil {
+		return GeoJSONGeometry{}, err
 	}
-
-	coordStr := wkt[13 : len(wkt)-1] // Remove "MULTIPOLYGON(" and ")"
-	polygons := parseMultiPolygonCoordinates(coordStr)
-
-	return GeoJSONGeometry{
-		Type:        "MultiPolygon",
-		Coordinates: polygons,
-	}, nil
+	return ToGeoJSONGeometry(g), nil
 }
 
-// parseMultiPolygonCoordinates parses the coordinate string for MultiPolygon
-func parseMultiPolygonCoordinates(coordStr string) [][][][2]float64 {
-	var polygons [][][][2]float64
-
-	// Split by ")),((" to separate individual polygons
-	polygonStrs := strings.Split(coordStr, ")),(")
-
-	for i, polygonStr := range polygonStrs {
-		// Clean up the polygon string
-		if i == 0 {
-			polygonStr = strings.TrimPrefix(polygonStr, "((")
+// ToGeoJSONGeometry converts a geometry to GeoJSON geometry
+func ToGeoJSONGeometry(g geom.Geometry) GeoJSONGeometry {
+	switch g := g.(type) {
+	case geom.Point:
+		return GeoJSONGeometry{Type: "Point", Coordinates: []float64{g[0], g[1]}}
+	case geom.LineString:
+		return GeoJSONGeometry{Type: "LineString", Coordinates: toCoordinates(g)}
+	case geom.Polygon:
+		return GeoJSONGeometry{Type: "Polygon", Coordinates: toRings(g)}
+	case geom.MultiPoint:
+		return GeoJSONGeometry{Type: "MultiPoint", Coordinates: toCoordinates(g)}
+	case geom.MultiLineString:
+		return GeoJSONGeometry{Type: "MultiLineString", Coordinates: toRings(g)}
+	case geom.MultiPolygon:
+		polygons := make([][][][2]float64, len(g))
+		for i, polygon := range g {
+			polygons[i] = toRings(polygon)
 		}
-		if i == len(polygonStrs)-1 {
-			polygonStr = strings.TrimSuffix(polygonStr, "))")
+		return GeoJSONGeometry{Type: "MultiPolygon", Coordinates: polygons}
+	case geom.GeometryCollection:
+		geometries := make([]GeoJSONGeometry, len(g))
+		for i, member := range g {
+			geometries[i] = ToGeoJSONGeometry(member)
 		}
-		if i > 0 && i < len(polygonStrs)-1 {
-			// Middle polygons, no trimming needed
-		}
-
-		rings := parsePolygonRings(polygonStr)
-		polygons = append(polygons, rings)
+		return GeoJSONGeometry{Type: "GeometryCollection", Geometries: geometries}
 	}
-
-	return polygons
+	return GeoJSONGeometry{}
 }
 
-// parsePolygonRings parses the coordinate string for Polygon rings
-func parsePolygonRings(coordStr string) [][][2]float64 {
-	var rings [][][2]float64
-
-	// Split by "),(" to separate rings
-	ringStrs := strings.Split(coordStr, "),(")
-
-	for i, ringStr := range ringStrs {
-		// Clean up the ring string
-		if i == 0 {
-			ringStr = strings.TrimPrefix(ringStr, "(")
-		}
-		if i == len(ringStrs)-1 {
-			ringStr = strings.TrimSuffix(ringStr, ")")
-		}
-
-		coordinates := parseCoordinateString(ringStr)
-		rings = append(rings, coordinates)
+// toCoordinates converts points into GeoJSON positions
+func toCoordinates(points []geom.Point) [][2]float64 {
+	coordinates := make([][2]float64, len(points))
+	for i, p := range points {
+		coordinates[i] = p
 	}
-
-	return rings
+	return coordinates
 }
 
-// parseCoordinateString parses a string of coordinates into [][2]float64
-func parseCoordinateString(coordStr string) [][2]float64 {
-	var coordinates [][2]float64
-
-	// Split by comma to get individual coordinate pairs
-	pairs := strings.Split(coordStr, ",")
-
-	for _, pair := range pairs {
-		pair = strings.TrimSpace(pair)
-		coords := strings.Fields(pair)
-
-		if len(coords) >= 2 {
-			lon, err1 := strconv.ParseFloat(coords[0], 64)
-			lat, err2 := strconv.ParseFloat(coords[1], 64)
-
-			if err1 == nil && err2 == nil {
-				coordinates = append(coordinates, [2]float64{lon, lat})
-			}
-		}
+// toRings converts lists of points into lists of GeoJSON positions
+func toRings(rings []geom.LineString) [][][2]float64 {
+	coordinates := make([][][2]float64, len(rings))
+	for i, ring := range rings {
+		coordinates[i] = toCoordinates(ring)
 	}
-
 	return coordinates
 }
 
@@ -202,6 +104,16 @@ func CreateGeoJSONFeature(wkt string, properties map[string]interface{}) (GeoJSO
 	}, nil
 }
 
+// NewGeoJSONFeature creates a GeoJSON feature from a geometry read from the
+// database, e.g. with geom.NullGeometry
+func NewGeoJSONFeature(g geom.Geometry, properties map[string]interface{}) GeoJSONFeature {
+	return GeoJSONFeature{
+		Type:       "Feature",
+		Geometry:   ToGeoJSONGeometry(g),
+		Properties: properties,
+	}
+}
+
 // CreateGeoJSONFeatureCollection creates a GeoJSON feature collection
 func CreateGeoJSONFeatureCollection(features []GeoJSONFeature) GeoJSONFeatureCollection {
 	return GeoJSONFeatureCollection{
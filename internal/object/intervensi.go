@@ -0,0 +1,124 @@
+package object
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MARK: Status Intervensi
+const (
+	IntervensiPlanned    = "planned"
+	IntervensiInProgress = "in_progress"
+	IntervensiCompleted  = "completed"
+	IntervensiCancelled  = "cancelled"
+)
+
+// IntervensiStatuses lists every valid intervensi.status value.
+var IntervensiStatuses = []string{IntervensiPlanned, IntervensiInProgress, IntervensiCompleted, IntervensiCancelled}
+
+// intervensiTransitions maps each status to the statuses it may move to.
+// Completed and cancelled are final.
+var intervensiTransitions = map[string][]string{
+	IntervensiPlanned:    {IntervensiInProgress, IntervensiCancelled},
+	IntervensiInProgress: {IntervensiCompleted, IntervensiCancelled},
+}
+
+var (
+	// ErrInvalidIntervensiTransition is returned when a status change is
+	// not allowed by the intervensi lifecycle.
+	ErrInvalidIntervensiTransition = errors.New("invalid intervensi status transition")
+
+	// ErrIntervensiStatusChanged is returned when the intervensi status was
+	// changed by someone else while the transition was being applied.
+	ErrIntervensiStatusChanged = errors.New("intervensi status was changed concurrently")
+)
+
+// IsIntervensiStatus reports whether status is a valid intervensi status.
+func IsIntervensiStatus(status string) bool {
+	return slices.Contains(IntervensiStatuses, status)
+}
+
+// IsIntervensiFinal reports whether no further transition is possible from status.
+func IsIntervensiFinal(status string) bool {
+	return status == IntervensiCompleted || status == IntervensiCancelled
+}
+
+// CanRecordPemeriksaan reports whether riwayat pemeriksaan may be recorded
+// for an intervensi with the given status. Examinations belong to an
+// intervensi that has started; cancelled intervensi are closed.
+func CanRecordPemeriksaan(status string) bool {
+	return status == IntervensiInProgress || status == IntervensiCompleted
+}
+
+// CanTransitionIntervensi reports whether an intervensi may move from one status to another.
+func CanTransitionIntervensi(from, to string) bool {
+	return slices.Contains(intervensiTransitions[from], to)
+}
+
+// ValidateIntervensiTransition returns a descriptive error wrapping
+// ErrInvalidIntervensiTransition when the transition is not allowed.
+func ValidateIntervensiTransition(from, to string) error {
+	if !IsIntervensiStatus(to) {
+		return fmt.Errorf("%w: unknown status '%s'", ErrInvalidIntervensiTransition, to)
+	}
+	if !CanTransitionIntervensi(from, to) {
+		if IsIntervensiFinal(from) {
+			return fmt.Errorf("%w: intervensi is already %s", ErrInvalidIntervensiTransition, from)
+		}
+		return fmt.Errorf("%w: cannot change status from %s to %s (allowed: %v)",
+			ErrInvalidIntervensiTransition, from, to, intervensiTransitions[from])
+	}
+	return nil
+}
+
+// TransitionIntervensi moves an intervensi from status `from` to status `to`
+// and records who made the change and when. When hasil is not empty it is
+// stored together with the transition.
+//
+// The update only applies while the intervensi still has status `from`, so
+// two concurrent transitions cannot both succeed.
+func TransitionIntervensi(tx *sql.Tx, id, from, to, hasil, userId string) error {
+	if err := ValidateIntervensiTransition(from, to); err != nil {
+		return err
+	}
+
+	currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+	// Each transition has its own actor and timestamp columns
+	var transitionColumns string
+	switch to {
+	case IntervensiInProgress:
+		transitionColumns = "started_id = ?, started_date = ?"
+	case IntervensiCompleted:
+		transitionColumns = "completed_id = ?, completed_date = ?"
+	case IntervensiCancelled:
+		transitionColumns = "cancelled_id = ?, cancelled_date = ?"
+	}
+
+	query := "UPDATE intervensi SET status = ?, " + transitionColumns + ", updated_id = ?, updated_date = ?"
+	args := []any{to, userId, currentTime, userId, currentTime}
+	if hasil != "" {
+		query += ", hasil = ?"
+		args = append(args, hasil)
+	}
+	query += " WHERE id = ? AND status = ? AND deleted_date IS NULL"
+	args = append(args, id, from)
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrIntervensiStatusChanged
+	}
+
+	return nil
+}
@@ -1,24 +1,51 @@
 package object
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var secretKey = []byte("secret_key")
+var (
+	// ErrInvalidToken is returned by ParseJWT for malformed, badly signed
+	// or expired tokens.
+	ErrInvalidToken = errors.New("invalid token")
 
-// GenerateJWT generates a JWT token for the given user ID and role.
-func GenerateJWT(userID, role string) (string, error) {
+	// ErrTokenRevoked is returned by ParseJWT when the session of a valid
+	// token was revoked.
+	ErrTokenRevoked = errors.New("token revoked")
+)
+
+// Claims are the values carried by an access token.
+type Claims struct {
+	UserId    string
+	Role      string
+	SessionId string // sesi.id the token was issued for
+}
+
+// RevocationChecker reports whether a session was revoked.
+type RevocationChecker interface {
+	IsRevoked(sessionId string) (bool, error)
+}
+
+// GenerateJWT generates an access token for the given user ID, role and
+// session ID, signed with secret and valid for ttl.
+func GenerateJWT(userID, role, sessionID, secret string, ttl time.Duration) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"role":    role,
-		"exp":     jwt.NewNumericDate(time.Now().Add(24 * time.Hour)), // Token valid for 24 hours
+		"sid":     sessionID,
+		"exp":     jwt.NewNumericDate(time.Now().Add(ttl)),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secretKey)
+	return token.SignedString([]byte(secret))
 }
 
 // GetJWTFromHeader extracts the JWT token from the Authorization header.
@@ -36,41 +63,74 @@ func GetJWTFromHeader(header string) (string, error) {
 	return header[7:], nil
 }
 
-// ParseJWT parses the JWT token and returns the user ID and role.
-func ParseJWT(tokenString string) (string, string, error) {
+// ParseJWT parses the access token signed with secret and returns its
+// claims. It returns ErrInvalidToken for tokens that do not verify and
+// ErrTokenRevoked when revocation reports the session as revoked.
+func ParseJWT(tokenString, secret string, revocation RevocationChecker) (Claims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("unexpected signing method")
 		}
-		return secretKey, nil
+		return []byte(secret), nil
 	})
 
 	if err != nil || !token.Valid {
-		return "", "", errors.New("invalid token")
+		return Claims{}, ErrInvalidToken
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", "", errors.New("invalid claims")
+		return Claims{}, fmt.Errorf("%w: invalid claims", ErrInvalidToken)
 	}
 
 	userID, ok := claims["user_id"].(string)
 	if !ok {
-		return "", "", errors.New("user_id not found in claims")
+		return Claims{}, fmt.Errorf("%w: user_id not found in claims", ErrInvalidToken)
 	}
 
 	role, ok := claims["role"].(string)
 	if !ok {
-		return "", "", errors.New("role not found in claims")
+		return Claims{}, fmt.Errorf("%w: role not found in claims", ErrInvalidToken)
+	}
+
+	// Tokens issued before sessions existed have no sid and cannot be revoked
+	sessionID, ok := claims["sid"].(string)
+	if !ok || sessionID == "" {
+		return Claims{}, fmt.Errorf("%w: sid not found in claims", ErrInvalidToken)
 	}
 
 	exp, ok := claims["exp"].(float64)
 	if !ok {
-		return "", "", errors.New("exp not found in claims")
+		return Claims{}, fmt.Errorf("%w: exp not found in claims", ErrInvalidToken)
 	}
 	if int64(exp) < time.Now().Unix() {
-		return "", "", errors.New("token expired")
+		return Claims{}, fmt.Errorf("%w: token expired", ErrInvalidToken)
 	}
 
-	return userID, role, nil
+	revoked, err := revocation.IsRevoked(sessionID)
+	if err != nil {
+		return Claims{}, fmt.Errorf("check token revocation: %w", err)
+	}
+	if revoked {
+		return Claims{}, ErrTokenRevoked
+	}
+
+	return Claims{UserId: userID, Role: role, SessionId: sessionID}, nil
+}
+
+// NewRefreshToken returns a random refresh token and the hash to store
+// for it.
+func NewRefreshToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the hex encoded SHA-256 hash of a refresh token.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
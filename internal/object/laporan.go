@@ -0,0 +1,149 @@
+package object
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MARK: Laporan Status Workflow
+
+// ErrInvalidLaporanTransition is returned when a status change is not
+// listed in the status_laporan_transisi table.
+var ErrInvalidLaporanTransition = errors.New("invalid status laporan transition")
+
+// LaporanStatusHistory is a single status change of a laporan masyarakat.
+// The first entry of every laporan has no previous status.
+type LaporanStatusHistory struct {
+	Id                    string `json:"id"`
+	IdStatusLaporanAsal   string `json:"id_status_laporan_asal,omitempty"`
+	StatusLaporanAsal     string `json:"status_laporan_asal,omitempty"`
+	IdStatusLaporanTujuan string `json:"id_status_laporan_tujuan"`
+	StatusLaporanTujuan   string `json:"status_laporan_tujuan"`
+	Catatan               string `json:"catatan"`
+	CreatedBy             string `json:"created_by,omitempty"` // email pengguna yang mengubah status
+	CreatedDate           string `json:"created_date"`
+}
+
+// ValidateLaporanTransition checks the status_laporan_transisi table for a
+// move from one status to another. An empty `from` validates the initial
+// status of a new laporan.
+func ValidateLaporanTransition(tx *sql.Tx, from, to string) error {
+	var allowed int
+	var err error
+	if from == "" {
+		query := "SELECT COUNT(*) FROM status_laporan_transisi WHERE id_status_asal IS NULL AND id_status_tujuan = ?"
+		err = tx.QueryRow(query, to).Scan(&allowed)
+	} else {
+		query := "SELECT COUNT(*) FROM status_laporan_transisi WHERE id_status_asal = ? AND id_status_tujuan = ?"
+		err = tx.QueryRow(query, from, to).Scan(&allowed)
+	}
+	if err != nil {
+		return err
+	}
+
+	if allowed == 0 {
+		if from == "" {
+			return fmt.Errorf("%w: status %s cannot be used for a new laporan", ErrInvalidLaporanTransition, to)
+		}
+		return fmt.Errorf("%w: cannot change status from %s to %s", ErrInvalidLaporanTransition, from, to)
+	}
+	return nil
+}
+
+// GetNextStatusLaporan returns the ids of the statuses a laporan with
+// status `from` may move to.
+func GetNextStatusLaporan(db *sql.DB, from string) ([]string, error) {
+	query := "SELECT id_status_tujuan FROM status_laporan_transisi WHERE id_status_asal = ? ORDER BY id_status_tujuan"
+	rows, err := db.Query(query, from)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nextStatus := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		nextStatus = append(nextStatus, id)
+	}
+
+	return nextStatus, rows.Err()
+}
+
+// RecordLaporanStatus writes a laporan_status_history entry. An empty
+// `from` records the initial status of a new laporan.
+func RecordLaporanStatus(tx *sql.Tx, idLaporan, from, to, catatan, userId string) error {
+	currentTime := time.Now().Format("2006-01-02 15:04:05")
+
+	var statusAsal sql.NullString
+	if from != "" {
+		statusAsal = sql.NullString{String: from, Valid: true}
+	}
+
+	insertQuery := `INSERT INTO laporan_status_history
+        (id_laporan_masyarakat, id_status_laporan_asal, id_status_laporan_tujuan, catatan, created_id, created_date)
+        VALUES (?, ?, ?, ?, ?, ?)`
+	_, err := tx.Exec(insertQuery, idLaporan, statusAsal, to, catatan, userId, currentTime)
+	return err
+}
+
+// GetLaporanStatusHistory returns the status changes of a laporan, oldest first.
+func GetLaporanStatusHistory(db *sql.DB, idLaporan string) ([]LaporanStatusHistory, error) {
+	query := `
+        SELECT
+            h.id, h.id_status_laporan_asal, sa.status,
+            h.id_status_laporan_tujuan, st.status,
+            h.catatan, p.email, h.created_date
+        FROM laporan_status_history h
+        LEFT JOIN status_laporan sa ON h.id_status_laporan_asal = sa.id
+        JOIN status_laporan st ON h.id_status_laporan_tujuan = st.id
+        LEFT JOIN pengguna p ON h.created_id = p.id
+        WHERE h.id_laporan_masyarakat = ?
+        ORDER BY h.created_date ASC, h.id ASC
+    `
+
+	rows, err := db.Query(query, idLaporan)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []LaporanStatusHistory{}
+	for rows.Next() {
+		var entry LaporanStatusHistory
+		var idStatusAsal, statusAsal, createdBy sql.NullString
+
+		err := rows.Scan(
+			&entry.Id,
+			&idStatusAsal,
+			&statusAsal,
+			&entry.IdStatusLaporanTujuan,
+			&entry.StatusLaporanTujuan,
+			&entry.Catatan,
+			&createdBy,
+			&entry.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handle nullable fields
+		if idStatusAsal.Valid {
+			entry.IdStatusLaporanAsal = idStatusAsal.String
+		}
+		if statusAsal.Valid {
+			entry.StatusLaporanAsal = statusAsal.String
+		}
+		if createdBy.Valid {
+			entry.CreatedBy = createdBy.String
+		}
+
+		history = append(history, entry)
+	}
+
+	return history, rows.Err()
+}
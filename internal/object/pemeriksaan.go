@@ -0,0 +1,254 @@
+package object
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/growth"
+)
+
+// MARK: Riwayat Pemeriksaan
+
+// RiwayatPemeriksaanInput is a new riwayat pemeriksaan, recorded by an
+// admin or by the petugas kesehatan assigned to the intervensi.
+type RiwayatPemeriksaanInput struct {
+	IdBalita            string `json:"id_balita"`
+	IdIntervensi        string `json:"id_intervensi"`
+	IdLaporanMasyarakat string `json:"id_laporan_masyarakat"`
+	Tanggal             string `json:"tanggal"`      // Format: YYYY-MM-DD
+	BeratBadan          string `json:"berat_badan"`  // in kg (decimal)
+	TinggiBadan         string `json:"tinggi_badan"` // in cm (decimal)
+	StatusGizi          string `json:"status_gizi"`  // optional, computed from WHO growth standards
+	Keterangan          string `json:"keterangan"`
+}
+
+var pemeriksaanDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// Validate checks the fields of the input that do not need the database.
+func (r *RiwayatPemeriksaanInput) Validate() error {
+	if r.IdBalita == "" {
+		return fmt.Errorf("id balita is required")
+	}
+	if r.IdIntervensi == "" {
+		return fmt.Errorf("id intervensi is required")
+	}
+	if r.IdLaporanMasyarakat == "" {
+		return fmt.Errorf("id laporan masyarakat is required")
+	}
+
+	// Tanggal validation: YYYY-MM-DD format, not in the future
+	if r.Tanggal == "" {
+		return fmt.Errorf("tanggal pemeriksaan is required")
+	}
+	if !pemeriksaanDateRegex.MatchString(r.Tanggal) {
+		return fmt.Errorf("tanggal must be in YYYY-MM-DD format")
+	}
+	pemeriksaanDate, err := time.Parse("2006-01-02", r.Tanggal)
+	if err != nil {
+		return fmt.Errorf("invalid tanggal format")
+	}
+	if pemeriksaanDate.After(time.Now()) {
+		return fmt.Errorf("tanggal pemeriksaan cannot be in the future")
+	}
+
+	// Berat Badan validation: decimal, reasonable range (1-50 kg)
+	if r.BeratBadan == "" {
+		return fmt.Errorf("berat badan is required")
+	}
+	beratBadan, err := strconv.ParseFloat(r.BeratBadan, 64)
+	if err != nil {
+		return fmt.Errorf("berat badan must be a valid decimal number (in kg)")
+	}
+	if beratBadan < 1.0 || beratBadan > 50.0 {
+		return fmt.Errorf("berat badan must be between 1.0-50.0 kg")
+	}
+
+	// Tinggi Badan validation: decimal, reasonable range (30-150 cm)
+	if r.TinggiBadan == "" {
+		return fmt.Errorf("tinggi badan is required")
+	}
+	tinggiBadan, err := strconv.ParseFloat(r.TinggiBadan, 64)
+	if err != nil {
+		return fmt.Errorf("tinggi badan must be a valid decimal number (in cm)")
+	}
+	if tinggiBadan < 30.0 || tinggiBadan > 150.0 {
+		return fmt.Errorf("tinggi badan must be between 30.0-150.0 cm")
+	}
+
+	// Status Gizi validation: optional, computed from WHO growth standards
+	if r.StatusGizi != "" && !growth.IsAllowedStatus(r.StatusGizi) {
+		return fmt.Errorf("status gizi must be one of: normal, stunting, gizi buruk")
+	}
+
+	if r.Keterangan == "" {
+		return fmt.Errorf("keterangan is required")
+	}
+	if len(r.Keterangan) < 5 || len(r.Keterangan) > 500 {
+		return fmt.Errorf("keterangan must be between 5-500 characters")
+	}
+
+	return nil
+}
+
+// PemeriksaanSubject is the balita and intervensi a riwayat pemeriksaan is
+// recorded for, as looked up by the handler for its role.
+type PemeriksaanSubject struct {
+	NamaBalita         string
+	TanggalLahirBalita string
+	JenisKelaminBalita string
+	JenisIntervensi    string
+	TanggalIntervensi  string
+	StatusIntervensi   string
+}
+
+// InsertedPemeriksaan is the outcome of InsertRiwayatPemeriksaan.
+type InsertedPemeriksaan struct {
+	Id         int64
+	StatusGizi string
+	Assessment *growth.Result // nil when the balita is outside the WHO range
+	Message    string
+}
+
+// laporanPemeriksaanStatuses are the status laporan under which a
+// riwayat pemeriksaan may be recorded.
+var laporanPemeriksaanStatuses = []string{"Diproses dan data sesuai", "Belum ditindaklanjuti", "Sudah ditindaklanjuti"}
+
+// InsertRiwayatPemeriksaan checks the laporan masyarakat and the dates of
+// a validated input against its subject, computes the status gizi from the
+// WHO growth standards and inserts the riwayat pemeriksaan. Failed checks
+// are returned as HTTPError.
+func InsertRiwayatPemeriksaan(tx *sql.Tx, in RiwayatPemeriksaanInput, subject PemeriksaanSubject, userId string) (InsertedPemeriksaan, error) {
+	var inserted InsertedPemeriksaan
+
+	// Intervensi must have started and not been cancelled
+	if !CanRecordPemeriksaan(subject.StatusIntervensi) {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Cannot record medical examination. Intervensi %s has status '%s', required status: in_progress or completed",
+				subject.JenisIntervensi, subject.StatusIntervensi))
+	}
+
+	// Laporan masyarakat must exist, belong to the balita and allow an examination
+	var idMasyarakatLaporan, laporanBalitaId, statusLaporan sql.NullString
+	var tanggalLaporan string
+	checkLaporanQuery := `
+        SELECT lm.id_masyarakat, lm.id_balita, sl.status, lm.tanggal_laporan
+        FROM laporan_masyarakat lm
+        LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
+        WHERE lm.id = ? AND lm.deleted_date IS NULL
+    `
+	err := tx.QueryRow(checkLaporanQuery, in.IdLaporanMasyarakat).Scan(&idMasyarakatLaporan, &laporanBalitaId, &statusLaporan, &tanggalLaporan)
+	if err == sql.ErrNoRows {
+		return inserted, NewHTTPError(http.StatusBadRequest, "Laporan masyarakat not found")
+	}
+	if err != nil {
+		return inserted, NewHTTPError(http.StatusInternalServerError, "Failed to check laporan masyarakat existence")
+	}
+
+	jenisLaporan := "admin"
+	if idMasyarakatLaporan.Valid {
+		jenisLaporan = "masyarakat"
+	}
+
+	if laporanBalitaId.String != in.IdBalita {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Laporan masyarakat is not related to balita '%s'. Please select the correct laporan.", subject.NamaBalita))
+	}
+
+	if !slices.Contains(laporanPemeriksaanStatuses, statusLaporan.String) {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Cannot create medical examination. Laporan status '%s' does not allow medical examination. Required status: %v",
+				statusLaporan.String, laporanPemeriksaanStatuses))
+	}
+
+	// Same balita, intervensi, laporan and tanggal is recorded only once
+	var duplicateExists int
+	checkDuplicateQuery := `
+        SELECT COUNT(*) FROM riwayat_pemeriksaan
+        WHERE id_balita = ? AND id_intervensi = ? AND id_laporan_masyarakat = ? AND tanggal = ? AND deleted_date IS NULL
+    `
+	err = tx.QueryRow(checkDuplicateQuery, in.IdBalita, in.IdIntervensi, in.IdLaporanMasyarakat, in.Tanggal).Scan(&duplicateExists)
+	if err != nil {
+		return inserted, NewHTTPError(http.StatusInternalServerError, "Failed to check duplicate riwayat pemeriksaan")
+	}
+	if duplicateExists > 0 {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Riwayat pemeriksaan for balita '%s' on date '%s' in intervensi '%s' with this laporan already exists",
+				subject.NamaBalita, in.Tanggal, subject.JenisIntervensi))
+	}
+
+	// The examination cannot precede the intervensi or the laporan
+	pemeriksaanDate, _ := time.Parse("2006-01-02", in.Tanggal)
+	intervensiDate, err := time.Parse("2006-01-02", subject.TanggalIntervensi)
+	if err == nil && pemeriksaanDate.Before(intervensiDate) {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Tanggal pemeriksaan (%s) cannot be before intervensi date (%s)", in.Tanggal, subject.TanggalIntervensi))
+	}
+	laporanDate, err := time.Parse("2006-01-02", tanggalLaporan)
+	if err == nil && pemeriksaanDate.Before(laporanDate) {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Tanggal pemeriksaan (%s) cannot be before laporan date (%s)", in.Tanggal, tanggalLaporan))
+	}
+
+	// Compute status gizi and z-scores from WHO growth standards
+	assessment, err := growth.AssessRecord(subject.JenisKelaminBalita, subject.TanggalLahirBalita, in.Tanggal, in.BeratBadan, in.TinggiBadan)
+	if err != nil {
+		return inserted, NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	statusGizi := in.StatusGizi
+	var zscoreTBU, zscoreBBU, zscoreBBTB sql.NullFloat64
+	if assessment != nil {
+		if err := assessment.Verify(in.StatusGizi); err != nil {
+			return inserted, NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		statusGizi = assessment.StatusGizi
+		zscoreTBU = sql.NullFloat64{Float64: assessment.ZScoreTBU, Valid: true}
+		zscoreBBU = sql.NullFloat64{Float64: assessment.ZScoreBBU, Valid: true}
+		zscoreBBTB = sql.NullFloat64{Float64: assessment.ZScoreBBTB, Valid: true}
+	} else if statusGizi == "" {
+		return inserted, NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("Balita '%s' is outside the WHO growth standard range, status gizi is required", subject.NamaBalita))
+	}
+
+	currentTime := time.Now().Format("2006-01-02 15:04:05")
+	insertQuery := `
+        INSERT INTO riwayat_pemeriksaan
+        (id_balita, id_intervensi, id_laporan_masyarakat, tanggal, berat_badan, tinggi_badan, status_gizi,
+        zscore_tb_u, zscore_bb_u, zscore_bb_tb, keterangan, created_id, created_date)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	result, err := tx.Exec(insertQuery,
+		in.IdBalita,
+		in.IdIntervensi,
+		in.IdLaporanMasyarakat,
+		in.Tanggal,
+		in.BeratBadan,
+		in.TinggiBadan,
+		statusGizi,
+		zscoreTBU,
+		zscoreBBU,
+		zscoreBBTB,
+		in.Keterangan,
+		userId,
+		currentTime,
+	)
+	if err != nil {
+		return inserted, NewHTTPError(http.StatusInternalServerError, "Failed to insert riwayat pemeriksaan")
+	}
+
+	inserted.Id, err = result.LastInsertId()
+	if err != nil {
+		return inserted, NewHTTPError(http.StatusInternalServerError, "Failed to retrieve inserted ID")
+	}
+	inserted.StatusGizi = statusGizi
+	inserted.Assessment = assessment
+	inserted.Message = fmt.Sprintf("Riwayat pemeriksaan balita '%s' berhasil ditambahkan untuk intervensi %s pada tanggal %s (Status: %s, Laporan: %s)",
+		subject.NamaBalita, subject.JenisIntervensi, in.Tanggal, statusGizi, jenisLaporan)
+
+	return inserted, nil
+}
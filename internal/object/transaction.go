@@ -0,0 +1,63 @@
+package object
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+)
+
+// MARK: Transactions
+
+// HTTPError is returned from a transaction body to abort the transaction
+// and answer the request with the given status and message.
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+// NewHTTPError creates an HTTPError.
+func NewHTTPError(statusCode int, message string) *HTTPError {
+	return &HTTPError{StatusCode: statusCode, Message: message}
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+// RunInTx runs fn in a database transaction and commits it when fn returns
+// nil. The transaction is rolled back when fn returns an error or panics,
+// so the checks and writes of a request are applied together or not at all.
+//
+// Checks that a concurrent request could invalidate before the write
+// should lock the rows they read with SELECT ... FOR UPDATE.
+func RunInTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return NewHTTPError(http.StatusInternalServerError, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+	return nil
+}
+
+// ErrorResponse converts an error returned by RunInTx into a Response.
+// Errors other than HTTPError and FieldError become an internal server
+// error.
+func ErrorResponse(err error) *Response {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return NewResponse(httpErr.StatusCode, httpErr.Message, nil)
+	}
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return ValidationResponse(fieldErr)
+	}
+	return NewResponse(http.StatusInternalServerError, "Internal server error", nil)
+}
@@ -0,0 +1,71 @@
+package object
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// MARK: Validation
+
+// FieldError is a request field that failed validation. Its response
+// carries the field name in data, so clients can show the message next to
+// the input.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// NewFieldError creates a FieldError with a formatted message.
+func NewFieldError(field, format string, args ...any) *FieldError {
+	return &FieldError{Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// ValidationResponse converts a validation error into a bad request
+// Response, with the FieldError as data when there is one.
+func ValidationResponse(err error) *Response {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return NewResponse(http.StatusBadRequest, fieldErr.Message, fieldErr)
+	}
+	return NewResponse(http.StatusBadRequest, err.Error(), nil)
+}
+
+// CheckKelurahanRegion checks that the kelurahan exists and that the nomor
+// KK was issued by its kecamatan, whose Kemendagri code starts the nomor
+// KK. A kecamatan whose kode is NULL, e.g. one added after migration 0015
+// without a code, has nothing to compare with: its nomor KK are accepted
+// with only the structural checks of nik.ParseKK.
+//
+// The NIK of the parents is deliberately not compared with the kelurahan.
+// A NIK is issued once and keeps the region code of the kecamatan that
+// issued it when its holder moves, so the parents of a family registered
+// here may carry the code of any region; nik.Parse only checks that the
+// code exists.
+func CheckKelurahanRegion(tx *sql.Tx, idKelurahan, nomorKk string) error {
+	var kode sql.NullString
+	query := `
+        SELECT kec.kode
+        FROM kelurahan kel
+        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+        WHERE kel.id = ?
+    `
+	err := tx.QueryRow(query, idKelurahan).Scan(&kode)
+	if err == sql.ErrNoRows {
+		return NewHTTPError(http.StatusBadRequest, "Kelurahan not found")
+	}
+	if err != nil {
+		return NewHTTPError(http.StatusInternalServerError, "Failed to check kelurahan")
+	}
+
+	if kode.String != "" && !strings.HasPrefix(nomorKk, kode.String) {
+		return NewFieldError("nomor_kk", "nomor KK region code must be %s, the code of the kecamatan of the kelurahan", kode.String)
+	}
+	return nil
+}
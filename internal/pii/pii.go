@@ -0,0 +1,172 @@
+// Package pii masks personal identifiers in API responses according to the
+// role of the caller.
+//
+// Response struct fields holding an identifier are tagged with the kind of
+// identifier they hold:
+//
+//	NikAyah string `json:"nik_ayah" pii:"nik"`
+//
+// and handlers pass the response through the Masker of the request before
+// writing it. The Policy decides how every kind is masked for every role,
+// e.g. 3209********0001 for a NIK. Admins may ask for the unmasked values
+// with the lengkap query parameter; such reads are logged as privileged.
+package pii
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// UnmaskParam is the query parameter with which an admin asks for the
+// unmasked values.
+const UnmaskParam = "lengkap"
+
+// Field is a kind of personal identifier, the value of the pii struct tag.
+type Field string
+
+const (
+	NIK     Field = "nik"
+	NomorKK Field = "nomor_kk"
+	Phone   Field = "phone"
+)
+
+// MARK: Rules
+
+// Rule masks a single value.
+type Rule func(value string) string
+
+// KeepEnds shows the first head and the last tail characters of a value,
+// e.g. 3209********0001. Values too short to hide anything are hidden
+// entirely.
+func KeepEnds(head, tail int) Rule {
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= head+tail {
+			return Hide(value)
+		}
+		return string(runes[:head]) + strings.Repeat("*", len(runes)-head-tail) + string(runes[len(runes)-tail:])
+	}
+}
+
+// KeepLast shows the last n characters of a value, e.g. ********7890.
+func KeepLast(n int) Rule {
+	return KeepEnds(0, n)
+}
+
+// Hide replaces every character of a value.
+func Hide(value string) string {
+	return strings.Repeat("*", len([]rune(value)))
+}
+
+// MARK: Policy
+
+// Policy holds the masking rule of every field for every role. Fields
+// without a rule, and roles without rules at all, are hidden.
+type Policy struct {
+	Rules map[string]map[Field]Rule
+
+	// Unmask lists the roles that may ask for the unmasked values.
+	Unmask []string
+}
+
+// partial shows just enough of an identifier to tell records apart.
+var partial = map[Field]Rule{
+	NIK:     KeepEnds(4, 4),
+	NomorKK: KeepEnds(4, 4),
+	Phone:   KeepLast(4),
+}
+
+// DefaultPolicy is the policy of the API.
+var DefaultPolicy = Policy{
+	Rules: map[string]map[Field]Rule{
+		middleware.RoleAdmin:            partial,
+		middleware.RoleMasyarakat:       partial,
+		middleware.RolePetugasKesehatan: partial,
+	},
+	Unmask: []string{middleware.RoleAdmin},
+}
+
+// Masker returns the Masker for the caller of r. It returns an
+// *object.HTTPError when the caller asks for the unmasked values without
+// being allowed to.
+func (p Policy) Masker(r *http.Request) (*Masker, error) {
+	role := middleware.GetPrincipal(r).Role
+
+	unmask, _ := strconv.ParseBool(r.URL.Query().Get(UnmaskParam))
+	if unmask && !slices.Contains(p.Unmask, role) {
+		return nil, object.NewHTTPError(http.StatusForbidden, "Unmasked data is only available to admin")
+	}
+
+	return &Masker{rules: p.Rules[role], unmasked: unmask}, nil
+}
+
+// MARK: Masker
+
+// Masker masks the identifiers returned to the caller of a request.
+type Masker struct {
+	rules    map[Field]Rule
+	unmasked bool
+}
+
+// Unmasked reports whether the caller asked for, and may see, the unmasked
+// values. Such reads must be recorded as privileged in the access log.
+func (m *Masker) Unmasked() bool {
+	return m.unmasked
+}
+
+// Mask returns value masked as a field of the given kind. The empty string
+// stays empty.
+func (m *Masker) Mask(field Field, value string) string {
+	if m.unmasked || value == "" {
+		return value
+	}
+	rule, ok := m.rules[field]
+	if !ok {
+		return Hide(value)
+	}
+	return rule(value)
+}
+
+// Apply masks in place every string field tagged with pii in v, which must
+// be a pointer. Nested structs, pointers and slices are followed.
+func (m *Masker) Apply(v any) {
+	if m.unmasked {
+		return
+	}
+	m.apply(reflect.ValueOf(v))
+}
+
+// Helper function to walk a value for Apply
+func (m *Masker) apply(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			m.apply(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			m.apply(v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			field := v.Field(i)
+			if !t.Field(i).IsExported() {
+				continue
+			}
+
+			tag, ok := t.Field(i).Tag.Lookup("pii")
+			if ok && field.Kind() == reflect.String && field.CanSet() {
+				field.SetString(m.Mask(Field(tag), field.String()))
+				continue
+			}
+			m.apply(field)
+		}
+	}
+}
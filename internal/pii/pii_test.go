@@ -0,0 +1,182 @@
+package pii
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+type testKeluarga struct {
+	Id       string `json:"id"`
+	NomorKk  string `json:"nomor_kk" pii:"nomor_kk"`
+	NikAyah  string `json:"nik_ayah" pii:"nik"`
+	NikIbu   string `json:"nik_ibu" pii:"nik"`
+	nikLain  string `pii:"nik"`
+	Kategori string `json:"kategori" pii:"unknown"`
+}
+
+type testLaporan struct {
+	NomorHpPelapor string        `json:"nomor_hp_pelapor" pii:"phone"`
+	Keluarga       testKeluarga  `json:"keluarga"`
+	KeluargaLain   *testKeluarga `json:"keluarga_lain"`
+	Riwayat        []testKeluarga
+	Terkait        []*testLaporan
+	Lainnya        any
+}
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		rule        Rule
+		value, want string
+	}{
+		{KeepEnds(4, 4), "3209010101900001", "3209********0001"},
+		{KeepEnds(4, 4), "32090101", "********"},
+		{KeepEnds(4, 4), "320901011", "3209*1011"},
+		{KeepLast(4), "081234567890", "********7890"},
+		{KeepLast(4), "0812", "****"},
+		{KeepLast(4), "ⅰⅱⅲⅳⅴ", "*ⅱⅲⅳⅴ"},
+		{Hide, "081234567890", "************"},
+	}
+	for _, tt := range tests {
+		if got := tt.rule(tt.value); got != tt.want {
+			t.Errorf("rule(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	keluarga := func() testKeluarga {
+		return testKeluarga{
+			Id:       "7",
+			NomorKk:  "3209010101230001",
+			NikAyah:  "3209010101900001",
+			NikIbu:   "",
+			nikLain:  "3209010101900003",
+			Kategori: "3209",
+		}
+	}
+	masked := testKeluarga{
+		Id:       "7",
+		NomorKk:  "3209********0001",
+		NikAyah:  "3209********0001",
+		NikIbu:   "",
+		nikLain:  "3209010101900003",
+		Kategori: "****",
+	}
+	hidden := testKeluarga{
+		Id:       "7",
+		NomorKk:  "****************",
+		NikAyah:  "****************",
+		NikIbu:   "",
+		nikLain:  "3209010101900003",
+		Kategori: "****",
+	}
+
+	tests := []struct {
+		name  string
+		rules map[Field]Rule
+		want  testKeluarga
+		phone string
+	}{
+		{"partial", partial, masked, "********7890"},
+		{"no rules", nil, hidden, "************"},
+	}
+	for _, tt := range tests {
+		k := keluarga()
+		laporan := testLaporan{
+			NomorHpPelapor: "081234567890",
+			Keluarga:       keluarga(),
+			KeluargaLain:   &k,
+			Riwayat:        []testKeluarga{keluarga(), keluarga()},
+			Terkait:        []*testLaporan{{Keluarga: keluarga()}, nil},
+			Lainnya:        &testLaporan{Keluarga: keluarga()},
+		}
+
+		m := &Masker{rules: tt.rules}
+		m.Apply(&laporan)
+
+		if laporan.NomorHpPelapor != tt.phone {
+			t.Errorf("%s: nomor_hp_pelapor = %q, want %q", tt.name, laporan.NomorHpPelapor, tt.phone)
+		}
+		for name, got := range map[string]testKeluarga{
+			"nested struct":        laporan.Keluarga,
+			"pointer":              *laporan.KeluargaLain,
+			"slice":                laporan.Riwayat[1],
+			"slice of pointers":    laporan.Terkait[0].Keluarga,
+			"pointer in interface": laporan.Lainnya.(*testLaporan).Keluarga,
+		} {
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s: %s = %+v, want %+v", tt.name, name, got, tt.want)
+			}
+		}
+	}
+
+	// Apply needs a pointer to change anything, a struct value is left as is
+	k := keluarga()
+	(&Masker{rules: partial}).Apply(k)
+	if k != keluarga() {
+		t.Errorf("Apply of a struct value changed it to %+v", k)
+	}
+
+	// Unmasked maskers leave the values alone
+	k = keluarga()
+	(&Masker{rules: partial, unmasked: true}).Apply(&k)
+	if k != keluarga() {
+		t.Errorf("unmasked Apply changed the values to %+v", k)
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	tests := []struct {
+		role     string
+		query    string
+		status   int // of the error, 0 when there is none
+		unmasked bool
+		nik      string
+	}{
+		{middleware.RoleAdmin, "", 0, false, "3209********0001"},
+		{middleware.RoleAdmin, "?lengkap=true", 0, true, "3209010101900001"},
+		{middleware.RoleAdmin, "?lengkap=1", 0, true, "3209010101900001"},
+		{middleware.RoleAdmin, "?lengkap=false", 0, false, "3209********0001"},
+		{middleware.RoleAdmin, "?lengkap=ya", 0, false, "3209********0001"},
+		{middleware.RolePetugasKesehatan, "", 0, false, "3209********0001"},
+		{middleware.RolePetugasKesehatan, "?lengkap=true", http.StatusForbidden, false, ""},
+		{middleware.RoleMasyarakat, "", 0, false, "3209********0001"},
+		{middleware.RoleMasyarakat, "?lengkap=true", http.StatusForbidden, false, ""},
+		{middleware.RoleMasyarakat, "?lengkap=false", 0, false, "3209********0001"},
+		{"", "", 0, false, "****************"},
+		{"", "?lengkap=true", http.StatusForbidden, false, ""},
+		{"operator", "", 0, false, "****************"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/api/keluarga"+tt.query, nil)
+		if tt.role != "" {
+			r = r.WithContext(middleware.WithPrincipal(r.Context(), middleware.Principal{UserId: "1", Role: tt.role}))
+		}
+
+		m, err := DefaultPolicy.Masker(r)
+		if tt.status != 0 {
+			var httpErr *object.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.status {
+				t.Errorf("%q%s: err = %v, want status %d", tt.role, tt.query, err, tt.status)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q%s: %v", tt.role, tt.query, err)
+			continue
+		}
+
+		if m.Unmasked() != tt.unmasked {
+			t.Errorf("%q%s: Unmasked = %v, want %v", tt.role, tt.query, m.Unmasked(), tt.unmasked)
+		}
+		if got := m.Mask(NIK, "3209010101900001"); got != tt.nik {
+			t.Errorf("%q%s: Mask = %q, want %q", tt.role, tt.query, got, tt.nik)
+		}
+	}
+}
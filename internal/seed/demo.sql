@@ -0,0 +1,71 @@
+-- Demo data for a fresh database, taken from stuntingdb_new.sql. The
+-- master data (kecamatan, kelurahan, status laporan) comes from the
+-- 0007_master_data migration, so run "migrate up" first. The koordinat
+-- of the keluarga are [longitude, latitude] points inside their
+-- kelurahan, the dump had them in [latitude, longitude] order or outside
+-- the city.
+--
+-- Demo accounts use the passwords listed in README.md and must never be
+-- loaded into a production database.
+
+SET FOREIGN_KEY_CHECKS = 0;
+
+INSERT INTO `pengguna` (`id`, `email`, `password_hash`, `role`) VALUES
+(1, 'rifqi@gmail.com', '1234', 'admin'),
+(3, 'wahyu@gmail.com', '$2a$10$a9iyG4yZXWAk2teyTu0yzOhIdp2GPiHU.hcnErhAgr9G/ImqWix.S', 'masyarakat'),
+(4, 'admin@gmail.com', '$2a$10$PPQOOSUVwflz0u1JREYHF.Ii7CU6p4i.ko7wGkNU68EUGSejxh6FC', 'admin'),
+(5, 'petugas1@gmail.com', '$2a$10$jOko.bIqwHrMa2dvQakTMOL8OyeKvoBwbZ0YXxOdQLe0CGvrLPDb6', 'petugas kesehatan'),
+(6, 'aiken@gmail.com', '$2a$10$8CkCo/IUmPDN7PyB47kjZO/cv1EMvz1dgWIklDbqA1D0VTARNqdTu', 'admin'),
+(7, 'dwiki@gmail.com', '$2a$10$5oZg/IVyserMt8Lnhjl2jOwVwoZp4e1ApQcXKOKmKOgBcsqZkiFgi', 'admin'),
+(8, 'petugas@gmail.com', '$2a$10$6WGKWf.4raKbofTOJ27mRuHkQtye68L42zy1/xcXu.kawlVbT/Yqe', 'petugas kesehatan');
+
+INSERT INTO `skpd` (`id`, `skpd`, `jenis`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, 'Puskesmas Cirebon', 'puskesmas', 4, '2025-08-06', 4, '2025-08-06', NULL, NULL);
+
+INSERT INTO `masyarakat` (`id`, `id_pengguna`, `nama`, `alamat`) VALUES
+(1, 3, 'Wahyu Widiasmoro', 'Cirebon');
+
+INSERT INTO `petugas_kesehatan` (`id`, `id_pengguna`, `id_skpd`, `nama`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, 5, 1, 'updated tes', 4, '2025-08-08', 4, '2025-08-08', NULL, NULL),
+(2, 8, 1, 'Nama Petugas Kesehatan Dua', 6, '2025-08-22', NULL, NULL, NULL, NULL);
+
+INSERT INTO `keluarga` (`id`, `nomor_kk`, `nama_ayah`, `nama_ibu`, `nik_ayah`, `nik_ibu`, `alamat`, `rt`, `rw`, `id_kelurahan`, `koordinat`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, '1234568789101115', 'Nama ayah diupdate', 'nama ibu diupdate', '1234568789101115', '1234568789101115', 'Alamat update tes alamat yang sangat panjang', '001', '001', 2, 0x000000000101000000211ff46c56225b40bd5301f73cff1ac040, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(2, '1234568789101112', 'Nama ayah kedua', 'nama ibu kedua', '1234568789101112', '1234568789101112', 'Alamat alamat alamat kedua', '001', '001', 1, 0x000000000101000000929735b1c0225b400ff0a485cb1a1bc040, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(3, '1234568789101113', 'Nama ayah ketiga', 'nama ibu ketiga', '1234568789101113', '1234568789101113', 'Alamat alamat alamat ketiga', '001', '001', 3, 0x000000000101000000f303577902235b402409c21550081bc040, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(4, '1234568789101114', 'Nama ayah keempat diedit', 'nama ibu keempat', '1234568789101114', '1234568789101114', 'Alamat alamat alamat keempat', '001', '001', 18, 0x000000000101000000d1ae42ca4f255b40cf108e59f6f41ac040, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(5, '3209012345678999', 'Ayah Paling Baru', 'Ibu baru', '1234134134132413', '1234134134134134', 'Alamat paling baru', '007', '001', 6, 0x000000000101000000e44ee960fd235b40a67ede54a4d21ac040, 6, '2025-08-21', 6, '2025-08-21', NULL, NULL),
+(6, '1234567890987654', 'BapakBapakedit', 'IbuIbu', '1234567890123456', '1234567890654321', 'Jl. Mekar Arum 15', '011', '006', 16, 0x0000000001010000009896ef6f7e245b40377cf1c76ee41ac040, 6, '2025-08-21', 6, '2025-08-21', 6, '2025-08-21'),
+(7, '1234567891234567', 'Nama diedit', 'Nama ibu', '1234567891234567', '1234567654321234', 'Jalan Soedirman 13', '009', '002', 2, 0x0000000001010000009a081b9e5e225b4030bc92e4b9fe1ac040, 3, '2025-08-22', 3, '2025-08-22', NULL, NULL),
+(8, '0999999999999999', 'Yudi edit', 'Sri Rayahu', '0912498129384189', '1345325235246259', 'Alamat data keluarga baru', '004', '003', 3, 0x0000000001010000006ced7daa0a235b4097715303cd071bc040, 6, '2025-08-22', 6, '2025-08-22', NULL, NULL);
+
+INSERT INTO `balita` (`id`, `id_keluarga`, `nama`, `tanggal_lahir`, `jenis_kelamin`, `berat_lahir`, `tinggi_lahir`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, 1, 'Balita diedit pertama', '2024-05-10', 'P', 5000, 25, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(2, 4, 'Balita kedua', '2024-05-10', 'P', 5000, 25, 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(3, 5, 'Balita sakit sedikit', '2021-08-17', 'L', 3000, 60, 6, '2025-08-21', 6, '2025-08-21', NULL, NULL),
+(4, 1, 'Balita Sangat Sakit', '2024-04-07', 'P', 2000, 40, 6, '2025-08-21', 6, '2025-08-21', 6, '2025-08-21'),
+(5, 7, 'Ahmad', '2024-05-10', 'P', 800, 30, 3, '2025-08-22', 6, '2025-08-22', NULL, NULL),
+(6, 8, 'Adit', '2024-10-09', 'L', 3500, 50, 6, '2025-08-22', NULL, NULL, NULL, NULL);
+
+INSERT INTO `laporan_masyarakat` (`id`, `id_masyarakat`, `id_balita`, `id_status_laporan`, `tanggal_laporan`, `hubungan_dengan_balita`, `nomor_hp_pelapor`, `nomor_hp_keluarga_balita`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, 1, 1, 4, '2025-05-10', 'Sebagai tetangga', '081324220229', '081247229347', 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(2, NULL, 2, 6, '2025-05-10', 'Sebagai tetangga', '081324220229', '081247229347', 4, '2025-08-05', 6, '2025-08-21', NULL, NULL),
+(3, NULL, 3, 2, '2025-08-20', 'Tetangga', '081234567800', '081234567891', 6, '2025-08-21', 6, '2025-08-21', NULL, NULL),
+(4, NULL, 5, 1, '2025-08-22', 'Petugas Administrasi', '082123412311', '081234567891', 6, '2025-08-22', NULL, NULL, NULL, NULL);
+
+INSERT INTO `laporan_status_history` (`id`, `id_laporan_masyarakat`, `id_status_laporan_asal`, `id_status_laporan_tujuan`, `catatan`, `created_id`, `created_date`) VALUES
+(1, 1, NULL, 4, 'Status awal sebelum riwayat status dicatat', 4, '2025-08-05 00:00:00'),
+(2, 2, NULL, 6, 'Status awal sebelum riwayat status dicatat', 4, '2025-08-05 00:00:00'),
+(3, 3, NULL, 2, 'Status awal sebelum riwayat status dicatat', 6, '2025-08-21 00:00:00'),
+(4, 4, NULL, 1, 'Status awal sebelum riwayat status dicatat', 6, '2025-08-22 00:00:00');
+
+INSERT INTO `intervensi` (`id`, `id_balita`, `jenis`, `tanggal`, `deskripsi`, `hasil`, `status`, `started_id`, `started_date`, `completed_id`, `completed_date`, `cancelled_id`, `cancelled_date`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(1, 2, 'gizi', '2024-05-10', 'Deskripsi pertama', 'hasil Updated', 'in_progress', 6, '2025-08-21 00:00:00', NULL, NULL, NULL, NULL, 4, '2025-08-11', 6, '2025-08-21', NULL, NULL),
+(2, 3, 'sosial', '2025-08-01', 'Dianalisa dan diberi pengarahan.', 'Sedikit membaik.', 'in_progress', 7, '2025-08-21 00:00:00', NULL, NULL, NULL, NULL, 7, '2025-08-21', 7, '2025-08-21', 7, '2025-08-21'),
+(3, 3, 'kesehatan', '2025-08-21', 'Memeriksa penyakit yang dialami balita.', 'Penurunan gejala penyakit.', 'in_progress', 6, '2025-08-22 00:00:00', NULL, NULL, NULL, NULL, 7, '2025-08-21', 6, '2025-08-22', NULL, NULL),
+(4, 5, 'kesehatan', '2025-08-22', 'Deskripsi dari intervensi', 'Hasil dari intervensi', 'planned', NULL, NULL, NULL, NULL, NULL, NULL, 6, '2025-08-22', NULL, NULL, NULL, NULL);
+
+INSERT INTO `riwayat_pemeriksaan` (`id`, `id_balita`, `id_intervensi`, `id_laporan_masyarakat`, `tanggal`, `berat_badan`, `tinggi_badan`, `status_gizi`, `keterangan`, `created_id`, `created_date`, `updated_id`, `updated_date`, `deleted_id`, `deleted_date`) VALUES
+(4, 2, 1, 2, '2025-05-12', 5.00, 30.00, 'stunting', 'string', 4, '2025-08-11', 6, '2025-08-21', 6, '2025-08-21');
+
+SET FOREIGN_KEY_CHECKS = 1;
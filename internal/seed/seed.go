@@ -0,0 +1,58 @@
+// Package seed loads the demo data used for local development and
+// demonstrations into a migrated database.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/rifqidaiva/stunting-web/internal/migrate"
+)
+
+//go:embed demo.sql
+var demoScript string
+
+// ErrNotEmpty is returned by Demo when the database already has users, so
+// the demo rows would clash with real data.
+var ErrNotEmpty = errors.New("seed: database already contains pengguna, demo data is only loaded into an empty database")
+
+// Demo loads the demo data into db in a single transaction. The schema and
+// master data must already be migrated.
+func Demo(ctx context.Context, db *sql.DB) error {
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM pengguna").Scan(&count); err != nil {
+		return fmt.Errorf("seed: count pengguna, run \"migrate up\" first: %w", err)
+	}
+	if count > 0 {
+		return ErrNotEmpty
+	}
+
+	// FOREIGN_KEY_CHECKS is a session setting, keep every statement on
+	// the same connection
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Do not hand the connection back to the pool with the checks disabled
+	// when a statement fails
+	defer conn.ExecContext(context.Background(), "SET FOREIGN_KEY_CHECKS = 1")
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i, statement := range migrate.SplitStatements(demoScript) {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("seed: statement %d: %w", i+1, err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,508 @@
+package store
+
+import (
+	"cmp"
+	"database/sql"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// Memory is an in-memory data set for tests. Fill the exported slices
+// with fixtures, then pass Store() to the code under test. The join
+// columns (kecamatan name, keluarga data of a balita, ...) are resolved
+// from the other slices on every read, like the MySQL queries do.
+type Memory struct {
+	mu sync.RWMutex
+
+	Kecamatan     []Kecamatan
+	Kelurahan     []Kelurahan
+	StatusLaporan []StatusLaporan
+	Profile       []Profile
+	Sesi          []Sesi
+	Keluarga      []Keluarga
+	Balita        []Balita
+
+	Intervensi         []Intervensi
+	IntervensiPetugas  []IntervensiPetugas
+	RiwayatPemeriksaan []RiwayatPemeriksaan
+	LaporanMasyarakat  []LaporanMasyarakat
+}
+
+// IntervensiPetugas assigns a petugas kesehatan to an intervensi in the
+// Memory fixtures.
+type IntervensiPetugas struct {
+	IdIntervensi       string
+	IdPetugasKesehatan string
+}
+
+// NewMemory returns an empty in-memory data set.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Store returns a Store reading from m. The fixtures hold plaintext, Keys
+// uses the development keys.
+func (m *Memory) Store() *Store {
+	return &Store{
+		Keys:          fieldcrypt.Development(),
+		Wilayah:       memoryWilayah{m},
+		StatusLaporan: memoryStatusLaporan{m},
+		Pengguna:      memoryPengguna{m},
+		Sesi:          memorySesi{m},
+		Keluarga:      memoryKeluarga{m},
+		Balita:        memoryBalita{m},
+
+		Intervensi:         memoryIntervensi{m},
+		RiwayatPemeriksaan: memoryRiwayatPemeriksaan{m},
+		LaporanMasyarakat:  memoryLaporanMasyarakat{m},
+	}
+}
+
+// Helper function to look up the kelurahan and kecamatan names of a kelurahan id
+func (m *Memory) wilayah(idKelurahan string) (kelurahan, kecamatan string) {
+	for _, kel := range m.Kelurahan {
+		if kel.Id != idKelurahan {
+			continue
+		}
+		for _, kec := range m.Kecamatan {
+			if kec.Id == kel.IdKecamatan {
+				return kel.Kelurahan, kec.Kecamatan
+			}
+		}
+		return kel.Kelurahan, ""
+	}
+	return "", ""
+}
+
+// Helper function to look up a keluarga by id
+func (m *Memory) keluarga(id string) (Keluarga, bool) {
+	for _, keluarga := range m.Keluarga {
+		if keluarga.Id == id {
+			return keluarga, true
+		}
+	}
+	return Keluarga{}, false
+}
+
+// Helper function to look up a balita by id
+func (m *Memory) balita(id string) (Balita, bool) {
+	for _, balita := range m.Balita {
+		if balita.Id == id {
+			return balita, true
+		}
+	}
+	return Balita{}, false
+}
+
+// Helper function to look up an intervensi by id
+func (m *Memory) intervensi(id string) (Intervensi, bool) {
+	for _, intervensi := range m.Intervensi {
+		if intervensi.Id == id {
+			return intervensi, true
+		}
+	}
+	return Intervensi{}, false
+}
+
+// Helper function to look up a laporan masyarakat and its status by id
+func (m *Memory) laporanMasyarakat(id string) (LaporanMasyarakat, bool) {
+	for _, laporan := range m.LaporanMasyarakat {
+		if laporan.Id != id {
+			continue
+		}
+		laporan.StatusLaporan = ""
+		for _, status := range m.StatusLaporan {
+			if status.Id == laporan.IdStatusLaporan {
+				laporan.StatusLaporan = status.Status
+			}
+		}
+		return laporan, true
+	}
+	return LaporanMasyarakat{}, false
+}
+
+// MARK: Wilayah
+
+type memoryWilayah struct {
+	m *Memory
+}
+
+func (s memoryWilayah) ListKecamatan() ([]Kecamatan, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	kecamatanList := slices.Clone(s.m.Kecamatan)
+	slices.SortStableFunc(kecamatanList, func(a, b Kecamatan) int {
+		return cmp.Compare(a.Kecamatan, b.Kecamatan)
+	})
+	return kecamatanList, nil
+}
+
+func (s memoryWilayah) ListKelurahan(idKecamatan string) ([]Kelurahan, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	kelurahanList := []Kelurahan{}
+	for _, kelurahan := range s.m.Kelurahan {
+		if idKecamatan != "" && kelurahan.IdKecamatan != idKecamatan {
+			continue
+		}
+		_, kelurahan.Kecamatan = s.m.wilayah(kelurahan.Id)
+		kelurahanList = append(kelurahanList, kelurahan)
+	}
+
+	slices.SortStableFunc(kelurahanList, func(a, b Kelurahan) int {
+		return cmp.Or(cmp.Compare(a.Kecamatan, b.Kecamatan), cmp.Compare(a.Kelurahan, b.Kelurahan))
+	})
+	return kelurahanList, nil
+}
+
+// MARK: Pengguna
+
+type memoryPengguna struct {
+	m *Memory
+}
+
+func (s memoryPengguna) GetProfileId(idPengguna, role string) (string, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	for _, profile := range s.m.Profile {
+		if profile.IdPengguna == idPengguna && profile.Role == role {
+			return profile.Id, nil
+		}
+	}
+	return "", ErrNotFound
+}
+
+// MARK: Sesi
+
+type memorySesi struct {
+	m *Memory
+}
+
+func (s memorySesi) Create(sesi Sesi) (string, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	sesi.Id = strconv.Itoa(len(s.m.Sesi) + 1)
+	s.m.Sesi = append(s.m.Sesi, sesi)
+	return sesi.Id, nil
+}
+
+func (s memorySesi) FindByTokenHash(hash string) (Sesi, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	for _, sesi := range s.m.Sesi {
+		if sesi.RefreshTokenHash == hash || (sesi.PreviousTokenHash != "" && sesi.PreviousTokenHash == hash) {
+			return sesi, nil
+		}
+	}
+	return Sesi{}, ErrNotFound
+}
+
+func (s memorySesi) Rotate(id, oldHash, newHash, expiresDate string) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	for i, sesi := range s.m.Sesi {
+		if sesi.Id == id && sesi.RefreshTokenHash == oldHash && sesi.RevokedDate == "" {
+			s.m.Sesi[i].RefreshTokenHash = newHash
+			s.m.Sesi[i].PreviousTokenHash = oldHash
+			s.m.Sesi[i].ExpiresDate = expiresDate
+			s.m.Sesi[i].UpdatedDate = time.Now().Format("2006-01-02 15:04:05")
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s memorySesi) Revoke(id string) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	for i, sesi := range s.m.Sesi {
+		if sesi.Id == id && sesi.RevokedDate == "" {
+			s.m.Sesi[i].RevokedDate = time.Now().Format("2006-01-02 15:04:05")
+		}
+	}
+	return nil
+}
+
+func (s memorySesi) RevokeAllForPengguna(idPengguna string) (int64, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+
+	var revoked int64
+	for i, sesi := range s.m.Sesi {
+		if sesi.IdPengguna == idPengguna && sesi.RevokedDate == "" {
+			s.m.Sesi[i].RevokedDate = time.Now().Format("2006-01-02 15:04:05")
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+// The in-memory store has no transactions, the sessions are revoked at once
+func (s memorySesi) RevokeAllForPenggunaTx(tx *sql.Tx, idPengguna string) (int64, error) {
+	return s.RevokeAllForPengguna(idPengguna)
+}
+
+func (s memorySesi) IsRevoked(id string) (bool, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	for _, sesi := range s.m.Sesi {
+		if sesi.Id == id {
+			return sesi.RevokedDate != "", nil
+		}
+	}
+	return true, nil
+}
+
+// MARK: Status Laporan
+
+type memoryStatusLaporan struct {
+	m *Memory
+}
+
+func (s memoryStatusLaporan) List() ([]StatusLaporan, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	return slices.Clone(s.m.StatusLaporan), nil
+}
+
+// MARK: Keluarga
+
+type memoryKeluarga struct {
+	m *Memory
+}
+
+func (s memoryKeluarga) Get(id string, filter KeluargaFilter) (Keluarga, error) {
+	keluargaList, err := s.List(filter)
+	if err != nil {
+		return Keluarga{}, err
+	}
+	for _, keluarga := range keluargaList {
+		if keluarga.Id == id {
+			return keluarga, nil
+		}
+	}
+	return Keluarga{}, ErrNotFound
+}
+
+func (s memoryKeluarga) List(filter KeluargaFilter) ([]Keluarga, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	keluargaList := []Keluarga{}
+	for _, keluarga := range s.m.Keluarga {
+		if filter.CreatedId != "" && keluarga.CreatedId != filter.CreatedId {
+			continue
+		}
+		keluarga.Kelurahan, keluarga.Kecamatan = s.m.wilayah(keluarga.IdKelurahan)
+		keluargaList = append(keluargaList, keluarga)
+	}
+
+	slices.SortStableFunc(keluargaList, func(a, b Keluarga) int {
+		return cmp.Compare(b.CreatedDate, a.CreatedDate)
+	})
+	return keluargaList, nil
+}
+
+// MARK: Balita
+
+type memoryBalita struct {
+	m *Memory
+}
+
+func (s memoryBalita) Get(id string, filter BalitaFilter) (Balita, error) {
+	balitaList, err := s.List(filter)
+	if err != nil {
+		return Balita{}, err
+	}
+	for _, balita := range balitaList {
+		if balita.Id == id {
+			return balita, nil
+		}
+	}
+	return Balita{}, ErrNotFound
+}
+
+func (s memoryBalita) List(filter BalitaFilter) ([]Balita, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	balitaList := []Balita{}
+	for _, balita := range s.m.Balita {
+		keluarga, ok := s.m.keluarga(balita.IdKeluarga)
+		if filter.KeluargaCreatedId != "" && (!ok || keluarga.CreatedId != filter.KeluargaCreatedId) {
+			continue
+		}
+		balita.NomorKk, balita.NamaAyah, balita.NamaIbu = keluarga.NomorKk, keluarga.NamaAyah, keluarga.NamaIbu
+		balita.Kelurahan, balita.Kecamatan = s.m.wilayah(keluarga.IdKelurahan)
+		balitaList = append(balitaList, balita)
+	}
+
+	slices.SortStableFunc(balitaList, func(a, b Balita) int {
+		return cmp.Compare(b.CreatedDate, a.CreatedDate)
+	})
+	return balitaList, nil
+}
+
+// MARK: Intervensi
+
+type memoryIntervensi struct {
+	m *Memory
+}
+
+func (s memoryIntervensi) Get(id string, filter IntervensiFilter) (Intervensi, error) {
+	intervensiList, err := s.List(filter)
+	if err != nil {
+		return Intervensi{}, err
+	}
+	for _, intervensi := range intervensiList {
+		if intervensi.Id == id {
+			return intervensi, nil
+		}
+	}
+	return Intervensi{}, ErrNotFound
+}
+
+func (s memoryIntervensi) List(filter IntervensiFilter) ([]Intervensi, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	intervensiList := []Intervensi{}
+	for _, intervensi := range s.m.Intervensi {
+		if filter.Status != "" && intervensi.Status != filter.Status {
+			continue
+		}
+		if filter.IdBalita != "" && intervensi.IdBalita != filter.IdBalita {
+			continue
+		}
+
+		balita, _ := s.m.balita(intervensi.IdBalita)
+		intervensi.NamaBalita = balita.Nama
+		intervensi.PetugasCount, intervensi.RiwayatCount = 0, 0
+		for _, petugas := range s.m.IntervensiPetugas {
+			if petugas.IdIntervensi == intervensi.Id {
+				intervensi.PetugasCount++
+			}
+		}
+		for _, riwayat := range s.m.RiwayatPemeriksaan {
+			if riwayat.IdIntervensi == intervensi.Id {
+				intervensi.RiwayatCount++
+			}
+		}
+		intervensiList = append(intervensiList, intervensi)
+	}
+
+	slices.SortStableFunc(intervensiList, func(a, b Intervensi) int {
+		return cmp.Or(cmp.Compare(b.Tanggal, a.Tanggal), cmp.Compare(b.CreatedDate, a.CreatedDate))
+	})
+	return intervensiList, nil
+}
+
+// MARK: Riwayat Pemeriksaan
+
+type memoryRiwayatPemeriksaan struct {
+	m *Memory
+}
+
+func (s memoryRiwayatPemeriksaan) Get(id string, filter RiwayatPemeriksaanFilter) (RiwayatPemeriksaan, error) {
+	riwayatList, err := s.List(filter)
+	if err != nil {
+		return RiwayatPemeriksaan{}, err
+	}
+	for _, riwayat := range riwayatList {
+		if riwayat.Id == id {
+			return riwayat, nil
+		}
+	}
+	return RiwayatPemeriksaan{}, ErrNotFound
+}
+
+func (s memoryRiwayatPemeriksaan) List(filter RiwayatPemeriksaanFilter) ([]RiwayatPemeriksaan, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	riwayatList := []RiwayatPemeriksaan{}
+	for _, riwayat := range s.m.RiwayatPemeriksaan {
+		if filter.IdBalita != "" && riwayat.IdBalita != filter.IdBalita {
+			continue
+		}
+		if filter.IdIntervensi != "" && riwayat.IdIntervensi != filter.IdIntervensi {
+			continue
+		}
+		if filter.IdLaporanMasyarakat != "" && riwayat.IdLaporanMasyarakat != filter.IdLaporanMasyarakat {
+			continue
+		}
+
+		balita, _ := s.m.balita(riwayat.IdBalita)
+		keluarga, _ := s.m.keluarga(balita.IdKeluarga)
+		intervensi, _ := s.m.intervensi(riwayat.IdIntervensi)
+		laporan, _ := s.m.laporanMasyarakat(riwayat.IdLaporanMasyarakat)
+		riwayat.NamaBalita, riwayat.TanggalLahirBalita, riwayat.JenisKelamin = balita.Nama, balita.TanggalLahir, balita.JenisKelamin
+		riwayat.NomorKk, riwayat.NamaAyah, riwayat.NamaIbu = keluarga.NomorKk, keluarga.NamaAyah, keluarga.NamaIbu
+		riwayat.Kelurahan, riwayat.Kecamatan = s.m.wilayah(keluarga.IdKelurahan)
+		riwayat.JenisIntervensi, riwayat.TanggalIntervensi = intervensi.Jenis, intervensi.Tanggal
+		riwayat.IdMasyarakat, riwayat.StatusLaporan, riwayat.TanggalLaporan = laporan.IdMasyarakat, laporan.StatusLaporan, laporan.TanggalLaporan
+		riwayatList = append(riwayatList, riwayat)
+	}
+
+	slices.SortStableFunc(riwayatList, func(a, b RiwayatPemeriksaan) int {
+		return cmp.Or(cmp.Compare(b.Tanggal, a.Tanggal), cmp.Compare(b.CreatedDate, a.CreatedDate))
+	})
+	return riwayatList, nil
+}
+
+// MARK: Laporan Masyarakat
+
+type memoryLaporanMasyarakat struct {
+	m *Memory
+}
+
+func (s memoryLaporanMasyarakat) Get(id string, filter LaporanMasyarakatFilter) (LaporanMasyarakat, error) {
+	laporanList, err := s.List(filter)
+	if err != nil {
+		return LaporanMasyarakat{}, err
+	}
+	for _, laporan := range laporanList {
+		if laporan.Id == id {
+			return laporan, nil
+		}
+	}
+	return LaporanMasyarakat{}, ErrNotFound
+}
+
+func (s memoryLaporanMasyarakat) List(filter LaporanMasyarakatFilter) ([]LaporanMasyarakat, error) {
+	s.m.mu.RLock()
+	defer s.m.mu.RUnlock()
+
+	laporanList := []LaporanMasyarakat{}
+	for _, fixture := range s.m.LaporanMasyarakat {
+		laporan, _ := s.m.laporanMasyarakat(fixture.Id)
+		balita, balitaOk := s.m.balita(laporan.IdBalita)
+		keluarga, keluargaOk := s.m.keluarga(balita.IdKeluarga)
+		if filter.IdMasyarakat != "" && (laporan.IdMasyarakat != filter.IdMasyarakat || !balitaOk || !keluargaOk) {
+			continue
+		}
+
+		laporan.NamaBalita = balita.Nama
+		laporan.NomorKk, laporan.NamaAyah, laporan.NamaIbu, laporan.Alamat = keluarga.NomorKk, keluarga.NamaAyah, keluarga.NamaIbu, keluarga.Alamat
+		laporan.Kelurahan, laporan.Kecamatan = s.m.wilayah(keluarga.IdKelurahan)
+		laporanList = append(laporanList, laporan)
+	}
+
+	slices.SortStableFunc(laporanList, func(a, b LaporanMasyarakat) int {
+		return cmp.Compare(b.CreatedDate, a.CreatedDate)
+	})
+	return laporanList, nil
+}
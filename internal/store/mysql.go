@@ -0,0 +1,816 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+)
+
+// NewMySQL returns a Store backed by the given MySQL connection pool. The
+// encrypted columns are decrypted with keys.
+func NewMySQL(db *sql.DB, keys *fieldcrypt.Keyring) *Store {
+	return &Store{
+		Keys:          keys,
+		Wilayah:       &mysqlWilayah{db: db},
+		StatusLaporan: &mysqlStatusLaporan{db: db},
+		Pengguna:      &mysqlPengguna{db: db},
+		Sesi:          &mysqlSesi{db: db},
+		Keluarga:      &mysqlKeluarga{db: db, keys: keys},
+		Balita:        &mysqlBalita{db: db, keys: keys},
+
+		Intervensi:         &mysqlIntervensi{db: db},
+		RiwayatPemeriksaan: &mysqlRiwayatPemeriksaan{db: db, keys: keys},
+		LaporanMasyarakat:  &mysqlLaporanMasyarakat{db: db, keys: keys},
+	}
+}
+
+// MARK: Wilayah
+
+type mysqlWilayah struct {
+	db *sql.DB
+}
+
+func (s *mysqlWilayah) ListKecamatan() ([]Kecamatan, error) {
+	query := "SELECT id, kecamatan FROM kecamatan ORDER BY kecamatan ASC"
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	kecamatanList := []Kecamatan{}
+	for rows.Next() {
+		var kecamatan Kecamatan
+		if err := rows.Scan(&kecamatan.Id, &kecamatan.Kecamatan); err != nil {
+			return nil, err
+		}
+		kecamatanList = append(kecamatanList, kecamatan)
+	}
+
+	return kecamatanList, rows.Err()
+}
+
+func (s *mysqlWilayah) ListKelurahan(idKecamatan string) ([]Kelurahan, error) {
+	query := `
+        SELECT kel.id, kel.id_kecamatan, kel.kelurahan, COALESCE(kec.kecamatan, '')
+        FROM kelurahan kel
+        LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+    `
+	var args []any
+	if idKecamatan != "" {
+		query += " WHERE kel.id_kecamatan = ? ORDER BY kel.kelurahan ASC"
+		args = append(args, idKecamatan)
+	} else {
+		query += " ORDER BY kec.kecamatan ASC, kel.kelurahan ASC"
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	kelurahanList := []Kelurahan{}
+	for rows.Next() {
+		var kelurahan Kelurahan
+		if err := rows.Scan(&kelurahan.Id, &kelurahan.IdKecamatan, &kelurahan.Kelurahan, &kelurahan.Kecamatan); err != nil {
+			return nil, err
+		}
+		kelurahanList = append(kelurahanList, kelurahan)
+	}
+
+	return kelurahanList, rows.Err()
+}
+
+// MARK: Pengguna
+
+type mysqlPengguna struct {
+	db *sql.DB
+}
+
+func (s *mysqlPengguna) GetProfileId(idPengguna, role string) (string, error) {
+	var query string
+	switch role {
+	case "masyarakat":
+		query = "SELECT id FROM masyarakat WHERE id_pengguna = ?"
+	case "petugas kesehatan":
+		query = "SELECT id FROM petugas_kesehatan WHERE id_pengguna = ? AND deleted_date IS NULL"
+	default:
+		return "", ErrNotFound
+	}
+
+	var id string
+	err := s.db.QueryRow(query, idPengguna).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return id, err
+}
+
+// MARK: Sesi
+
+type mysqlSesi struct {
+	db *sql.DB
+}
+
+func (s *mysqlSesi) Create(sesi Sesi) (string, error) {
+	query := `
+        INSERT INTO sesi (id_pengguna, refresh_token_hash, user_agent, ip_address, expires_date, created_date)
+        VALUES (?, ?, ?, ?, ?, ?)
+    `
+	result, err := s.db.Exec(query,
+		sesi.IdPengguna,
+		sesi.RefreshTokenHash,
+		sesi.UserAgent,
+		sesi.IPAddress,
+		sesi.ExpiresDate,
+		sesi.CreatedDate,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+func (s *mysqlSesi) FindByTokenHash(hash string) (Sesi, error) {
+	query := `
+        SELECT id, id_pengguna, refresh_token_hash, COALESCE(previous_token_hash, ''),
+            user_agent, ip_address, expires_date, COALESCE(revoked_date, ''),
+            created_date, COALESCE(updated_date, '')
+        FROM sesi
+        WHERE refresh_token_hash = ? OR previous_token_hash = ?
+    `
+	var sesi Sesi
+	err := s.db.QueryRow(query, hash, hash).Scan(
+		&sesi.Id,
+		&sesi.IdPengguna,
+		&sesi.RefreshTokenHash,
+		&sesi.PreviousTokenHash,
+		&sesi.UserAgent,
+		&sesi.IPAddress,
+		&sesi.ExpiresDate,
+		&sesi.RevokedDate,
+		&sesi.CreatedDate,
+		&sesi.UpdatedDate,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Sesi{}, ErrNotFound
+	}
+	return sesi, err
+}
+
+func (s *mysqlSesi) Rotate(id, oldHash, newHash, expiresDate string) error {
+	query := `
+        UPDATE sesi
+        SET refresh_token_hash = ?, previous_token_hash = ?, expires_date = ?, updated_date = ?
+        WHERE id = ? AND refresh_token_hash = ? AND revoked_date IS NULL
+    `
+	now := time.Now().Format("2006-01-02 15:04:05")
+	result, err := s.db.Exec(query, newHash, oldHash, expiresDate, now, id, oldHash)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *mysqlSesi) Revoke(id string) error {
+	query := "UPDATE sesi SET revoked_date = ? WHERE id = ? AND revoked_date IS NULL"
+	_, err := s.db.Exec(query, time.Now().Format("2006-01-02 15:04:05"), id)
+	return err
+}
+
+func (s *mysqlSesi) RevokeAllForPengguna(idPengguna string) (int64, error) {
+	return revokeAllForPengguna(s.db, idPengguna)
+}
+
+func (s *mysqlSesi) RevokeAllForPenggunaTx(tx *sql.Tx, idPengguna string) (int64, error) {
+	return revokeAllForPengguna(tx, idPengguna)
+}
+
+// Helper function to revoke the sessions of a pengguna with the pool or a
+// transaction
+func revokeAllForPengguna(db interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}, idPengguna string) (int64, error) {
+	query := "UPDATE sesi SET revoked_date = ? WHERE id_pengguna = ? AND revoked_date IS NULL"
+	result, err := db.Exec(query, time.Now().Format("2006-01-02 15:04:05"), idPengguna)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (s *mysqlSesi) IsRevoked(id string) (bool, error) {
+	var revoked bool
+	query := "SELECT revoked_date IS NOT NULL FROM sesi WHERE id = ?"
+	err := s.db.QueryRow(query, id).Scan(&revoked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, nil
+	}
+	return revoked, err
+}
+
+// MARK: Status Laporan
+
+type mysqlStatusLaporan struct {
+	db *sql.DB
+}
+
+func (s *mysqlStatusLaporan) List() ([]StatusLaporan, error) {
+	query := "SELECT id, status FROM status_laporan ORDER BY id ASC"
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statusList := []StatusLaporan{}
+	for rows.Next() {
+		var status StatusLaporan
+		if err := rows.Scan(&status.Id, &status.Status); err != nil {
+			return nil, err
+		}
+		statusList = append(statusList, status)
+	}
+
+	return statusList, rows.Err()
+}
+
+// MARK: Keluarga
+
+type mysqlKeluarga struct {
+	db   *sql.DB
+	keys *fieldcrypt.Keyring
+}
+
+const keluargaSelect = `
+    SELECT
+        k.id, k.nomor_kk, k.nama_ayah, k.nama_ibu, k.nik_ayah, k.nik_ibu,
+        k.alamat, k.rt, k.rw, k.id_kelurahan,
+        COALESCE(kel.kelurahan, ''), COALESCE(kec.kecamatan, ''),
+        k.koordinat,
+        k.created_id, k.created_date, k.updated_date
+    FROM keluarga k
+    LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
+    LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+    WHERE k.deleted_date IS NULL`
+
+// Helper function to append the filter conditions to a keluarga query
+func (f KeluargaFilter) where(query string, args []any) (string, []any) {
+	if f.CreatedId != "" {
+		query += " AND k.created_id = ?"
+		args = append(args, f.CreatedId)
+	}
+	return query, args
+}
+
+func (s *mysqlKeluarga) Get(id string, filter KeluargaFilter) (Keluarga, error) {
+	query, args := filter.where(keluargaSelect+" AND k.id = ?", []any{id})
+
+	keluarga, err := scanKeluarga(s.db.QueryRow(query, args...), s.keys)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Keluarga{}, ErrNotFound
+	}
+	return keluarga, err
+}
+
+func (s *mysqlKeluarga) List(filter KeluargaFilter) ([]Keluarga, error) {
+	query, args := filter.where(keluargaSelect, nil)
+	query += " ORDER BY k.created_date DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keluargaList := []Keluarga{}
+	for rows.Next() {
+		keluarga, err := scanKeluarga(rows, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		keluargaList = append(keluargaList, keluarga)
+	}
+
+	return keluargaList, rows.Err()
+}
+
+// Helper function to scan a keluargaSelect row and decrypt its NIK and
+// nomor KK
+func scanKeluarga(row interface{ Scan(...any) error }, keys *fieldcrypt.Keyring) (Keluarga, error) {
+	var keluarga Keluarga
+	var koordinat geom.NullGeometry
+	var createdId, updatedDate sql.NullString
+
+	err := row.Scan(
+		&keluarga.Id,
+		&keluarga.NomorKk,
+		&keluarga.NamaAyah,
+		&keluarga.NamaIbu,
+		&keluarga.NikAyah,
+		&keluarga.NikIbu,
+		&keluarga.Alamat,
+		&keluarga.Rt,
+		&keluarga.Rw,
+		&keluarga.IdKelurahan,
+		&keluarga.Kelurahan,
+		&keluarga.Kecamatan,
+		&koordinat,
+		&createdId,
+		&keluarga.CreatedDate,
+		&updatedDate,
+	)
+	if err != nil {
+		return Keluarga{}, err
+	}
+
+	err = keys.DecryptAll("keluarga", keluarga.Id, &keluarga.NomorKk, &keluarga.NikAyah, &keluarga.NikIbu)
+	if err != nil {
+		return Keluarga{}, err
+	}
+
+	if p, ok := koordinat.Point(); ok {
+		keluarga.Koordinat = p
+	}
+	keluarga.CreatedId = createdId.String
+	keluarga.UpdatedDate = updatedDate.String
+
+	return keluarga, nil
+}
+
+// MARK: Balita
+
+type mysqlBalita struct {
+	db   *sql.DB
+	keys *fieldcrypt.Keyring
+}
+
+const balitaSelect = `
+    SELECT
+        b.id, b.id_keluarga, b.nama, b.tanggal_lahir, b.jenis_kelamin,
+        b.berat_lahir, b.tinggi_lahir, b.created_date, b.updated_date,
+        COALESCE(k.nomor_kk, ''), COALESCE(k.nama_ayah, ''), COALESCE(k.nama_ibu, ''),
+        COALESCE(kel.kelurahan, ''), COALESCE(kec.kecamatan, '')
+    FROM balita b
+    LEFT JOIN keluarga k ON b.id_keluarga = k.id
+    LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
+    LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+    WHERE b.deleted_date IS NULL`
+
+// Helper function to append the filter conditions to a balita query
+func (f BalitaFilter) where(query string, args []any) (string, []any) {
+	if f.KeluargaCreatedId != "" {
+		query += " AND k.created_id = ? AND k.deleted_date IS NULL"
+		args = append(args, f.KeluargaCreatedId)
+	}
+	return query, args
+}
+
+func (s *mysqlBalita) Get(id string, filter BalitaFilter) (Balita, error) {
+	query, args := filter.where(balitaSelect+" AND b.id = ?", []any{id})
+
+	balita, err := scanBalita(s.db.QueryRow(query, args...), s.keys)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Balita{}, ErrNotFound
+	}
+	return balita, err
+}
+
+func (s *mysqlBalita) List(filter BalitaFilter) ([]Balita, error) {
+	query, args := filter.where(balitaSelect, nil)
+	query += " ORDER BY b.created_date DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balitaList := []Balita{}
+	for rows.Next() {
+		balita, err := scanBalita(rows, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		balitaList = append(balitaList, balita)
+	}
+
+	return balitaList, rows.Err()
+}
+
+// Helper function to scan a balitaSelect row and decrypt the nomor KK of its
+// keluarga
+func scanBalita(row interface{ Scan(...any) error }, keys *fieldcrypt.Keyring) (Balita, error) {
+	var balita Balita
+	var updatedDate sql.NullString
+
+	err := row.Scan(
+		&balita.Id,
+		&balita.IdKeluarga,
+		&balita.Nama,
+		&balita.TanggalLahir,
+		&balita.JenisKelamin,
+		&balita.BeratLahir,
+		&balita.TinggiLahir,
+		&balita.CreatedDate,
+		&updatedDate,
+		&balita.NomorKk,
+		&balita.NamaAyah,
+		&balita.NamaIbu,
+		&balita.Kelurahan,
+		&balita.Kecamatan,
+	)
+	if err != nil {
+		return Balita{}, err
+	}
+
+	balita.NomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, balita.IdKeluarga, balita.NomorKk)
+	if err != nil {
+		return Balita{}, err
+	}
+
+	balita.UpdatedDate = updatedDate.String
+
+	return balita, nil
+}
+
+// MARK: Intervensi
+
+type mysqlIntervensi struct {
+	db *sql.DB
+}
+
+const intervensiSelect = `
+    SELECT
+        i.id, i.id_balita, COALESCE(b.nama, ''), i.jenis, i.tanggal, i.deskripsi, i.hasil,
+        i.status, i.started_date, i.completed_date, i.cancelled_date,
+        (SELECT COUNT(*) FROM intervensi_petugas ip WHERE ip.id_intervensi = i.id),
+        (SELECT COUNT(*) FROM riwayat_pemeriksaan rp WHERE rp.id_intervensi = i.id AND rp.deleted_date IS NULL),
+        COALESCE(pc.email, ''), COALESCE(pu.email, ''),
+        i.created_date, i.updated_date
+    FROM intervensi i
+    LEFT JOIN balita b ON i.id_balita = b.id AND b.deleted_date IS NULL
+    LEFT JOIN pengguna pc ON i.created_id = pc.id
+    LEFT JOIN pengguna pu ON i.updated_id = pu.id
+    WHERE i.deleted_date IS NULL`
+
+// Helper function to append the filter conditions to an intervensi query
+func (f IntervensiFilter) where(query string, args []any) (string, []any) {
+	if f.Status != "" {
+		query += " AND i.status = ?"
+		args = append(args, f.Status)
+	}
+	if f.IdBalita != "" {
+		query += " AND i.id_balita = ?"
+		args = append(args, f.IdBalita)
+	}
+	return query, args
+}
+
+func (s *mysqlIntervensi) Get(id string, filter IntervensiFilter) (Intervensi, error) {
+	query, args := filter.where(intervensiSelect+" AND i.id = ?", []any{id})
+
+	intervensi, err := scanIntervensi(s.db.QueryRow(query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Intervensi{}, ErrNotFound
+	}
+	return intervensi, err
+}
+
+func (s *mysqlIntervensi) List(filter IntervensiFilter) ([]Intervensi, error) {
+	query, args := filter.where(intervensiSelect, nil)
+	query += " ORDER BY i.tanggal DESC, i.created_date DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	intervensiList := []Intervensi{}
+	for rows.Next() {
+		intervensi, err := scanIntervensi(rows)
+		if err != nil {
+			return nil, err
+		}
+		intervensiList = append(intervensiList, intervensi)
+	}
+
+	return intervensiList, rows.Err()
+}
+
+// Helper function to scan an intervensiSelect row
+func scanIntervensi(row interface{ Scan(...any) error }) (Intervensi, error) {
+	var intervensi Intervensi
+	var startedDate, completedDate, cancelledDate, updatedDate sql.NullString
+
+	err := row.Scan(
+		&intervensi.Id,
+		&intervensi.IdBalita,
+		&intervensi.NamaBalita,
+		&intervensi.Jenis,
+		&intervensi.Tanggal,
+		&intervensi.Deskripsi,
+		&intervensi.Hasil,
+		&intervensi.Status,
+		&startedDate,
+		&completedDate,
+		&cancelledDate,
+		&intervensi.PetugasCount,
+		&intervensi.RiwayatCount,
+		&intervensi.CreatedBy,
+		&intervensi.UpdatedBy,
+		&intervensi.CreatedDate,
+		&updatedDate,
+	)
+	if err != nil {
+		return Intervensi{}, err
+	}
+
+	intervensi.StartedDate = startedDate.String
+	intervensi.CompletedDate = completedDate.String
+	intervensi.CancelledDate = cancelledDate.String
+	intervensi.UpdatedDate = updatedDate.String
+
+	return intervensi, nil
+}
+
+// MARK: Riwayat Pemeriksaan
+
+type mysqlRiwayatPemeriksaan struct {
+	db   *sql.DB
+	keys *fieldcrypt.Keyring
+}
+
+const riwayatPemeriksaanSelect = `
+    SELECT
+        rp.id, rp.id_balita, rp.id_intervensi, rp.id_laporan_masyarakat, rp.tanggal,
+        rp.berat_badan, rp.tinggi_badan, rp.status_gizi, rp.keterangan,
+        rp.zscore_tb_u, rp.zscore_bb_u, rp.zscore_bb_tb,
+        rp.created_date, rp.updated_date,
+        COALESCE(b.nama, ''), COALESCE(b.tanggal_lahir, ''), COALESCE(b.jenis_kelamin, ''),
+        COALESCE(k.id, ''), COALESCE(k.nomor_kk, ''), COALESCE(k.nama_ayah, ''), COALESCE(k.nama_ibu, ''),
+        COALESCE(kel.kelurahan, ''), COALESCE(kec.kecamatan, ''),
+        COALESCE(i.jenis, ''), COALESCE(i.tanggal, ''),
+        COALESCE(lm.id_masyarakat, ''), COALESCE(sl.status, ''), COALESCE(lm.tanggal_laporan, ''),
+        COALESCE(pc.email, ''), COALESCE(pu.email, '')
+    FROM riwayat_pemeriksaan rp
+    LEFT JOIN balita b ON rp.id_balita = b.id AND b.deleted_date IS NULL
+    LEFT JOIN keluarga k ON b.id_keluarga = k.id AND k.deleted_date IS NULL
+    LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
+    LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+    LEFT JOIN intervensi i ON rp.id_intervensi = i.id AND i.deleted_date IS NULL
+    LEFT JOIN laporan_masyarakat lm ON rp.id_laporan_masyarakat = lm.id AND lm.deleted_date IS NULL
+    LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
+    LEFT JOIN pengguna pc ON rp.created_id = pc.id
+    LEFT JOIN pengguna pu ON rp.updated_id = pu.id
+    WHERE rp.deleted_date IS NULL`
+
+// Helper function to append the filter conditions to a riwayat pemeriksaan
+// query
+func (f RiwayatPemeriksaanFilter) where(query string, args []any) (string, []any) {
+	if f.IdBalita != "" {
+		query += " AND rp.id_balita = ?"
+		args = append(args, f.IdBalita)
+	}
+	if f.IdIntervensi != "" {
+		query += " AND rp.id_intervensi = ?"
+		args = append(args, f.IdIntervensi)
+	}
+	if f.IdLaporanMasyarakat != "" {
+		query += " AND rp.id_laporan_masyarakat = ?"
+		args = append(args, f.IdLaporanMasyarakat)
+	}
+	return query, args
+}
+
+func (s *mysqlRiwayatPemeriksaan) Get(id string, filter RiwayatPemeriksaanFilter) (RiwayatPemeriksaan, error) {
+	query, args := filter.where(riwayatPemeriksaanSelect+" AND rp.id = ?", []any{id})
+
+	riwayat, err := scanRiwayatPemeriksaan(s.db.QueryRow(query, args...), s.keys)
+	if errors.Is(err, sql.ErrNoRows) {
+		return RiwayatPemeriksaan{}, ErrNotFound
+	}
+	return riwayat, err
+}
+
+func (s *mysqlRiwayatPemeriksaan) List(filter RiwayatPemeriksaanFilter) ([]RiwayatPemeriksaan, error) {
+	query, args := filter.where(riwayatPemeriksaanSelect, nil)
+	query += " ORDER BY rp.tanggal DESC, rp.created_date DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	riwayatList := []RiwayatPemeriksaan{}
+	for rows.Next() {
+		riwayat, err := scanRiwayatPemeriksaan(rows, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		riwayatList = append(riwayatList, riwayat)
+	}
+
+	return riwayatList, rows.Err()
+}
+
+// Helper function to scan a riwayatPemeriksaanSelect row and decrypt the
+// nomor KK of its keluarga
+func scanRiwayatPemeriksaan(row interface{ Scan(...any) error }, keys *fieldcrypt.Keyring) (RiwayatPemeriksaan, error) {
+	var riwayat RiwayatPemeriksaan
+	var idKeluarga string
+	var zscoreTBU, zscoreBBU, zscoreBBTB sql.NullFloat64
+	var updatedDate sql.NullString
+
+	err := row.Scan(
+		&riwayat.Id,
+		&riwayat.IdBalita,
+		&riwayat.IdIntervensi,
+		&riwayat.IdLaporanMasyarakat,
+		&riwayat.Tanggal,
+		&riwayat.BeratBadan,
+		&riwayat.TinggiBadan,
+		&riwayat.StatusGizi,
+		&riwayat.Keterangan,
+		&zscoreTBU,
+		&zscoreBBU,
+		&zscoreBBTB,
+		&riwayat.CreatedDate,
+		&updatedDate,
+		&riwayat.NamaBalita,
+		&riwayat.TanggalLahirBalita,
+		&riwayat.JenisKelamin,
+		&idKeluarga,
+		&riwayat.NomorKk,
+		&riwayat.NamaAyah,
+		&riwayat.NamaIbu,
+		&riwayat.Kelurahan,
+		&riwayat.Kecamatan,
+		&riwayat.JenisIntervensi,
+		&riwayat.TanggalIntervensi,
+		&riwayat.IdMasyarakat,
+		&riwayat.StatusLaporan,
+		&riwayat.TanggalLaporan,
+		&riwayat.CreatedBy,
+		&riwayat.UpdatedBy,
+	)
+	if err != nil {
+		return RiwayatPemeriksaan{}, err
+	}
+
+	riwayat.NomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, idKeluarga, riwayat.NomorKk)
+	if err != nil {
+		return RiwayatPemeriksaan{}, err
+	}
+
+	if zscoreTBU.Valid {
+		riwayat.ZScoreTBU = &zscoreTBU.Float64
+	}
+	if zscoreBBU.Valid {
+		riwayat.ZScoreBBU = &zscoreBBU.Float64
+	}
+	if zscoreBBTB.Valid {
+		riwayat.ZScoreBBTB = &zscoreBBTB.Float64
+	}
+	riwayat.UpdatedDate = updatedDate.String
+
+	return riwayat, nil
+}
+
+// MARK: Laporan Masyarakat
+
+type mysqlLaporanMasyarakat struct {
+	db   *sql.DB
+	keys *fieldcrypt.Keyring
+}
+
+const laporanMasyarakatSelect = `
+    SELECT
+        lm.id, COALESCE(lm.id_masyarakat, ''), COALESCE(m.nama, ''), COALESCE(p.email, ''),
+        lm.id_balita, COALESCE(b.nama, ''),
+        COALESCE(k.id, ''), COALESCE(k.nama_ayah, ''), COALESCE(k.nama_ibu, ''), COALESCE(k.nomor_kk, ''), COALESCE(k.alamat, ''),
+        COALESCE(kel.kelurahan, ''), COALESCE(kec.kecamatan, ''),
+        lm.id_status_laporan, COALESCE(sl.status, ''),
+        lm.tanggal_laporan, lm.hubungan_dengan_balita, lm.nomor_hp_pelapor, lm.nomor_hp_keluarga_balita,
+        lm.created_date, lm.updated_date
+    FROM laporan_masyarakat lm
+    LEFT JOIN balita b ON lm.id_balita = b.id
+    LEFT JOIN keluarga k ON b.id_keluarga = k.id
+    LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
+    LEFT JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+    LEFT JOIN status_laporan sl ON lm.id_status_laporan = sl.id
+    LEFT JOIN masyarakat m ON lm.id_masyarakat = m.id
+    LEFT JOIN pengguna p ON m.id_pengguna = p.id
+    WHERE lm.deleted_date IS NULL`
+
+// Helper function to append the filter conditions to a laporan masyarakat
+// query
+func (f LaporanMasyarakatFilter) where(query string, args []any) (string, []any) {
+	if f.IdMasyarakat != "" {
+		query += " AND lm.id_masyarakat = ? AND b.deleted_date IS NULL AND k.deleted_date IS NULL AND k.id IS NOT NULL"
+		args = append(args, f.IdMasyarakat)
+	}
+	return query, args
+}
+
+func (s *mysqlLaporanMasyarakat) Get(id string, filter LaporanMasyarakatFilter) (LaporanMasyarakat, error) {
+	query, args := filter.where(laporanMasyarakatSelect+" AND lm.id = ?", []any{id})
+
+	laporan, err := scanLaporanMasyarakat(s.db.QueryRow(query, args...), s.keys)
+	if errors.Is(err, sql.ErrNoRows) {
+		return LaporanMasyarakat{}, ErrNotFound
+	}
+	return laporan, err
+}
+
+func (s *mysqlLaporanMasyarakat) List(filter LaporanMasyarakatFilter) ([]LaporanMasyarakat, error) {
+	query, args := filter.where(laporanMasyarakatSelect, nil)
+	query += " ORDER BY lm.created_date DESC"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	laporanList := []LaporanMasyarakat{}
+	for rows.Next() {
+		laporan, err := scanLaporanMasyarakat(rows, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		laporanList = append(laporanList, laporan)
+	}
+
+	return laporanList, rows.Err()
+}
+
+// Helper function to scan a laporanMasyarakatSelect row and decrypt the
+// nomor KK and the phone numbers
+func scanLaporanMasyarakat(row interface{ Scan(...any) error }, keys *fieldcrypt.Keyring) (LaporanMasyarakat, error) {
+	var laporan LaporanMasyarakat
+	var idKeluarga string
+	var updatedDate sql.NullString
+
+	err := row.Scan(
+		&laporan.Id,
+		&laporan.IdMasyarakat,
+		&laporan.NamaPelapor,
+		&laporan.EmailPelapor,
+		&laporan.IdBalita,
+		&laporan.NamaBalita,
+		&idKeluarga,
+		&laporan.NamaAyah,
+		&laporan.NamaIbu,
+		&laporan.NomorKk,
+		&laporan.Alamat,
+		&laporan.Kelurahan,
+		&laporan.Kecamatan,
+		&laporan.IdStatusLaporan,
+		&laporan.StatusLaporan,
+		&laporan.TanggalLaporan,
+		&laporan.HubunganDenganBalita,
+		&laporan.NomorHpPelapor,
+		&laporan.NomorHpKeluargaBalita,
+		&laporan.CreatedDate,
+		&updatedDate,
+	)
+	if err != nil {
+		return LaporanMasyarakat{}, err
+	}
+
+	laporan.NomorKk, err = keys.Decrypt(fieldcrypt.NomorKk, idKeluarga, laporan.NomorKk)
+	if err != nil {
+		return LaporanMasyarakat{}, err
+	}
+	err = keys.DecryptAll("laporan_masyarakat", laporan.Id, &laporan.NomorHpPelapor, &laporan.NomorHpKeluargaBalita)
+	if err != nil {
+		return LaporanMasyarakat{}, err
+	}
+
+	laporan.UpdatedDate = updatedDate.String
+
+	return laporan, nil
+}
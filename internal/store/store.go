@@ -0,0 +1,357 @@
+// Package store holds the canonical queries for the domain entities behind
+// repository interfaces.
+//
+// Handlers depend on the interfaces only, so the same query is shared by the
+// admin, community and health worker APIs and can be replaced by the
+// in-memory implementation (see NewMemory) in tests.
+package store
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// ErrNotFound is returned when a requested row does not exist, is soft
+// deleted, or is not visible with the given filter.
+var ErrNotFound = errors.New("store: not found")
+
+// Store groups every repository.
+type Store struct {
+	// Keys encrypts and decrypts the personal data columns, for the queries
+	// that are not behind a repository.
+	Keys *fieldcrypt.Keyring
+
+	Wilayah            WilayahRepository
+	StatusLaporan      StatusLaporanRepository
+	Pengguna           PenggunaRepository
+	Sesi               SesiRepository
+	Keluarga           KeluargaRepository
+	Balita             BalitaRepository
+	Intervensi         IntervensiRepository
+	RiwayatPemeriksaan RiwayatPemeriksaanRepository
+	LaporanMasyarakat  LaporanMasyarakatRepository
+}
+
+// MARK: Wilayah
+
+// Kecamatan is a row of the kecamatan table.
+type Kecamatan struct {
+	Id        string
+	Kecamatan string
+}
+
+// Kelurahan is a row of the kelurahan table with its kecamatan name.
+type Kelurahan struct {
+	Id          string
+	IdKecamatan string
+	Kelurahan   string
+	Kecamatan   string
+}
+
+// WilayahRepository reads the administrative areas.
+type WilayahRepository interface {
+	// ListKecamatan returns every kecamatan ordered by name.
+	ListKecamatan() ([]Kecamatan, error)
+
+	// ListKelurahan returns the kelurahan of one kecamatan ordered by name,
+	// or every kelurahan ordered by kecamatan and name when idKecamatan is empty.
+	ListKelurahan(idKecamatan string) ([]Kelurahan, error)
+}
+
+// MARK: Pengguna
+
+// Profile links a pengguna to its masyarakat or petugas kesehatan row.
+type Profile struct {
+	IdPengguna string
+	Role       string // "masyarakat" or "petugas kesehatan"
+	Id         string // masyarakat.id or petugas_kesehatan.id
+}
+
+// PenggunaRepository reads pengguna accounts.
+type PenggunaRepository interface {
+	// GetProfileId returns the masyarakat id or petugas kesehatan id of a
+	// pengguna with the given role, or ErrNotFound.
+	GetProfileId(idPengguna, role string) (string, error)
+}
+
+// MARK: Sesi
+
+// Sesi is a login session of a pengguna. Access tokens carry its id and
+// stop working when it is revoked, the refresh token is only stored as a
+// SHA-256 hash.
+type Sesi struct {
+	Id                string
+	IdPengguna        string
+	RefreshTokenHash  string // hash of the current refresh token
+	PreviousTokenHash string // hash of the refresh token it replaced, if any
+	UserAgent         string
+	IPAddress         string
+	ExpiresDate       string
+	RevokedDate       string // empty while the session is active
+	CreatedDate       string
+	UpdatedDate       string
+}
+
+// SesiRepository stores login sessions.
+type SesiRepository interface {
+	// Create stores a new session and returns its id.
+	Create(sesi Sesi) (string, error)
+
+	// FindByTokenHash returns the session whose current or previous refresh
+	// token has the given hash, or ErrNotFound.
+	FindByTokenHash(hash string) (Sesi, error)
+
+	// Rotate replaces the refresh token of an active session whose current
+	// token hash is oldHash and moves its expiry, or returns ErrNotFound if
+	// the token was rotated or revoked in the meantime.
+	Rotate(id, oldHash, newHash, expiresDate string) error
+
+	// Revoke revokes a session. Revoking a revoked session is a no-op.
+	Revoke(id string) error
+
+	// RevokeAllForPengguna revokes every active session of a pengguna and
+	// returns the number of sessions it revoked.
+	RevokeAllForPengguna(idPengguna string) (int64, error)
+
+	// RevokeAllForPenggunaTx is RevokeAllForPengguna in tx, so the sessions
+	// are only revoked when the transaction commits.
+	RevokeAllForPenggunaTx(tx *sql.Tx, idPengguna string) (int64, error)
+
+	// IsRevoked reports whether a session was revoked. Unknown sessions
+	// count as revoked.
+	IsRevoked(id string) (bool, error)
+}
+
+// MARK: Status Laporan
+
+// StatusLaporan is a row of the status_laporan table.
+type StatusLaporan struct {
+	Id     string
+	Status string
+}
+
+// StatusLaporanRepository reads the status laporan master data.
+type StatusLaporanRepository interface {
+	// List returns every status laporan ordered by id.
+	List() ([]StatusLaporan, error)
+}
+
+// MARK: Keluarga
+
+// Keluarga is an active keluarga with its location names.
+type Keluarga struct {
+	Id          string
+	NomorKk     string
+	NamaAyah    string
+	NamaIbu     string
+	NikAyah     string
+	NikIbu      string
+	Alamat      string
+	Rt          string
+	Rw          string
+	IdKelurahan string
+	Kelurahan   string
+	Kecamatan   string
+	Koordinat   [2]float64 // [longitude, latitude]
+	CreatedId   string
+	CreatedDate string
+	UpdatedDate string
+}
+
+// KeluargaFilter narrows the keluarga visible to a query.
+type KeluargaFilter struct {
+	// CreatedId limits the result to keluarga created by this pengguna.
+	CreatedId string
+}
+
+// KeluargaRepository reads keluarga.
+type KeluargaRepository interface {
+	// Get returns an active keluarga, or ErrNotFound.
+	Get(id string, filter KeluargaFilter) (Keluarga, error)
+
+	// List returns the active keluarga, newest first.
+	List(filter KeluargaFilter) ([]Keluarga, error)
+}
+
+// MARK: Balita
+
+// Balita is an active balita with its keluarga and location names.
+type Balita struct {
+	Id           string
+	IdKeluarga   string
+	NomorKk      string
+	NamaAyah     string
+	NamaIbu      string
+	Nama         string
+	TanggalLahir string
+	JenisKelamin string
+	BeratLahir   string
+	TinggiLahir  string
+	Kelurahan    string
+	Kecamatan    string
+	CreatedDate  string
+	UpdatedDate  string
+}
+
+// BalitaFilter narrows the balita visible to a query.
+type BalitaFilter struct {
+	// KeluargaCreatedId limits the result to balita of active keluarga
+	// created by this pengguna.
+	KeluargaCreatedId string
+}
+
+// BalitaRepository reads balita.
+type BalitaRepository interface {
+	// Get returns an active balita, or ErrNotFound.
+	Get(id string, filter BalitaFilter) (Balita, error)
+
+	// List returns the active balita, newest first.
+	List(filter BalitaFilter) ([]Balita, error)
+}
+
+// MARK: Intervensi
+
+// Intervensi is an active intervensi with its balita name and the number
+// of assigned petugas kesehatan and recorded riwayat pemeriksaan.
+type Intervensi struct {
+	Id            string
+	IdBalita      string
+	NamaBalita    string
+	Jenis         string
+	Tanggal       string
+	Deskripsi     string
+	Hasil         string
+	Status        string // planned, in_progress, completed or cancelled
+	StartedDate   string
+	CompletedDate string
+	CancelledDate string
+	PetugasCount  int
+	RiwayatCount  int
+	CreatedBy     string // email of the pengguna who created it
+	UpdatedBy     string // email of the pengguna who last updated it
+	CreatedDate   string
+	UpdatedDate   string
+}
+
+// IntervensiFilter narrows the intervensi visible to a query.
+type IntervensiFilter struct {
+	// Status limits the result to intervensi with this status.
+	Status string
+
+	// IdBalita limits the result to intervensi of this balita.
+	IdBalita string
+}
+
+// IntervensiRepository reads intervensi.
+type IntervensiRepository interface {
+	// Get returns an active intervensi, or ErrNotFound.
+	Get(id string, filter IntervensiFilter) (Intervensi, error)
+
+	// List returns the active intervensi, latest tanggal first.
+	List(filter IntervensiFilter) ([]Intervensi, error)
+}
+
+// MARK: Riwayat Pemeriksaan
+
+// RiwayatPemeriksaan is an active riwayat pemeriksaan with its balita,
+// keluarga, intervensi and laporan masyarakat data.
+type RiwayatPemeriksaan struct {
+	Id                  string
+	IdBalita            string
+	NamaBalita          string
+	TanggalLahirBalita  string
+	JenisKelamin        string
+	NamaAyah            string
+	NamaIbu             string
+	NomorKk             string
+	Kelurahan           string
+	Kecamatan           string
+	IdIntervensi        string
+	JenisIntervensi     string
+	TanggalIntervensi   string
+	IdLaporanMasyarakat string
+	IdMasyarakat        string // empty when the laporan was made by an admin
+	StatusLaporan       string
+	TanggalLaporan      string
+	Tanggal             string
+	BeratBadan          string
+	TinggiBadan         string
+	StatusGizi          string
+	ZScoreTBU           *float64 // nil for balita outside the WHO range
+	ZScoreBBU           *float64
+	ZScoreBBTB          *float64
+	Keterangan          string
+	CreatedBy           string // email of the pengguna who recorded it
+	UpdatedBy           string // email of the pengguna who last updated it
+	CreatedDate         string
+	UpdatedDate         string
+}
+
+// RiwayatPemeriksaanFilter narrows the riwayat pemeriksaan visible to a
+// query.
+type RiwayatPemeriksaanFilter struct {
+	// IdBalita limits the result to riwayat pemeriksaan of this balita.
+	IdBalita string
+
+	// IdIntervensi limits the result to riwayat pemeriksaan recorded for
+	// this intervensi.
+	IdIntervensi string
+
+	// IdLaporanMasyarakat limits the result to riwayat pemeriksaan recorded
+	// for this laporan masyarakat.
+	IdLaporanMasyarakat string
+}
+
+// RiwayatPemeriksaanRepository reads riwayat pemeriksaan.
+type RiwayatPemeriksaanRepository interface {
+	// Get returns an active riwayat pemeriksaan, or ErrNotFound.
+	Get(id string, filter RiwayatPemeriksaanFilter) (RiwayatPemeriksaan, error)
+
+	// List returns the active riwayat pemeriksaan, latest tanggal first.
+	List(filter RiwayatPemeriksaanFilter) ([]RiwayatPemeriksaan, error)
+}
+
+// MARK: Laporan Masyarakat
+
+// LaporanMasyarakat is an active laporan masyarakat with its balita,
+// keluarga, status and pelapor data.
+type LaporanMasyarakat struct {
+	Id                    string
+	IdMasyarakat          string // empty when the laporan was made by an admin
+	NamaPelapor           string
+	EmailPelapor          string
+	IdBalita              string
+	NamaBalita            string
+	NamaAyah              string
+	NamaIbu               string
+	NomorKk               string
+	Alamat                string
+	Kelurahan             string
+	Kecamatan             string
+	IdStatusLaporan       string
+	StatusLaporan         string
+	TanggalLaporan        string
+	HubunganDenganBalita  string
+	NomorHpPelapor        string
+	NomorHpKeluargaBalita string
+	CreatedDate           string
+	UpdatedDate           string
+}
+
+// LaporanMasyarakatFilter narrows the laporan masyarakat visible to a query.
+type LaporanMasyarakatFilter struct {
+	// IdMasyarakat limits the result to laporan made by this masyarakat
+	// about an active balita of an active keluarga.
+	IdMasyarakat string
+}
+
+// LaporanMasyarakatRepository reads laporan masyarakat.
+type LaporanMasyarakatRepository interface {
+	// Get returns an active laporan masyarakat, or ErrNotFound.
+	Get(id string, filter LaporanMasyarakatFilter) (LaporanMasyarakat, error)
+
+	// List returns the active laporan masyarakat, newest first.
+	List(filter LaporanMasyarakatFilter) ([]LaporanMasyarakat, error)
+}
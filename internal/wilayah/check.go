@@ -0,0 +1,128 @@
+package wilayah
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/geom"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// Mismatch is an active keluarga whose id_kelurahan does not match its
+// koordinat.
+type Mismatch struct {
+	IdKeluarga  string
+	IdKelurahan string // stored kelurahan, empty when NULL
+	Kelurahan   string
+
+	// Located is the kelurahan of the koordinat, unset when Outside.
+	Located Kelurahan
+	Outside bool
+
+	// Invalid is set with Outside when the koordinat is not a
+	// [longitude, latitude] pair within range, see CheckKoordinat.
+	Invalid   bool
+	Koordinat [2]float64
+}
+
+// FindMismatches returns the active keluarga whose koordinat lies in
+// another kelurahan than the stored one, or outside every kelurahan.
+// Keluarga without koordinat are skipped. Koordinat out of range, e.g.
+// still in [latitude, longitude] order, are not located and are returned
+// as Invalid.
+func FindMismatches(ctx context.Context, db *sql.DB) ([]Mismatch, error) {
+	query := `
+        SELECT k.id, k.id_kelurahan, kel.kelurahan, k.koordinat
+        FROM keluarga k
+        LEFT JOIN kelurahan kel ON k.id_kelurahan = kel.id
+        WHERE k.deleted_date IS NULL AND k.koordinat IS NOT NULL
+        ORDER BY k.id
+    `
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	type keluarga struct {
+		id, idKelurahan, kelurahan string
+		koordinat                  [2]float64
+	}
+	var list []keluarga
+	for rows.Next() {
+		var k keluarga
+		var idKelurahan, kelurahan sql.NullString
+		var koordinat geom.NullGeometry
+		if err := rows.Scan(&k.id, &idKelurahan, &kelurahan, &koordinat); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		k.idKelurahan, k.kelurahan = idKelurahan.String, kelurahan.String
+		if p, ok := koordinat.Point(); ok {
+			k.koordinat = p
+		}
+		list = append(list, k)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var mismatches []Mismatch
+	for _, k := range list {
+		if k.koordinat == [2]float64{0, 0} {
+			continue
+		}
+
+		m := Mismatch{IdKeluarga: k.id, IdKelurahan: k.idKelurahan, Kelurahan: k.kelurahan, Koordinat: k.koordinat}
+		if CheckKoordinat(k.koordinat) != nil {
+			m.Outside, m.Invalid = true, true
+			mismatches = append(mismatches, m)
+			continue
+		}
+		m.Located, err = Locate(db, k.koordinat)
+		if err == ErrOutside {
+			m.Outside = true
+			mismatches = append(mismatches, m)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("wilayah: keluarga %s: %w", k.id, err)
+		}
+		if m.Located.Id != k.idKelurahan {
+			mismatches = append(mismatches, m)
+		}
+	}
+	return mismatches, nil
+}
+
+// Fix stores the located kelurahan of a mismatch and records the change in
+// the audit log. Mismatches outside every kelurahan cannot be fixed, their
+// koordinat has to be corrected by hand.
+func Fix(ctx context.Context, db *sql.DB, m Mismatch) error {
+	if m.Outside {
+		return fmt.Errorf("wilayah: keluarga %s is outside every kelurahan", m.IdKeluarga)
+	}
+
+	return object.RunInTx(db, func(tx *sql.Tx) error {
+		before, err := audit.Snapshot(tx, "keluarga", m.IdKeluarga)
+		if err != nil {
+			return err
+		}
+
+		updateQuery := "UPDATE keluarga SET id_kelurahan = ?, updated_date = ? WHERE id = ? AND deleted_date IS NULL"
+		_, err = tx.ExecContext(ctx, updateQuery, m.Located.Id, time.Now().Format("2006-01-02 15:04:05"), m.IdKeluarga)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordChange(tx, audit.Entry{
+			Action:   audit.ActionUpdate,
+			Entity:   "keluarga",
+			EntityId: m.IdKeluarga,
+			Detail:   "Kelurahan resolved from koordinat by the check-kelurahan command",
+		}, before)
+	})
+}
@@ -0,0 +1,109 @@
+// Package wilayah places keluarga in their kelurahan from their koordinat.
+//
+// The kelurahan boundaries are the MULTIPOLYGON in kelurahan.area. A
+// koordinat belongs to the kelurahan containing it; koordinat just outside
+// every boundary, within Tolerance, belong to the nearest kelurahan, which
+// absorbs GPS error and the simplified boundaries along the city limits.
+package wilayah
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/rifqidaiva/stunting-web/internal/object"
+)
+
+// Tolerance is the distance in degrees, about 100 meters, within which a
+// koordinat outside every kelurahan is placed in the nearest one.
+const Tolerance = 0.001
+
+// ErrOutside is returned when a koordinat is farther than Tolerance from
+// every kelurahan.
+var ErrOutside = errors.New("wilayah: koordinat is outside every kelurahan")
+
+// Queryer is implemented by *sql.DB and *sql.Tx.
+type Queryer interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// Kelurahan is the kelurahan found for a koordinat.
+type Kelurahan struct {
+	Id          string
+	Kelurahan   string
+	IdKecamatan string
+	Kecamatan   string
+	Inside      bool // false when the koordinat is within Tolerance outside it
+}
+
+// CheckKoordinat checks that koordinat is a [longitude, latitude] pair
+// within range. [0, 0] stands for no koordinat and passes. A pair in the
+// [latitude, longitude] order of Leaflet, e.g. [-6.72, 108.55], fails on
+// its latitude.
+func CheckKoordinat(koordinat [2]float64) error {
+	longitude, latitude := koordinat[0], koordinat[1]
+	if !(longitude >= -180 && longitude <= 180) {
+		return object.NewFieldError("koordinat", "koordinat must be [longitude, latitude], longitude %v is not between -180 and 180", longitude)
+	}
+	if !(latitude >= -90 && latitude <= 90) {
+		return object.NewFieldError("koordinat", "koordinat must be [longitude, latitude], latitude %v is not between -90 and 90", latitude)
+	}
+	return nil
+}
+
+// Locate returns the kelurahan containing koordinat, or the nearest one
+// within Tolerance. Koordinat on the border of two kelurahan belong to the
+// one with the lowest id.
+func Locate(q Queryer, koordinat [2]float64) (Kelurahan, error) {
+	point := object.ToWKT(koordinat)
+	query := `
+        SELECT kel.id, kel.kelurahan, kec.id, kec.kecamatan, ST_Distance(kel.area, ST_GeomFromText(?)) AS jarak
+        FROM kelurahan kel
+        JOIN kecamatan kec ON kel.id_kecamatan = kec.id
+        HAVING jarak <= ?
+        ORDER BY jarak, kel.id
+        LIMIT 1
+    `
+
+	var k Kelurahan
+	var distance float64
+	err := q.QueryRow(query, point, Tolerance).Scan(&k.Id, &k.Kelurahan, &k.IdKecamatan, &k.Kecamatan, &distance)
+	if err == sql.ErrNoRows {
+		return Kelurahan{}, ErrOutside
+	}
+	if err != nil {
+		return Kelurahan{}, err
+	}
+	k.Inside = distance == 0
+	return k, nil
+}
+
+// ResolveKelurahan returns the kelurahan of a keluarga: the one located
+// from its koordinat when idKelurahan is empty, or idKelurahan after
+// checking that the koordinat lies in it. Without koordinat idKelurahan is
+// required and returned unchecked.
+//
+// Mismatches are returned as *object.FieldError, database errors as
+// *object.HTTPError.
+func ResolveKelurahan(q Queryer, idKelurahan string, koordinat [2]float64) (string, error) {
+	if koordinat == [2]float64{0, 0} {
+		if idKelurahan == "" {
+			return "", object.NewFieldError("id_kelurahan", "id kelurahan is required when koordinat is not given")
+		}
+		return idKelurahan, nil
+	}
+
+	located, err := Locate(q, koordinat)
+	if err == ErrOutside {
+		return "", object.NewFieldError("koordinat", "koordinat is outside every kelurahan of the city")
+	}
+	if err != nil {
+		return "", object.NewHTTPError(http.StatusInternalServerError, "Failed to resolve kelurahan")
+	}
+
+	if idKelurahan != "" && idKelurahan != located.Id {
+		return "", object.NewFieldError("id_kelurahan", "koordinat lies in kelurahan %s, kecamatan %s (id %s), not in the chosen kelurahan",
+			located.Kelurahan, located.Kecamatan, located.Id)
+	}
+	return located.Id, nil
+}
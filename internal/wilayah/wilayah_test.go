@@ -0,0 +1,28 @@
+package wilayah
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCheckKoordinat(t *testing.T) {
+	tests := []struct {
+		koordinat [2]float64
+		valid     bool
+	}{
+		{[2]float64{108.5492, -6.7064}, true},
+		{[2]float64{0, 0}, true},
+		{[2]float64{-180, 90}, true},
+		// [latitude, longitude] as sent by Leaflet
+		{[2]float64{-6.7064, 108.5492}, false},
+		{[2]float64{180.5, 0}, false},
+		{[2]float64{0, -90.5}, false},
+		{[2]float64{math.NaN(), 0}, false},
+		{[2]float64{0, math.Inf(1)}, false},
+	}
+	for _, tt := range tests {
+		if err := CheckKoordinat(tt.koordinat); (err == nil) != tt.valid {
+			t.Errorf("CheckKoordinat(%v) = %v, want valid %v", tt.koordinat, err, tt.valid)
+		}
+	}
+}
@@ -1,18 +1,39 @@
 package main
 
 import (
+	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
-	"net/http"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
-	_ "github.com/rifqidaiva/stunting-web/docs" // Import for Swagger documentation
-	"github.com/rifqidaiva/stunting-web/internal/api/admin"
-	"github.com/rifqidaiva/stunting-web/internal/api/auth"
-	"github.com/rifqidaiva/stunting-web/internal/api/community"
-	healthworker "github.com/rifqidaiva/stunting-web/internal/api/health_worker"
+	"github.com/rifqidaiva/stunting-web/internal/config"
 	"github.com/rifqidaiva/stunting-web/internal/object"
-	httpSwagger "github.com/swaggo/http-swagger"
 )
 
+// command is a subcommand of the binary.
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
+
+// commands lists the subcommands in the order shown by the usage text.
+var commands = []command{
+	{"serve", "start the HTTP server (default)", runServe},
+	{"migrate", "apply, revert or list the schema migrations", runMigrate},
+	{"seed", "load the demo data into an empty database", runSeed},
+	{"create-admin", "create an admin account", runCreateAdmin},
+	{"import", "bulk import keluarga or balita from a CSV file", runImport},
+	{"convert-legacy", "copy the data of a legacy stuntingdb database", runConvertLegacy},
+	{"rotate-keys", "re-encrypt the personal data with the active encryption key", runRotateKeys},
+	{"dedup", "find duplicate keluarga and balita for review", runDedup},
+	{"check-kelurahan", "report or fix keluarga whose kelurahan does not match their koordinat", runCheckKelurahan},
+}
+
 // @title Stunting Web API
 // @version 0.0.2
 // @description API for managing stunting data
@@ -24,126 +45,61 @@ import (
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 // @host localhost:8080
 func main() {
-	// Authentication
-	http.HandleFunc("/api/auth/login", auth.Login)
-	http.HandleFunc("/api/auth/register", auth.Register)
-	http.HandleFunc("/api/auth/register_admin", auth.RegisterAdmin)
-	http.HandleFunc("/api/auth/profile", auth.UserProfileGet)
-
-	/* ===================
-	   Admin API Endpoints
-	====================== */
-
-	// SKPD Management
-	http.HandleFunc("/api/admin/skpd/get", admin.SKPDGet)
-	http.HandleFunc("/api/admin/skpd/insert", admin.SKPDInsert)
-	http.HandleFunc("/api/admin/skpd/update", admin.SKPDUpdate)
-	http.HandleFunc("/api/admin/skpd/delete", admin.SKPDDelete)
-	http.HandleFunc("/api/admin/skpd/restore", admin.SKPDRestore)
-
-	// Petugas Kesehatan Management
-	http.HandleFunc("/api/admin/petugas-kesehatan/get", admin.PetugasKesehatanGet)
-	http.HandleFunc("/api/admin/petugas-kesehatan/insert", admin.PetugasKesehatanInsert)
-	http.HandleFunc("/api/admin/petugas-kesehatan/update", admin.PetugasKesehatanUpdate)
-	http.HandleFunc("/api/admin/petugas-kesehatan/delete", admin.PetugasKesehatanDelete)
-	http.HandleFunc("/api/admin/petugas-kesehatan/restore", admin.PetugasKesehatanRestore)
-
-	// Keluarga Management
-	http.HandleFunc("/api/admin/keluarga/get", admin.KeluargaGet)
-	http.HandleFunc("/api/admin/keluarga/insert", admin.KeluargaInsert)
-	http.HandleFunc("/api/admin/keluarga/update", admin.KeluargaUpdate)
-	http.HandleFunc("/api/admin/keluarga/delete", admin.KeluargaDelete)
-	http.HandleFunc("/api/admin/keluarga/restore", admin.KeluargaRestore)
-
-	// Balita Management
-	http.HandleFunc("/api/admin/balita/get", admin.BalitaGet)
-	http.HandleFunc("/api/admin/balita/insert", admin.BalitaInsert)
-	http.HandleFunc("/api/admin/balita/update", admin.BalitaUpdate)
-	http.HandleFunc("/api/admin/balita/delete", admin.BalitaDelete)
-	http.HandleFunc("/api/admin/balita/restore", admin.BalitaRestore)
-
-	// Laporan Masyarakat Management
-	http.HandleFunc("/api/admin/laporan-masyarakat/get", admin.LaporanMasyarakatGet)
-	http.HandleFunc("/api/admin/laporan-masyarakat/insert", admin.LaporanMasyarakatInsert)
-	http.HandleFunc("/api/admin/laporan-masyarakat/update", admin.LaporanMasyarakatUpdate)
-	http.HandleFunc("/api/admin/laporan-masyarakat/delete", admin.LaporanMasyarakatDelete)
-	http.HandleFunc("/api/admin/laporan-masyarakat/restore", admin.LaporanMasyarakatRestore)
-
-	// Intervensi Management
-	http.HandleFunc("/api/admin/intervensi/get", admin.IntervensiGet)
-	http.HandleFunc("/api/admin/intervensi/insert", admin.IntervensiInsert)
-	http.HandleFunc("/api/admin/intervensi/update", admin.IntervensiUpdate)
-	http.HandleFunc("/api/admin/intervensi/delete", admin.IntervensiDelete)
-	http.HandleFunc("/api/admin/intervensi/restore", admin.IntervensiRestore)
-
-	// Riwayat Pemeriksaan Management
-	http.HandleFunc("/api/admin/riwayat-pemeriksaan/get", admin.RiwayatPemeriksaanGet)
-	http.HandleFunc("/api/admin/riwayat-pemeriksaan/insert", admin.RiwayatPemeriksaanInsert)
-	http.HandleFunc("/api/admin/riwayat-pemeriksaan/update", admin.RiwayatPemeriksaanUpdate)
-	http.HandleFunc("/api/admin/riwayat-pemeriksaan/delete", admin.RiwayatPemeriksaanDelete)
-	http.HandleFunc("/api/admin/riwayat-pemeriksaan/restore", admin.RiwayatPemeriksaanRestore)
-
-	// Intervensi Petugas (Junction Table)
-	http.HandleFunc("/api/admin/intervensi-petugas/get", admin.IntervensiPetugasGet)
-	http.HandleFunc("/api/admin/intervensi-petugas/assign", admin.IntervensiPetugasAssign)
-	http.HandleFunc("/api/admin/intervensi-petugas/remove", admin.IntervensiPetugasRemove)
-
-	// Master Data Management
-	http.HandleFunc("/api/admin/master-status-laporan", admin.StatusLaporanGet)
-	http.HandleFunc("/api/admin/master-masyarakat", admin.MasyarakatGet)
-	http.HandleFunc("/api/admin/master-kecamatan", admin.KecamatanGet)
-	http.HandleFunc("/api/admin/master-kelurahan", admin.KelurahanGet)
-	http.HandleFunc("/api/admin/master-skpd", admin.SkpdMasterGet)
-
-	// GeoJSON Data Management
-	http.HandleFunc("/api/admin/geojson-kecamatan", admin.KecamatanGeoJSONGet)
-	http.HandleFunc("/api/admin/geojson-kelurahan", admin.KelurahanGeoJSONGet)
-	http.HandleFunc("/api/admin/geojson-balita-points", admin.BalitaPointsGeoJSONGet)
-
-	/* ========================
-	   Masyarakat API Endpoints
-	=========================== */
-
-	// Masyarakat - Keluarga Management (untuk input data keluarga balita yang dilaporkan)
-	http.HandleFunc("/api/community/keluarga/insert", community.KeluargaInsert)
-	http.HandleFunc("/api/community/keluarga/get", community.KeluargaGet)
-	http.HandleFunc("/api/community/keluarga/update", community.KeluargaUpdate)
-
-	// Masyarakat - Balita Management (untuk input data balita yang dilaporkan)
-	http.HandleFunc("/api/community/balita/insert", community.BalitaInsert)
-	http.HandleFunc("/api/community/balita/get", community.BalitaGet)
-	http.HandleFunc("/api/community/balita/update", community.BalitaUpdate)
-
-	// Masyarakat - Laporan Management (untuk melaporkan balita)
-	http.HandleFunc("/api/community/laporan/insert", community.LaporanInsert)
-	http.HandleFunc("/api/community/laporan/get", community.LaporanGet)
-
-	// Masyarakat - Master Data (untuk dropdown/reference)
-	http.HandleFunc("/api/community/kelurahan/get", community.KelurahanGet)
-	http.HandleFunc("/api/community/kecamatan/get", community.KecamatanGet)
-	http.HandleFunc("/api/community/status-laporan/get", community.StatusLaporanGet)
-
-	/* ===============================
-	   Petugas Kesehatan API Endpoints
-	================================== */
+	// Without a command the binary serves, as it did before it had commands
+	args := os.Args[1:]
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		usage()
+		return
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		args = append([]string{"serve"}, args...)
+	}
+
+	name := args[0]
+
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(args[1:])
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-	// Petugas Kesehatan - Mengambil Penugasan
-	http.HandleFunc("/api/health-worker/assignment/get", healthworker.AssignmentGet)
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
+}
 
-	// API test endpoint
-	http.HandleFunc("/api/test", func(w http.ResponseWriter, r *http.Request) {
-		response := object.NewResponse(http.StatusOK, "Test API is working", nil)
-		if err := response.WriteJson(w); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-	})
+// usage prints the available commands.
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s <command> [flags]\n\ncommands:\n", filepath.Base(os.Args[0]))
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-16s%s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(os.Stderr, "\nrun \"<command> -h\" to list the flags of a command")
+}
 
-	// Swagger documentation endpoint
-	http.HandleFunc("/swagger/", httpSwagger.WrapHandler)
-	http.HandleFunc("/swagger/doc.json", httpSwagger.Handler(
-		httpSwagger.URL("http://localhost:8080/swagger/doc.json"),
-	))
+// configFlag adds the -config flag shared by every command.
+func configFlag(flags *flag.FlagSet) *string {
+	return flags.String("config", os.Getenv("STUNTING_CONFIG"), "JSON config file, defaults to $STUNTING_CONFIG")
+}
 
-	fmt.Println("starting web server at http://localhost:8080/")
-	http.ListenAndServe("localhost:8080", nil)
+// openDatabase loads the configuration and connects to its database.
+func openDatabase(configPath string) (*config.Config, *sql.DB, error) {
+	cfg, err := config.LoadFile(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := object.ConnectDb(cfg.Database)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, db, nil
 }
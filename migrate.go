@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/rifqidaiva/stunting-web/internal/migrate"
+)
+
+// runMigrate runs the migrate command: "up" applies every pending
+// migration and then encrypts and indexes the personal data like
+// rotate-keys, "down" reverts the latest one and "status" lists them.
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: migrate [-config file] up|down|status")
+	}
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migrate.New(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch flags.Arg(0) {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("database is up to date")
+		}
+
+		// Rows written before the encryption migrations, or by an older
+		// version, are encrypted, bound to their row and indexed
+		if err := rotateKeys(db, cfg.Encryption); err != nil {
+			return err
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			fmt.Println("no migration to revert")
+			return nil
+		}
+		fmt.Printf("reverted %04d_%s\n", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED")
+		for _, status := range statuses {
+			applied := "pending"
+			if status.Applied {
+				applied = status.AppliedDate
+			}
+			fmt.Fprintf(tw, "%04d\t%s\t%s\n", status.Version, status.Name, applied)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, use up, down or status", flags.Arg(0))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+
+	"github.com/rifqidaiva/stunting-web/internal/config"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+)
+
+// runRotateKeys runs the rotate-keys command, which re-wraps every
+// encrypted value with the active key, encrypts the values written before
+// encryption was enabled and rebuilds the blind indexes. Run it after
+// changing encryption.active_key or encryption.index_key; keys that are no
+// longer active can be removed from the config once it succeeded.
+func runRotateKeys(args []string) error {
+	flags := flag.NewFlagSet("rotate-keys", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return rotateKeys(db, cfg.Encryption)
+}
+
+// rotateKeys brings every encrypted value up to date with the key ring and
+// prints the rows updated per table.
+func rotateKeys(db *sql.DB, cfg config.EncryptionConfig) error {
+	keys, err := fieldcrypt.NewKeyring(cfg)
+	if err != nil {
+		return err
+	}
+
+	results, err := fieldcrypt.RotateAll(context.Background(), db, keys)
+	for _, result := range results {
+		fmt.Printf("%s: %d of %d rows updated\n", result.Table, result.Updated, result.Checked)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("every value is encrypted with key %q\n", keys.ActiveKey())
+	return nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/rifqidaiva/stunting-web/internal/config"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/seed"
+)
+
+// runSeed runs the seed command, which loads the demo data. It refuses to
+// run in production so demo accounts never reach a real installation.
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if cfg.Env == config.EnvProduction {
+		return errors.New("seed: demo data must not be loaded in production")
+	}
+
+	keys, err := fieldcrypt.NewKeyring(cfg.Encryption)
+	if err != nil {
+		return err
+	}
+
+	if err := seed.Demo(context.Background(), db); err != nil {
+		return err
+	}
+
+	// The demo script holds plaintext NIK, nomor KK and phone numbers
+	if _, err := fieldcrypt.RotateAll(context.Background(), db, keys); err != nil {
+		return err
+	}
+	fmt.Println("demo data loaded")
+	return nil
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/rifqidaiva/stunting-web/docs" // Swagger documentation
+	"github.com/rifqidaiva/stunting-web/internal/api/admin"
+	"github.com/rifqidaiva/stunting-web/internal/api/auth"
+	"github.com/rifqidaiva/stunting-web/internal/api/community"
+	healthworker "github.com/rifqidaiva/stunting-web/internal/api/health_worker"
+	"github.com/rifqidaiva/stunting-web/internal/audit"
+	"github.com/rifqidaiva/stunting-web/internal/config"
+	"github.com/rifqidaiva/stunting-web/internal/fieldcrypt"
+	"github.com/rifqidaiva/stunting-web/internal/middleware"
+	"github.com/rifqidaiva/stunting-web/internal/migrate"
+	"github.com/rifqidaiva/stunting-web/internal/object"
+	"github.com/rifqidaiva/stunting-web/internal/store"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// runServe runs the serve command, which starts the HTTP server.
+func runServe(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	configPath := configFlag(flags)
+	addr := flags.String("addr", "", "listen address, overrides server.addr of the config, e.g. :8080")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, db, err := openDatabase(*configPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if *addr != "" {
+		cfg.Server.Addr = *addr
+	}
+
+	migrator, err := migrate.New(db)
+	if err != nil {
+		return err
+	}
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		log.Printf("warning: %d database migrations are pending, run \"migrate up\"", len(pending))
+	} else {
+		// Rows without a blind index would slip through the duplicate
+		// checks, and unbound values cannot be read
+		unrotated, err := fieldcrypt.Unrotated(context.Background(), db)
+		if err != nil {
+			return err
+		}
+		if len(unrotated) > 0 {
+			var tables []string
+			for _, table := range slices.Sorted(maps.Keys(unrotated)) {
+				tables = append(tables, fmt.Sprintf("%d rows of %s", unrotated[table], table))
+			}
+			return fmt.Errorf("%s hold personal data that is not encrypted and indexed yet, run \"rotate-keys\"", strings.Join(tables, ", "))
+		}
+	}
+
+	keys, err := fieldcrypt.NewKeyring(cfg.Encryption)
+	if err != nil {
+		return err
+	}
+
+	st := store.NewMySQL(db, keys)
+
+	authService := auth.NewService(cfg, db, st)
+	adminService := admin.NewService(cfg, db, st)
+	communityService := community.NewService(cfg, db, st)
+	healthWorkerService := healthworker.NewService(cfg, db, st)
+
+	// Every route declares its method and the roles allowed to call it,
+	// see middleware.Router
+	router := middleware.NewRouter(cfg.JWT.Secret, st.Pengguna, st.Sesi)
+
+	// Authentication
+	router.HandlePublic(http.MethodPost, "/api/auth/login", authService.Login)
+	router.HandlePublic(http.MethodPost, "/api/auth/register", authService.Register)
+	router.HandleOptional(http.MethodPost, "/api/auth/register_admin", authService.RegisterAdmin, middleware.RoleAdmin)
+	router.HandlePublic(http.MethodPost, "/api/auth/refresh", authService.Refresh)
+	router.Handle(http.MethodPost, "/api/auth/logout", authService.Logout, middleware.RoleAdmin, middleware.RoleMasyarakat, middleware.RolePetugasKesehatan)
+	router.Handle(http.MethodGet, "/api/auth/profile", authService.UserProfileGet, middleware.RoleAdmin, middleware.RoleMasyarakat, middleware.RolePetugasKesehatan)
+
+	/* ===================
+	   Admin API Endpoints
+	====================== */
+
+	// Pengguna Management
+	router.Handle(http.MethodPost, "/api/admin/pengguna/revoke-sessions", adminService.PenggunaRevokeSessions, middleware.RoleAdmin)
+
+	// Audit Log
+	router.Handle(http.MethodGet, "/api/admin/audit-log/get", adminService.AuditLogGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/access-log/get", adminService.AccessLogGet, middleware.RoleAdmin)
+
+	// SKPD Management
+	router.Handle(http.MethodGet, "/api/admin/skpd/get", adminService.SKPDGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/skpd/insert", adminService.SKPDInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/skpd/update", adminService.SKPDUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/skpd/delete", adminService.SKPDDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/skpd/restore", adminService.SKPDRestore, middleware.RoleAdmin)
+
+	// Petugas Kesehatan Management
+	router.Handle(http.MethodGet, "/api/admin/petugas-kesehatan/get", adminService.PetugasKesehatanGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/petugas-kesehatan/insert", adminService.PetugasKesehatanInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/petugas-kesehatan/update", adminService.PetugasKesehatanUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/petugas-kesehatan/delete", adminService.PetugasKesehatanDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/petugas-kesehatan/restore", adminService.PetugasKesehatanRestore, middleware.RoleAdmin)
+
+	// Keluarga Management
+	router.Handle(http.MethodGet, "/api/admin/keluarga/get", adminService.KeluargaGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/keluarga/insert", adminService.KeluargaInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/keluarga/update", adminService.KeluargaUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/keluarga/delete", adminService.KeluargaDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/keluarga/restore", adminService.KeluargaRestore, middleware.RoleAdmin)
+
+	// Balita Management
+	router.Handle(http.MethodGet, "/api/admin/balita/get", adminService.BalitaGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/balita/insert", adminService.BalitaInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/balita/update", adminService.BalitaUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/balita/delete", adminService.BalitaDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/balita/restore", adminService.BalitaRestore, middleware.RoleAdmin)
+
+	// Laporan Masyarakat Management
+	router.Handle(http.MethodGet, "/api/admin/laporan-masyarakat/get", adminService.LaporanMasyarakatGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/laporan-masyarakat/insert", adminService.LaporanMasyarakatInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/laporan-masyarakat/update", adminService.LaporanMasyarakatUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/laporan-masyarakat/delete", adminService.LaporanMasyarakatDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/laporan-masyarakat/restore", adminService.LaporanMasyarakatRestore, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/laporan-masyarakat/advance", adminService.LaporanMasyarakatAdvance, middleware.RoleAdmin)
+
+	// Intervensi Management
+	router.Handle(http.MethodGet, "/api/admin/intervensi/get", adminService.IntervensiGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/intervensi/insert", adminService.IntervensiInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/intervensi/update", adminService.IntervensiUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/intervensi/delete", adminService.IntervensiDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/intervensi/restore", adminService.IntervensiRestore, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/intervensi/status", adminService.IntervensiStatusUpdate, middleware.RoleAdmin)
+
+	// Riwayat Pemeriksaan Management
+	router.Handle(http.MethodGet, "/api/admin/riwayat-pemeriksaan/get", adminService.RiwayatPemeriksaanGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/riwayat-pemeriksaan/insert", adminService.RiwayatPemeriksaanInsert, middleware.RoleAdmin)
+	router.Handle(http.MethodPut, "/api/admin/riwayat-pemeriksaan/update", adminService.RiwayatPemeriksaanUpdate, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/riwayat-pemeriksaan/delete", adminService.RiwayatPemeriksaanDelete, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/riwayat-pemeriksaan/restore", adminService.RiwayatPemeriksaanRestore, middleware.RoleAdmin)
+
+	// Intervensi Petugas (Junction Table)
+	router.Handle(http.MethodGet, "/api/admin/intervensi-petugas/get", adminService.IntervensiPetugasGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/intervensi-petugas/assign", adminService.IntervensiPetugasAssign, middleware.RoleAdmin)
+	router.Handle(http.MethodDelete, "/api/admin/intervensi-petugas/remove", adminService.IntervensiPetugasRemove, middleware.RoleAdmin)
+
+	// Duplicate Review
+	router.Handle(http.MethodGet, "/api/admin/duplikat/get", adminService.DuplikatGet, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/duplikat/merge", adminService.DuplikatMerge, middleware.RoleAdmin)
+	router.Handle(http.MethodPost, "/api/admin/duplikat/reject", adminService.DuplikatReject, middleware.RoleAdmin)
+
+	// Master Data Management
+	router.Handle(http.MethodGet, "/api/admin/master-status-laporan", adminService.StatusLaporanGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/master-masyarakat", adminService.MasyarakatGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/master-kecamatan", adminService.KecamatanGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/master-kelurahan", adminService.KelurahanGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/master-skpd", adminService.SkpdMasterGet, middleware.RoleAdmin)
+
+	// GeoJSON Data Management
+	router.Handle(http.MethodGet, "/api/admin/geojson-kecamatan", adminService.KecamatanGeoJSONGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/geojson-kelurahan", adminService.KelurahanGeoJSONGet, middleware.RoleAdmin)
+	router.Handle(http.MethodGet, "/api/admin/geojson-balita-points", adminService.BalitaPointsGeoJSONGet, middleware.RoleAdmin)
+
+	// Vector Tiles at /api/tiles/{layer}/{z}/{x}/{y}.mvt, a wildcard has to be a
+	// whole segment so TileGet checks the .mvt extension of y
+	router.Handle(http.MethodGet, "/api/tiles/{layer}/{z}/{x}/{y}", adminService.TileGet, middleware.RoleAdmin, middleware.RolePetugasKesehatan)
+
+	/* ========================
+	   Masyarakat API Endpoints
+	=========================== */
+
+	// Masyarakat - Keluarga Management (untuk input data keluarga balita yang dilaporkan)
+	router.Handle(http.MethodPost, "/api/community/keluarga/insert", communityService.KeluargaInsert, middleware.RoleMasyarakat)
+	router.Handle(http.MethodGet, "/api/community/keluarga/get", communityService.KeluargaGet, middleware.RoleMasyarakat)
+	router.Handle(http.MethodPut, "/api/community/keluarga/update", communityService.KeluargaUpdate, middleware.RoleMasyarakat)
+
+	// Masyarakat - Balita Management (untuk input data balita yang dilaporkan)
+	router.Handle(http.MethodPost, "/api/community/balita/insert", communityService.BalitaInsert, middleware.RoleMasyarakat)
+	router.Handle(http.MethodGet, "/api/community/balita/get", communityService.BalitaGet, middleware.RoleMasyarakat)
+	router.Handle(http.MethodPut, "/api/community/balita/update", communityService.BalitaUpdate, middleware.RoleMasyarakat)
+
+	// Masyarakat - Laporan Management (untuk melaporkan balita)
+	router.Handle(http.MethodPost, "/api/community/laporan/insert", communityService.LaporanInsert, middleware.RoleMasyarakat)
+	router.Handle(http.MethodGet, "/api/community/laporan/get", communityService.LaporanGet, middleware.RoleMasyarakat)
+
+	// Masyarakat - Master Data (untuk dropdown/reference)
+	router.Handle(http.MethodGet, "/api/community/kelurahan/get", communityService.KelurahanGet, middleware.RoleMasyarakat)
+	router.Handle(http.MethodGet, "/api/community/kecamatan/get", communityService.KecamatanGet, middleware.RoleMasyarakat)
+	router.Handle(http.MethodGet, "/api/community/status-laporan/get", communityService.StatusLaporanGet, middleware.RoleMasyarakat)
+
+	/* ===============================
+	   Petugas Kesehatan API Endpoints
+	================================== */
+
+	// Petugas Kesehatan - Mengambil Penugasan
+	router.Handle(http.MethodGet, "/api/health-worker/assignment/get", healthWorkerService.AssignmentGet, middleware.RolePetugasKesehatan)
+
+	// Petugas Kesehatan - Melaporkan Progres Penugasan
+	router.Handle(http.MethodPut, "/api/health-worker/assignment/update", healthWorkerService.AssignmentUpdate, middleware.RolePetugasKesehatan)
+	router.Handle(http.MethodPost, "/api/health-worker/assignment/complete", healthWorkerService.AssignmentComplete, middleware.RolePetugasKesehatan)
+
+	// Petugas Kesehatan - Mencatat Riwayat Pemeriksaan
+	router.Handle(http.MethodPost, "/api/health-worker/riwayat-pemeriksaan/insert", healthWorkerService.RiwayatPemeriksaanInsert, middleware.RolePetugasKesehatan)
+
+	// API test endpoint
+	router.HandlePublic(http.MethodGet, "/api/test", func(w http.ResponseWriter, r *http.Request) {
+		response := object.NewResponse(http.StatusOK, "Test API is working", nil)
+		if err := response.WriteJson(w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	// Swagger documentation endpoint
+	if publicURL, err := url.Parse(cfg.Server.PublicURL); err == nil && publicURL.Host != "" {
+		docs.SwaggerInfo.Host = publicURL.Host
+		docs.SwaggerInfo.Schemes = []string{publicURL.Scheme}
+	}
+	router.Mount("/swagger/", httpSwagger.Handler(
+		httpSwagger.URL(strings.TrimSuffix(cfg.Server.PublicURL, "/")+"/swagger/doc.json"),
+	))
+
+	fmt.Printf("starting web server at %s/ (env: %s)\n", strings.TrimSuffix(cfg.Server.PublicURL, "/"), cfg.Env)
+	return http.ListenAndServe(cfg.Server.Addr, withCORS(cfg.CORS, middleware.WithRequestId(router)))
+}
+
+// withCORS adds the CORS headers for the configured origins and answers
+// preflight requests. Requests from other origins are passed through
+// without CORS headers, so browsers will block the response.
+func withCORS(cors config.CORSConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && (slices.Contains(cors.AllowedOrigins, "*") || slices.Contains(cors.AllowedOrigins, origin)) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, "+audit.PurposeHeader)
+			w.Header().Set("Access-Control-Expose-Headers", middleware.RequestIdHeader)
+			w.Header().Add("Vary", "Origin")
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
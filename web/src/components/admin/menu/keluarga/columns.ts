@@ -24,7 +24,7 @@ export interface Keluarga {
   id_kelurahan: string
   kelurahan: string
   kecamatan: string
-  koordinat: [number, number]
+  koordinat: [number, number] // [longitude, latitude]
   created_date: string
   updated_date?: string
 }
@@ -133,8 +133,8 @@ export const columns: ColumnDef<Keluarga>[] = [
     cell: ({ row }) => {
       const koordinat = row.getValue("koordinat") as [number, number]
       return h("div", { class: "font-mono text-xs" }, [
-        h("div", {}, `${koordinat[1].toFixed(6)}`), // Longitude
-        h("div", {}, `${koordinat[0].toFixed(6)}`), // Latitude
+        h("div", {}, `${koordinat[0].toFixed(6)}`), // Longitude
+        h("div", {}, `${koordinat[1].toFixed(6)}`), // Latitude
       ])
     },
     meta: {
@@ -82,25 +82,62 @@ const authUtils = {
   // Clear authentication data
   clearAuth(): void {
     localStorage.removeItem("auth_token")
+    localStorage.removeItem("refresh_token")
     localStorage.removeItem("user_data")
   },
 
-  // Verify token with API
-  async verifyTokenWithAPI(): Promise<ProfileResponse | null> {
-    const token = this.getToken()
-    if (!token) return null
+  // Exchange the refresh token for a new access token
+  async refreshToken(): Promise<boolean> {
+    const refreshToken = localStorage.getItem("refresh_token")
+    if (!refreshToken) return false
 
     try {
-      const response = await fetch("/api/auth/profile", {
-        method: "GET",
+      const response = await fetch("/api/auth/refresh", {
+        method: "POST",
         headers: {
-          Authorization: `Bearer ${token}`,
           "Content-Type": "application/json",
         },
+        body: JSON.stringify({ refresh_token: refreshToken }),
       })
 
       const data = await response.json()
 
+      if (!response.ok || data.status_code !== 200) {
+        console.error("Token refresh failed:", data.message)
+        return false
+      }
+
+      localStorage.setItem("auth_token", data.data.token)
+      localStorage.setItem("refresh_token", data.data.refresh_token)
+      return true
+    } catch (error) {
+      console.error("Error refreshing token:", error)
+      return false
+    }
+  },
+
+  // Verify token with API
+  async verifyTokenWithAPI(): Promise<ProfileResponse | null> {
+    if (!this.getToken()) return null
+
+    try {
+      const fetchProfile = () =>
+        fetch("/api/auth/profile", {
+          method: "GET",
+          headers: {
+            Authorization: `Bearer ${this.getToken()}`,
+            "Content-Type": "application/json",
+          },
+        })
+
+      // Access tokens are short-lived, renew an expired one once
+      let response = await fetchProfile()
+      if (response.status === 401 && (await this.refreshToken())) {
+        response = await fetchProfile()
+      }
+
+      const data = await response.json()
+
       if (!response.ok || data.status_code !== 200) {
         console.error("Token verification failed:", data.message)
         this.clearAuth()